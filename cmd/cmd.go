@@ -46,7 +46,7 @@ func Run() error {
 		Action: func(c *cli.Context) error {
 			return nil
 		},
-		Commands: []*cli.Command{api(cfg, log), migrate(cfg, log)},
+		Commands: []*cli.Command{api(cfg, log), migrate(cfg, log), importShifts(log)},
 		Flags: []cli.Flag{
 			&cli.StringFlag{
 				Name:        "log-level",
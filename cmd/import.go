@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/urfave/cli/v2"
+	"github.com/webitel/webitel-go-kit/logging/wlog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+)
+
+// importOptions holds flags of the import command.
+type importOptions struct {
+	address           string
+	token             string
+	workingScheduleId int64
+	file              string
+	mode              string
+	dryRun            bool
+}
+
+// importShifts imports agent shifts from a CSV or XLSX roster through the API of a running WFM server,
+// so the roster goes through the same checks and permissions as the API requests.
+func importShifts(log *wlog.Logger) *cli.Command {
+	opts := &importOptions{}
+
+	return &cli.Command{
+		Name:    "import",
+		Aliases: []string{"i"},
+		Usage:   "Import agent shifts of a working schedule from a CSV or XLSX roster",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "address",
+				Usage:       "WFM API server address",
+				Value:       "127.0.0.1:10031",
+				Destination: &opts.address,
+				EnvVars:     []string{"WFM_ADDRESS"},
+			},
+			&cli.StringFlag{
+				Name:        "token",
+				Usage:       "access token of the user, that imports shifts",
+				Required:    true,
+				Destination: &opts.token,
+				EnvVars:     []string{"WEBITEL_ACCESS_TOKEN"},
+			},
+			&cli.Int64Flag{
+				Name:        "working-schedule",
+				Usage:       "working schedule id",
+				Required:    true,
+				Destination: &opts.workingScheduleId,
+				Aliases:     []string{"w"},
+			},
+			&cli.StringFlag{
+				Name:        "file",
+				Usage:       "roster file path, the format is defined by the .csv or .xlsx extension",
+				Required:    true,
+				Destination: &opts.file,
+				Aliases:     []string{"f"},
+			},
+			&cli.StringFlag{
+				Name:        "mode",
+				Usage:       "handling of agent days, that already have a shift: fail, skip_existing, overwrite or append",
+				Value:       "fail",
+				Destination: &opts.mode,
+			},
+			&cli.BoolFlag{
+				Name:        "dry-run",
+				Usage:       "validate the roster without writing shifts",
+				Destination: &opts.dryRun,
+			},
+		},
+		Action: func(c *cli.Context) error {
+			return runImport(c, log, opts)
+		},
+	}
+}
+
+func runImport(c *cli.Context, log *wlog.Logger, opts *importOptions) error {
+	mode, ok := pb.ShiftConflictMode_value["SHIFT_CONFLICT_MODE_"+strings.ToUpper(opts.mode)]
+	if !ok {
+		return fmt.Errorf("unknown mode %q", opts.mode)
+	}
+
+	format := pb.SpreadsheetFormat_SPREADSHEET_FORMAT_UNSPECIFIED
+	switch strings.ToLower(filepath.Ext(opts.file)) {
+	case ".csv":
+		format = pb.SpreadsheetFormat_SPREADSHEET_FORMAT_CSV
+	case ".xlsx":
+		format = pb.SpreadsheetFormat_SPREADSHEET_FORMAT_XLSX
+	}
+
+	file, err := os.ReadFile(opts.file)
+	if err != nil {
+		return err
+	}
+
+	conn, err := grpc.NewClient(opts.address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	ctx := metadata.AppendToOutgoingContext(c.Context, "X-Webitel-Access", opts.token)
+	out, err := pb.NewAgentWorkingScheduleServiceClient(conn).ImportAgentsWorkingScheduleShifts(ctx, &pb.ImportAgentsWorkingScheduleShiftsRequest{
+		WorkingScheduleId: opts.workingScheduleId,
+		File:              file,
+		Format:            format,
+		DryRun:            opts.dryRun,
+		Mode:              pb.ShiftConflictMode(mode),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range out.Errors {
+		log.Error("invalid roster row", wlog.Int64("row", e.Row), wlog.String("column", e.Column), wlog.String("error", e.Message))
+	}
+
+	if len(out.Errors) > 0 {
+		return fmt.Errorf("roster has %d errors within %d rows, nothing is imported", len(out.Errors), out.Rows)
+	}
+
+	log.Info("imported roster", wlog.Int64("rows", out.Rows), wlog.Any("dry_run", opts.dryRun),
+		wlog.Int("agents", len(out.Items)), wlog.Int("created", countDates(out.Created)),
+		wlog.Int("replaced", countDates(out.Replaced)), wlog.Int("skipped", countDates(out.Skipped)),
+	)
+
+	return nil
+}
+
+func countDates(in []*pb.AgentScheduleDates) int {
+	var count int
+	for _, d := range in {
+		count += len(d.Dates)
+	}
+
+	return count
+}
@@ -94,27 +94,52 @@ func initHandlers(cmdResources *resources, forecastStore cluster.ForecastStore)
 	serviceAgentWorkingConditions := service.NewAgentWorkingConditions(agentWorkingConditions, client)
 	handlerAgentWorkingConditions := handler.NewAgentWorkingConditions(serverServer, serviceAgentWorkingConditions)
 	agentAbsence := storage.NewAgentAbsence(store, manager)
+	absenceType := storage.NewAbsenceType(store)
 	audit := cmdResources.audit
-	serviceAgentAbsence := service.NewAgentAbsence(agentAbsence, audit, client)
+	serviceAgentAbsence := service.NewAgentAbsence(agentAbsence, absenceType, agentWorkingConditions, audit, client)
 	handlerAgentAbsence := handler.NewAgentAbsence(serverServer, serviceAgentAbsence)
 	forecastCalculation := storage.NewForecastCalculation(store, manager, forecastStore)
 	serviceForecastCalculation := service.NewForecastCalculation(forecastCalculation)
 	handlerForecastCalculation := handler.NewForecastCalculation(serverServer, serviceForecastCalculation)
 	workingSchedule := storage.NewWorkingSchedule(store, manager)
-	serviceWorkingSchedule := service.NewWorkingSchedule(workingSchedule, client)
-	handlerWorkingSchedule := handler.NewWorkingSchedule(serverServer, serviceWorkingSchedule)
 	agentWorkingSchedule := storage.NewAgentWorkingSchedule(store, manager)
-	serviceAgentWorkingSchedule := service.NewAgentWorkingSchedule(agentWorkingSchedule, workingSchedule, client)
+	serviceWorkingSchedule := service.NewWorkingSchedule(workingSchedule, agentWorkingSchedule, agentWorkingConditions, workingCondition, shiftTemplate, pauseTemplate, client, serviceForecastCalculation)
+	handlerWorkingSchedule := handler.NewWorkingSchedule(serverServer, serviceWorkingSchedule)
+	rosterPattern := storage.NewRosterPattern(store)
+	serviceAgentWorkingSchedule := service.NewAgentWorkingSchedule(agentWorkingSchedule, workingSchedule, agentWorkingConditions, workingCondition, pauseTemplate, shiftTemplate, rosterPattern, serviceWorkingSchedule, client)
 	handlerAgentWorkingSchedule := handler.NewAgentWorkingSchedule(serverServer, serviceAgentWorkingSchedule)
+	timeOffRequest := storage.NewTimeOffRequest(store)
+	agent := storage.NewAgent(store)
+	serviceTimeOffRequest := service.NewTimeOffRequest(timeOffRequest, agent, absenceType, serviceAgentAbsence, client)
+	handlerTimeOffRequest := handler.NewTimeOffRequest(serverServer, serviceTimeOffRequest)
+	serviceAbsenceType := service.NewAbsenceType(absenceType)
+	handlerAbsenceType := handler.NewAbsenceType(serverServer, serviceAbsenceType)
+	agentCalendar := storage.NewAgentCalendar(store)
+	serviceAgentCalendar := service.NewAgentCalendar(agentCalendar, agent, client)
+	handlerAgentCalendar := handler.NewAgentCalendar(serverServer, serviceAgentCalendar)
+	shiftSwap := storage.NewShiftSwap(store)
+	serviceShiftSwap := service.NewShiftSwap(shiftSwap, agent, workingSchedule, agentWorkingSchedule, agentWorkingConditions, workingCondition, client)
+	handlerShiftSwap := handler.NewShiftSwap(serverServer, serviceShiftSwap)
+	workingScheduleSnapshot := storage.NewWorkingScheduleSnapshot(store)
+	serviceWorkingScheduleSnapshot := service.NewWorkingScheduleSnapshot(workingScheduleSnapshot, workingSchedule, agentWorkingSchedule)
+	handlerWorkingScheduleSnapshot := handler.NewWorkingScheduleSnapshot(serverServer, serviceWorkingScheduleSnapshot)
+	serviceRosterPattern := service.NewRosterPattern(rosterPattern)
+	handlerRosterPattern := handler.NewRosterPattern(serverServer, serviceRosterPattern)
 	handlers := &handler.Handlers{
-		PauseTemplate:          handlerPauseTemplate,
-		ShiftTemplate:          handlerShiftTemplate,
-		WorkingCondition:       handlerWorkingCondition,
-		AgentWorkingConditions: handlerAgentWorkingConditions,
-		AgentAbsence:           handlerAgentAbsence,
-		ForecastCalculation:    handlerForecastCalculation,
-		WorkingSchedule:        handlerWorkingSchedule,
-		AgentWorkingSchedule:   handlerAgentWorkingSchedule,
+		PauseTemplate:           handlerPauseTemplate,
+		ShiftTemplate:           handlerShiftTemplate,
+		WorkingCondition:        handlerWorkingCondition,
+		AgentWorkingConditions:  handlerAgentWorkingConditions,
+		AgentAbsence:            handlerAgentAbsence,
+		ForecastCalculation:     handlerForecastCalculation,
+		WorkingSchedule:         handlerWorkingSchedule,
+		AgentWorkingSchedule:    handlerAgentWorkingSchedule,
+		TimeOffRequest:          handlerTimeOffRequest,
+		AbsenceType:             handlerAbsenceType,
+		AgentCalendar:           handlerAgentCalendar,
+		ShiftSwap:               handlerShiftSwap,
+		WorkingScheduleSnapshot: handlerWorkingScheduleSnapshot,
+		RosterPattern:           handlerRosterPattern,
 	}
 	return handlers, nil
 }
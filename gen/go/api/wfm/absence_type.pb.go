@@ -0,0 +1,1136 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: absence_type.proto
+
+package wfm
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AbsenceAllowance int32
+
+const (
+	AbsenceAllowance_ABSENCE_ALLOWANCE_UNSPECIFIED AbsenceAllowance = 0
+	// Vacation days of the agent working condition.
+	AbsenceAllowance_ABSENCE_ALLOWANCE_VACATION AbsenceAllowance = 1
+	// Sick leaves of the agent working condition.
+	AbsenceAllowance_ABSENCE_ALLOWANCE_SICK_LEAVES AbsenceAllowance = 2
+)
+
+// Enum value maps for AbsenceAllowance.
+var (
+	AbsenceAllowance_name = map[int32]string{
+		0: "ABSENCE_ALLOWANCE_UNSPECIFIED",
+		1: "ABSENCE_ALLOWANCE_VACATION",
+		2: "ABSENCE_ALLOWANCE_SICK_LEAVES",
+	}
+	AbsenceAllowance_value = map[string]int32{
+		"ABSENCE_ALLOWANCE_UNSPECIFIED": 0,
+		"ABSENCE_ALLOWANCE_VACATION":    1,
+		"ABSENCE_ALLOWANCE_SICK_LEAVES": 2,
+	}
+)
+
+func (x AbsenceAllowance) Enum() *AbsenceAllowance {
+	p := new(AbsenceAllowance)
+	*p = x
+	return p
+}
+
+func (x AbsenceAllowance) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AbsenceAllowance) Descriptor() protoreflect.EnumDescriptor {
+	return file_absence_type_proto_enumTypes[0].Descriptor()
+}
+
+func (AbsenceAllowance) Type() protoreflect.EnumType {
+	return &file_absence_type_proto_enumTypes[0]
+}
+
+func (x AbsenceAllowance) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AbsenceAllowance.Descriptor instead.
+func (AbsenceAllowance) EnumDescriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{0}
+}
+
+type CreateAbsenceTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *AbsenceTypeItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CreateAbsenceTypeRequest) Reset() {
+	*x = CreateAbsenceTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAbsenceTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAbsenceTypeRequest) ProtoMessage() {}
+
+func (x *CreateAbsenceTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAbsenceTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateAbsenceTypeRequest) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateAbsenceTypeRequest) GetItem() *AbsenceTypeItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type CreateAbsenceTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *AbsenceTypeItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CreateAbsenceTypeResponse) Reset() {
+	*x = CreateAbsenceTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAbsenceTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAbsenceTypeResponse) ProtoMessage() {}
+
+func (x *CreateAbsenceTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAbsenceTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateAbsenceTypeResponse) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAbsenceTypeResponse) GetItem() *AbsenceTypeItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ReadAbsenceTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *ReadAbsenceTypeRequest) Reset() {
+	*x = ReadAbsenceTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAbsenceTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAbsenceTypeRequest) ProtoMessage() {}
+
+func (x *ReadAbsenceTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAbsenceTypeRequest.ProtoReflect.Descriptor instead.
+func (*ReadAbsenceTypeRequest) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadAbsenceTypeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReadAbsenceTypeRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ReadAbsenceTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *AbsenceTypeItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ReadAbsenceTypeResponse) Reset() {
+	*x = ReadAbsenceTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAbsenceTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAbsenceTypeResponse) ProtoMessage() {}
+
+func (x *ReadAbsenceTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAbsenceTypeResponse.ProtoReflect.Descriptor instead.
+func (*ReadAbsenceTypeResponse) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReadAbsenceTypeResponse) GetItem() *AbsenceTypeItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type SearchAbsenceTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page   *int32   `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Size   *int32   `protobuf:"varint,2,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	Q      *string  `protobuf:"bytes,3,opt,name=q,proto3,oneof" json:"q,omitempty"`
+	Sort   *string  `protobuf:"bytes,4,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	Fields []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *SearchAbsenceTypeRequest) Reset() {
+	*x = SearchAbsenceTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAbsenceTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAbsenceTypeRequest) ProtoMessage() {}
+
+func (x *SearchAbsenceTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAbsenceTypeRequest.ProtoReflect.Descriptor instead.
+func (*SearchAbsenceTypeRequest) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchAbsenceTypeRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *SearchAbsenceTypeRequest) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *SearchAbsenceTypeRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *SearchAbsenceTypeRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+func (x *SearchAbsenceTypeRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SearchAbsenceTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*AbsenceTypeItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next  bool               `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *SearchAbsenceTypeResponse) Reset() {
+	*x = SearchAbsenceTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchAbsenceTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAbsenceTypeResponse) ProtoMessage() {}
+
+func (x *SearchAbsenceTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAbsenceTypeResponse.ProtoReflect.Descriptor instead.
+func (*SearchAbsenceTypeResponse) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchAbsenceTypeResponse) GetItems() []*AbsenceTypeItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchAbsenceTypeResponse) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+type UpdateAbsenceTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *AbsenceTypeItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *UpdateAbsenceTypeRequest) Reset() {
+	*x = UpdateAbsenceTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAbsenceTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAbsenceTypeRequest) ProtoMessage() {}
+
+func (x *UpdateAbsenceTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAbsenceTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAbsenceTypeRequest) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateAbsenceTypeRequest) GetItem() *AbsenceTypeItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type UpdateAbsenceTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *AbsenceTypeItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *UpdateAbsenceTypeResponse) Reset() {
+	*x = UpdateAbsenceTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAbsenceTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAbsenceTypeResponse) ProtoMessage() {}
+
+func (x *UpdateAbsenceTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAbsenceTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAbsenceTypeResponse) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateAbsenceTypeResponse) GetItem() *AbsenceTypeItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteAbsenceTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteAbsenceTypeRequest) Reset() {
+	*x = DeleteAbsenceTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAbsenceTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAbsenceTypeRequest) ProtoMessage() {}
+
+func (x *DeleteAbsenceTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAbsenceTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAbsenceTypeRequest) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteAbsenceTypeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteAbsenceTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteAbsenceTypeResponse) Reset() {
+	*x = DeleteAbsenceTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAbsenceTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAbsenceTypeResponse) ProtoMessage() {}
+
+func (x *DeleteAbsenceTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAbsenceTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAbsenceTypeResponse) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteAbsenceTypeResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// AbsenceTypeItem is a domain-defined type of agent absence, e.g. vacation, training or business trip.
+type AbsenceTypeItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId    int64         `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	CreatedAt   int64         `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy   *LookupEntity `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt   int64         `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy   *LookupEntity `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Name        string        `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string       `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Colour in the hex format, e.g. #4caf50, grey by default.
+	Color string `protobuf:"bytes,9,opt,name=color,proto3" json:"color,omitempty"`
+	Paid  bool   `protobuf:"varint,10,opt,name=paid,proto3" json:"paid,omitempty"`
+	// Absence days are deducted from the agent balance.
+	CountsAgainstBalance bool `protobuf:"varint,11,opt,name=counts_against_balance,json=countsAgainstBalance,proto3" json:"counts_against_balance,omitempty"`
+	// Working condition allowance, that the agent balance is granted by.
+	// Used only if counts_against_balance is set.
+	Allowance AbsenceAllowance `protobuf:"varint,12,opt,name=allowance,proto3,enum=wfm.AbsenceAllowance" json:"allowance,omitempty"`
+	// Time-off requests of the type should be approved by a supervisor.
+	RequiresApproval bool `protobuf:"varint,13,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+}
+
+func (x *AbsenceTypeItem) Reset() {
+	*x = AbsenceTypeItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_absence_type_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AbsenceTypeItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsenceTypeItem) ProtoMessage() {}
+
+func (x *AbsenceTypeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_absence_type_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsenceTypeItem.ProtoReflect.Descriptor instead.
+func (*AbsenceTypeItem) Descriptor() ([]byte, []int) {
+	return file_absence_type_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AbsenceTypeItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AbsenceTypeItem) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *AbsenceTypeItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AbsenceTypeItem) GetCreatedBy() *LookupEntity {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *AbsenceTypeItem) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *AbsenceTypeItem) GetUpdatedBy() *LookupEntity {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *AbsenceTypeItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsenceTypeItem) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *AbsenceTypeItem) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *AbsenceTypeItem) GetPaid() bool {
+	if x != nil {
+		return x.Paid
+	}
+	return false
+}
+
+func (x *AbsenceTypeItem) GetCountsAgainstBalance() bool {
+	if x != nil {
+		return x.CountsAgainstBalance
+	}
+	return false
+}
+
+func (x *AbsenceTypeItem) GetAllowance() AbsenceAllowance {
+	if x != nil {
+		return x.Allowance
+	}
+	return AbsenceAllowance_ABSENCE_ALLOWANCE_UNSPECIFIED
+}
+
+func (x *AbsenceTypeItem) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+var File_absence_type_proto protoreflect.FileDescriptor
+
+var file_absence_type_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x77, 0x66, 0x6d, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4c,
+	0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x45, 0x0a, 0x19,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x22, 0xf7, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x41, 0x62, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8,
+	0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0xc0, 0x01, 0x0a, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0xa7, 0x01, 0xba, 0x48,
+	0xa3, 0x01, 0x92, 0x01, 0x9f, 0x01, 0x18, 0x01, 0x22, 0x9a, 0x01, 0x72, 0x97, 0x01, 0x10, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x52, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x52,
+	0x16, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x43, 0x0a,
+	0x17, 0x52, 0x65, 0x61, 0x64, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x22, 0xfa, 0x02, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x62, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x01, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x01, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfa, 0x01, 0x48, 0x02, 0x52, 0x01, 0x71, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0xc0, 0x01, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0xa7, 0x01, 0xba,
+	0x48, 0xa3, 0x01, 0x92, 0x01, 0x9f, 0x01, 0x18, 0x01, 0x22, 0x9a, 0x01, 0x72, 0x97, 0x01, 0x10,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x52, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64,
+	0x52, 0x16, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x42, 0x04, 0x0a, 0x02, 0x5f, 0x71, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x22,
+	0x5b, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65,
+	0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x4c, 0x0a, 0x18,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x45, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x22, 0x36, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01,
+	0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xa3, 0x04, 0x0a, 0x0f, 0x41, 0x62, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0xfa, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1b, 0xba, 0x48, 0x18, 0xd8, 0x01, 0x01, 0x72, 0x13, 0x32, 0x11, 0x5e, 0x23,
+	0x5b, 0x30, 0x2d, 0x39, 0x61, 0x2d, 0x66, 0x41, 0x2d, 0x46, 0x5d, 0x7b, 0x36, 0x7d, 0x24, 0x52,
+	0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x61, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x41, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x3d, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x78, 0x0a, 0x10,
+	0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x21, 0x0a, 0x1d, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x4f,
+	0x57, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x56, 0x41, 0x43, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x41,
+	0x4c, 0x4c, 0x4f, 0x57, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x49, 0x43, 0x4b, 0x5f, 0x4c, 0x45,
+	0x41, 0x56, 0x45, 0x53, 0x10, 0x02, 0x32, 0xa6, 0x05, 0x0a, 0x12, 0x41, 0x62, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7d, 0x0a,
+	0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x62,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x29, 0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a,
+	0x22, 0x1a, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x61,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x79, 0x0a, 0x0f,
+	0x52, 0x65, 0x61, 0x64, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x90, 0xb5, 0x18, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x73, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7a, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x90, 0xb5, 0x18,
+	0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x87, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x62,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x1a, 0x24, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x7f, 0x0a,
+	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x62,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2b, 0x90, 0xb5, 0x18, 0x03, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x2a, 0x1f, 0x2f,
+	0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x61, 0x62, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x1a, 0x0f,
+	0x8a, 0xb5, 0x18, 0x0b, 0x77, 0x66, 0x6d, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x42,
+	0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d,
+	0x3b, 0x77, 0x66, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_absence_type_proto_rawDescOnce sync.Once
+	file_absence_type_proto_rawDescData = file_absence_type_proto_rawDesc
+)
+
+func file_absence_type_proto_rawDescGZIP() []byte {
+	file_absence_type_proto_rawDescOnce.Do(func() {
+		file_absence_type_proto_rawDescData = protoimpl.X.CompressGZIP(file_absence_type_proto_rawDescData)
+	})
+	return file_absence_type_proto_rawDescData
+}
+
+var file_absence_type_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_absence_type_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_absence_type_proto_goTypes = []interface{}{
+	(AbsenceAllowance)(0),             // 0: wfm.AbsenceAllowance
+	(*CreateAbsenceTypeRequest)(nil),  // 1: wfm.CreateAbsenceTypeRequest
+	(*CreateAbsenceTypeResponse)(nil), // 2: wfm.CreateAbsenceTypeResponse
+	(*ReadAbsenceTypeRequest)(nil),    // 3: wfm.ReadAbsenceTypeRequest
+	(*ReadAbsenceTypeResponse)(nil),   // 4: wfm.ReadAbsenceTypeResponse
+	(*SearchAbsenceTypeRequest)(nil),  // 5: wfm.SearchAbsenceTypeRequest
+	(*SearchAbsenceTypeResponse)(nil), // 6: wfm.SearchAbsenceTypeResponse
+	(*UpdateAbsenceTypeRequest)(nil),  // 7: wfm.UpdateAbsenceTypeRequest
+	(*UpdateAbsenceTypeResponse)(nil), // 8: wfm.UpdateAbsenceTypeResponse
+	(*DeleteAbsenceTypeRequest)(nil),  // 9: wfm.DeleteAbsenceTypeRequest
+	(*DeleteAbsenceTypeResponse)(nil), // 10: wfm.DeleteAbsenceTypeResponse
+	(*AbsenceTypeItem)(nil),           // 11: wfm.AbsenceTypeItem
+	(*LookupEntity)(nil),              // 12: wfm.LookupEntity
+}
+var file_absence_type_proto_depIdxs = []int32{
+	11, // 0: wfm.CreateAbsenceTypeRequest.item:type_name -> wfm.AbsenceTypeItem
+	11, // 1: wfm.CreateAbsenceTypeResponse.item:type_name -> wfm.AbsenceTypeItem
+	11, // 2: wfm.ReadAbsenceTypeResponse.item:type_name -> wfm.AbsenceTypeItem
+	11, // 3: wfm.SearchAbsenceTypeResponse.items:type_name -> wfm.AbsenceTypeItem
+	11, // 4: wfm.UpdateAbsenceTypeRequest.item:type_name -> wfm.AbsenceTypeItem
+	11, // 5: wfm.UpdateAbsenceTypeResponse.item:type_name -> wfm.AbsenceTypeItem
+	12, // 6: wfm.AbsenceTypeItem.created_by:type_name -> wfm.LookupEntity
+	12, // 7: wfm.AbsenceTypeItem.updated_by:type_name -> wfm.LookupEntity
+	0,  // 8: wfm.AbsenceTypeItem.allowance:type_name -> wfm.AbsenceAllowance
+	1,  // 9: wfm.AbsenceTypeService.CreateAbsenceType:input_type -> wfm.CreateAbsenceTypeRequest
+	3,  // 10: wfm.AbsenceTypeService.ReadAbsenceType:input_type -> wfm.ReadAbsenceTypeRequest
+	5,  // 11: wfm.AbsenceTypeService.SearchAbsenceType:input_type -> wfm.SearchAbsenceTypeRequest
+	7,  // 12: wfm.AbsenceTypeService.UpdateAbsenceType:input_type -> wfm.UpdateAbsenceTypeRequest
+	9,  // 13: wfm.AbsenceTypeService.DeleteAbsenceType:input_type -> wfm.DeleteAbsenceTypeRequest
+	2,  // 14: wfm.AbsenceTypeService.CreateAbsenceType:output_type -> wfm.CreateAbsenceTypeResponse
+	4,  // 15: wfm.AbsenceTypeService.ReadAbsenceType:output_type -> wfm.ReadAbsenceTypeResponse
+	6,  // 16: wfm.AbsenceTypeService.SearchAbsenceType:output_type -> wfm.SearchAbsenceTypeResponse
+	8,  // 17: wfm.AbsenceTypeService.UpdateAbsenceType:output_type -> wfm.UpdateAbsenceTypeResponse
+	10, // 18: wfm.AbsenceTypeService.DeleteAbsenceType:output_type -> wfm.DeleteAbsenceTypeResponse
+	14, // [14:19] is the sub-list for method output_type
+	9,  // [9:14] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_absence_type_proto_init() }
+func file_absence_type_proto_init() {
+	if File_absence_type_proto != nil {
+		return
+	}
+	file_lookup_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_absence_type_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAbsenceTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_absence_type_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAbsenceTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_absence_type_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAbsenceTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_absence_type_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAbsenceTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_absence_type_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAbsenceTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_absence_type_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAbsenceTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_absence_type_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAbsenceTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_absence_type_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAbsenceTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_absence_type_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAbsenceTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_absence_type_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAbsenceTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_absence_type_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AbsenceTypeItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_absence_type_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_absence_type_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_absence_type_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_absence_type_proto_goTypes,
+		DependencyIndexes: file_absence_type_proto_depIdxs,
+		EnumInfos:         file_absence_type_proto_enumTypes,
+		MessageInfos:      file_absence_type_proto_msgTypes,
+	}.Build()
+	File_absence_type_proto = out.File
+	file_absence_type_proto_rawDesc = nil
+	file_absence_type_proto_goTypes = nil
+	file_absence_type_proto_depIdxs = nil
+}
@@ -0,0 +1,1441 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: absence_type.proto
+
+package wfm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateAbsenceTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAbsenceTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAbsenceTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAbsenceTypeRequestMultiError, or nil if none found.
+func (m *CreateAbsenceTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAbsenceTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAbsenceTypeRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAbsenceTypeRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAbsenceTypeRequestValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAbsenceTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAbsenceTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAbsenceTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAbsenceTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAbsenceTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAbsenceTypeRequestMultiError) AllErrors() []error { return m }
+
+// CreateAbsenceTypeRequestValidationError is the validation error returned by
+// CreateAbsenceTypeRequest.Validate if the designated constraints aren't met.
+type CreateAbsenceTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAbsenceTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAbsenceTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAbsenceTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAbsenceTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAbsenceTypeRequestValidationError) ErrorName() string {
+	return "CreateAbsenceTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAbsenceTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAbsenceTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAbsenceTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAbsenceTypeRequestValidationError{}
+
+// Validate checks the field values on CreateAbsenceTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAbsenceTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAbsenceTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAbsenceTypeResponseMultiError, or nil if none found.
+func (m *CreateAbsenceTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAbsenceTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAbsenceTypeResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAbsenceTypeResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAbsenceTypeResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAbsenceTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAbsenceTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAbsenceTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type CreateAbsenceTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAbsenceTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAbsenceTypeResponseMultiError) AllErrors() []error { return m }
+
+// CreateAbsenceTypeResponseValidationError is the validation error returned by
+// CreateAbsenceTypeResponse.Validate if the designated constraints aren't met.
+type CreateAbsenceTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAbsenceTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAbsenceTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAbsenceTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAbsenceTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAbsenceTypeResponseValidationError) ErrorName() string {
+	return "CreateAbsenceTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAbsenceTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAbsenceTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAbsenceTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAbsenceTypeResponseValidationError{}
+
+// Validate checks the field values on ReadAbsenceTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadAbsenceTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadAbsenceTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadAbsenceTypeRequestMultiError, or nil if none found.
+func (m *ReadAbsenceTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadAbsenceTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ReadAbsenceTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadAbsenceTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by ReadAbsenceTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReadAbsenceTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadAbsenceTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadAbsenceTypeRequestMultiError) AllErrors() []error { return m }
+
+// ReadAbsenceTypeRequestValidationError is the validation error returned by
+// ReadAbsenceTypeRequest.Validate if the designated constraints aren't met.
+type ReadAbsenceTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadAbsenceTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadAbsenceTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadAbsenceTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadAbsenceTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadAbsenceTypeRequestValidationError) ErrorName() string {
+	return "ReadAbsenceTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadAbsenceTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadAbsenceTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadAbsenceTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadAbsenceTypeRequestValidationError{}
+
+// Validate checks the field values on ReadAbsenceTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadAbsenceTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadAbsenceTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadAbsenceTypeResponseMultiError, or nil if none found.
+func (m *ReadAbsenceTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadAbsenceTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadAbsenceTypeResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadAbsenceTypeResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadAbsenceTypeResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReadAbsenceTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadAbsenceTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by ReadAbsenceTypeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReadAbsenceTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadAbsenceTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadAbsenceTypeResponseMultiError) AllErrors() []error { return m }
+
+// ReadAbsenceTypeResponseValidationError is the validation error returned by
+// ReadAbsenceTypeResponse.Validate if the designated constraints aren't met.
+type ReadAbsenceTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadAbsenceTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadAbsenceTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadAbsenceTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadAbsenceTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadAbsenceTypeResponseValidationError) ErrorName() string {
+	return "ReadAbsenceTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadAbsenceTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadAbsenceTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadAbsenceTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadAbsenceTypeResponseValidationError{}
+
+// Validate checks the field values on SearchAbsenceTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchAbsenceTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchAbsenceTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchAbsenceTypeRequestMultiError, or nil if none found.
+func (m *SearchAbsenceTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchAbsenceTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.Size != nil {
+		// no validation rules for Size
+	}
+
+	if m.Q != nil {
+		// no validation rules for Q
+	}
+
+	if m.Sort != nil {
+		// no validation rules for Sort
+	}
+
+	if len(errors) > 0 {
+		return SearchAbsenceTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchAbsenceTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchAbsenceTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchAbsenceTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchAbsenceTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchAbsenceTypeRequestMultiError) AllErrors() []error { return m }
+
+// SearchAbsenceTypeRequestValidationError is the validation error returned by
+// SearchAbsenceTypeRequest.Validate if the designated constraints aren't met.
+type SearchAbsenceTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchAbsenceTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchAbsenceTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchAbsenceTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchAbsenceTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchAbsenceTypeRequestValidationError) ErrorName() string {
+	return "SearchAbsenceTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchAbsenceTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchAbsenceTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchAbsenceTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchAbsenceTypeRequestValidationError{}
+
+// Validate checks the field values on SearchAbsenceTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchAbsenceTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchAbsenceTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchAbsenceTypeResponseMultiError, or nil if none found.
+func (m *SearchAbsenceTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchAbsenceTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchAbsenceTypeResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchAbsenceTypeResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchAbsenceTypeResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Next
+
+	if len(errors) > 0 {
+		return SearchAbsenceTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchAbsenceTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchAbsenceTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type SearchAbsenceTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchAbsenceTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchAbsenceTypeResponseMultiError) AllErrors() []error { return m }
+
+// SearchAbsenceTypeResponseValidationError is the validation error returned by
+// SearchAbsenceTypeResponse.Validate if the designated constraints aren't met.
+type SearchAbsenceTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchAbsenceTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchAbsenceTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchAbsenceTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchAbsenceTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchAbsenceTypeResponseValidationError) ErrorName() string {
+	return "SearchAbsenceTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchAbsenceTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchAbsenceTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchAbsenceTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchAbsenceTypeResponseValidationError{}
+
+// Validate checks the field values on UpdateAbsenceTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAbsenceTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAbsenceTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAbsenceTypeRequestMultiError, or nil if none found.
+func (m *UpdateAbsenceTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAbsenceTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAbsenceTypeRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAbsenceTypeRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAbsenceTypeRequestValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAbsenceTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAbsenceTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAbsenceTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAbsenceTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAbsenceTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAbsenceTypeRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAbsenceTypeRequestValidationError is the validation error returned by
+// UpdateAbsenceTypeRequest.Validate if the designated constraints aren't met.
+type UpdateAbsenceTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAbsenceTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAbsenceTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAbsenceTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAbsenceTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAbsenceTypeRequestValidationError) ErrorName() string {
+	return "UpdateAbsenceTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAbsenceTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAbsenceTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAbsenceTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAbsenceTypeRequestValidationError{}
+
+// Validate checks the field values on UpdateAbsenceTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAbsenceTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAbsenceTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAbsenceTypeResponseMultiError, or nil if none found.
+func (m *UpdateAbsenceTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAbsenceTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAbsenceTypeResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAbsenceTypeResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAbsenceTypeResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAbsenceTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAbsenceTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateAbsenceTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateAbsenceTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAbsenceTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAbsenceTypeResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAbsenceTypeResponseValidationError is the validation error returned by
+// UpdateAbsenceTypeResponse.Validate if the designated constraints aren't met.
+type UpdateAbsenceTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAbsenceTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAbsenceTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAbsenceTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAbsenceTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAbsenceTypeResponseValidationError) ErrorName() string {
+	return "UpdateAbsenceTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAbsenceTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAbsenceTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAbsenceTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAbsenceTypeResponseValidationError{}
+
+// Validate checks the field values on DeleteAbsenceTypeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAbsenceTypeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAbsenceTypeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAbsenceTypeRequestMultiError, or nil if none found.
+func (m *DeleteAbsenceTypeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAbsenceTypeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteAbsenceTypeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAbsenceTypeRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAbsenceTypeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAbsenceTypeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAbsenceTypeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAbsenceTypeRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAbsenceTypeRequestValidationError is the validation error returned by
+// DeleteAbsenceTypeRequest.Validate if the designated constraints aren't met.
+type DeleteAbsenceTypeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAbsenceTypeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAbsenceTypeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAbsenceTypeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAbsenceTypeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAbsenceTypeRequestValidationError) ErrorName() string {
+	return "DeleteAbsenceTypeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAbsenceTypeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAbsenceTypeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAbsenceTypeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAbsenceTypeRequestValidationError{}
+
+// Validate checks the field values on DeleteAbsenceTypeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAbsenceTypeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAbsenceTypeResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAbsenceTypeResponseMultiError, or nil if none found.
+func (m *DeleteAbsenceTypeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAbsenceTypeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteAbsenceTypeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAbsenceTypeResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteAbsenceTypeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteAbsenceTypeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAbsenceTypeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAbsenceTypeResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAbsenceTypeResponseValidationError is the validation error returned by
+// DeleteAbsenceTypeResponse.Validate if the designated constraints aren't met.
+type DeleteAbsenceTypeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAbsenceTypeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAbsenceTypeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAbsenceTypeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAbsenceTypeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAbsenceTypeResponseValidationError) ErrorName() string {
+	return "DeleteAbsenceTypeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAbsenceTypeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAbsenceTypeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAbsenceTypeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAbsenceTypeResponseValidationError{}
+
+// Validate checks the field values on AbsenceTypeItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AbsenceTypeItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AbsenceTypeItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AbsenceTypeItemMultiError, or nil if none found.
+func (m *AbsenceTypeItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AbsenceTypeItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DomainId
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetCreatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AbsenceTypeItemValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AbsenceTypeItemValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AbsenceTypeItemValidationError{
+				field:  "CreatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedAt
+
+	if all {
+		switch v := interface{}(m.GetUpdatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AbsenceTypeItemValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AbsenceTypeItemValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AbsenceTypeItemValidationError{
+				field:  "UpdatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Color
+
+	// no validation rules for Paid
+
+	// no validation rules for CountsAgainstBalance
+
+	// no validation rules for Allowance
+
+	// no validation rules for RequiresApproval
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if len(errors) > 0 {
+		return AbsenceTypeItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// AbsenceTypeItemMultiError is an error wrapping multiple validation errors
+// returned by AbsenceTypeItem.ValidateAll() if the designated constraints
+// aren't met.
+type AbsenceTypeItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AbsenceTypeItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AbsenceTypeItemMultiError) AllErrors() []error { return m }
+
+// AbsenceTypeItemValidationError is the validation error returned by
+// AbsenceTypeItem.Validate if the designated constraints aren't met.
+type AbsenceTypeItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AbsenceTypeItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AbsenceTypeItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AbsenceTypeItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AbsenceTypeItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AbsenceTypeItemValidationError) ErrorName() string { return "AbsenceTypeItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AbsenceTypeItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAbsenceTypeItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AbsenceTypeItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AbsenceTypeItemValidationError{}
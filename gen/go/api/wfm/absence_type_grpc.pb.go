@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: absence_type.proto
+
+package wfm
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AbsenceTypeService_CreateAbsenceType_FullMethodName = "/wfm.AbsenceTypeService/CreateAbsenceType"
+	AbsenceTypeService_ReadAbsenceType_FullMethodName   = "/wfm.AbsenceTypeService/ReadAbsenceType"
+	AbsenceTypeService_SearchAbsenceType_FullMethodName = "/wfm.AbsenceTypeService/SearchAbsenceType"
+	AbsenceTypeService_UpdateAbsenceType_FullMethodName = "/wfm.AbsenceTypeService/UpdateAbsenceType"
+	AbsenceTypeService_DeleteAbsenceType_FullMethodName = "/wfm.AbsenceTypeService/DeleteAbsenceType"
+)
+
+// AbsenceTypeServiceClient is the client API for AbsenceTypeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AbsenceTypeServiceClient interface {
+	CreateAbsenceType(ctx context.Context, in *CreateAbsenceTypeRequest, opts ...grpc.CallOption) (*CreateAbsenceTypeResponse, error)
+	ReadAbsenceType(ctx context.Context, in *ReadAbsenceTypeRequest, opts ...grpc.CallOption) (*ReadAbsenceTypeResponse, error)
+	SearchAbsenceType(ctx context.Context, in *SearchAbsenceTypeRequest, opts ...grpc.CallOption) (*SearchAbsenceTypeResponse, error)
+	UpdateAbsenceType(ctx context.Context, in *UpdateAbsenceTypeRequest, opts ...grpc.CallOption) (*UpdateAbsenceTypeResponse, error)
+	DeleteAbsenceType(ctx context.Context, in *DeleteAbsenceTypeRequest, opts ...grpc.CallOption) (*DeleteAbsenceTypeResponse, error)
+}
+
+type absenceTypeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAbsenceTypeServiceClient(cc grpc.ClientConnInterface) AbsenceTypeServiceClient {
+	return &absenceTypeServiceClient{cc}
+}
+
+func (c *absenceTypeServiceClient) CreateAbsenceType(ctx context.Context, in *CreateAbsenceTypeRequest, opts ...grpc.CallOption) (*CreateAbsenceTypeResponse, error) {
+	out := new(CreateAbsenceTypeResponse)
+	err := c.cc.Invoke(ctx, AbsenceTypeService_CreateAbsenceType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *absenceTypeServiceClient) ReadAbsenceType(ctx context.Context, in *ReadAbsenceTypeRequest, opts ...grpc.CallOption) (*ReadAbsenceTypeResponse, error) {
+	out := new(ReadAbsenceTypeResponse)
+	err := c.cc.Invoke(ctx, AbsenceTypeService_ReadAbsenceType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *absenceTypeServiceClient) SearchAbsenceType(ctx context.Context, in *SearchAbsenceTypeRequest, opts ...grpc.CallOption) (*SearchAbsenceTypeResponse, error) {
+	out := new(SearchAbsenceTypeResponse)
+	err := c.cc.Invoke(ctx, AbsenceTypeService_SearchAbsenceType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *absenceTypeServiceClient) UpdateAbsenceType(ctx context.Context, in *UpdateAbsenceTypeRequest, opts ...grpc.CallOption) (*UpdateAbsenceTypeResponse, error) {
+	out := new(UpdateAbsenceTypeResponse)
+	err := c.cc.Invoke(ctx, AbsenceTypeService_UpdateAbsenceType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *absenceTypeServiceClient) DeleteAbsenceType(ctx context.Context, in *DeleteAbsenceTypeRequest, opts ...grpc.CallOption) (*DeleteAbsenceTypeResponse, error) {
+	out := new(DeleteAbsenceTypeResponse)
+	err := c.cc.Invoke(ctx, AbsenceTypeService_DeleteAbsenceType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AbsenceTypeServiceServer is the server API for AbsenceTypeService service.
+// All implementations must embed UnimplementedAbsenceTypeServiceServer
+// for forward compatibility
+type AbsenceTypeServiceServer interface {
+	CreateAbsenceType(context.Context, *CreateAbsenceTypeRequest) (*CreateAbsenceTypeResponse, error)
+	ReadAbsenceType(context.Context, *ReadAbsenceTypeRequest) (*ReadAbsenceTypeResponse, error)
+	SearchAbsenceType(context.Context, *SearchAbsenceTypeRequest) (*SearchAbsenceTypeResponse, error)
+	UpdateAbsenceType(context.Context, *UpdateAbsenceTypeRequest) (*UpdateAbsenceTypeResponse, error)
+	DeleteAbsenceType(context.Context, *DeleteAbsenceTypeRequest) (*DeleteAbsenceTypeResponse, error)
+	mustEmbedUnimplementedAbsenceTypeServiceServer()
+}
+
+// UnimplementedAbsenceTypeServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAbsenceTypeServiceServer struct {
+}
+
+func (UnimplementedAbsenceTypeServiceServer) CreateAbsenceType(context.Context, *CreateAbsenceTypeRequest) (*CreateAbsenceTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAbsenceType not implemented")
+}
+func (UnimplementedAbsenceTypeServiceServer) ReadAbsenceType(context.Context, *ReadAbsenceTypeRequest) (*ReadAbsenceTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAbsenceType not implemented")
+}
+func (UnimplementedAbsenceTypeServiceServer) SearchAbsenceType(context.Context, *SearchAbsenceTypeRequest) (*SearchAbsenceTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAbsenceType not implemented")
+}
+func (UnimplementedAbsenceTypeServiceServer) UpdateAbsenceType(context.Context, *UpdateAbsenceTypeRequest) (*UpdateAbsenceTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAbsenceType not implemented")
+}
+func (UnimplementedAbsenceTypeServiceServer) DeleteAbsenceType(context.Context, *DeleteAbsenceTypeRequest) (*DeleteAbsenceTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAbsenceType not implemented")
+}
+func (UnimplementedAbsenceTypeServiceServer) mustEmbedUnimplementedAbsenceTypeServiceServer() {}
+
+// UnsafeAbsenceTypeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AbsenceTypeServiceServer will
+// result in compilation errors.
+type UnsafeAbsenceTypeServiceServer interface {
+	mustEmbedUnimplementedAbsenceTypeServiceServer()
+}
+
+func RegisterAbsenceTypeServiceServer(s grpc.ServiceRegistrar, srv AbsenceTypeServiceServer) {
+	s.RegisterService(&AbsenceTypeService_ServiceDesc, srv)
+}
+
+func _AbsenceTypeService_CreateAbsenceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAbsenceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbsenceTypeServiceServer).CreateAbsenceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbsenceTypeService_CreateAbsenceType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbsenceTypeServiceServer).CreateAbsenceType(ctx, req.(*CreateAbsenceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AbsenceTypeService_ReadAbsenceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAbsenceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbsenceTypeServiceServer).ReadAbsenceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbsenceTypeService_ReadAbsenceType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbsenceTypeServiceServer).ReadAbsenceType(ctx, req.(*ReadAbsenceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AbsenceTypeService_SearchAbsenceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAbsenceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbsenceTypeServiceServer).SearchAbsenceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbsenceTypeService_SearchAbsenceType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbsenceTypeServiceServer).SearchAbsenceType(ctx, req.(*SearchAbsenceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AbsenceTypeService_UpdateAbsenceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAbsenceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbsenceTypeServiceServer).UpdateAbsenceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbsenceTypeService_UpdateAbsenceType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbsenceTypeServiceServer).UpdateAbsenceType(ctx, req.(*UpdateAbsenceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AbsenceTypeService_DeleteAbsenceType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsenceTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AbsenceTypeServiceServer).DeleteAbsenceType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AbsenceTypeService_DeleteAbsenceType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AbsenceTypeServiceServer).DeleteAbsenceType(ctx, req.(*DeleteAbsenceTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AbsenceTypeService_ServiceDesc is the grpc.ServiceDesc for AbsenceTypeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AbsenceTypeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wfm.AbsenceTypeService",
+	HandlerType: (*AbsenceTypeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAbsenceType",
+			Handler:    _AbsenceTypeService_CreateAbsenceType_Handler,
+		},
+		{
+			MethodName: "ReadAbsenceType",
+			Handler:    _AbsenceTypeService_ReadAbsenceType_Handler,
+		},
+		{
+			MethodName: "SearchAbsenceType",
+			Handler:    _AbsenceTypeService_SearchAbsenceType_Handler,
+		},
+		{
+			MethodName: "UpdateAbsenceType",
+			Handler:    _AbsenceTypeService_UpdateAbsenceType_Handler,
+		},
+		{
+			MethodName: "DeleteAbsenceType",
+			Handler:    _AbsenceTypeService_DeleteAbsenceType_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "absence_type.proto",
+}
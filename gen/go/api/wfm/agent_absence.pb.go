@@ -23,58 +23,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type AbsenceType int32
-
-const (
-	AbsenceType_ABSENCE_TYPE_UNSPECIFIED AbsenceType = 0
-	AbsenceType_ABSENCE_TYPE_DAYOFF      AbsenceType = 1
-	AbsenceType_ABSENCE_TYPE_VACATION    AbsenceType = 2
-	AbsenceType_ABSENCE_TYPE_SICKDAY     AbsenceType = 3
-)
-
-// Enum value maps for AbsenceType.
-var (
-	AbsenceType_name = map[int32]string{
-		0: "ABSENCE_TYPE_UNSPECIFIED",
-		1: "ABSENCE_TYPE_DAYOFF",
-		2: "ABSENCE_TYPE_VACATION",
-		3: "ABSENCE_TYPE_SICKDAY",
-	}
-	AbsenceType_value = map[string]int32{
-		"ABSENCE_TYPE_UNSPECIFIED": 0,
-		"ABSENCE_TYPE_DAYOFF":      1,
-		"ABSENCE_TYPE_VACATION":    2,
-		"ABSENCE_TYPE_SICKDAY":     3,
-	}
-)
-
-func (x AbsenceType) Enum() *AbsenceType {
-	p := new(AbsenceType)
-	*p = x
-	return p
-}
-
-func (x AbsenceType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
-}
-
-func (AbsenceType) Descriptor() protoreflect.EnumDescriptor {
-	return file_agent_absence_proto_enumTypes[0].Descriptor()
-}
-
-func (AbsenceType) Type() protoreflect.EnumType {
-	return &file_agent_absence_proto_enumTypes[0]
-}
-
-func (x AbsenceType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
-}
-
-// Deprecated: Use AbsenceType.Descriptor instead.
-func (AbsenceType) EnumDescriptor() ([]byte, []int) {
-	return file_agent_absence_proto_rawDescGZIP(), []int{0}
-}
-
 type CreateAgentAbsenceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -82,6 +30,8 @@ type CreateAgentAbsenceRequest struct {
 
 	AgentId int64    `protobuf:"varint,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 	Item    *Absence `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	// Creates absence even if it exceeds the remaining agent balance.
+	AllowExceedBalance bool `protobuf:"varint,3,opt,name=allow_exceed_balance,json=allowExceedBalance,proto3" json:"allow_exceed_balance,omitempty"`
 }
 
 func (x *CreateAgentAbsenceRequest) Reset() {
@@ -130,12 +80,21 @@ func (x *CreateAgentAbsenceRequest) GetItem() *Absence {
 	return nil
 }
 
+func (x *CreateAgentAbsenceRequest) GetAllowExceedBalance() bool {
+	if x != nil {
+		return x.AllowExceedBalance
+	}
+	return false
+}
+
 type CreateAgentAbsenceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Item *Absence `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// Absence exceeds the remaining agent balance.
+	BalanceExceeded bool `protobuf:"varint,2,opt,name=balance_exceeded,json=balanceExceeded,proto3" json:"balance_exceeded,omitempty"`
 }
 
 func (x *CreateAgentAbsenceResponse) Reset() {
@@ -177,6 +136,13 @@ func (x *CreateAgentAbsenceResponse) GetItem() *Absence {
 	return nil
 }
 
+func (x *CreateAgentAbsenceResponse) GetBalanceExceeded() bool {
+	if x != nil {
+		return x.BalanceExceeded
+	}
+	return false
+}
+
 type ReadAgentAbsenceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -861,6 +827,200 @@ func (x *SearchAgentsAbsencesResponse) GetNext() bool {
 	return false
 }
 
+type ReadAgentAbsenceBalanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId int64 `protobuf:"varint,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Year    int32 `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	// Pro-rates entitled days by the periods of agent working conditions within the year.
+	Prorate bool `protobuf:"varint,3,opt,name=prorate,proto3" json:"prorate,omitempty"`
+}
+
+func (x *ReadAgentAbsenceBalanceRequest) Reset() {
+	*x = ReadAgentAbsenceBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_absence_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAgentAbsenceBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAgentAbsenceBalanceRequest) ProtoMessage() {}
+
+func (x *ReadAgentAbsenceBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_absence_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAgentAbsenceBalanceRequest.ProtoReflect.Descriptor instead.
+func (*ReadAgentAbsenceBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_agent_absence_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReadAgentAbsenceBalanceRequest) GetAgentId() int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+func (x *ReadAgentAbsenceBalanceRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ReadAgentAbsenceBalanceRequest) GetProrate() bool {
+	if x != nil {
+		return x.Prorate
+	}
+	return false
+}
+
+type ReadAgentAbsenceBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*AgentAbsenceBalance `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ReadAgentAbsenceBalanceResponse) Reset() {
+	*x = ReadAgentAbsenceBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_absence_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAgentAbsenceBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAgentAbsenceBalanceResponse) ProtoMessage() {}
+
+func (x *ReadAgentAbsenceBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_absence_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAgentAbsenceBalanceResponse.ProtoReflect.Descriptor instead.
+func (*ReadAgentAbsenceBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_agent_absence_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReadAgentAbsenceBalanceResponse) GetItems() []*AgentAbsenceBalance {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AgentAbsenceBalance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type *LookupEntity `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"`
+	// Days per year granted by the agent working condition.
+	Entitled float64 `protobuf:"fixed64,2,opt,name=entitled,proto3" json:"entitled,omitempty"`
+	// Days of absence in the past.
+	// Partial-day absences are counted by hours relative to the agent workday hours.
+	Used float64 `protobuf:"fixed64,3,opt,name=used,proto3" json:"used,omitempty"`
+	// Days of absence in the future.
+	Planned   float64 `protobuf:"fixed64,4,opt,name=planned,proto3" json:"planned,omitempty"`
+	Remaining float64 `protobuf:"fixed64,5,opt,name=remaining,proto3" json:"remaining,omitempty"`
+}
+
+func (x *AgentAbsenceBalance) Reset() {
+	*x = AgentAbsenceBalance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_absence_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentAbsenceBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentAbsenceBalance) ProtoMessage() {}
+
+func (x *AgentAbsenceBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_absence_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentAbsenceBalance.ProtoReflect.Descriptor instead.
+func (*AgentAbsenceBalance) Descriptor() ([]byte, []int) {
+	return file_agent_absence_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AgentAbsenceBalance) GetType() *LookupEntity {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *AgentAbsenceBalance) GetEntitled() float64 {
+	if x != nil {
+		return x.Entitled
+	}
+	return 0
+}
+
+func (x *AgentAbsenceBalance) GetUsed() float64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *AgentAbsenceBalance) GetPlanned() float64 {
+	if x != nil {
+		return x.Planned
+	}
+	return 0
+}
+
+func (x *AgentAbsenceBalance) GetRemaining() float64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
 type AgentAbsences struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -873,7 +1033,7 @@ type AgentAbsences struct {
 func (x *AgentAbsences) Reset() {
 	*x = AgentAbsences{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_absence_proto_msgTypes[14]
+		mi := &file_agent_absence_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -886,7 +1046,7 @@ func (x *AgentAbsences) String() string {
 func (*AgentAbsences) ProtoMessage() {}
 
 func (x *AgentAbsences) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_absence_proto_msgTypes[14]
+	mi := &file_agent_absence_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,7 +1059,7 @@ func (x *AgentAbsences) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentAbsences.ProtoReflect.Descriptor instead.
 func (*AgentAbsences) Descriptor() ([]byte, []int) {
-	return file_agent_absence_proto_rawDescGZIP(), []int{14}
+	return file_agent_absence_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AgentAbsences) GetAgent() *LookupEntity {
@@ -927,14 +1087,20 @@ type Absence struct {
 	CreatedBy *LookupEntity `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedAt int64         `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	UpdatedBy *LookupEntity `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	TypeId    AbsenceType   `protobuf:"varint,7,opt,name=type_id,json=typeId,proto3,enum=wfm.AbsenceType" json:"type_id,omitempty"`
 	AbsentAt  int64         `protobuf:"varint,8,opt,name=absent_at,json=absentAt,proto3" json:"absent_at,omitempty"`
+	// Minutes from the start of the day, when the agent becomes absent.
+	// Unset for the whole-day absence.
+	Start *int64 `protobuf:"varint,9,opt,name=start,proto3,oneof" json:"start,omitempty"`
+	// Minutes from the start of the day, when the agent becomes available again.
+	End *int64 `protobuf:"varint,10,opt,name=end,proto3,oneof" json:"end,omitempty"`
+	// Absence type from the domain catalogue.
+	Type *LookupEntity `protobuf:"bytes,11,opt,name=type,proto3" json:"type,omitempty"`
 }
 
 func (x *Absence) Reset() {
 	*x = Absence{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_absence_proto_msgTypes[15]
+		mi := &file_agent_absence_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -947,7 +1113,7 @@ func (x *Absence) String() string {
 func (*Absence) ProtoMessage() {}
 
 func (x *Absence) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_absence_proto_msgTypes[15]
+	mi := &file_agent_absence_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -960,7 +1126,7 @@ func (x *Absence) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Absence.ProtoReflect.Descriptor instead.
 func (*Absence) Descriptor() ([]byte, []int) {
-	return file_agent_absence_proto_rawDescGZIP(), []int{15}
+	return file_agent_absence_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Absence) GetId() int64 {
@@ -1005,34 +1171,54 @@ func (x *Absence) GetUpdatedBy() *LookupEntity {
 	return nil
 }
 
-func (x *Absence) GetTypeId() AbsenceType {
+func (x *Absence) GetAbsentAt() int64 {
 	if x != nil {
-		return x.TypeId
+		return x.AbsentAt
 	}
-	return AbsenceType_ABSENCE_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *Absence) GetAbsentAt() int64 {
-	if x != nil {
-		return x.AbsentAt
+func (x *Absence) GetStart() int64 {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	return 0
+}
+
+func (x *Absence) GetEnd() int64 {
+	if x != nil && x.End != nil {
+		return *x.End
 	}
 	return 0
 }
 
+func (x *Absence) GetType() *LookupEntity {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
 type CreateAgentsAbsencesRequestAbsentType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TypeId   AbsenceType `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3,enum=wfm.AbsenceType" json:"type_id,omitempty"`
-	DateFrom int64       `protobuf:"varint,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
-	DateTo   int64       `protobuf:"varint,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// Identifier of the absence type from the domain catalogue.
+	TypeId   int64 `protobuf:"varint,1,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty"`
+	DateFrom int64 `protobuf:"varint,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo   int64 `protobuf:"varint,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	// Minutes from the start of each day, when the agent becomes absent.
+	// Unset for the whole-day absences.
+	Start *int64 `protobuf:"varint,4,opt,name=start,proto3,oneof" json:"start,omitempty"`
+	// Minutes from the start of each day, when the agent becomes available again.
+	End *int64 `protobuf:"varint,5,opt,name=end,proto3,oneof" json:"end,omitempty"`
 }
 
 func (x *CreateAgentsAbsencesRequestAbsentType) Reset() {
 	*x = CreateAgentsAbsencesRequestAbsentType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_absence_proto_msgTypes[16]
+		mi := &file_agent_absence_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1045,7 +1231,7 @@ func (x *CreateAgentsAbsencesRequestAbsentType) String() string {
 func (*CreateAgentsAbsencesRequestAbsentType) ProtoMessage() {}
 
 func (x *CreateAgentsAbsencesRequestAbsentType) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_absence_proto_msgTypes[16]
+	mi := &file_agent_absence_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1061,11 +1247,11 @@ func (*CreateAgentsAbsencesRequestAbsentType) Descriptor() ([]byte, []int) {
 	return file_agent_absence_proto_rawDescGZIP(), []int{10, 0}
 }
 
-func (x *CreateAgentsAbsencesRequestAbsentType) GetTypeId() AbsenceType {
+func (x *CreateAgentsAbsencesRequestAbsentType) GetTypeId() int64 {
 	if x != nil {
 		return x.TypeId
 	}
-	return AbsenceType_ABSENCE_TYPE_UNSPECIFIED
+	return 0
 }
 
 func (x *CreateAgentsAbsencesRequestAbsentType) GetDateFrom() int64 {
@@ -1082,6 +1268,20 @@ func (x *CreateAgentsAbsencesRequestAbsentType) GetDateTo() int64 {
 	return 0
 }
 
+func (x *CreateAgentsAbsencesRequestAbsentType) GetStart() int64 {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	return 0
+}
+
+func (x *CreateAgentsAbsencesRequestAbsentType) GetEnd() int64 {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	return 0
+}
+
 var File_agent_absence_proto protoreflect.FileDescriptor
 
 var file_agent_absence_proto_rawDesc = []byte{
@@ -1093,205 +1293,269 @@ var file_agent_absence_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x65, 0x62,
 	0x69, 0x74, 0x65, 0x6c, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x60, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62,
-	0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65,
-	0x6e, 0x63, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x69, 0x74, 0x65,
-	0x6d, 0x22, 0x3e, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x20, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
-	0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65,
-	0x6d, 0x22, 0x44, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62,
-	0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x92, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x65, 0x78, 0x63, 0x65,
+	0x65, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x22, 0x69, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x22,
+	0x44, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x20, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x22, 0xce, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17,
+	0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x73, 0x6f, 0x72, 0x74, 0x22, 0x40, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
 	0x0b, 0x32, 0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xce, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x58, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x04,
-	0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x17, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
-	0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x40, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x58, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0x3e, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0x46, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65,
 	0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x20, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x22, 0x3e, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x20, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x22, 0x46, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x85, 0x03, 0x0a, 0x1b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74,
-	0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02,
-	0x08, 0x01, 0x52, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x1a, 0xf1, 0x01, 0x0a,
-	0x0a, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x74,
-	0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x77,
-	0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0d,
-	0xba, 0x48, 0x0a, 0x82, 0x01, 0x07, 0x10, 0x01, 0x1a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x06, 0x74,
-	0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72,
-	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
-	0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x07, 0x64,
-	0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
-	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x3a, 0x61, 0xba,
-	0x48, 0x5e, 0x1a, 0x5c, 0x0a, 0x10, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x2e,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x20,
-	0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
-	0x1a, 0x1e, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x20, 0x3e,
-	0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
-	0x22, 0x48, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xfc, 0x04, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01,
+	0x52, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x1a, 0xe8, 0x03, 0x0a, 0x0a, 0x61,
+	0x62, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x07, 0x74, 0x79, 0x70,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x09, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x20, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x06, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x22, 0x05, 0x18, 0xa0, 0x0b, 0x28, 0x00, 0x48, 0x00,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x03, 0x65, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x22, 0x05, 0x18, 0xa0,
+	0x0b, 0x28, 0x00, 0x48, 0x01, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x3a, 0x93, 0x02,
+	0xba, 0x48, 0x8f, 0x02, 0x1a, 0x5c, 0x0a, 0x10, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x74, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x6f, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x1a, 0x1e, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f,
+	0x20, 0x3e, 0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72,
+	0x6f, 0x6d, 0x1a, 0xae, 0x01, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x55, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20,
+	0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x6f,
+	0x67, 0x65, 0x74, 0x68, 0x65, 0x72, 0x2c, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65,
+	0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x65, 0x61, 0x72, 0x6c, 0x69,
+	0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x74, 0x69,
+	0x6d, 0x65, 0x1a, 0x4f, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x65, 0x6e, 0x64, 0x29, 0x20, 0x26, 0x26, 0x20, 0x28, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x3c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x65,
+	0x6e, 0x64, 0x29, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x65, 0x6e, 0x64, 0x22, 0x48, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0xce, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x11, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x01, 0x71, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42,
+	0x04, 0x0a, 0x02, 0x5f, 0x71, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x72, 0x74,
+	0x22, 0x5c, 0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73,
 	0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xce, 0x01, 0x0a, 0x1b, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x11, 0x0a, 0x01, 0x71, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x01, 0x71, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
-	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x17, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
-	0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x71,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69,
-	0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x5c, 0x0a, 0x1c, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x69,
-	0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d,
-	0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x62, 0x0a, 0x0d, 0x41, 0x67, 0x65,
-	0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x08, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65,
-	0x6e, 0x63, 0x65, 0x52, 0x08, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xb8, 0x02,
-	0x0a, 0x07, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d,
-	0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x38, 0x0a, 0x07, 0x74, 0x79, 0x70, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
-	0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0d, 0xba, 0x48, 0x0a,
-	0x82, 0x01, 0x07, 0x10, 0x01, 0x1a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x06, 0x74, 0x79, 0x70, 0x65,
-	0x49, 0x64, 0x12, 0x24, 0x0a, 0x09, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08,
-	0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x2a, 0x79, 0x0a, 0x0b, 0x41, 0x62, 0x73, 0x65,
-	0x6e, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x42, 0x53, 0x45, 0x4e,
-	0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x43, 0x45,
-	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x59, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12, 0x19,
-	0x0a, 0x15, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56,
-	0x41, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x42, 0x53,
-	0x45, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x43, 0x4b, 0x44, 0x41,
-	0x59, 0x10, 0x03, 0x32, 0xd8, 0x07, 0x0a, 0x13, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73,
-	0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x12,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e,
-	0x63, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2e, 0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a,
-	0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f,
-	0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61,
-	0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x26, 0x12, 0x24, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63,
-	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2b, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x77, 0x66,
-	0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x7d, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x8f, 0x01, 0x0a,
-	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65,
-	0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x63, 0x65, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65,
+	0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x76,
+	0x0a, 0x1e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x79,
+	0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x1a, 0x06,
+	0x18, 0x8f, 0x4e, 0x28, 0xb2, 0x0f, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x72, 0x61, 0x74, 0x65, 0x22, 0x51, 0x0a, 0x1f, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x13, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x62, 0x0a, 0x0d, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x12, 0x28, 0x0a, 0x08, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x08, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0xc7, 0x04, 0x0a, 0x07, 0x41,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62,
+	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x24, 0x0a, 0x09, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x08, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x74, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x22,
+	0x05, 0x18, 0xa0, 0x0b, 0x28, 0x00, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x21, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x22, 0x05, 0x18, 0xa0, 0x0b, 0x28, 0x00, 0x48, 0x01, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x3a, 0xb5, 0x01, 0xba, 0x48, 0xb1, 0x01, 0x1a, 0xae, 0x01, 0x0a, 0x04,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x55, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x61, 0x6e, 0x64, 0x20,
+	0x65, 0x6e, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20,
+	0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x67, 0x65, 0x74, 0x68, 0x65, 0x72, 0x2c,
+	0x20, 0x65, 0x6e, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x20, 0x62, 0x65, 0x20, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e,
+	0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x4f, 0x68, 0x61, 0x73,
+	0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x29, 0x20, 0x3d, 0x3d, 0x20,
+	0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x29, 0x20, 0x26, 0x26,
+	0x20, 0x28, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x20, 0x3c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x29, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x65, 0x6e, 0x64, 0x4a, 0x04,
+	0x08, 0x07, 0x10, 0x08, 0x32, 0xf4, 0x08, 0x0a, 0x13, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x85, 0x01, 0x0a,
+	0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e,
-	0x3a, 0x01, 0x2a, 0x1a, 0x29, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
+	0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
 	0x2f, 0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x62, 0x73, 0x65,
-	0x6e, 0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x87,
-	0x01, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62,
-	0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x90, 0xb5, 0x18, 0x03, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x26, 0x2a, 0x24, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x12, 0x24, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f,
 	0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
-	0x73, 0x12, 0x20, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e,
-	0x74, 0x73, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x7d, 0x0a, 0x14, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e,
-	0x63, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2b, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x77,
+	0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x99, 0x01,
+	0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x23, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29,
+	0x12, 0x27, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x12, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x38, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a,
+	0x1a, 0x29, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x87, 0x01, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x30, 0x90, 0xb5, 0x18, 0x03, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x2a,
+	0x24, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x80, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x20,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x23, 0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a,
+	0x01, 0x2a, 0x22, 0x14, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x7d, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72,
 	0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x73, 0x2f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x12, 0x8a, 0xb5, 0x18, 0x0e,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x23,
-	0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62,
-	0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d, 0x3b,
-	0x77, 0x66, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x20, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x16, 0x12, 0x14, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x61,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x12, 0x8a, 0xb5, 0x18, 0x0e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x23, 0x5a, 0x21, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d, 0x3b, 0x77, 0x66, 0x6d,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1306,64 +1570,68 @@ func file_agent_absence_proto_rawDescGZIP() []byte {
 	return file_agent_absence_proto_rawDescData
 }
 
-var file_agent_absence_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_agent_absence_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_agent_absence_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_agent_absence_proto_goTypes = []interface{}{
-	(AbsenceType)(0),                              // 0: wfm.AbsenceType
-	(*CreateAgentAbsenceRequest)(nil),             // 1: wfm.CreateAgentAbsenceRequest
-	(*CreateAgentAbsenceResponse)(nil),            // 2: wfm.CreateAgentAbsenceResponse
-	(*ReadAgentAbsenceRequest)(nil),               // 3: wfm.ReadAgentAbsenceRequest
-	(*ReadAgentAbsenceResponse)(nil),              // 4: wfm.ReadAgentAbsenceResponse
-	(*SearchAgentAbsenceRequest)(nil),             // 5: wfm.SearchAgentAbsenceRequest
-	(*SearchAgentAbsenceResponse)(nil),            // 6: wfm.SearchAgentAbsenceResponse
-	(*UpdateAgentAbsenceRequest)(nil),             // 7: wfm.UpdateAgentAbsenceRequest
-	(*UpdateAgentAbsenceResponse)(nil),            // 8: wfm.UpdateAgentAbsenceResponse
-	(*DeleteAgentAbsenceRequest)(nil),             // 9: wfm.DeleteAgentAbsenceRequest
-	(*DeleteAgentAbsenceResponse)(nil),            // 10: wfm.DeleteAgentAbsenceResponse
-	(*CreateAgentsAbsencesRequest)(nil),           // 11: wfm.CreateAgentsAbsencesRequest
-	(*CreateAgentsAbsencesResponse)(nil),          // 12: wfm.CreateAgentsAbsencesResponse
-	(*SearchAgentsAbsencesRequest)(nil),           // 13: wfm.SearchAgentsAbsencesRequest
-	(*SearchAgentsAbsencesResponse)(nil),          // 14: wfm.SearchAgentsAbsencesResponse
-	(*AgentAbsences)(nil),                         // 15: wfm.AgentAbsences
-	(*Absence)(nil),                               // 16: wfm.Absence
-	(*CreateAgentsAbsencesRequestAbsentType)(nil), // 17: wfm.CreateAgentsAbsencesRequest.absentType
-	(*LookupEntity)(nil),                          // 18: wfm.LookupEntity
+	(*CreateAgentAbsenceRequest)(nil),             // 0: wfm.CreateAgentAbsenceRequest
+	(*CreateAgentAbsenceResponse)(nil),            // 1: wfm.CreateAgentAbsenceResponse
+	(*ReadAgentAbsenceRequest)(nil),               // 2: wfm.ReadAgentAbsenceRequest
+	(*ReadAgentAbsenceResponse)(nil),              // 3: wfm.ReadAgentAbsenceResponse
+	(*SearchAgentAbsenceRequest)(nil),             // 4: wfm.SearchAgentAbsenceRequest
+	(*SearchAgentAbsenceResponse)(nil),            // 5: wfm.SearchAgentAbsenceResponse
+	(*UpdateAgentAbsenceRequest)(nil),             // 6: wfm.UpdateAgentAbsenceRequest
+	(*UpdateAgentAbsenceResponse)(nil),            // 7: wfm.UpdateAgentAbsenceResponse
+	(*DeleteAgentAbsenceRequest)(nil),             // 8: wfm.DeleteAgentAbsenceRequest
+	(*DeleteAgentAbsenceResponse)(nil),            // 9: wfm.DeleteAgentAbsenceResponse
+	(*CreateAgentsAbsencesRequest)(nil),           // 10: wfm.CreateAgentsAbsencesRequest
+	(*CreateAgentsAbsencesResponse)(nil),          // 11: wfm.CreateAgentsAbsencesResponse
+	(*SearchAgentsAbsencesRequest)(nil),           // 12: wfm.SearchAgentsAbsencesRequest
+	(*SearchAgentsAbsencesResponse)(nil),          // 13: wfm.SearchAgentsAbsencesResponse
+	(*ReadAgentAbsenceBalanceRequest)(nil),        // 14: wfm.ReadAgentAbsenceBalanceRequest
+	(*ReadAgentAbsenceBalanceResponse)(nil),       // 15: wfm.ReadAgentAbsenceBalanceResponse
+	(*AgentAbsenceBalance)(nil),                   // 16: wfm.AgentAbsenceBalance
+	(*AgentAbsences)(nil),                         // 17: wfm.AgentAbsences
+	(*Absence)(nil),                               // 18: wfm.Absence
+	(*CreateAgentsAbsencesRequestAbsentType)(nil), // 19: wfm.CreateAgentsAbsencesRequest.absentType
+	(*LookupEntity)(nil),                          // 20: wfm.LookupEntity
 }
 var file_agent_absence_proto_depIdxs = []int32{
-	16, // 0: wfm.CreateAgentAbsenceRequest.item:type_name -> wfm.Absence
-	16, // 1: wfm.CreateAgentAbsenceResponse.item:type_name -> wfm.Absence
-	16, // 2: wfm.ReadAgentAbsenceResponse.item:type_name -> wfm.Absence
-	16, // 3: wfm.SearchAgentAbsenceResponse.items:type_name -> wfm.Absence
-	16, // 4: wfm.UpdateAgentAbsenceRequest.item:type_name -> wfm.Absence
-	16, // 5: wfm.UpdateAgentAbsenceResponse.item:type_name -> wfm.Absence
-	17, // 6: wfm.CreateAgentsAbsencesRequest.items:type_name -> wfm.CreateAgentsAbsencesRequest.absentType
-	15, // 7: wfm.CreateAgentsAbsencesResponse.items:type_name -> wfm.AgentAbsences
-	15, // 8: wfm.SearchAgentsAbsencesResponse.items:type_name -> wfm.AgentAbsences
-	18, // 9: wfm.AgentAbsences.agent:type_name -> wfm.LookupEntity
-	16, // 10: wfm.AgentAbsences.absences:type_name -> wfm.Absence
-	18, // 11: wfm.Absence.created_by:type_name -> wfm.LookupEntity
-	18, // 12: wfm.Absence.updated_by:type_name -> wfm.LookupEntity
-	0,  // 13: wfm.Absence.type_id:type_name -> wfm.AbsenceType
-	0,  // 14: wfm.CreateAgentsAbsencesRequest.absentType.type_id:type_name -> wfm.AbsenceType
-	1,  // 15: wfm.AgentAbsenceService.CreateAgentAbsence:input_type -> wfm.CreateAgentAbsenceRequest
-	3,  // 16: wfm.AgentAbsenceService.ReadAgentAbsence:input_type -> wfm.ReadAgentAbsenceRequest
-	5,  // 17: wfm.AgentAbsenceService.SearchAgentAbsence:input_type -> wfm.SearchAgentAbsenceRequest
-	7,  // 18: wfm.AgentAbsenceService.UpdateAgentAbsence:input_type -> wfm.UpdateAgentAbsenceRequest
-	9,  // 19: wfm.AgentAbsenceService.DeleteAgentAbsence:input_type -> wfm.DeleteAgentAbsenceRequest
-	11, // 20: wfm.AgentAbsenceService.CreateAgentsAbsences:input_type -> wfm.CreateAgentsAbsencesRequest
-	13, // 21: wfm.AgentAbsenceService.SearchAgentsAbsences:input_type -> wfm.SearchAgentsAbsencesRequest
-	2,  // 22: wfm.AgentAbsenceService.CreateAgentAbsence:output_type -> wfm.CreateAgentAbsenceResponse
-	4,  // 23: wfm.AgentAbsenceService.ReadAgentAbsence:output_type -> wfm.ReadAgentAbsenceResponse
-	6,  // 24: wfm.AgentAbsenceService.SearchAgentAbsence:output_type -> wfm.SearchAgentAbsenceResponse
-	8,  // 25: wfm.AgentAbsenceService.UpdateAgentAbsence:output_type -> wfm.UpdateAgentAbsenceResponse
-	10, // 26: wfm.AgentAbsenceService.DeleteAgentAbsence:output_type -> wfm.DeleteAgentAbsenceResponse
-	12, // 27: wfm.AgentAbsenceService.CreateAgentsAbsences:output_type -> wfm.CreateAgentsAbsencesResponse
-	14, // 28: wfm.AgentAbsenceService.SearchAgentsAbsences:output_type -> wfm.SearchAgentsAbsencesResponse
-	22, // [22:29] is the sub-list for method output_type
-	15, // [15:22] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	18, // 0: wfm.CreateAgentAbsenceRequest.item:type_name -> wfm.Absence
+	18, // 1: wfm.CreateAgentAbsenceResponse.item:type_name -> wfm.Absence
+	18, // 2: wfm.ReadAgentAbsenceResponse.item:type_name -> wfm.Absence
+	18, // 3: wfm.SearchAgentAbsenceResponse.items:type_name -> wfm.Absence
+	18, // 4: wfm.UpdateAgentAbsenceRequest.item:type_name -> wfm.Absence
+	18, // 5: wfm.UpdateAgentAbsenceResponse.item:type_name -> wfm.Absence
+	19, // 6: wfm.CreateAgentsAbsencesRequest.items:type_name -> wfm.CreateAgentsAbsencesRequest.absentType
+	17, // 7: wfm.CreateAgentsAbsencesResponse.items:type_name -> wfm.AgentAbsences
+	17, // 8: wfm.SearchAgentsAbsencesResponse.items:type_name -> wfm.AgentAbsences
+	16, // 9: wfm.ReadAgentAbsenceBalanceResponse.items:type_name -> wfm.AgentAbsenceBalance
+	20, // 10: wfm.AgentAbsenceBalance.type:type_name -> wfm.LookupEntity
+	20, // 11: wfm.AgentAbsences.agent:type_name -> wfm.LookupEntity
+	18, // 12: wfm.AgentAbsences.absences:type_name -> wfm.Absence
+	20, // 13: wfm.Absence.created_by:type_name -> wfm.LookupEntity
+	20, // 14: wfm.Absence.updated_by:type_name -> wfm.LookupEntity
+	20, // 15: wfm.Absence.type:type_name -> wfm.LookupEntity
+	0,  // 16: wfm.AgentAbsenceService.CreateAgentAbsence:input_type -> wfm.CreateAgentAbsenceRequest
+	2,  // 17: wfm.AgentAbsenceService.ReadAgentAbsence:input_type -> wfm.ReadAgentAbsenceRequest
+	4,  // 18: wfm.AgentAbsenceService.SearchAgentAbsence:input_type -> wfm.SearchAgentAbsenceRequest
+	14, // 19: wfm.AgentAbsenceService.ReadAgentAbsenceBalance:input_type -> wfm.ReadAgentAbsenceBalanceRequest
+	6,  // 20: wfm.AgentAbsenceService.UpdateAgentAbsence:input_type -> wfm.UpdateAgentAbsenceRequest
+	8,  // 21: wfm.AgentAbsenceService.DeleteAgentAbsence:input_type -> wfm.DeleteAgentAbsenceRequest
+	10, // 22: wfm.AgentAbsenceService.CreateAgentsAbsences:input_type -> wfm.CreateAgentsAbsencesRequest
+	12, // 23: wfm.AgentAbsenceService.SearchAgentsAbsences:input_type -> wfm.SearchAgentsAbsencesRequest
+	1,  // 24: wfm.AgentAbsenceService.CreateAgentAbsence:output_type -> wfm.CreateAgentAbsenceResponse
+	3,  // 25: wfm.AgentAbsenceService.ReadAgentAbsence:output_type -> wfm.ReadAgentAbsenceResponse
+	5,  // 26: wfm.AgentAbsenceService.SearchAgentAbsence:output_type -> wfm.SearchAgentAbsenceResponse
+	15, // 27: wfm.AgentAbsenceService.ReadAgentAbsenceBalance:output_type -> wfm.ReadAgentAbsenceBalanceResponse
+	7,  // 28: wfm.AgentAbsenceService.UpdateAgentAbsence:output_type -> wfm.UpdateAgentAbsenceResponse
+	9,  // 29: wfm.AgentAbsenceService.DeleteAgentAbsence:output_type -> wfm.DeleteAgentAbsenceResponse
+	11, // 30: wfm.AgentAbsenceService.CreateAgentsAbsences:output_type -> wfm.CreateAgentsAbsencesResponse
+	13, // 31: wfm.AgentAbsenceService.SearchAgentsAbsences:output_type -> wfm.SearchAgentsAbsencesResponse
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_agent_absence_proto_init() }
@@ -1542,7 +1810,7 @@ func file_agent_absence_proto_init() {
 			}
 		}
 		file_agent_absence_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentAbsences); i {
+			switch v := v.(*ReadAgentAbsenceBalanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1554,7 +1822,7 @@ func file_agent_absence_proto_init() {
 			}
 		}
 		file_agent_absence_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Absence); i {
+			switch v := v.(*ReadAgentAbsenceBalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1566,6 +1834,42 @@ func file_agent_absence_proto_init() {
 			}
 		}
 		file_agent_absence_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentAbsenceBalance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_absence_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentAbsences); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_absence_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Absence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_absence_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateAgentsAbsencesRequestAbsentType); i {
 			case 0:
 				return &v.state
@@ -1580,19 +1884,20 @@ func file_agent_absence_proto_init() {
 	}
 	file_agent_absence_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	file_agent_absence_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_agent_absence_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_agent_absence_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_agent_absence_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   17,
+			NumEnums:      0,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_agent_absence_proto_goTypes,
 		DependencyIndexes: file_agent_absence_proto_depIdxs,
-		EnumInfos:         file_agent_absence_proto_enumTypes,
 		MessageInfos:      file_agent_absence_proto_msgTypes,
 	}.Build()
 	File_agent_absence_proto = out.File
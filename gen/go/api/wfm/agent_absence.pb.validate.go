@@ -88,6 +88,8 @@ func (m *CreateAgentAbsenceRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for AllowExceedBalance
+
 	if len(errors) > 0 {
 		return CreateAgentAbsenceRequestMultiError(errors)
 	}
@@ -219,6 +221,8 @@ func (m *CreateAgentAbsenceResponse) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for BalanceExceeded
+
 	if len(errors) > 0 {
 		return CreateAgentAbsenceResponseMultiError(errors)
 	}
@@ -1794,6 +1798,391 @@ var _ interface {
 	ErrorName() string
 } = SearchAgentsAbsencesResponseValidationError{}
 
+// Validate checks the field values on ReadAgentAbsenceBalanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadAgentAbsenceBalanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadAgentAbsenceBalanceRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReadAgentAbsenceBalanceRequestMultiError, or nil if none found.
+func (m *ReadAgentAbsenceBalanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadAgentAbsenceBalanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgentId
+
+	// no validation rules for Year
+
+	// no validation rules for Prorate
+
+	if len(errors) > 0 {
+		return ReadAgentAbsenceBalanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadAgentAbsenceBalanceRequestMultiError is an error wrapping multiple
+// validation errors returned by ReadAgentAbsenceBalanceRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ReadAgentAbsenceBalanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadAgentAbsenceBalanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadAgentAbsenceBalanceRequestMultiError) AllErrors() []error { return m }
+
+// ReadAgentAbsenceBalanceRequestValidationError is the validation error
+// returned by ReadAgentAbsenceBalanceRequest.Validate if the designated
+// constraints aren't met.
+type ReadAgentAbsenceBalanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadAgentAbsenceBalanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadAgentAbsenceBalanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadAgentAbsenceBalanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadAgentAbsenceBalanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadAgentAbsenceBalanceRequestValidationError) ErrorName() string {
+	return "ReadAgentAbsenceBalanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadAgentAbsenceBalanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadAgentAbsenceBalanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadAgentAbsenceBalanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadAgentAbsenceBalanceRequestValidationError{}
+
+// Validate checks the field values on ReadAgentAbsenceBalanceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadAgentAbsenceBalanceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadAgentAbsenceBalanceResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReadAgentAbsenceBalanceResponseMultiError, or nil if none found.
+func (m *ReadAgentAbsenceBalanceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadAgentAbsenceBalanceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadAgentAbsenceBalanceResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadAgentAbsenceBalanceResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadAgentAbsenceBalanceResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadAgentAbsenceBalanceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadAgentAbsenceBalanceResponseMultiError is an error wrapping multiple
+// validation errors returned by ReadAgentAbsenceBalanceResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ReadAgentAbsenceBalanceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadAgentAbsenceBalanceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadAgentAbsenceBalanceResponseMultiError) AllErrors() []error { return m }
+
+// ReadAgentAbsenceBalanceResponseValidationError is the validation error
+// returned by ReadAgentAbsenceBalanceResponse.Validate if the designated
+// constraints aren't met.
+type ReadAgentAbsenceBalanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadAgentAbsenceBalanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadAgentAbsenceBalanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadAgentAbsenceBalanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadAgentAbsenceBalanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadAgentAbsenceBalanceResponseValidationError) ErrorName() string {
+	return "ReadAgentAbsenceBalanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadAgentAbsenceBalanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadAgentAbsenceBalanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadAgentAbsenceBalanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadAgentAbsenceBalanceResponseValidationError{}
+
+// Validate checks the field values on AgentAbsenceBalance with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AgentAbsenceBalance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgentAbsenceBalance with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgentAbsenceBalanceMultiError, or nil if none found.
+func (m *AgentAbsenceBalance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgentAbsenceBalance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentAbsenceBalanceValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentAbsenceBalanceValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentAbsenceBalanceValidationError{
+				field:  "Type",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Entitled
+
+	// no validation rules for Used
+
+	// no validation rules for Planned
+
+	// no validation rules for Remaining
+
+	if len(errors) > 0 {
+		return AgentAbsenceBalanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgentAbsenceBalanceMultiError is an error wrapping multiple validation
+// errors returned by AgentAbsenceBalance.ValidateAll() if the designated
+// constraints aren't met.
+type AgentAbsenceBalanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgentAbsenceBalanceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgentAbsenceBalanceMultiError) AllErrors() []error { return m }
+
+// AgentAbsenceBalanceValidationError is the validation error returned by
+// AgentAbsenceBalance.Validate if the designated constraints aren't met.
+type AgentAbsenceBalanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgentAbsenceBalanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgentAbsenceBalanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgentAbsenceBalanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgentAbsenceBalanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgentAbsenceBalanceValidationError) ErrorName() string {
+	return "AgentAbsenceBalanceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgentAbsenceBalanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgentAbsenceBalance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgentAbsenceBalanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgentAbsenceBalanceValidationError{}
+
 // Validate checks the field values on AgentAbsences with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2044,10 +2433,45 @@ func (m *Absence) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for TypeId
-
 	// no validation rules for AbsentAt
 
+	if all {
+		switch v := interface{}(m.GetType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AbsenceValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AbsenceValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AbsenceValidationError{
+				field:  "Type",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Start != nil {
+		// no validation rules for Start
+	}
+
+	if m.End != nil {
+		// no validation rules for End
+	}
+
 	if len(errors) > 0 {
 		return AbsenceMultiError(errors)
 	}
@@ -2154,6 +2578,14 @@ func (m *CreateAgentsAbsencesRequestAbsentType) validate(all bool) error {
 
 	// no validation rules for DateTo
 
+	if m.Start != nil {
+		// no validation rules for Start
+	}
+
+	if m.End != nil {
+		// no validation rules for End
+	}
+
 	if len(errors) > 0 {
 		return CreateAgentsAbsencesRequestAbsentTypeMultiError(errors)
 	}
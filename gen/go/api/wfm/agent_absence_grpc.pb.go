@@ -19,13 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AgentAbsenceService_CreateAgentAbsence_FullMethodName   = "/wfm.AgentAbsenceService/CreateAgentAbsence"
-	AgentAbsenceService_ReadAgentAbsence_FullMethodName     = "/wfm.AgentAbsenceService/ReadAgentAbsence"
-	AgentAbsenceService_SearchAgentAbsence_FullMethodName   = "/wfm.AgentAbsenceService/SearchAgentAbsence"
-	AgentAbsenceService_UpdateAgentAbsence_FullMethodName   = "/wfm.AgentAbsenceService/UpdateAgentAbsence"
-	AgentAbsenceService_DeleteAgentAbsence_FullMethodName   = "/wfm.AgentAbsenceService/DeleteAgentAbsence"
-	AgentAbsenceService_CreateAgentsAbsences_FullMethodName = "/wfm.AgentAbsenceService/CreateAgentsAbsences"
-	AgentAbsenceService_SearchAgentsAbsences_FullMethodName = "/wfm.AgentAbsenceService/SearchAgentsAbsences"
+	AgentAbsenceService_CreateAgentAbsence_FullMethodName      = "/wfm.AgentAbsenceService/CreateAgentAbsence"
+	AgentAbsenceService_ReadAgentAbsence_FullMethodName        = "/wfm.AgentAbsenceService/ReadAgentAbsence"
+	AgentAbsenceService_SearchAgentAbsence_FullMethodName      = "/wfm.AgentAbsenceService/SearchAgentAbsence"
+	AgentAbsenceService_ReadAgentAbsenceBalance_FullMethodName = "/wfm.AgentAbsenceService/ReadAgentAbsenceBalance"
+	AgentAbsenceService_UpdateAgentAbsence_FullMethodName      = "/wfm.AgentAbsenceService/UpdateAgentAbsence"
+	AgentAbsenceService_DeleteAgentAbsence_FullMethodName      = "/wfm.AgentAbsenceService/DeleteAgentAbsence"
+	AgentAbsenceService_CreateAgentsAbsences_FullMethodName    = "/wfm.AgentAbsenceService/CreateAgentsAbsences"
+	AgentAbsenceService_SearchAgentsAbsences_FullMethodName    = "/wfm.AgentAbsenceService/SearchAgentsAbsences"
 )
 
 // AgentAbsenceServiceClient is the client API for AgentAbsenceService service.
@@ -38,6 +39,8 @@ type AgentAbsenceServiceClient interface {
 	ReadAgentAbsence(ctx context.Context, in *ReadAgentAbsenceRequest, opts ...grpc.CallOption) (*ReadAgentAbsenceResponse, error)
 	// Searches agent absences by filters.
 	SearchAgentAbsence(ctx context.Context, in *SearchAgentAbsenceRequest, opts ...grpc.CallOption) (*SearchAgentAbsenceResponse, error)
+	// Reads agent absence balances per absence type within a year.
+	ReadAgentAbsenceBalance(ctx context.Context, in *ReadAgentAbsenceBalanceRequest, opts ...grpc.CallOption) (*ReadAgentAbsenceBalanceResponse, error)
 	// Updates agent concrete absence by its id.
 	UpdateAgentAbsence(ctx context.Context, in *UpdateAgentAbsenceRequest, opts ...grpc.CallOption) (*UpdateAgentAbsenceResponse, error)
 	// Deletes agent concrete absence by its id.
@@ -83,6 +86,15 @@ func (c *agentAbsenceServiceClient) SearchAgentAbsence(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *agentAbsenceServiceClient) ReadAgentAbsenceBalance(ctx context.Context, in *ReadAgentAbsenceBalanceRequest, opts ...grpc.CallOption) (*ReadAgentAbsenceBalanceResponse, error) {
+	out := new(ReadAgentAbsenceBalanceResponse)
+	err := c.cc.Invoke(ctx, AgentAbsenceService_ReadAgentAbsenceBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentAbsenceServiceClient) UpdateAgentAbsence(ctx context.Context, in *UpdateAgentAbsenceRequest, opts ...grpc.CallOption) (*UpdateAgentAbsenceResponse, error) {
 	out := new(UpdateAgentAbsenceResponse)
 	err := c.cc.Invoke(ctx, AgentAbsenceService_UpdateAgentAbsence_FullMethodName, in, out, opts...)
@@ -129,6 +141,8 @@ type AgentAbsenceServiceServer interface {
 	ReadAgentAbsence(context.Context, *ReadAgentAbsenceRequest) (*ReadAgentAbsenceResponse, error)
 	// Searches agent absences by filters.
 	SearchAgentAbsence(context.Context, *SearchAgentAbsenceRequest) (*SearchAgentAbsenceResponse, error)
+	// Reads agent absence balances per absence type within a year.
+	ReadAgentAbsenceBalance(context.Context, *ReadAgentAbsenceBalanceRequest) (*ReadAgentAbsenceBalanceResponse, error)
 	// Updates agent concrete absence by its id.
 	UpdateAgentAbsence(context.Context, *UpdateAgentAbsenceRequest) (*UpdateAgentAbsenceResponse, error)
 	// Deletes agent concrete absence by its id.
@@ -153,6 +167,9 @@ func (UnimplementedAgentAbsenceServiceServer) ReadAgentAbsence(context.Context,
 func (UnimplementedAgentAbsenceServiceServer) SearchAgentAbsence(context.Context, *SearchAgentAbsenceRequest) (*SearchAgentAbsenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchAgentAbsence not implemented")
 }
+func (UnimplementedAgentAbsenceServiceServer) ReadAgentAbsenceBalance(context.Context, *ReadAgentAbsenceBalanceRequest) (*ReadAgentAbsenceBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAgentAbsenceBalance not implemented")
+}
 func (UnimplementedAgentAbsenceServiceServer) UpdateAgentAbsence(context.Context, *UpdateAgentAbsenceRequest) (*UpdateAgentAbsenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentAbsence not implemented")
 }
@@ -232,6 +249,24 @@ func _AgentAbsenceService_SearchAgentAbsence_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentAbsenceService_ReadAgentAbsenceBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAgentAbsenceBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentAbsenceServiceServer).ReadAgentAbsenceBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentAbsenceService_ReadAgentAbsenceBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentAbsenceServiceServer).ReadAgentAbsenceBalance(ctx, req.(*ReadAgentAbsenceBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AgentAbsenceService_UpdateAgentAbsence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateAgentAbsenceRequest)
 	if err := dec(in); err != nil {
@@ -323,6 +358,10 @@ var AgentAbsenceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchAgentAbsence",
 			Handler:    _AgentAbsenceService_SearchAgentAbsence_Handler,
 		},
+		{
+			MethodName: "ReadAgentAbsenceBalance",
+			Handler:    _AgentAbsenceService_ReadAgentAbsenceBalance_Handler,
+		},
 		{
 			MethodName: "UpdateAgentAbsence",
 			Handler:    _AgentAbsenceService_UpdateAgentAbsence_Handler,
@@ -0,0 +1,1327 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: agent_calendar.proto
+
+package wfm
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AgentScheduleChangeAction int32
+
+const (
+	AgentScheduleChangeAction_AGENT_SCHEDULE_CHANGE_ACTION_UNSPECIFIED AgentScheduleChangeAction = 0
+	AgentScheduleChangeAction_AGENT_SCHEDULE_CHANGE_ACTION_CREATED     AgentScheduleChangeAction = 1
+	AgentScheduleChangeAction_AGENT_SCHEDULE_CHANGE_ACTION_UPDATED     AgentScheduleChangeAction = 2
+	AgentScheduleChangeAction_AGENT_SCHEDULE_CHANGE_ACTION_DELETED     AgentScheduleChangeAction = 3
+)
+
+// Enum value maps for AgentScheduleChangeAction.
+var (
+	AgentScheduleChangeAction_name = map[int32]string{
+		0: "AGENT_SCHEDULE_CHANGE_ACTION_UNSPECIFIED",
+		1: "AGENT_SCHEDULE_CHANGE_ACTION_CREATED",
+		2: "AGENT_SCHEDULE_CHANGE_ACTION_UPDATED",
+		3: "AGENT_SCHEDULE_CHANGE_ACTION_DELETED",
+	}
+	AgentScheduleChangeAction_value = map[string]int32{
+		"AGENT_SCHEDULE_CHANGE_ACTION_UNSPECIFIED": 0,
+		"AGENT_SCHEDULE_CHANGE_ACTION_CREATED":     1,
+		"AGENT_SCHEDULE_CHANGE_ACTION_UPDATED":     2,
+		"AGENT_SCHEDULE_CHANGE_ACTION_DELETED":     3,
+	}
+)
+
+func (x AgentScheduleChangeAction) Enum() *AgentScheduleChangeAction {
+	p := new(AgentScheduleChangeAction)
+	*p = x
+	return p
+}
+
+func (x AgentScheduleChangeAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AgentScheduleChangeAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_calendar_proto_enumTypes[0].Descriptor()
+}
+
+func (AgentScheduleChangeAction) Type() protoreflect.EnumType {
+	return &file_agent_calendar_proto_enumTypes[0]
+}
+
+func (x AgentScheduleChangeAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AgentScheduleChangeAction.Descriptor instead.
+func (AgentScheduleChangeAction) EnumDescriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{0}
+}
+
+type ReadAgentCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId int64 `protobuf:"varint,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// Defaults to 30 days before and 90 days after the current date.
+	Date *FilterBetween `protobuf:"bytes,2,opt,name=date,proto3,oneof" json:"date,omitempty"`
+}
+
+func (x *ReadAgentCalendarRequest) Reset() {
+	*x = ReadAgentCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAgentCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAgentCalendarRequest) ProtoMessage() {}
+
+func (x *ReadAgentCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAgentCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ReadAgentCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReadAgentCalendarRequest) GetAgentId() int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+func (x *ReadAgentCalendarRequest) GetDate() *FilterBetween {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type CreateAgentCalendarFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId int64 `protobuf:"varint,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+}
+
+func (x *CreateAgentCalendarFeedRequest) Reset() {
+	*x = CreateAgentCalendarFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAgentCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAgentCalendarFeedRequest) ProtoMessage() {}
+
+func (x *CreateAgentCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAgentCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateAgentCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateAgentCalendarFeedRequest) GetAgentId() int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+type CreateAgentCalendarFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *AgentCalendarFeed `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CreateAgentCalendarFeedResponse) Reset() {
+	*x = CreateAgentCalendarFeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAgentCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAgentCalendarFeedResponse) ProtoMessage() {}
+
+func (x *CreateAgentCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAgentCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*CreateAgentCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateAgentCalendarFeedResponse) GetItem() *AgentCalendarFeed {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ReadAgentCalendarFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId int64 `protobuf:"varint,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+}
+
+func (x *ReadAgentCalendarFeedRequest) Reset() {
+	*x = ReadAgentCalendarFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAgentCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAgentCalendarFeedRequest) ProtoMessage() {}
+
+func (x *ReadAgentCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAgentCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*ReadAgentCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReadAgentCalendarFeedRequest) GetAgentId() int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+type ReadAgentCalendarFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *AgentCalendarFeed `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ReadAgentCalendarFeedResponse) Reset() {
+	*x = ReadAgentCalendarFeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadAgentCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAgentCalendarFeedResponse) ProtoMessage() {}
+
+func (x *ReadAgentCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAgentCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*ReadAgentCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReadAgentCalendarFeedResponse) GetItem() *AgentCalendarFeed {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteAgentCalendarFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentId int64 `protobuf:"varint,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+}
+
+func (x *DeleteAgentCalendarFeedRequest) Reset() {
+	*x = DeleteAgentCalendarFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAgentCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAgentCalendarFeedRequest) ProtoMessage() {}
+
+func (x *DeleteAgentCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAgentCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAgentCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteAgentCalendarFeedRequest) GetAgentId() int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return 0
+}
+
+type DeleteAgentCalendarFeedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteAgentCalendarFeedResponse) Reset() {
+	*x = DeleteAgentCalendarFeedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAgentCalendarFeedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAgentCalendarFeedResponse) ProtoMessage() {}
+
+func (x *DeleteAgentCalendarFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAgentCalendarFeedResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAgentCalendarFeedResponse) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{6}
+}
+
+type SubscribeAgentCalendarFeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *SubscribeAgentCalendarFeedRequest) Reset() {
+	*x = SubscribeAgentCalendarFeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeAgentCalendarFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAgentCalendarFeedRequest) ProtoMessage() {}
+
+func (x *SubscribeAgentCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAgentCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeAgentCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SubscribeAgentCalendarFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// AgentCalendarFeed is a subscription of calendar clients to the agent's calendar,
+// the token grants read access to the calendar without a session.
+type AgentCalendarFeed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Agent *LookupEntity `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// Subscribe token, only its hash is stored, so it is returned only when the feed is created.
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// Subscribe path of the feed relative to the API address, returned only when the feed is created.
+	Path      string        `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	CreatedAt int64         `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy *LookupEntity `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+}
+
+func (x *AgentCalendarFeed) Reset() {
+	*x = AgentCalendarFeed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentCalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentCalendarFeed) ProtoMessage() {}
+
+func (x *AgentCalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentCalendarFeed.ProtoReflect.Descriptor instead.
+func (*AgentCalendarFeed) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AgentCalendarFeed) GetAgent() *LookupEntity {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *AgentCalendarFeed) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AgentCalendarFeed) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AgentCalendarFeed) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AgentCalendarFeed) GetCreatedBy() *LookupEntity {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+type ReadMyScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Defaults to 30 days before and 90 days after the current date.
+	Date *FilterBetween `protobuf:"bytes,1,opt,name=date,proto3,oneof" json:"date,omitempty"`
+}
+
+func (x *ReadMyScheduleRequest) Reset() {
+	*x = ReadMyScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadMyScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMyScheduleRequest) ProtoMessage() {}
+
+func (x *ReadMyScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMyScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ReadMyScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReadMyScheduleRequest) GetDate() *FilterBetween {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+type ReadMyScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Agent *LookupEntity `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	// Agent's own timezone, local times of shifts are within it.
+	Timezone string                `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Shifts   []*AgentCalendarShift `protobuf:"bytes,3,rep,name=shifts,proto3" json:"shifts,omitempty"`
+	Absences []*Absence            `protobuf:"bytes,4,rep,name=absences,proto3" json:"absences,omitempty"`
+	Holidays []*Holiday            `protobuf:"bytes,5,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	// Changes of shifts within the date period, the latest first.
+	Changes []*AgentScheduleChange `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ReadMyScheduleResponse) Reset() {
+	*x = ReadMyScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadMyScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadMyScheduleResponse) ProtoMessage() {}
+
+func (x *ReadMyScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadMyScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ReadMyScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReadMyScheduleResponse) GetAgent() *LookupEntity {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *ReadMyScheduleResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ReadMyScheduleResponse) GetShifts() []*AgentCalendarShift {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+func (x *ReadMyScheduleResponse) GetAbsences() []*Absence {
+	if x != nil {
+		return x.Absences
+	}
+	return nil
+}
+
+func (x *ReadMyScheduleResponse) GetHolidays() []*Holiday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+func (x *ReadMyScheduleResponse) GetChanges() []*AgentScheduleChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// AgentCalendarShift is a shift or a segment of a split shift within a working schedule.
+type AgentCalendarShift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingSchedule *LookupEntity       `protobuf:"bytes,1,opt,name=working_schedule,json=workingSchedule,proto3" json:"working_schedule,omitempty"`
+	Date            int64               `protobuf:"varint,2,opt,name=date,proto3" json:"date,omitempty"`
+	Shift           *AgentScheduleShift `protobuf:"bytes,3,opt,name=shift,proto3" json:"shift,omitempty"`
+}
+
+func (x *AgentCalendarShift) Reset() {
+	*x = AgentCalendarShift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentCalendarShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentCalendarShift) ProtoMessage() {}
+
+func (x *AgentCalendarShift) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentCalendarShift.ProtoReflect.Descriptor instead.
+func (*AgentCalendarShift) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AgentCalendarShift) GetWorkingSchedule() *LookupEntity {
+	if x != nil {
+		return x.WorkingSchedule
+	}
+	return nil
+}
+
+func (x *AgentCalendarShift) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *AgentCalendarShift) GetShift() *AgentScheduleShift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+// AgentScheduleChange is a change of an agent shift. Start and end are set unless the shift is deleted,
+// previous start and end are set unless the shift is created.
+type AgentScheduleChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt       int64                     `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy       *LookupEntity             `protobuf:"bytes,3,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	WorkingSchedule *LookupEntity             `protobuf:"bytes,4,opt,name=working_schedule,json=workingSchedule,proto3" json:"working_schedule,omitempty"`
+	ShiftId         int64                     `protobuf:"varint,5,opt,name=shift_id,json=shiftId,proto3" json:"shift_id,omitempty"`
+	Date            int64                     `protobuf:"varint,6,opt,name=date,proto3" json:"date,omitempty"`
+	Action          AgentScheduleChangeAction `protobuf:"varint,7,opt,name=action,proto3,enum=wfm.AgentScheduleChangeAction" json:"action,omitempty"`
+	Start           *int64                    `protobuf:"varint,8,opt,name=start,proto3,oneof" json:"start,omitempty"`
+	End             *int64                    `protobuf:"varint,9,opt,name=end,proto3,oneof" json:"end,omitempty"`
+	PrevStart       *int64                    `protobuf:"varint,10,opt,name=prev_start,json=prevStart,proto3,oneof" json:"prev_start,omitempty"`
+	PrevEnd         *int64                    `protobuf:"varint,11,opt,name=prev_end,json=prevEnd,proto3,oneof" json:"prev_end,omitempty"`
+}
+
+func (x *AgentScheduleChange) Reset() {
+	*x = AgentScheduleChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_calendar_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentScheduleChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentScheduleChange) ProtoMessage() {}
+
+func (x *AgentScheduleChange) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_calendar_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentScheduleChange.ProtoReflect.Descriptor instead.
+func (*AgentScheduleChange) Descriptor() ([]byte, []int) {
+	return file_agent_calendar_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AgentScheduleChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AgentScheduleChange) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AgentScheduleChange) GetCreatedBy() *LookupEntity {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *AgentScheduleChange) GetWorkingSchedule() *LookupEntity {
+	if x != nil {
+		return x.WorkingSchedule
+	}
+	return nil
+}
+
+func (x *AgentScheduleChange) GetShiftId() int64 {
+	if x != nil {
+		return x.ShiftId
+	}
+	return 0
+}
+
+func (x *AgentScheduleChange) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *AgentScheduleChange) GetAction() AgentScheduleChangeAction {
+	if x != nil {
+		return x.Action
+	}
+	return AgentScheduleChangeAction_AGENT_SCHEDULE_CHANGE_ACTION_UNSPECIFIED
+}
+
+func (x *AgentScheduleChange) GetStart() int64 {
+	if x != nil && x.Start != nil {
+		return *x.Start
+	}
+	return 0
+}
+
+func (x *AgentScheduleChange) GetEnd() int64 {
+	if x != nil && x.End != nil {
+		return *x.End
+	}
+	return 0
+}
+
+func (x *AgentScheduleChange) GetPrevStart() int64 {
+	if x != nil && x.PrevStart != nil {
+		return *x.PrevStart
+	}
+	return 0
+}
+
+func (x *AgentScheduleChange) GetPrevEnd() int64 {
+	if x != nil && x.PrevEnd != nil {
+		return *x.PrevEnd
+	}
+	return 0
+}
+
+var File_agent_calendar_proto protoreflect.FileDescriptor
+
+var file_agent_calendar_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x77, 0x66, 0x6d, 0x1a, 0x1b, 0x62, 0x75, 0x66,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x6c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x77, 0x0a, 0x18,
+	0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8,
+	0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x6e, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x47, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01,
+	0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4d,
+	0x0a, 0x1f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x45, 0x0a,
+	0x1c, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0x4b, 0x0a, 0x1d, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x22, 0x47, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x21, 0x0a, 0x1f, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x44, 0x0a,
+	0x21, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1f, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x09, 0xba, 0x48, 0x06, 0x72, 0x04, 0x10, 0x20, 0x18, 0x40, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xb7, 0x01, 0x0a, 0x11, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x22, 0x4d, 0x0a,
+	0x15, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x96, 0x02, 0x0a,
+	0x16, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x2f, 0x0a, 0x06,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x28, 0x0a,
+	0x08, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x61,
+	0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79,
+	0x73, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x3c, 0x0a, 0x10,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2d,
+	0x0a, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x22, 0xbf, 0x03,
+	0x0a, 0x13, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3c, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x68, 0x69, 0x66, 0x74, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x02, 0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x53, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1e, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x45, 0x6e, 0x64, 0x88, 0x01,
+	0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f,
+	0x65, 0x6e, 0x64, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x65, 0x6e, 0x64, 0x2a,
+	0xc7, 0x01, 0x0a, 0x19, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a,
+	0x28, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x41,
+	0x47, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x28, 0x0a, 0x24, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x28, 0x0a, 0x24, 0x41, 0x47, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
+	0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x32, 0xdb, 0x06, 0x0a, 0x14, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x75, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x2b, 0x90, 0xb5,
+	0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x99, 0x01, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x46, 0x65, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x33, 0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22,
+	0x24, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x2f, 0x66, 0x65, 0x65, 0x64, 0x12, 0x90, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x12,
+	0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43,
+	0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x12, 0x24, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x2f, 0x66, 0x65, 0x65, 0x64, 0x12, 0x96, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x46, 0x65, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x30, 0x90, 0xb5, 0x18, 0x03, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x2a, 0x24, 0x2f, 0x77, 0x66,
+	0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x2f, 0x66, 0x65, 0x65,
+	0x64, 0x12, 0x67, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x79,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x79, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x90, 0xb5,
+	0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6d,
+	0x79, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x1a, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x12, 0x26, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48,
+	0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x27, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x64, 0x73, 0x2f, 0x7b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x7d,
+	0x1a, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d, 0x3b, 0x77, 0x66, 0x6d, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_agent_calendar_proto_rawDescOnce sync.Once
+	file_agent_calendar_proto_rawDescData = file_agent_calendar_proto_rawDesc
+)
+
+func file_agent_calendar_proto_rawDescGZIP() []byte {
+	file_agent_calendar_proto_rawDescOnce.Do(func() {
+		file_agent_calendar_proto_rawDescData = protoimpl.X.CompressGZIP(file_agent_calendar_proto_rawDescData)
+	})
+	return file_agent_calendar_proto_rawDescData
+}
+
+var file_agent_calendar_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_agent_calendar_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_agent_calendar_proto_goTypes = []interface{}{
+	(AgentScheduleChangeAction)(0),            // 0: wfm.AgentScheduleChangeAction
+	(*ReadAgentCalendarRequest)(nil),          // 1: wfm.ReadAgentCalendarRequest
+	(*CreateAgentCalendarFeedRequest)(nil),    // 2: wfm.CreateAgentCalendarFeedRequest
+	(*CreateAgentCalendarFeedResponse)(nil),   // 3: wfm.CreateAgentCalendarFeedResponse
+	(*ReadAgentCalendarFeedRequest)(nil),      // 4: wfm.ReadAgentCalendarFeedRequest
+	(*ReadAgentCalendarFeedResponse)(nil),     // 5: wfm.ReadAgentCalendarFeedResponse
+	(*DeleteAgentCalendarFeedRequest)(nil),    // 6: wfm.DeleteAgentCalendarFeedRequest
+	(*DeleteAgentCalendarFeedResponse)(nil),   // 7: wfm.DeleteAgentCalendarFeedResponse
+	(*SubscribeAgentCalendarFeedRequest)(nil), // 8: wfm.SubscribeAgentCalendarFeedRequest
+	(*AgentCalendarFeed)(nil),                 // 9: wfm.AgentCalendarFeed
+	(*ReadMyScheduleRequest)(nil),             // 10: wfm.ReadMyScheduleRequest
+	(*ReadMyScheduleResponse)(nil),            // 11: wfm.ReadMyScheduleResponse
+	(*AgentCalendarShift)(nil),                // 12: wfm.AgentCalendarShift
+	(*AgentScheduleChange)(nil),               // 13: wfm.AgentScheduleChange
+	(*FilterBetween)(nil),                     // 14: wfm.FilterBetween
+	(*LookupEntity)(nil),                      // 15: wfm.LookupEntity
+	(*Absence)(nil),                           // 16: wfm.Absence
+	(*Holiday)(nil),                           // 17: wfm.Holiday
+	(*AgentScheduleShift)(nil),                // 18: wfm.AgentScheduleShift
+	(*httpbody.HttpBody)(nil),                 // 19: google.api.HttpBody
+}
+var file_agent_calendar_proto_depIdxs = []int32{
+	14, // 0: wfm.ReadAgentCalendarRequest.date:type_name -> wfm.FilterBetween
+	9,  // 1: wfm.CreateAgentCalendarFeedResponse.item:type_name -> wfm.AgentCalendarFeed
+	9,  // 2: wfm.ReadAgentCalendarFeedResponse.item:type_name -> wfm.AgentCalendarFeed
+	15, // 3: wfm.AgentCalendarFeed.agent:type_name -> wfm.LookupEntity
+	15, // 4: wfm.AgentCalendarFeed.created_by:type_name -> wfm.LookupEntity
+	14, // 5: wfm.ReadMyScheduleRequest.date:type_name -> wfm.FilterBetween
+	15, // 6: wfm.ReadMyScheduleResponse.agent:type_name -> wfm.LookupEntity
+	12, // 7: wfm.ReadMyScheduleResponse.shifts:type_name -> wfm.AgentCalendarShift
+	16, // 8: wfm.ReadMyScheduleResponse.absences:type_name -> wfm.Absence
+	17, // 9: wfm.ReadMyScheduleResponse.holidays:type_name -> wfm.Holiday
+	13, // 10: wfm.ReadMyScheduleResponse.changes:type_name -> wfm.AgentScheduleChange
+	15, // 11: wfm.AgentCalendarShift.working_schedule:type_name -> wfm.LookupEntity
+	18, // 12: wfm.AgentCalendarShift.shift:type_name -> wfm.AgentScheduleShift
+	15, // 13: wfm.AgentScheduleChange.created_by:type_name -> wfm.LookupEntity
+	15, // 14: wfm.AgentScheduleChange.working_schedule:type_name -> wfm.LookupEntity
+	0,  // 15: wfm.AgentScheduleChange.action:type_name -> wfm.AgentScheduleChangeAction
+	1,  // 16: wfm.AgentCalendarService.ReadAgentCalendar:input_type -> wfm.ReadAgentCalendarRequest
+	2,  // 17: wfm.AgentCalendarService.CreateAgentCalendarFeed:input_type -> wfm.CreateAgentCalendarFeedRequest
+	4,  // 18: wfm.AgentCalendarService.ReadAgentCalendarFeed:input_type -> wfm.ReadAgentCalendarFeedRequest
+	6,  // 19: wfm.AgentCalendarService.DeleteAgentCalendarFeed:input_type -> wfm.DeleteAgentCalendarFeedRequest
+	10, // 20: wfm.AgentCalendarService.ReadMySchedule:input_type -> wfm.ReadMyScheduleRequest
+	8,  // 21: wfm.AgentCalendarService.SubscribeAgentCalendarFeed:input_type -> wfm.SubscribeAgentCalendarFeedRequest
+	19, // 22: wfm.AgentCalendarService.ReadAgentCalendar:output_type -> google.api.HttpBody
+	3,  // 23: wfm.AgentCalendarService.CreateAgentCalendarFeed:output_type -> wfm.CreateAgentCalendarFeedResponse
+	5,  // 24: wfm.AgentCalendarService.ReadAgentCalendarFeed:output_type -> wfm.ReadAgentCalendarFeedResponse
+	7,  // 25: wfm.AgentCalendarService.DeleteAgentCalendarFeed:output_type -> wfm.DeleteAgentCalendarFeedResponse
+	11, // 26: wfm.AgentCalendarService.ReadMySchedule:output_type -> wfm.ReadMyScheduleResponse
+	19, // 27: wfm.AgentCalendarService.SubscribeAgentCalendarFeed:output_type -> google.api.HttpBody
+	22, // [22:28] is the sub-list for method output_type
+	16, // [16:22] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_agent_calendar_proto_init() }
+func file_agent_calendar_proto_init() {
+	if File_agent_calendar_proto != nil {
+		return
+	}
+	file_lookup_proto_init()
+	file_filter_proto_init()
+	file_agent_absence_proto_init()
+	file_agent_working_schedule_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_agent_calendar_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAgentCalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAgentCalendarFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAgentCalendarFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAgentCalendarFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadAgentCalendarFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAgentCalendarFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAgentCalendarFeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeAgentCalendarFeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentCalendarFeed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMyScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMyScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentCalendarShift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_calendar_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentScheduleChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_agent_calendar_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_agent_calendar_proto_msgTypes[9].OneofWrappers = []interface{}{}
+	file_agent_calendar_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_agent_calendar_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_agent_calendar_proto_goTypes,
+		DependencyIndexes: file_agent_calendar_proto_depIdxs,
+		EnumInfos:         file_agent_calendar_proto_enumTypes,
+		MessageInfos:      file_agent_calendar_proto_msgTypes,
+	}.Build()
+	File_agent_calendar_proto = out.File
+	file_agent_calendar_proto_rawDesc = nil
+	file_agent_calendar_proto_goTypes = nil
+	file_agent_calendar_proto_depIdxs = nil
+}
@@ -0,0 +1,1880 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: agent_calendar.proto
+
+package wfm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ReadAgentCalendarRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadAgentCalendarRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadAgentCalendarRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadAgentCalendarRequestMultiError, or nil if none found.
+func (m *ReadAgentCalendarRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadAgentCalendarRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgentId
+
+	if m.Date != nil {
+
+		if all {
+			switch v := interface{}(m.GetDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadAgentCalendarRequestValidationError{
+						field:  "Date",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadAgentCalendarRequestValidationError{
+						field:  "Date",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadAgentCalendarRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadAgentCalendarRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadAgentCalendarRequestMultiError is an error wrapping multiple validation
+// errors returned by ReadAgentCalendarRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReadAgentCalendarRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadAgentCalendarRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadAgentCalendarRequestMultiError) AllErrors() []error { return m }
+
+// ReadAgentCalendarRequestValidationError is the validation error returned by
+// ReadAgentCalendarRequest.Validate if the designated constraints aren't met.
+type ReadAgentCalendarRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadAgentCalendarRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadAgentCalendarRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadAgentCalendarRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadAgentCalendarRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadAgentCalendarRequestValidationError) ErrorName() string {
+	return "ReadAgentCalendarRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadAgentCalendarRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadAgentCalendarRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadAgentCalendarRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadAgentCalendarRequestValidationError{}
+
+// Validate checks the field values on CreateAgentCalendarFeedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAgentCalendarFeedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAgentCalendarFeedRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateAgentCalendarFeedRequestMultiError, or nil if none found.
+func (m *CreateAgentCalendarFeedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAgentCalendarFeedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgentId
+
+	if len(errors) > 0 {
+		return CreateAgentCalendarFeedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAgentCalendarFeedRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateAgentCalendarFeedRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateAgentCalendarFeedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAgentCalendarFeedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAgentCalendarFeedRequestMultiError) AllErrors() []error { return m }
+
+// CreateAgentCalendarFeedRequestValidationError is the validation error
+// returned by CreateAgentCalendarFeedRequest.Validate if the designated
+// constraints aren't met.
+type CreateAgentCalendarFeedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAgentCalendarFeedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAgentCalendarFeedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAgentCalendarFeedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAgentCalendarFeedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAgentCalendarFeedRequestValidationError) ErrorName() string {
+	return "CreateAgentCalendarFeedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAgentCalendarFeedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAgentCalendarFeedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAgentCalendarFeedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAgentCalendarFeedRequestValidationError{}
+
+// Validate checks the field values on CreateAgentCalendarFeedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAgentCalendarFeedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAgentCalendarFeedResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateAgentCalendarFeedResponseMultiError, or nil if none found.
+func (m *CreateAgentCalendarFeedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAgentCalendarFeedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAgentCalendarFeedResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAgentCalendarFeedResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAgentCalendarFeedResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAgentCalendarFeedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAgentCalendarFeedResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateAgentCalendarFeedResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateAgentCalendarFeedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAgentCalendarFeedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAgentCalendarFeedResponseMultiError) AllErrors() []error { return m }
+
+// CreateAgentCalendarFeedResponseValidationError is the validation error
+// returned by CreateAgentCalendarFeedResponse.Validate if the designated
+// constraints aren't met.
+type CreateAgentCalendarFeedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAgentCalendarFeedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAgentCalendarFeedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAgentCalendarFeedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAgentCalendarFeedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAgentCalendarFeedResponseValidationError) ErrorName() string {
+	return "CreateAgentCalendarFeedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAgentCalendarFeedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAgentCalendarFeedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAgentCalendarFeedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAgentCalendarFeedResponseValidationError{}
+
+// Validate checks the field values on ReadAgentCalendarFeedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadAgentCalendarFeedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadAgentCalendarFeedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadAgentCalendarFeedRequestMultiError, or nil if none found.
+func (m *ReadAgentCalendarFeedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadAgentCalendarFeedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgentId
+
+	if len(errors) > 0 {
+		return ReadAgentCalendarFeedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadAgentCalendarFeedRequestMultiError is an error wrapping multiple
+// validation errors returned by ReadAgentCalendarFeedRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReadAgentCalendarFeedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadAgentCalendarFeedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadAgentCalendarFeedRequestMultiError) AllErrors() []error { return m }
+
+// ReadAgentCalendarFeedRequestValidationError is the validation error returned
+// by ReadAgentCalendarFeedRequest.Validate if the designated constraints
+// aren't met.
+type ReadAgentCalendarFeedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadAgentCalendarFeedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadAgentCalendarFeedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadAgentCalendarFeedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadAgentCalendarFeedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadAgentCalendarFeedRequestValidationError) ErrorName() string {
+	return "ReadAgentCalendarFeedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadAgentCalendarFeedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadAgentCalendarFeedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadAgentCalendarFeedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadAgentCalendarFeedRequestValidationError{}
+
+// Validate checks the field values on ReadAgentCalendarFeedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadAgentCalendarFeedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadAgentCalendarFeedResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ReadAgentCalendarFeedResponseMultiError, or nil if none found.
+func (m *ReadAgentCalendarFeedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadAgentCalendarFeedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadAgentCalendarFeedResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadAgentCalendarFeedResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadAgentCalendarFeedResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReadAgentCalendarFeedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadAgentCalendarFeedResponseMultiError is an error wrapping multiple
+// validation errors returned by ReadAgentCalendarFeedResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ReadAgentCalendarFeedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadAgentCalendarFeedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadAgentCalendarFeedResponseMultiError) AllErrors() []error { return m }
+
+// ReadAgentCalendarFeedResponseValidationError is the validation error
+// returned by ReadAgentCalendarFeedResponse.Validate if the designated
+// constraints aren't met.
+type ReadAgentCalendarFeedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadAgentCalendarFeedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadAgentCalendarFeedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadAgentCalendarFeedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadAgentCalendarFeedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadAgentCalendarFeedResponseValidationError) ErrorName() string {
+	return "ReadAgentCalendarFeedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadAgentCalendarFeedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadAgentCalendarFeedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadAgentCalendarFeedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadAgentCalendarFeedResponseValidationError{}
+
+// Validate checks the field values on DeleteAgentCalendarFeedRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAgentCalendarFeedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAgentCalendarFeedRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteAgentCalendarFeedRequestMultiError, or nil if none found.
+func (m *DeleteAgentCalendarFeedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAgentCalendarFeedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgentId
+
+	if len(errors) > 0 {
+		return DeleteAgentCalendarFeedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAgentCalendarFeedRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteAgentCalendarFeedRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteAgentCalendarFeedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAgentCalendarFeedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAgentCalendarFeedRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAgentCalendarFeedRequestValidationError is the validation error
+// returned by DeleteAgentCalendarFeedRequest.Validate if the designated
+// constraints aren't met.
+type DeleteAgentCalendarFeedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAgentCalendarFeedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAgentCalendarFeedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAgentCalendarFeedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAgentCalendarFeedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAgentCalendarFeedRequestValidationError) ErrorName() string {
+	return "DeleteAgentCalendarFeedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAgentCalendarFeedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAgentCalendarFeedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAgentCalendarFeedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAgentCalendarFeedRequestValidationError{}
+
+// Validate checks the field values on DeleteAgentCalendarFeedResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAgentCalendarFeedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAgentCalendarFeedResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteAgentCalendarFeedResponseMultiError, or nil if none found.
+func (m *DeleteAgentCalendarFeedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAgentCalendarFeedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteAgentCalendarFeedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAgentCalendarFeedResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteAgentCalendarFeedResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteAgentCalendarFeedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAgentCalendarFeedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAgentCalendarFeedResponseMultiError) AllErrors() []error { return m }
+
+// DeleteAgentCalendarFeedResponseValidationError is the validation error
+// returned by DeleteAgentCalendarFeedResponse.Validate if the designated
+// constraints aren't met.
+type DeleteAgentCalendarFeedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAgentCalendarFeedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAgentCalendarFeedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAgentCalendarFeedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAgentCalendarFeedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAgentCalendarFeedResponseValidationError) ErrorName() string {
+	return "DeleteAgentCalendarFeedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAgentCalendarFeedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAgentCalendarFeedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAgentCalendarFeedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAgentCalendarFeedResponseValidationError{}
+
+// Validate checks the field values on SubscribeAgentCalendarFeedRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SubscribeAgentCalendarFeedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeAgentCalendarFeedRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SubscribeAgentCalendarFeedRequestMultiError, or nil if none found.
+func (m *SubscribeAgentCalendarFeedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeAgentCalendarFeedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	if len(errors) > 0 {
+		return SubscribeAgentCalendarFeedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscribeAgentCalendarFeedRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SubscribeAgentCalendarFeedRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SubscribeAgentCalendarFeedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscribeAgentCalendarFeedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscribeAgentCalendarFeedRequestMultiError) AllErrors() []error { return m }
+
+// SubscribeAgentCalendarFeedRequestValidationError is the validation error
+// returned by SubscribeAgentCalendarFeedRequest.Validate if the designated
+// constraints aren't met.
+type SubscribeAgentCalendarFeedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscribeAgentCalendarFeedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscribeAgentCalendarFeedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeAgentCalendarFeedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeAgentCalendarFeedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeAgentCalendarFeedRequestValidationError) ErrorName() string {
+	return "SubscribeAgentCalendarFeedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubscribeAgentCalendarFeedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribeAgentCalendarFeedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeAgentCalendarFeedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeAgentCalendarFeedRequestValidationError{}
+
+// Validate checks the field values on AgentCalendarFeed with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AgentCalendarFeed) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgentCalendarFeed with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgentCalendarFeedMultiError, or nil if none found.
+func (m *AgentCalendarFeed) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgentCalendarFeed) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAgent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentCalendarFeedValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentCalendarFeedValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAgent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentCalendarFeedValidationError{
+				field:  "Agent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Token
+
+	// no validation rules for Path
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetCreatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentCalendarFeedValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentCalendarFeedValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentCalendarFeedValidationError{
+				field:  "CreatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AgentCalendarFeedMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgentCalendarFeedMultiError is an error wrapping multiple validation errors
+// returned by AgentCalendarFeed.ValidateAll() if the designated constraints
+// aren't met.
+type AgentCalendarFeedMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgentCalendarFeedMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgentCalendarFeedMultiError) AllErrors() []error { return m }
+
+// AgentCalendarFeedValidationError is the validation error returned by
+// AgentCalendarFeed.Validate if the designated constraints aren't met.
+type AgentCalendarFeedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgentCalendarFeedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgentCalendarFeedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgentCalendarFeedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgentCalendarFeedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgentCalendarFeedValidationError) ErrorName() string {
+	return "AgentCalendarFeedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgentCalendarFeedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgentCalendarFeed.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgentCalendarFeedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgentCalendarFeedValidationError{}
+
+// Validate checks the field values on ReadMyScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadMyScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadMyScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadMyScheduleRequestMultiError, or nil if none found.
+func (m *ReadMyScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadMyScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Date != nil {
+
+		if all {
+			switch v := interface{}(m.GetDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadMyScheduleRequestValidationError{
+						field:  "Date",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadMyScheduleRequestValidationError{
+						field:  "Date",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadMyScheduleRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadMyScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadMyScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by ReadMyScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReadMyScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadMyScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadMyScheduleRequestMultiError) AllErrors() []error { return m }
+
+// ReadMyScheduleRequestValidationError is the validation error returned by
+// ReadMyScheduleRequest.Validate if the designated constraints aren't met.
+type ReadMyScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadMyScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadMyScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadMyScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadMyScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadMyScheduleRequestValidationError) ErrorName() string {
+	return "ReadMyScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadMyScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadMyScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadMyScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadMyScheduleRequestValidationError{}
+
+// Validate checks the field values on ReadMyScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadMyScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadMyScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadMyScheduleResponseMultiError, or nil if none found.
+func (m *ReadMyScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadMyScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAgent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadMyScheduleResponseValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadMyScheduleResponseValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAgent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadMyScheduleResponseValidationError{
+				field:  "Agent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timezone
+
+	for idx, item := range m.GetShifts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadMyScheduleResponseValidationError{
+						field:  fmt.Sprintf("Shifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadMyScheduleResponseValidationError{
+						field:  fmt.Sprintf("Shifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadMyScheduleResponseValidationError{
+					field:  fmt.Sprintf("Shifts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAbsences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadMyScheduleResponseValidationError{
+						field:  fmt.Sprintf("Absences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadMyScheduleResponseValidationError{
+						field:  fmt.Sprintf("Absences[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadMyScheduleResponseValidationError{
+					field:  fmt.Sprintf("Absences[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHolidays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadMyScheduleResponseValidationError{
+						field:  fmt.Sprintf("Holidays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadMyScheduleResponseValidationError{
+						field:  fmt.Sprintf("Holidays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadMyScheduleResponseValidationError{
+					field:  fmt.Sprintf("Holidays[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadMyScheduleResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadMyScheduleResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadMyScheduleResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadMyScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadMyScheduleResponseMultiError is an error wrapping multiple validation
+// errors returned by ReadMyScheduleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReadMyScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadMyScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadMyScheduleResponseMultiError) AllErrors() []error { return m }
+
+// ReadMyScheduleResponseValidationError is the validation error returned by
+// ReadMyScheduleResponse.Validate if the designated constraints aren't met.
+type ReadMyScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadMyScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadMyScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadMyScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadMyScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadMyScheduleResponseValidationError) ErrorName() string {
+	return "ReadMyScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadMyScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadMyScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadMyScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadMyScheduleResponseValidationError{}
+
+// Validate checks the field values on AgentCalendarShift with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AgentCalendarShift) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgentCalendarShift with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgentCalendarShiftMultiError, or nil if none found.
+func (m *AgentCalendarShift) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgentCalendarShift) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWorkingSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentCalendarShiftValidationError{
+					field:  "WorkingSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentCalendarShiftValidationError{
+					field:  "WorkingSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkingSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentCalendarShiftValidationError{
+				field:  "WorkingSchedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Date
+
+	if all {
+		switch v := interface{}(m.GetShift()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentCalendarShiftValidationError{
+					field:  "Shift",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentCalendarShiftValidationError{
+					field:  "Shift",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShift()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentCalendarShiftValidationError{
+				field:  "Shift",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AgentCalendarShiftMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgentCalendarShiftMultiError is an error wrapping multiple validation errors
+// returned by AgentCalendarShift.ValidateAll() if the designated constraints
+// aren't met.
+type AgentCalendarShiftMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgentCalendarShiftMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgentCalendarShiftMultiError) AllErrors() []error { return m }
+
+// AgentCalendarShiftValidationError is the validation error returned by
+// AgentCalendarShift.Validate if the designated constraints aren't met.
+type AgentCalendarShiftValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgentCalendarShiftValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgentCalendarShiftValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgentCalendarShiftValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgentCalendarShiftValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgentCalendarShiftValidationError) ErrorName() string {
+	return "AgentCalendarShiftValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgentCalendarShiftValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgentCalendarShift.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgentCalendarShiftValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgentCalendarShiftValidationError{}
+
+// Validate checks the field values on AgentScheduleChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AgentScheduleChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgentScheduleChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgentScheduleChangeMultiError, or nil if none found.
+func (m *AgentScheduleChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgentScheduleChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetCreatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentScheduleChangeValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentScheduleChangeValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentScheduleChangeValidationError{
+				field:  "CreatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWorkingSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentScheduleChangeValidationError{
+					field:  "WorkingSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentScheduleChangeValidationError{
+					field:  "WorkingSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkingSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentScheduleChangeValidationError{
+				field:  "WorkingSchedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ShiftId
+
+	// no validation rules for Date
+
+	// no validation rules for Action
+
+	if m.Start != nil {
+		// no validation rules for Start
+	}
+
+	if m.End != nil {
+		// no validation rules for End
+	}
+
+	if m.PrevStart != nil {
+		// no validation rules for PrevStart
+	}
+
+	if m.PrevEnd != nil {
+		// no validation rules for PrevEnd
+	}
+
+	if len(errors) > 0 {
+		return AgentScheduleChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgentScheduleChangeMultiError is an error wrapping multiple validation
+// errors returned by AgentScheduleChange.ValidateAll() if the designated
+// constraints aren't met.
+type AgentScheduleChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgentScheduleChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgentScheduleChangeMultiError) AllErrors() []error { return m }
+
+// AgentScheduleChangeValidationError is the validation error returned by
+// AgentScheduleChange.Validate if the designated constraints aren't met.
+type AgentScheduleChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgentScheduleChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgentScheduleChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgentScheduleChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgentScheduleChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgentScheduleChangeValidationError) ErrorName() string {
+	return "AgentScheduleChangeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgentScheduleChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgentScheduleChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgentScheduleChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgentScheduleChangeValidationError{}
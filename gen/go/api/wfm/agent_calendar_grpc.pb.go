@@ -0,0 +1,305 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: agent_calendar.proto
+
+package wfm
+
+import (
+	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AgentCalendarService_ReadAgentCalendar_FullMethodName          = "/wfm.AgentCalendarService/ReadAgentCalendar"
+	AgentCalendarService_CreateAgentCalendarFeed_FullMethodName    = "/wfm.AgentCalendarService/CreateAgentCalendarFeed"
+	AgentCalendarService_ReadAgentCalendarFeed_FullMethodName      = "/wfm.AgentCalendarService/ReadAgentCalendarFeed"
+	AgentCalendarService_DeleteAgentCalendarFeed_FullMethodName    = "/wfm.AgentCalendarService/DeleteAgentCalendarFeed"
+	AgentCalendarService_ReadMySchedule_FullMethodName             = "/wfm.AgentCalendarService/ReadMySchedule"
+	AgentCalendarService_SubscribeAgentCalendarFeed_FullMethodName = "/wfm.AgentCalendarService/SubscribeAgentCalendarFeed"
+)
+
+// AgentCalendarServiceClient is the client API for AgentCalendarService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AgentCalendarServiceClient interface {
+	// Renders shifts, pauses and absences of the agent across working schedules as an iCalendar file.
+	ReadAgentCalendar(ctx context.Context, in *ReadAgentCalendarRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// Creates a subscribe token of the agent's calendar, the previous token of the agent is revoked.
+	CreateAgentCalendarFeed(ctx context.Context, in *CreateAgentCalendarFeedRequest, opts ...grpc.CallOption) (*CreateAgentCalendarFeedResponse, error)
+	ReadAgentCalendarFeed(ctx context.Context, in *ReadAgentCalendarFeedRequest, opts ...grpc.CallOption) (*ReadAgentCalendarFeedResponse, error)
+	// Revokes the subscribe token of the agent's calendar.
+	DeleteAgentCalendarFeed(ctx context.Context, in *DeleteAgentCalendarFeedRequest, opts ...grpc.CallOption) (*DeleteAgentCalendarFeedResponse, error)
+	// Returns the schedule of the agent linked to the signed-in user across published working schedules.
+	ReadMySchedule(ctx context.Context, in *ReadMyScheduleRequest, opts ...grpc.CallOption) (*ReadMyScheduleResponse, error)
+	// Renders the agent's calendar by the subscribe token, calendar clients poll it without a session.
+	SubscribeAgentCalendarFeed(ctx context.Context, in *SubscribeAgentCalendarFeedRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+}
+
+type agentCalendarServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentCalendarServiceClient(cc grpc.ClientConnInterface) AgentCalendarServiceClient {
+	return &agentCalendarServiceClient{cc}
+}
+
+func (c *agentCalendarServiceClient) ReadAgentCalendar(ctx context.Context, in *ReadAgentCalendarRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, AgentCalendarService_ReadAgentCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentCalendarServiceClient) CreateAgentCalendarFeed(ctx context.Context, in *CreateAgentCalendarFeedRequest, opts ...grpc.CallOption) (*CreateAgentCalendarFeedResponse, error) {
+	out := new(CreateAgentCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, AgentCalendarService_CreateAgentCalendarFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentCalendarServiceClient) ReadAgentCalendarFeed(ctx context.Context, in *ReadAgentCalendarFeedRequest, opts ...grpc.CallOption) (*ReadAgentCalendarFeedResponse, error) {
+	out := new(ReadAgentCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, AgentCalendarService_ReadAgentCalendarFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentCalendarServiceClient) DeleteAgentCalendarFeed(ctx context.Context, in *DeleteAgentCalendarFeedRequest, opts ...grpc.CallOption) (*DeleteAgentCalendarFeedResponse, error) {
+	out := new(DeleteAgentCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, AgentCalendarService_DeleteAgentCalendarFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentCalendarServiceClient) ReadMySchedule(ctx context.Context, in *ReadMyScheduleRequest, opts ...grpc.CallOption) (*ReadMyScheduleResponse, error) {
+	out := new(ReadMyScheduleResponse)
+	err := c.cc.Invoke(ctx, AgentCalendarService_ReadMySchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentCalendarServiceClient) SubscribeAgentCalendarFeed(ctx context.Context, in *SubscribeAgentCalendarFeedRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, AgentCalendarService_SubscribeAgentCalendarFeed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentCalendarServiceServer is the server API for AgentCalendarService service.
+// All implementations must embed UnimplementedAgentCalendarServiceServer
+// for forward compatibility
+type AgentCalendarServiceServer interface {
+	// Renders shifts, pauses and absences of the agent across working schedules as an iCalendar file.
+	ReadAgentCalendar(context.Context, *ReadAgentCalendarRequest) (*httpbody.HttpBody, error)
+	// Creates a subscribe token of the agent's calendar, the previous token of the agent is revoked.
+	CreateAgentCalendarFeed(context.Context, *CreateAgentCalendarFeedRequest) (*CreateAgentCalendarFeedResponse, error)
+	ReadAgentCalendarFeed(context.Context, *ReadAgentCalendarFeedRequest) (*ReadAgentCalendarFeedResponse, error)
+	// Revokes the subscribe token of the agent's calendar.
+	DeleteAgentCalendarFeed(context.Context, *DeleteAgentCalendarFeedRequest) (*DeleteAgentCalendarFeedResponse, error)
+	// Returns the schedule of the agent linked to the signed-in user across published working schedules.
+	ReadMySchedule(context.Context, *ReadMyScheduleRequest) (*ReadMyScheduleResponse, error)
+	// Renders the agent's calendar by the subscribe token, calendar clients poll it without a session.
+	SubscribeAgentCalendarFeed(context.Context, *SubscribeAgentCalendarFeedRequest) (*httpbody.HttpBody, error)
+	mustEmbedUnimplementedAgentCalendarServiceServer()
+}
+
+// UnimplementedAgentCalendarServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAgentCalendarServiceServer struct {
+}
+
+func (UnimplementedAgentCalendarServiceServer) ReadAgentCalendar(context.Context, *ReadAgentCalendarRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAgentCalendar not implemented")
+}
+func (UnimplementedAgentCalendarServiceServer) CreateAgentCalendarFeed(context.Context, *CreateAgentCalendarFeedRequest) (*CreateAgentCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAgentCalendarFeed not implemented")
+}
+func (UnimplementedAgentCalendarServiceServer) ReadAgentCalendarFeed(context.Context, *ReadAgentCalendarFeedRequest) (*ReadAgentCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAgentCalendarFeed not implemented")
+}
+func (UnimplementedAgentCalendarServiceServer) DeleteAgentCalendarFeed(context.Context, *DeleteAgentCalendarFeedRequest) (*DeleteAgentCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAgentCalendarFeed not implemented")
+}
+func (UnimplementedAgentCalendarServiceServer) ReadMySchedule(context.Context, *ReadMyScheduleRequest) (*ReadMyScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadMySchedule not implemented")
+}
+func (UnimplementedAgentCalendarServiceServer) SubscribeAgentCalendarFeed(context.Context, *SubscribeAgentCalendarFeedRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeAgentCalendarFeed not implemented")
+}
+func (UnimplementedAgentCalendarServiceServer) mustEmbedUnimplementedAgentCalendarServiceServer() {}
+
+// UnsafeAgentCalendarServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AgentCalendarServiceServer will
+// result in compilation errors.
+type UnsafeAgentCalendarServiceServer interface {
+	mustEmbedUnimplementedAgentCalendarServiceServer()
+}
+
+func RegisterAgentCalendarServiceServer(s grpc.ServiceRegistrar, srv AgentCalendarServiceServer) {
+	s.RegisterService(&AgentCalendarService_ServiceDesc, srv)
+}
+
+func _AgentCalendarService_ReadAgentCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAgentCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentCalendarServiceServer).ReadAgentCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentCalendarService_ReadAgentCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentCalendarServiceServer).ReadAgentCalendar(ctx, req.(*ReadAgentCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentCalendarService_CreateAgentCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAgentCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentCalendarServiceServer).CreateAgentCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentCalendarService_CreateAgentCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentCalendarServiceServer).CreateAgentCalendarFeed(ctx, req.(*CreateAgentCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentCalendarService_ReadAgentCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAgentCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentCalendarServiceServer).ReadAgentCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentCalendarService_ReadAgentCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentCalendarServiceServer).ReadAgentCalendarFeed(ctx, req.(*ReadAgentCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentCalendarService_DeleteAgentCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAgentCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentCalendarServiceServer).DeleteAgentCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentCalendarService_DeleteAgentCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentCalendarServiceServer).DeleteAgentCalendarFeed(ctx, req.(*DeleteAgentCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentCalendarService_ReadMySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadMyScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentCalendarServiceServer).ReadMySchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentCalendarService_ReadMySchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentCalendarServiceServer).ReadMySchedule(ctx, req.(*ReadMyScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentCalendarService_SubscribeAgentCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeAgentCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentCalendarServiceServer).SubscribeAgentCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentCalendarService_SubscribeAgentCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentCalendarServiceServer).SubscribeAgentCalendarFeed(ctx, req.(*SubscribeAgentCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AgentCalendarService_ServiceDesc is the grpc.ServiceDesc for AgentCalendarService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AgentCalendarService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wfm.AgentCalendarService",
+	HandlerType: (*AgentCalendarServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadAgentCalendar",
+			Handler:    _AgentCalendarService_ReadAgentCalendar_Handler,
+		},
+		{
+			MethodName: "CreateAgentCalendarFeed",
+			Handler:    _AgentCalendarService_CreateAgentCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ReadAgentCalendarFeed",
+			Handler:    _AgentCalendarService_ReadAgentCalendarFeed_Handler,
+		},
+		{
+			MethodName: "DeleteAgentCalendarFeed",
+			Handler:    _AgentCalendarService_DeleteAgentCalendarFeed_Handler,
+		},
+		{
+			MethodName: "ReadMySchedule",
+			Handler:    _AgentCalendarService_ReadMySchedule_Handler,
+		},
+		{
+			MethodName: "SubscribeAgentCalendarFeed",
+			Handler:    _AgentCalendarService_SubscribeAgentCalendarFeed_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agent_calendar.proto",
+}
@@ -630,6 +630,39 @@ func (m *AgentWorkingConditions) validate(all bool) error {
 
 	}
 
+	if m.Timezone != nil {
+
+		if all {
+			switch v := interface{}(m.GetTimezone()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AgentWorkingConditionsValidationError{
+						field:  "Timezone",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AgentWorkingConditionsValidationError{
+						field:  "Timezone",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTimezone()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AgentWorkingConditionsValidationError{
+					field:  "Timezone",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return AgentWorkingConditionsMultiError(errors)
 	}
@@ -23,35 +23,909 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ScheduleExportLayout int32
+
+const (
+	// Same as SCHEDULE_EXPORT_LAYOUT_GRID.
+	ScheduleExportLayout_SCHEDULE_EXPORT_LAYOUT_UNSPECIFIED ScheduleExportLayout = 0
+	// Agents as rows and dates as columns, cells hold shift times, absence type or "locked".
+	// Holidays are listed in the row after the header.
+	ScheduleExportLayout_SCHEDULE_EXPORT_LAYOUT_GRID ScheduleExportLayout = 1
+	// A row per shift or segment of a split shift with its pauses and skills,
+	// in the roster format of ImportAgentsWorkingScheduleShifts.
+	ScheduleExportLayout_SCHEDULE_EXPORT_LAYOUT_FLAT ScheduleExportLayout = 2
+)
+
+// Enum value maps for ScheduleExportLayout.
+var (
+	ScheduleExportLayout_name = map[int32]string{
+		0: "SCHEDULE_EXPORT_LAYOUT_UNSPECIFIED",
+		1: "SCHEDULE_EXPORT_LAYOUT_GRID",
+		2: "SCHEDULE_EXPORT_LAYOUT_FLAT",
+	}
+	ScheduleExportLayout_value = map[string]int32{
+		"SCHEDULE_EXPORT_LAYOUT_UNSPECIFIED": 0,
+		"SCHEDULE_EXPORT_LAYOUT_GRID":        1,
+		"SCHEDULE_EXPORT_LAYOUT_FLAT":        2,
+	}
+)
+
+func (x ScheduleExportLayout) Enum() *ScheduleExportLayout {
+	p := new(ScheduleExportLayout)
+	*p = x
+	return p
+}
+
+func (x ScheduleExportLayout) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduleExportLayout) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_working_schedule_proto_enumTypes[0].Descriptor()
+}
+
+func (ScheduleExportLayout) Type() protoreflect.EnumType {
+	return &file_agent_working_schedule_proto_enumTypes[0]
+}
+
+func (x ScheduleExportLayout) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduleExportLayout.Descriptor instead.
+func (ScheduleExportLayout) EnumDescriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+type SpreadsheetFormat int32
+
+const (
+	SpreadsheetFormat_SPREADSHEET_FORMAT_UNSPECIFIED SpreadsheetFormat = 0
+	SpreadsheetFormat_SPREADSHEET_FORMAT_CSV         SpreadsheetFormat = 1
+	SpreadsheetFormat_SPREADSHEET_FORMAT_XLSX        SpreadsheetFormat = 2
+)
+
+// Enum value maps for SpreadsheetFormat.
+var (
+	SpreadsheetFormat_name = map[int32]string{
+		0: "SPREADSHEET_FORMAT_UNSPECIFIED",
+		1: "SPREADSHEET_FORMAT_CSV",
+		2: "SPREADSHEET_FORMAT_XLSX",
+	}
+	SpreadsheetFormat_value = map[string]int32{
+		"SPREADSHEET_FORMAT_UNSPECIFIED": 0,
+		"SPREADSHEET_FORMAT_CSV":         1,
+		"SPREADSHEET_FORMAT_XLSX":        2,
+	}
+)
+
+func (x SpreadsheetFormat) Enum() *SpreadsheetFormat {
+	p := new(SpreadsheetFormat)
+	*p = x
+	return p
+}
+
+func (x SpreadsheetFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SpreadsheetFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_working_schedule_proto_enumTypes[1].Descriptor()
+}
+
+func (SpreadsheetFormat) Type() protoreflect.EnumType {
+	return &file_agent_working_schedule_proto_enumTypes[1]
+}
+
+func (x SpreadsheetFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SpreadsheetFormat.Descriptor instead.
+func (SpreadsheetFormat) EnumDescriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+type ShiftConflictMode int32
+
+const (
+	// Same as SHIFT_CONFLICT_MODE_FAIL.
+	ShiftConflictMode_SHIFT_CONFLICT_MODE_UNSPECIFIED ShiftConflictMode = 0
+	// Fails the whole request if any agent day already has a shift.
+	ShiftConflictMode_SHIFT_CONFLICT_MODE_FAIL ShiftConflictMode = 1
+	// Keeps existing shifts untouched.
+	ShiftConflictMode_SHIFT_CONFLICT_MODE_SKIP_EXISTING ShiftConflictMode = 2
+	// Replaces existing shifts, including their pauses and skills.
+	ShiftConflictMode_SHIFT_CONFLICT_MODE_OVERWRITE ShiftConflictMode = 3
+	// Adds shifts as extra segments of the day, segments shouldn't overlap existing ones.
+	ShiftConflictMode_SHIFT_CONFLICT_MODE_APPEND ShiftConflictMode = 4
+)
+
+// Enum value maps for ShiftConflictMode.
+var (
+	ShiftConflictMode_name = map[int32]string{
+		0: "SHIFT_CONFLICT_MODE_UNSPECIFIED",
+		1: "SHIFT_CONFLICT_MODE_FAIL",
+		2: "SHIFT_CONFLICT_MODE_SKIP_EXISTING",
+		3: "SHIFT_CONFLICT_MODE_OVERWRITE",
+		4: "SHIFT_CONFLICT_MODE_APPEND",
+	}
+	ShiftConflictMode_value = map[string]int32{
+		"SHIFT_CONFLICT_MODE_UNSPECIFIED":   0,
+		"SHIFT_CONFLICT_MODE_FAIL":          1,
+		"SHIFT_CONFLICT_MODE_SKIP_EXISTING": 2,
+		"SHIFT_CONFLICT_MODE_OVERWRITE":     3,
+		"SHIFT_CONFLICT_MODE_APPEND":        4,
+	}
+)
+
+func (x ShiftConflictMode) Enum() *ShiftConflictMode {
+	p := new(ShiftConflictMode)
+	*p = x
+	return p
+}
+
+func (x ShiftConflictMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShiftConflictMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_agent_working_schedule_proto_enumTypes[2].Descriptor()
+}
+
+func (ShiftConflictMode) Type() protoreflect.EnumType {
+	return &file_agent_working_schedule_proto_enumTypes[2]
+}
+
+func (x ShiftConflictMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShiftConflictMode.Descriptor instead.
+func (ShiftConflictMode) EnumDescriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{2}
+}
+
 type CreateAgentsWorkingScheduleShiftsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkingScheduleId int64           `protobuf:"varint,1,opt,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
-	Date              *FilterBetween  `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
-	Agents            []*LookupEntity `protobuf:"bytes,3,rep,name=agents,proto3" json:"agents,omitempty"`
-	// Map key is a day of week: 0 - Sunday, ..., 6 - Saturday.
-	Items map[int64]*AgentScheduleShift `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	WorkingScheduleId int64          `protobuf:"varint,1,opt,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
+	Date              *FilterBetween `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Defaults to the agents of the working schedule, that are assigned to the roster pattern.
+	Agents []*LookupEntity `protobuf:"bytes,3,rep,name=agents,proto3" json:"agents,omitempty"`
+	// Map key is a day of week: 0 - Sunday, ..., 6 - Saturday.
+	Items map[int64]*AgentScheduleShift `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Defines how to handle agent days that already have a shift.
+	Mode ShiftConflictMode `protobuf:"varint,5,opt,name=mode,proto3,enum=wfm.ShiftConflictMode" json:"mode,omitempty"`
+	// Places pauses of the pause template into created shifts,
+	// pauses of the items are ignored if set.
+	PlacePauses *PausePlacement `protobuf:"bytes,6,opt,name=place_pauses,json=placePauses,proto3,oneof" json:"place_pauses,omitempty"`
+	// Split shifts with multiple segments per day, e.g. 08:00-12:00 and 16:00-20:00.
+	// Map key is a day of week: 0 - Sunday, ..., 6 - Saturday.
+	Segments map[int64]*AgentScheduleShifts `protobuf:"bytes,7,rep,name=segments,proto3" json:"segments,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Shift template with a rotation pattern, that is expanded across the date period.
+	ShiftTemplateId *int64 `protobuf:"varint,8,opt,name=shift_template_id,json=shiftTemplateId,proto3,oneof" json:"shift_template_id,omitempty"`
+	// Date of the first day of the rotation cycle, it may lie outside the date period.
+	AnchorDate *int64 `protobuf:"varint,9,opt,name=anchor_date,json=anchorDate,proto3,oneof" json:"anchor_date,omitempty"`
+	// Roster pattern, that replaces items, created shifts keep a reference to it.
+	RosterPatternId *int64 `protobuf:"varint,10,opt,name=roster_pattern_id,json=rosterPatternId,proto3,oneof" json:"roster_pattern_id,omitempty"`
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) Reset() {
+	*x = CreateAgentsWorkingScheduleShiftsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAgentsWorkingScheduleShiftsRequest) ProtoMessage() {}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAgentsWorkingScheduleShiftsRequest.ProtoReflect.Descriptor instead.
+func (*CreateAgentsWorkingScheduleShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) GetWorkingScheduleId() int64 {
+	if x != nil {
+		return x.WorkingScheduleId
+	}
+	return 0
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) GetDate() *FilterBetween {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) GetAgents() []*LookupEntity {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) GetItems() map[int64]*AgentScheduleShift {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) GetMode() ShiftConflictMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ShiftConflictMode_SHIFT_CONFLICT_MODE_UNSPECIFIED
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) GetPlacePauses() *PausePlacement {
+	if x != nil {
+		return x.PlacePauses
+	}
+	return nil
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) GetSegments() map[int64]*AgentScheduleShifts {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) GetShiftTemplateId() int64 {
+	if x != nil && x.ShiftTemplateId != nil {
+		return *x.ShiftTemplateId
+	}
+	return 0
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) GetAnchorDate() int64 {
+	if x != nil && x.AnchorDate != nil {
+		return *x.AnchorDate
+	}
+	return 0
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsRequest) GetRosterPatternId() int64 {
+	if x != nil && x.RosterPatternId != nil {
+		return *x.RosterPatternId
+	}
+	return 0
+}
+
+// AgentScheduleShifts lists segments of a split shift within a day.
+type AgentScheduleShifts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*AgentScheduleShift `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *AgentScheduleShifts) Reset() {
+	*x = AgentScheduleShifts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgentScheduleShifts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentScheduleShifts) ProtoMessage() {}
+
+func (x *AgentScheduleShifts) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgentScheduleShifts.ProtoReflect.Descriptor instead.
+func (*AgentScheduleShifts) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AgentScheduleShifts) GetItems() []*AgentScheduleShift {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// PausePlacement defines how pauses of the pause template are placed into shifts.
+// Pauses are staggered across agents to keep coverage of the forecast as high as possible.
+type PausePlacement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Overrides pause templates from agents working conditions.
+	PauseTemplateId *int64 `protobuf:"varint,1,opt,name=pause_template_id,json=pauseTemplateId,proto3,oneof" json:"pause_template_id,omitempty"`
+	// Minutes after the shift start, before which pauses can't start.
+	MinStartOffset int64 `protobuf:"varint,2,opt,name=min_start_offset,json=minStartOffset,proto3" json:"min_start_offset,omitempty"`
+	// Minutes after the shift start, before which the first pause should start, 0 - unlimited.
+	MaxStartOffset int64 `protobuf:"varint,3,opt,name=max_start_offset,json=maxStartOffset,proto3" json:"max_start_offset,omitempty"`
+	// Minutes before the shift end, after which pauses can't end.
+	MinEndOffset int64 `protobuf:"varint,4,opt,name=min_end_offset,json=minEndOffset,proto3" json:"min_end_offset,omitempty"`
+	// Minutes before the shift end, after which the last pause should end, 0 - unlimited.
+	MaxEndOffset int64 `protobuf:"varint,5,opt,name=max_end_offset,json=maxEndOffset,proto3" json:"max_end_offset,omitempty"`
+}
+
+func (x *PausePlacement) Reset() {
+	*x = PausePlacement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PausePlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PausePlacement) ProtoMessage() {}
+
+func (x *PausePlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PausePlacement.ProtoReflect.Descriptor instead.
+func (*PausePlacement) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PausePlacement) GetPauseTemplateId() int64 {
+	if x != nil && x.PauseTemplateId != nil {
+		return *x.PauseTemplateId
+	}
+	return 0
+}
+
+func (x *PausePlacement) GetMinStartOffset() int64 {
+	if x != nil {
+		return x.MinStartOffset
+	}
+	return 0
+}
+
+func (x *PausePlacement) GetMaxStartOffset() int64 {
+	if x != nil {
+		return x.MaxStartOffset
+	}
+	return 0
+}
+
+func (x *PausePlacement) GetMinEndOffset() int64 {
+	if x != nil {
+		return x.MinEndOffset
+	}
+	return 0
+}
+
+func (x *PausePlacement) GetMaxEndOffset() int64 {
+	if x != nil {
+		return x.MaxEndOffset
+	}
+	return 0
+}
+
+type CreateAgentsWorkingScheduleShiftsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items    []*AgentWorkingSchedule `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Created  []*AgentScheduleDates   `protobuf:"bytes,2,rep,name=created,proto3" json:"created,omitempty"`
+	Replaced []*AgentScheduleDates   `protobuf:"bytes,3,rep,name=replaced,proto3" json:"replaced,omitempty"`
+	Skipped  []*AgentScheduleDates   `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsResponse) Reset() {
+	*x = CreateAgentsWorkingScheduleShiftsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAgentsWorkingScheduleShiftsResponse) ProtoMessage() {}
+
+func (x *CreateAgentsWorkingScheduleShiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAgentsWorkingScheduleShiftsResponse.ProtoReflect.Descriptor instead.
+func (*CreateAgentsWorkingScheduleShiftsResponse) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsResponse) GetItems() []*AgentWorkingSchedule {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsResponse) GetCreated() []*AgentScheduleDates {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsResponse) GetReplaced() []*AgentScheduleDates {
+	if x != nil {
+		return x.Replaced
+	}
+	return nil
+}
+
+func (x *CreateAgentsWorkingScheduleShiftsResponse) GetSkipped() []*AgentScheduleDates {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type ImportAgentsWorkingScheduleShiftsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingScheduleId int64 `protobuf:"varint,1,opt,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
+	// Roster with a header row and columns:
+	//   agent  - agent id or name;
+	//   date   - YYYY-MM-DD;
+	//   start  - HH:MM;
+	//   end    - HH:MM, the shift is overnight if the end isn't after the start;
+	//   pauses - optional, HH:MM-HH:MM[/cause id] separated by semicolons;
+	//   skills - optional, skill id:capacity[:disabled] separated by semicolons.
+	// Each row is a shift or a segment of a split shift.
+	File []byte `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	// Detected by the file contents if unspecified.
+	Format SpreadsheetFormat `protobuf:"varint,3,opt,name=format,proto3,enum=wfm.SpreadsheetFormat" json:"format,omitempty"`
+	// Validates the file and reports what would be written without writing it.
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Defines how to handle agent days that already have a shift.
+	Mode ShiftConflictMode `protobuf:"varint,5,opt,name=mode,proto3,enum=wfm.ShiftConflictMode" json:"mode,omitempty"`
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsRequest) Reset() {
+	*x = ImportAgentsWorkingScheduleShiftsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAgentsWorkingScheduleShiftsRequest) ProtoMessage() {}
+
+func (x *ImportAgentsWorkingScheduleShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAgentsWorkingScheduleShiftsRequest.ProtoReflect.Descriptor instead.
+func (*ImportAgentsWorkingScheduleShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsRequest) GetWorkingScheduleId() int64 {
+	if x != nil {
+		return x.WorkingScheduleId
+	}
+	return 0
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsRequest) GetFormat() SpreadsheetFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SpreadsheetFormat_SPREADSHEET_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsRequest) GetMode() ShiftConflictMode {
+	if x != nil {
+		return x.Mode
+	}
+	return ShiftConflictMode_SHIFT_CONFLICT_MODE_UNSPECIFIED
+}
+
+type ImportAgentsWorkingScheduleShiftsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Nothing is written if any row has errors.
+	Errors []*ImportRowError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Number of rows without the header.
+	Rows int64 `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	// Written shifts or shifts to be written on a dry run.
+	Items    []*AgentWorkingSchedule `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Created  []*AgentScheduleDates   `protobuf:"bytes,4,rep,name=created,proto3" json:"created,omitempty"`
+	Replaced []*AgentScheduleDates   `protobuf:"bytes,5,rep,name=replaced,proto3" json:"replaced,omitempty"`
+	Skipped  []*AgentScheduleDates   `protobuf:"bytes,6,rep,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsResponse) Reset() {
+	*x = ImportAgentsWorkingScheduleShiftsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAgentsWorkingScheduleShiftsResponse) ProtoMessage() {}
+
+func (x *ImportAgentsWorkingScheduleShiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAgentsWorkingScheduleShiftsResponse.ProtoReflect.Descriptor instead.
+func (*ImportAgentsWorkingScheduleShiftsResponse) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsResponse) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsResponse) GetItems() []*AgentWorkingSchedule {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsResponse) GetCreated() []*AgentScheduleDates {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsResponse) GetReplaced() []*AgentScheduleDates {
+	if x != nil {
+		return x.Replaced
+	}
+	return nil
+}
+
+func (x *ImportAgentsWorkingScheduleShiftsResponse) GetSkipped() []*AgentScheduleDates {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Row number in the file, the header is row 1.
+	Row     int64  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column  string `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ImportRowError) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ExportAgentsWorkingScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingScheduleId int64 `protobuf:"varint,1,opt,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
+	// Defaults to the working schedule period.
+	Date *FilterBetween `protobuf:"bytes,2,opt,name=date,proto3,oneof" json:"date,omitempty"`
+	// CSV if unspecified.
+	Format SpreadsheetFormat    `protobuf:"varint,3,opt,name=format,proto3,enum=wfm.SpreadsheetFormat" json:"format,omitempty"`
+	Layout ScheduleExportLayout `protobuf:"varint,4,opt,name=layout,proto3,enum=wfm.ScheduleExportLayout" json:"layout,omitempty"`
+}
+
+func (x *ExportAgentsWorkingScheduleRequest) Reset() {
+	*x = ExportAgentsWorkingScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAgentsWorkingScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAgentsWorkingScheduleRequest) ProtoMessage() {}
+
+func (x *ExportAgentsWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAgentsWorkingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ExportAgentsWorkingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ExportAgentsWorkingScheduleRequest) GetWorkingScheduleId() int64 {
+	if x != nil {
+		return x.WorkingScheduleId
+	}
+	return 0
+}
+
+func (x *ExportAgentsWorkingScheduleRequest) GetDate() *FilterBetween {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ExportAgentsWorkingScheduleRequest) GetFormat() SpreadsheetFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SpreadsheetFormat_SPREADSHEET_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportAgentsWorkingScheduleRequest) GetLayout() ScheduleExportLayout {
+	if x != nil {
+		return x.Layout
+	}
+	return ScheduleExportLayout_SCHEDULE_EXPORT_LAYOUT_UNSPECIFIED
+}
+
+type ExportAgentsWorkingScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File        []byte `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	FileName    string `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+}
+
+func (x *ExportAgentsWorkingScheduleResponse) Reset() {
+	*x = ExportAgentsWorkingScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAgentsWorkingScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAgentsWorkingScheduleResponse) ProtoMessage() {}
+
+func (x *ExportAgentsWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAgentsWorkingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ExportAgentsWorkingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExportAgentsWorkingScheduleResponse) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *ExportAgentsWorkingScheduleResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportAgentsWorkingScheduleResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+type AgentScheduleDates struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Agent *LookupEntity `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Dates []int64       `protobuf:"varint,2,rep,packed,name=dates,proto3" json:"dates,omitempty"`
 }
 
-func (x *CreateAgentsWorkingScheduleShiftsRequest) Reset() {
-	*x = CreateAgentsWorkingScheduleShiftsRequest{}
+func (x *AgentScheduleDates) Reset() {
+	*x = AgentScheduleDates{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_working_schedule_proto_msgTypes[0]
+		mi := &file_agent_working_schedule_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateAgentsWorkingScheduleShiftsRequest) String() string {
+func (x *AgentScheduleDates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAgentsWorkingScheduleShiftsRequest) ProtoMessage() {}
+func (*AgentScheduleDates) ProtoMessage() {}
 
-func (x *CreateAgentsWorkingScheduleShiftsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_working_schedule_proto_msgTypes[0]
+func (x *AgentScheduleDates) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,64 +936,215 @@ func (x *CreateAgentsWorkingScheduleShiftsRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAgentsWorkingScheduleShiftsRequest.ProtoReflect.Descriptor instead.
-func (*CreateAgentsWorkingScheduleShiftsRequest) Descriptor() ([]byte, []int) {
-	return file_agent_working_schedule_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use AgentScheduleDates.ProtoReflect.Descriptor instead.
+func (*AgentScheduleDates) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CreateAgentsWorkingScheduleShiftsRequest) GetWorkingScheduleId() int64 {
+func (x *AgentScheduleDates) GetAgent() *LookupEntity {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *AgentScheduleDates) GetDates() []int64 {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+type UpdateAgentWorkingScheduleShiftRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingScheduleId int64               `protobuf:"varint,1,opt,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
+	Item              *AgentScheduleShift `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *UpdateAgentWorkingScheduleShiftRequest) Reset() {
+	*x = UpdateAgentWorkingScheduleShiftRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAgentWorkingScheduleShiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAgentWorkingScheduleShiftRequest) ProtoMessage() {}
+
+func (x *UpdateAgentWorkingScheduleShiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAgentWorkingScheduleShiftRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAgentWorkingScheduleShiftRequest) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateAgentWorkingScheduleShiftRequest) GetWorkingScheduleId() int64 {
 	if x != nil {
 		return x.WorkingScheduleId
 	}
 	return 0
 }
 
-func (x *CreateAgentsWorkingScheduleShiftsRequest) GetDate() *FilterBetween {
+func (x *UpdateAgentWorkingScheduleShiftRequest) GetItem() *AgentScheduleShift {
 	if x != nil {
-		return x.Date
+		return x.Item
 	}
 	return nil
 }
 
-func (x *CreateAgentsWorkingScheduleShiftsRequest) GetAgents() []*LookupEntity {
+type UpdateAgentWorkingScheduleShiftResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *AgentWorkingSchedule `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *UpdateAgentWorkingScheduleShiftResponse) Reset() {
+	*x = UpdateAgentWorkingScheduleShiftResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAgentWorkingScheduleShiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAgentWorkingScheduleShiftResponse) ProtoMessage() {}
+
+func (x *UpdateAgentWorkingScheduleShiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAgentWorkingScheduleShiftResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAgentWorkingScheduleShiftResponse) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateAgentWorkingScheduleShiftResponse) GetItem() *AgentWorkingSchedule {
 	if x != nil {
-		return x.Agents
+		return x.Item
 	}
 	return nil
 }
 
-func (x *CreateAgentsWorkingScheduleShiftsRequest) GetItems() map[int64]*AgentScheduleShift {
+type DeleteAgentsWorkingScheduleShiftsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingScheduleId int64          `protobuf:"varint,1,opt,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
+	Date              *FilterBetween `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	AgentId           []int64        `protobuf:"varint,3,rep,packed,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+}
+
+func (x *DeleteAgentsWorkingScheduleShiftsRequest) Reset() {
+	*x = DeleteAgentsWorkingScheduleShiftsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAgentsWorkingScheduleShiftsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAgentsWorkingScheduleShiftsRequest) ProtoMessage() {}
+
+func (x *DeleteAgentsWorkingScheduleShiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAgentsWorkingScheduleShiftsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAgentsWorkingScheduleShiftsRequest) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteAgentsWorkingScheduleShiftsRequest) GetWorkingScheduleId() int64 {
 	if x != nil {
-		return x.Items
+		return x.WorkingScheduleId
+	}
+	return 0
+}
+
+func (x *DeleteAgentsWorkingScheduleShiftsRequest) GetDate() *FilterBetween {
+	if x != nil {
+		return x.Date
 	}
 	return nil
 }
 
-type CreateAgentsWorkingScheduleShiftsResponse struct {
+func (x *DeleteAgentsWorkingScheduleShiftsRequest) GetAgentId() []int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return nil
+}
+
+type DeleteAgentsWorkingScheduleShiftsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*AgentWorkingSchedule `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *CreateAgentsWorkingScheduleShiftsResponse) Reset() {
-	*x = CreateAgentsWorkingScheduleShiftsResponse{}
+func (x *DeleteAgentsWorkingScheduleShiftsResponse) Reset() {
+	*x = DeleteAgentsWorkingScheduleShiftsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_working_schedule_proto_msgTypes[1]
+		mi := &file_agent_working_schedule_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateAgentsWorkingScheduleShiftsResponse) String() string {
+func (x *DeleteAgentsWorkingScheduleShiftsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAgentsWorkingScheduleShiftsResponse) ProtoMessage() {}
+func (*DeleteAgentsWorkingScheduleShiftsResponse) ProtoMessage() {}
 
-func (x *CreateAgentsWorkingScheduleShiftsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_working_schedule_proto_msgTypes[1]
+func (x *DeleteAgentsWorkingScheduleShiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -130,14 +1155,14 @@ func (x *CreateAgentsWorkingScheduleShiftsResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAgentsWorkingScheduleShiftsResponse.ProtoReflect.Descriptor instead.
-func (*CreateAgentsWorkingScheduleShiftsResponse) Descriptor() ([]byte, []int) {
-	return file_agent_working_schedule_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use DeleteAgentsWorkingScheduleShiftsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAgentsWorkingScheduleShiftsResponse) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CreateAgentsWorkingScheduleShiftsResponse) GetItems() []*AgentWorkingSchedule {
+func (x *DeleteAgentsWorkingScheduleShiftsResponse) GetIds() []int64 {
 	if x != nil {
-		return x.Items
+		return x.Ids
 	}
 	return nil
 }
@@ -158,7 +1183,7 @@ type SearchAgentsWorkingScheduleRequest struct {
 func (x *SearchAgentsWorkingScheduleRequest) Reset() {
 	*x = SearchAgentsWorkingScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_working_schedule_proto_msgTypes[2]
+		mi := &file_agent_working_schedule_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -171,7 +1196,7 @@ func (x *SearchAgentsWorkingScheduleRequest) String() string {
 func (*SearchAgentsWorkingScheduleRequest) ProtoMessage() {}
 
 func (x *SearchAgentsWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_working_schedule_proto_msgTypes[2]
+	mi := &file_agent_working_schedule_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -184,7 +1209,7 @@ func (x *SearchAgentsWorkingScheduleRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SearchAgentsWorkingScheduleRequest.ProtoReflect.Descriptor instead.
 func (*SearchAgentsWorkingScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_agent_working_schedule_proto_rawDescGZIP(), []int{2}
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *SearchAgentsWorkingScheduleRequest) GetWorkingScheduleId() int64 {
@@ -242,7 +1267,7 @@ type SearchAgentsWorkingScheduleResponse struct {
 func (x *SearchAgentsWorkingScheduleResponse) Reset() {
 	*x = SearchAgentsWorkingScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_working_schedule_proto_msgTypes[3]
+		mi := &file_agent_working_schedule_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -255,7 +1280,7 @@ func (x *SearchAgentsWorkingScheduleResponse) String() string {
 func (*SearchAgentsWorkingScheduleResponse) ProtoMessage() {}
 
 func (x *SearchAgentsWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_working_schedule_proto_msgTypes[3]
+	mi := &file_agent_working_schedule_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +1293,7 @@ func (x *SearchAgentsWorkingScheduleResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use SearchAgentsWorkingScheduleResponse.ProtoReflect.Descriptor instead.
 func (*SearchAgentsWorkingScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_agent_working_schedule_proto_rawDescGZIP(), []int{3}
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *SearchAgentsWorkingScheduleResponse) GetHolidays() []*Holiday {
@@ -304,7 +1329,7 @@ type Holiday struct {
 func (x *Holiday) Reset() {
 	*x = Holiday{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_working_schedule_proto_msgTypes[4]
+		mi := &file_agent_working_schedule_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -317,7 +1342,7 @@ func (x *Holiday) String() string {
 func (*Holiday) ProtoMessage() {}
 
 func (x *Holiday) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_working_schedule_proto_msgTypes[4]
+	mi := &file_agent_working_schedule_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +1355,7 @@ func (x *Holiday) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
 func (*Holiday) Descriptor() ([]byte, []int) {
-	return file_agent_working_schedule_proto_rawDescGZIP(), []int{4}
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Holiday) GetDate() int64 {
@@ -358,15 +1383,25 @@ type AgentScheduleShiftPause struct {
 	CreatedBy *LookupEntity `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
 	UpdatedAt int64         `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	UpdatedBy *LookupEntity `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	Start     int64         `protobuf:"varint,7,opt,name=start,proto3" json:"start,omitempty"`
-	End       int64         `protobuf:"varint,8,opt,name=end,proto3" json:"end,omitempty"`
-	Cause     *LookupEntity `protobuf:"bytes,9,opt,name=cause,proto3,oneof" json:"cause,omitempty"`
+	// Minutes from the start of the shift date, pauses of overnight shifts may go past 1440.
+	Start int64         `protobuf:"varint,7,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64         `protobuf:"varint,8,opt,name=end,proto3" json:"end,omitempty"`
+	Cause *LookupEntity `protobuf:"bytes,9,opt,name=cause,proto3,oneof" json:"cause,omitempty"`
+	// Absolute start of the pause in the calendar timezone of the working schedule, unix milliseconds (UTC).
+	// Output only.
+	StartAt int64 `protobuf:"varint,10,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// Absolute end of the pause in the calendar timezone of the working schedule, unix milliseconds (UTC).
+	// Output only.
+	EndAt int64 `protobuf:"varint,11,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// Pause times in the agent's own timezone, set only if the agent has one.
+	// Output only.
+	Local *AgentScheduleLocalTime `protobuf:"bytes,12,opt,name=local,proto3" json:"local,omitempty"`
 }
 
 func (x *AgentScheduleShiftPause) Reset() {
 	*x = AgentScheduleShiftPause{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_working_schedule_proto_msgTypes[5]
+		mi := &file_agent_working_schedule_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -379,7 +1414,7 @@ func (x *AgentScheduleShiftPause) String() string {
 func (*AgentScheduleShiftPause) ProtoMessage() {}
 
 func (x *AgentScheduleShiftPause) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_working_schedule_proto_msgTypes[5]
+	mi := &file_agent_working_schedule_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +1427,7 @@ func (x *AgentScheduleShiftPause) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentScheduleShiftPause.ProtoReflect.Descriptor instead.
 func (*AgentScheduleShiftPause) Descriptor() ([]byte, []int) {
-	return file_agent_working_schedule_proto_rawDescGZIP(), []int{5}
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AgentScheduleShiftPause) GetId() int64 {
@@ -458,6 +1493,27 @@ func (x *AgentScheduleShiftPause) GetCause() *LookupEntity {
 	return nil
 }
 
+func (x *AgentScheduleShiftPause) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *AgentScheduleShiftPause) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *AgentScheduleShiftPause) GetLocal() *AgentScheduleLocalTime {
+	if x != nil {
+		return x.Local
+	}
+	return nil
+}
+
 type AgentScheduleShiftSkill struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -471,7 +1527,7 @@ type AgentScheduleShiftSkill struct {
 func (x *AgentScheduleShiftSkill) Reset() {
 	*x = AgentScheduleShiftSkill{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_working_schedule_proto_msgTypes[6]
+		mi := &file_agent_working_schedule_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -484,7 +1540,7 @@ func (x *AgentScheduleShiftSkill) String() string {
 func (*AgentScheduleShiftSkill) ProtoMessage() {}
 
 func (x *AgentScheduleShiftSkill) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_working_schedule_proto_msgTypes[6]
+	mi := &file_agent_working_schedule_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +1553,7 @@ func (x *AgentScheduleShiftSkill) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentScheduleShiftSkill.ProtoReflect.Descriptor instead.
 func (*AgentScheduleShiftSkill) Descriptor() ([]byte, []int) {
-	return file_agent_working_schedule_proto_rawDescGZIP(), []int{6}
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *AgentScheduleShiftSkill) GetSkill() *LookupEntity {
@@ -526,22 +1582,37 @@ type AgentScheduleShift struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64                      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	DomainId  int64                      `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	CreatedAt int64                      `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedBy *LookupEntity              `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedAt int64                      `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UpdatedBy *LookupEntity              `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
-	Start     int64                      `protobuf:"varint,7,opt,name=start,proto3" json:"start,omitempty"`
-	End       int64                      `protobuf:"varint,8,opt,name=end,proto3" json:"end,omitempty"`
-	Pauses    []*AgentScheduleShiftPause `protobuf:"bytes,9,rep,name=pauses,proto3" json:"pauses,omitempty"`
-	Skills    []*AgentScheduleShiftSkill `protobuf:"bytes,10,rep,name=skills,proto3" json:"skills,omitempty"`
+	Id        int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId  int64         `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	CreatedAt int64         `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy *LookupEntity `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt int64         `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy *LookupEntity `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// Minutes from the start of the shift date.
+	Start int64 `protobuf:"varint,7,opt,name=start,proto3" json:"start,omitempty"`
+	// Minutes from the start of the shift date, goes past 1440 for overnight shifts,
+	// e.g. 22:00-06:00 shift is 1320-1800.
+	End    int64                      `protobuf:"varint,8,opt,name=end,proto3" json:"end,omitempty"`
+	Pauses []*AgentScheduleShiftPause `protobuf:"bytes,9,rep,name=pauses,proto3" json:"pauses,omitempty"`
+	Skills []*AgentScheduleShiftSkill `protobuf:"bytes,10,rep,name=skills,proto3" json:"skills,omitempty"`
+	// Absolute start of the shift in the calendar timezone of the working schedule, unix milliseconds (UTC).
+	// Output only.
+	StartAt int64 `protobuf:"varint,11,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	// Absolute end of the shift in the calendar timezone of the working schedule, unix milliseconds (UTC).
+	// Output only.
+	EndAt int64 `protobuf:"varint,12,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	// Shift times in the agent's own timezone, set only if the agent has one.
+	// Output only.
+	Local *AgentScheduleLocalTime `protobuf:"bytes,13,opt,name=local,proto3" json:"local,omitempty"`
+	// Roster pattern, that the shift is created from.
+	// Output only.
+	RosterPattern *LookupEntity `protobuf:"bytes,14,opt,name=roster_pattern,json=rosterPattern,proto3" json:"roster_pattern,omitempty"`
 }
 
 func (x *AgentScheduleShift) Reset() {
 	*x = AgentScheduleShift{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_working_schedule_proto_msgTypes[7]
+		mi := &file_agent_working_schedule_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -554,7 +1625,7 @@ func (x *AgentScheduleShift) String() string {
 func (*AgentScheduleShift) ProtoMessage() {}
 
 func (x *AgentScheduleShift) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_working_schedule_proto_msgTypes[7]
+	mi := &file_agent_working_schedule_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +1638,7 @@ func (x *AgentScheduleShift) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentScheduleShift.ProtoReflect.Descriptor instead.
 func (*AgentScheduleShift) Descriptor() ([]byte, []int) {
-	return file_agent_working_schedule_proto_rawDescGZIP(), []int{7}
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *AgentScheduleShift) GetId() int64 {
@@ -577,69 +1648,165 @@ func (x *AgentScheduleShift) GetId() int64 {
 	return 0
 }
 
-func (x *AgentScheduleShift) GetDomainId() int64 {
+func (x *AgentScheduleShift) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *AgentScheduleShift) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AgentScheduleShift) GetCreatedBy() *LookupEntity {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *AgentScheduleShift) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *AgentScheduleShift) GetUpdatedBy() *LookupEntity {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *AgentScheduleShift) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *AgentScheduleShift) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *AgentScheduleShift) GetPauses() []*AgentScheduleShiftPause {
+	if x != nil {
+		return x.Pauses
+	}
+	return nil
+}
+
+func (x *AgentScheduleShift) GetSkills() []*AgentScheduleShiftSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *AgentScheduleShift) GetStartAt() int64 {
 	if x != nil {
-		return x.DomainId
+		return x.StartAt
 	}
 	return 0
 }
 
-func (x *AgentScheduleShift) GetCreatedAt() int64 {
+func (x *AgentScheduleShift) GetEndAt() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.EndAt
 	}
 	return 0
 }
 
-func (x *AgentScheduleShift) GetCreatedBy() *LookupEntity {
+func (x *AgentScheduleShift) GetLocal() *AgentScheduleLocalTime {
 	if x != nil {
-		return x.CreatedBy
+		return x.Local
 	}
 	return nil
 }
 
-func (x *AgentScheduleShift) GetUpdatedAt() int64 {
+func (x *AgentScheduleShift) GetRosterPattern() *LookupEntity {
 	if x != nil {
-		return x.UpdatedAt
+		return x.RosterPattern
 	}
-	return 0
+	return nil
 }
 
-func (x *AgentScheduleShift) GetUpdatedBy() *LookupEntity {
-	if x != nil {
-		return x.UpdatedBy
+// AgentScheduleLocalTime is a shift or a pause in the agent's own timezone.
+type AgentScheduleLocalTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Local date of the shift start.
+	Date int64 `protobuf:"varint,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Minutes from the start of the local date.
+	Start int64 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *AgentScheduleLocalTime) Reset() {
+	*x = AgentScheduleLocalTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_agent_working_schedule_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *AgentScheduleShift) GetStart() int64 {
-	if x != nil {
-		return x.Start
+func (x *AgentScheduleLocalTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgentScheduleLocalTime) ProtoMessage() {}
+
+func (x *AgentScheduleLocalTime) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_working_schedule_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *AgentScheduleShift) GetEnd() int64 {
+// Deprecated: Use AgentScheduleLocalTime.ProtoReflect.Descriptor instead.
+func (*AgentScheduleLocalTime) Descriptor() ([]byte, []int) {
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AgentScheduleLocalTime) GetDate() int64 {
 	if x != nil {
-		return x.End
+		return x.Date
 	}
 	return 0
 }
 
-func (x *AgentScheduleShift) GetPauses() []*AgentScheduleShiftPause {
+func (x *AgentScheduleLocalTime) GetStart() int64 {
 	if x != nil {
-		return x.Pauses
+		return x.Start
 	}
-	return nil
+	return 0
 }
 
-func (x *AgentScheduleShift) GetSkills() []*AgentScheduleShiftSkill {
+func (x *AgentScheduleLocalTime) GetEnd() int64 {
 	if x != nil {
-		return x.Skills
+		return x.End
 	}
-	return nil
+	return 0
 }
 
+// AgentSchedule is a single item of the agent day.
+// Each segment of a split shift is listed as a separate item with the same date.
 type AgentSchedule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -652,12 +1819,18 @@ type AgentSchedule struct {
 	//	*AgentSchedule_Absence
 	//	*AgentSchedule_Shift
 	Type isAgentSchedule_Type `protobuf_oneof:"type"`
+	// Bounds of the partial-day absence in minutes from the start of the day.
+	AbsenceStart *int64 `protobuf:"varint,5,opt,name=absence_start,json=absenceStart,proto3,oneof" json:"absence_start,omitempty"`
+	AbsenceEnd   *int64 `protobuf:"varint,6,opt,name=absence_end,json=absenceEnd,proto3,oneof" json:"absence_end,omitempty"`
+	// The overnight shift started on the previous day and continues on the date.
+	// Shift times are relative to its start date.
+	Carryover bool `protobuf:"varint,8,opt,name=carryover,proto3" json:"carryover,omitempty"`
 }
 
 func (x *AgentSchedule) Reset() {
 	*x = AgentSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_working_schedule_proto_msgTypes[8]
+		mi := &file_agent_working_schedule_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -670,7 +1843,7 @@ func (x *AgentSchedule) String() string {
 func (*AgentSchedule) ProtoMessage() {}
 
 func (x *AgentSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_working_schedule_proto_msgTypes[8]
+	mi := &file_agent_working_schedule_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -683,7 +1856,7 @@ func (x *AgentSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentSchedule.ProtoReflect.Descriptor instead.
 func (*AgentSchedule) Descriptor() ([]byte, []int) {
-	return file_agent_working_schedule_proto_rawDescGZIP(), []int{8}
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *AgentSchedule) GetDate() int64 {
@@ -707,11 +1880,11 @@ func (m *AgentSchedule) GetType() isAgentSchedule_Type {
 	return nil
 }
 
-func (x *AgentSchedule) GetAbsence() AbsenceType {
+func (x *AgentSchedule) GetAbsence() *LookupEntity {
 	if x, ok := x.GetType().(*AgentSchedule_Absence); ok {
 		return x.Absence
 	}
-	return AbsenceType_ABSENCE_TYPE_UNSPECIFIED
+	return nil
 }
 
 func (x *AgentSchedule) GetShift() *AgentScheduleShift {
@@ -721,12 +1894,34 @@ func (x *AgentSchedule) GetShift() *AgentScheduleShift {
 	return nil
 }
 
+func (x *AgentSchedule) GetAbsenceStart() int64 {
+	if x != nil && x.AbsenceStart != nil {
+		return *x.AbsenceStart
+	}
+	return 0
+}
+
+func (x *AgentSchedule) GetAbsenceEnd() int64 {
+	if x != nil && x.AbsenceEnd != nil {
+		return *x.AbsenceEnd
+	}
+	return 0
+}
+
+func (x *AgentSchedule) GetCarryover() bool {
+	if x != nil {
+		return x.Carryover
+	}
+	return false
+}
+
 type isAgentSchedule_Type interface {
 	isAgentSchedule_Type()
 }
 
 type AgentSchedule_Absence struct {
-	Absence AbsenceType `protobuf:"varint,3,opt,name=absence,proto3,enum=wfm.AbsenceType,oneof"`
+	// Absence type from the domain catalogue.
+	Absence *LookupEntity `protobuf:"bytes,7,opt,name=absence,proto3,oneof"`
 }
 
 type AgentSchedule_Shift struct {
@@ -744,12 +1939,15 @@ type AgentWorkingSchedule struct {
 
 	Agent    *LookupEntity    `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
 	Schedule []*AgentSchedule `protobuf:"bytes,2,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	// IANA name of the agent's own timezone, empty if the agent uses the calendar timezone
+	// of the working schedule, which is the reference time of shifts.
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
 }
 
 func (x *AgentWorkingSchedule) Reset() {
 	*x = AgentWorkingSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_agent_working_schedule_proto_msgTypes[9]
+		mi := &file_agent_working_schedule_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -762,7 +1960,7 @@ func (x *AgentWorkingSchedule) String() string {
 func (*AgentWorkingSchedule) ProtoMessage() {}
 
 func (x *AgentWorkingSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_working_schedule_proto_msgTypes[9]
+	mi := &file_agent_working_schedule_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,7 +1973,7 @@ func (x *AgentWorkingSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentWorkingSchedule.ProtoReflect.Descriptor instead.
 func (*AgentWorkingSchedule) Descriptor() ([]byte, []int) {
-	return file_agent_working_schedule_proto_rawDescGZIP(), []int{9}
+	return file_agent_working_schedule_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *AgentWorkingSchedule) GetAgent() *LookupEntity {
@@ -792,6 +1990,13 @@ func (x *AgentWorkingSchedule) GetSchedule() []*AgentSchedule {
 	return nil
 }
 
+func (x *AgentWorkingSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 var File_agent_working_schedule_proto protoreflect.FileDescriptor
 
 var file_agent_working_schedule_proto_rawDesc = []byte{
@@ -805,7 +2010,7 @@ var file_agent_working_schedule_proto_rawDesc = []byte{
 	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b,
 	0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x62,
-	0x73, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x02, 0x0a, 0x28,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfa, 0x0a, 0x0a, 0x28,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b,
 	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b,
@@ -814,170 +2019,488 @@ var file_agent_working_schedule_proto_rawDesc = []byte{
 	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x46, 0x69, 0x6c,
 	0x74, 0x65, 0x72, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e,
+	0x01, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e,
 	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c,
-	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x08, 0xba, 0x48, 0x05,
-	0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x6a, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x77,
-	0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57,
-	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68,
-	0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1a, 0xba, 0x48, 0x17, 0xc8, 0x01, 0x01, 0x9a, 0x01,
-	0x11, 0x08, 0x01, 0x10, 0x07, 0x22, 0x06, 0x22, 0x04, 0x18, 0x06, 0x28, 0x00, 0x2a, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x51, 0x0a, 0x0a, 0x49, 0x74, 0x65,
-	0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66,
-	0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c, 0x0a, 0x29,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x65, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x15, 0xba, 0x48,
+	0x12, 0x9a, 0x01, 0x0f, 0x10, 0x07, 0x22, 0x06, 0x22, 0x04, 0x18, 0x06, 0x28, 0x00, 0x2a, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x34, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x3b, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x73, 0x88, 0x01, 0x01, 0x12, 0x6e, 0x0a,
+	0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x15, 0xba, 0x48,
+	0x12, 0x9a, 0x01, 0x0f, 0x10, 0x07, 0x22, 0x06, 0x22, 0x04, 0x18, 0x06, 0x28, 0x00, 0x2a, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a,
+	0x11, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x48, 0x01, 0x52, 0x0f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0b, 0x61, 0x6e, 0x63, 0x68, 0x6f,
+	0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x02, 0x52, 0x0a, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x44,
+	0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x11, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x03, 0x52, 0x0f, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x1a, 0x51, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x55, 0x0a, 0x0d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0xde, 0x03, 0xba, 0x48, 0xda,
+	0x03, 0x1a, 0xe2, 0x01, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x54, 0x65, 0x78, 0x61,
+	0x63, 0x74, 0x6c, 0x79, 0x20, 0x6f, 0x6e, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x2c, 0x20, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2c, 0x20, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x20, 0x6f,
+	0x72, 0x20, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x5f, 0x69, 0x64, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65,
+	0x74, 0x1a, 0x82, 0x01, 0x5b, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x2c, 0x20, 0x73, 0x69, 0x7a, 0x65, 0x28,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x29, 0x20, 0x3e,
+	0x20, 0x30, 0x2c, 0x20, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x29, 0x2c,
+	0x20, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x29, 0x5d, 0x2e, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x28, 0x78, 0x2c, 0x20, 0x78, 0x29, 0x2e, 0x73, 0x69, 0x7a, 0x65, 0x28,
+	0x29, 0x20, 0x3d, 0x3d, 0x20, 0x31, 0x1a, 0x74, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x34, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20,
+	0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x75, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x72, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x20,
+	0x69, 0x73, 0x20, 0x73, 0x65, 0x74, 0x1a, 0x34, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x20, 0x7c, 0x7c,
+	0x20, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x29, 0x1a, 0x7d, 0x0a, 0x0b,
+	0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x12, 0x38, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x20, 0x61,
+	0x6e, 0x64, 0x20, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x20, 0x73,
+	0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x74, 0x6f, 0x67,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x1a, 0x34, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69,
+	0x64, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x61,
+	0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x29, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x73, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f,
+	0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x6e, 0x63, 0x68, 0x6f, 0x72, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x5f, 0x69, 0x64, 0x22, 0x4e, 0x0a, 0x13, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12,
+	0x37, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x08,
+	0x01, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xb0, 0x02, 0x0a, 0x0e, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x11, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48,
+	0x00, 0x52, 0x0f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x22, 0x05, 0x18, 0xa0, 0x0b, 0x28, 0x00, 0x52, 0x0e, 0x6d, 0x69, 0x6e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x10, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x22, 0x05, 0x18, 0xa0, 0x0b, 0x28,
+	0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x30, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x22, 0x05,
+	0x18, 0xa0, 0x0b, 0x28, 0x00, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x45, 0x6e, 0x64, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x22, 0x05, 0x18, 0xa0, 0x0b, 0x28, 0x00, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x45, 0x6e, 0x64, 0x4f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0xf7, 0x01, 0x0a, 0x29,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b,
 	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74,
 	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65,
 	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41,
 	0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x22, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
-	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49,
-	0x64, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x65, 0x74, 0x77,
-	0x65, 0x65, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x70,
-	0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03,
-	0x52, 0x0c, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52,
-	0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
-	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
-	0x49, 0x64, 0x12, 0x11, 0x0a, 0x01, 0x71, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x01, 0x71, 0x88, 0x01, 0x01, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x71, 0x22, 0x96, 0x01, 0x0a, 0x23,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x48, 0x6f, 0x6c, 0x69,
-	0x64, 0x61, 0x79, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x73, 0x12, 0x2f, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77,
-	0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x22, 0x31, 0x0a, 0x07, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xb6, 0x03, 0x0a, 0x17, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x50, 0x61,
-	0x75, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
-	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x42, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x22, 0x05, 0x18, 0xa0, 0x0b, 0x28, 0x00,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x22, 0x05, 0x18, 0xa0,
-	0x0b, 0x28, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x61, 0x75, 0x73,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f,
-	0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x05, 0x63, 0x61,
-	0x75, 0x73, 0x65, 0x88, 0x01, 0x01, 0x3a, 0x4e, 0xba, 0x48, 0x4b, 0x1a, 0x49, 0x0a, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x12, 0x2a, 0x65, 0x6e, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x63, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x72, 0x20,
-	0x74, 0x68, 0x61, 0x6e, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x1a,
-	0x15, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x3c, 0x20, 0x74, 0x68,
-	0x69, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65,
-	0x22, 0x88, 0x01, 0x0a, 0x17, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x2f, 0x0a, 0x05,
-	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66,
-	0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x06,
-	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x22, 0x0a,
-	0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
-	0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xe5, 0x03, 0x0a, 0x12,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69,
-	0x66, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
-	0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79,
-	0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42,
-	0x79, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x22, 0x05, 0x18, 0xa0, 0x0b, 0x28, 0x00, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x22, 0x05, 0x18, 0xa0, 0x0b,
-	0x28, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65,
-	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74,
-	0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x73, 0x12, 0x34, 0x0a,
-	0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x75, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x07, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x92, 0x02, 0x0a, 0x28, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x11, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0xba, 0x48,
+	0x0a, 0xc8, 0x01, 0x01, 0x7a, 0x05, 0x18, 0x80, 0x80, 0x80, 0x05, 0x52, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x38, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68,
+	0x65, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x12, 0x34, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0xb8, 0x02, 0x0a, 0x29, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x31, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x07, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x54, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6c,
+	0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d,
+	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x8d, 0x02, 0x0a, 0x22,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x11, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e,
+	0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x22, 0x79, 0x0a, 0x23, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x22, 0x53, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x44, 0x61, 0x74, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x05,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x05,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x26,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x49, 0x64, 0x12, 0x33, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x58, 0x0a, 0x27, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x22, 0xbd, 0x01, 0x0a, 0x28, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a,
+	0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x08, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x18, 0x01, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0x3d, 0x0a, 0x29, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73,
+	0x22, 0xee, 0x01, 0x0a, 0x22, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x07, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x11, 0x0a, 0x01, 0x71, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x01, 0x71, 0x88, 0x01, 0x01, 0x42, 0x04, 0x0a, 0x02, 0x5f,
+	0x71, 0x22, 0x96, 0x01, 0x0a, 0x23, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x08, 0x68, 0x6f, 0x6c,
+	0x69, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x48, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x79, 0x52, 0x08, 0x68, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x79, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x31, 0x0a, 0x07, 0x48, 0x6f,
+	0x6c, 0x69, 0x64, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9b, 0x04,
+	0x0a, 0x17, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x22,
+	0x05, 0x18, 0xc0, 0x16, 0x28, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1f, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8,
+	0x01, 0x01, 0x22, 0x05, 0x18, 0xc0, 0x16, 0x28, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x2c,
+	0x0a, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x48, 0x00, 0x52, 0x05, 0x63, 0x61, 0x75, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x65, 0x6e, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x31,
+	0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
 	0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x06, 0x73, 0x6b, 0x69,
-	0x6c, 0x6c, 0x73, 0x3a, 0x4e, 0xba, 0x48, 0x4b, 0x1a, 0x49, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65,
-	0x12, 0x2a, 0x65, 0x6e, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x20, 0x62, 0x65, 0x20, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61,
-	0x6e, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x15, 0x74, 0x68,
-	0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x3c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
-	0x65, 0x6e, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x0d, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
-	0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x12, 0x2c, 0x0a, 0x07, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x07, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12,
-	0x2f, 0x0a, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74,
-	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x6f, 0x0a, 0x14, 0x41, 0x67, 0x65, 0x6e,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x3a, 0x4e, 0xba, 0x48, 0x4b, 0x1a, 0x49, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2a,
+	0x65, 0x6e, 0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x20,
+	0x62, 0x65, 0x20, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x15, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x3c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x65, 0x6e,
+	0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x17,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x05, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x12, 0x22, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xd6, 0x05, 0x0a, 0x12, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x23, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0xba, 0x48, 0x0a,
+	0xc8, 0x01, 0x01, 0x22, 0x05, 0x10, 0xa0, 0x0b, 0x28, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x1f, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d,
+	0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x22, 0x05, 0x18, 0xc0, 0x16, 0x20, 0x00, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x6b, 0x69, 0x6c,
+	0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x52, 0x06, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x65, 0x6e, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x65, 0x6e, 0x64, 0x41, 0x74,
+	0x12, 0x31, 0x0a, 0x05, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x05, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x0e, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0d,
+	0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x3a, 0x9f, 0x01,
+	0xba, 0x48, 0x9b, 0x01, 0x1a, 0x49, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x65, 0x6e,
+	0x64, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65,
+	0x20, 0x65, 0x61, 0x72, 0x6c, 0x69, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x20, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x15, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x20, 0x3c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x1a,
+	0x4e, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x20, 0x63, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x6c,
+	0x6f, 0x6e, 0x67, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x61, 0x20, 0x64, 0x61, 0x79,
+	0x1a, 0x1d, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x20, 0x2d, 0x20, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x3c, 0x3d, 0x20, 0x31, 0x34, 0x34, 0x30, 0x22,
+	0x54, 0x0a, 0x16, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0xb9, 0x02, 0x0a, 0x0d, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x07, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x48, 0x00, 0x52, 0x07, 0x61, 0x62, 0x73, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x12, 0x28, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0c, 0x61, 0x62,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x0b, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x02, 0x52, 0x0a, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x64,
+	0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x72, 0x79, 0x6f, 0x76, 0x65, 0x72,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x72, 0x72, 0x79, 0x6f, 0x76, 0x65,
+	0x72, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x62,
+	0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x4a, 0x04, 0x08, 0x03, 0x10,
+	0x04, 0x22, 0x8b, 0x01, 0x0a, 0x14, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x2a,
+	0x80, 0x01, 0x0a, 0x14, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x22, 0x53, 0x43, 0x48, 0x45,
+	0x44, 0x55, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x41, 0x59, 0x4f,
+	0x55, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x47, 0x52, 0x49, 0x44, 0x10,
+	0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x46, 0x4c, 0x41, 0x54,
+	0x10, 0x02, 0x2a, 0x70, 0x0a, 0x11, 0x53, 0x70, 0x72, 0x65, 0x61, 0x64, 0x73, 0x68, 0x65, 0x65,
+	0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x50, 0x52, 0x45, 0x41,
+	0x44, 0x53, 0x48, 0x45, 0x45, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x53,
+	0x50, 0x52, 0x45, 0x41, 0x44, 0x53, 0x48, 0x45, 0x45, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x50, 0x52, 0x45, 0x41,
+	0x44, 0x53, 0x48, 0x45, 0x45, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x58, 0x4c,
+	0x53, 0x58, 0x10, 0x02, 0x2a, 0xc0, 0x01, 0x0a, 0x11, 0x53, 0x68, 0x69, 0x66, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x48,
+	0x49, 0x46, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x1c, 0x0a, 0x18, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43,
+	0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x01, 0x12, 0x25, 0x0a,
+	0x21, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x4f,
+	0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52,
+	0x57, 0x52, 0x49, 0x54, 0x45, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x48, 0x49, 0x46, 0x54,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41,
+	0x50, 0x50, 0x45, 0x4e, 0x44, 0x10, 0x04, 0x32, 0xdd, 0x09, 0x0a, 0x1b, 0x41, 0x67, 0x65, 0x6e,
 	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x12, 0x27, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66,
-	0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
-	0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x32, 0xb1, 0x03, 0x0a, 0x1b, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc6, 0x01, 0x0a, 0x21, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
-	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12,
-	0x2d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc6, 0x01, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x2d, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42, 0x90, 0xb5,
+	0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x3a, 0x01, 0x2a, 0x22, 0x33, 0x2f, 0x77, 0x66,
+	0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x12, 0xcd, 0x01, 0x0a, 0x21, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74,
 	0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x42,
-	0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x38, 0x3a, 0x01, 0x2a, 0x22, 0x33, 0x2f,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x3f, 0x3a, 0x01, 0x2a, 0x22, 0x3a, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0xb8, 0x01, 0x0a, 0x1b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x27, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x46, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12,
+	0x3a, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0xb1, 0x01, 0x0a, 0x1b,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f,
+	0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f, 0x77, 0x66, 0x6d,
+	0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0xd1, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x12, 0x2b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x53,
+	0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x49, 0x3a, 0x01, 0x2a, 0x1a, 0x44, 0x2f,
 	0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69,
 	0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f,
 	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69,
-	0x64, 0x7d, 0x12, 0xb1, 0x01, 0x0a, 0x1b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65,
-	0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x12, 0x27, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x66,
-	0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f,
-	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x35, 0x12, 0x33, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x77,
-	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73,
-	0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x1a, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x77, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x23, 0x5a,
-	0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69,
-	0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d, 0x3b, 0x77,
-	0x66, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x64, 0x7d, 0x2f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x2e,
+	0x69, 0x64, 0x7d, 0x12, 0xca, 0x01, 0x0a, 0x21, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x46, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x3c, 0x2a, 0x3a, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73,
+	0x1a, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d, 0x3b, 0x77, 0x66, 0x6d, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -992,53 +2515,103 @@ func file_agent_working_schedule_proto_rawDescGZIP() []byte {
 	return file_agent_working_schedule_proto_rawDescData
 }
 
-var file_agent_working_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_agent_working_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_agent_working_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_agent_working_schedule_proto_goTypes = []interface{}{
-	(*CreateAgentsWorkingScheduleShiftsRequest)(nil),  // 0: wfm.CreateAgentsWorkingScheduleShiftsRequest
-	(*CreateAgentsWorkingScheduleShiftsResponse)(nil), // 1: wfm.CreateAgentsWorkingScheduleShiftsResponse
-	(*SearchAgentsWorkingScheduleRequest)(nil),        // 2: wfm.SearchAgentsWorkingScheduleRequest
-	(*SearchAgentsWorkingScheduleResponse)(nil),       // 3: wfm.SearchAgentsWorkingScheduleResponse
-	(*Holiday)(nil),                 // 4: wfm.Holiday
-	(*AgentScheduleShiftPause)(nil), // 5: wfm.AgentScheduleShiftPause
-	(*AgentScheduleShiftSkill)(nil), // 6: wfm.AgentScheduleShiftSkill
-	(*AgentScheduleShift)(nil),      // 7: wfm.AgentScheduleShift
-	(*AgentSchedule)(nil),           // 8: wfm.AgentSchedule
-	(*AgentWorkingSchedule)(nil),    // 9: wfm.AgentWorkingSchedule
-	nil,                             // 10: wfm.CreateAgentsWorkingScheduleShiftsRequest.ItemsEntry
-	(*FilterBetween)(nil),           // 11: wfm.FilterBetween
-	(*LookupEntity)(nil),            // 12: wfm.LookupEntity
-	(AbsenceType)(0),                // 13: wfm.AbsenceType
+	(ScheduleExportLayout)(0),                         // 0: wfm.ScheduleExportLayout
+	(SpreadsheetFormat)(0),                            // 1: wfm.SpreadsheetFormat
+	(ShiftConflictMode)(0),                            // 2: wfm.ShiftConflictMode
+	(*CreateAgentsWorkingScheduleShiftsRequest)(nil),  // 3: wfm.CreateAgentsWorkingScheduleShiftsRequest
+	(*AgentScheduleShifts)(nil),                       // 4: wfm.AgentScheduleShifts
+	(*PausePlacement)(nil),                            // 5: wfm.PausePlacement
+	(*CreateAgentsWorkingScheduleShiftsResponse)(nil), // 6: wfm.CreateAgentsWorkingScheduleShiftsResponse
+	(*ImportAgentsWorkingScheduleShiftsRequest)(nil),  // 7: wfm.ImportAgentsWorkingScheduleShiftsRequest
+	(*ImportAgentsWorkingScheduleShiftsResponse)(nil), // 8: wfm.ImportAgentsWorkingScheduleShiftsResponse
+	(*ImportRowError)(nil),                            // 9: wfm.ImportRowError
+	(*ExportAgentsWorkingScheduleRequest)(nil),        // 10: wfm.ExportAgentsWorkingScheduleRequest
+	(*ExportAgentsWorkingScheduleResponse)(nil),       // 11: wfm.ExportAgentsWorkingScheduleResponse
+	(*AgentScheduleDates)(nil),                        // 12: wfm.AgentScheduleDates
+	(*UpdateAgentWorkingScheduleShiftRequest)(nil),    // 13: wfm.UpdateAgentWorkingScheduleShiftRequest
+	(*UpdateAgentWorkingScheduleShiftResponse)(nil),   // 14: wfm.UpdateAgentWorkingScheduleShiftResponse
+	(*DeleteAgentsWorkingScheduleShiftsRequest)(nil),  // 15: wfm.DeleteAgentsWorkingScheduleShiftsRequest
+	(*DeleteAgentsWorkingScheduleShiftsResponse)(nil), // 16: wfm.DeleteAgentsWorkingScheduleShiftsResponse
+	(*SearchAgentsWorkingScheduleRequest)(nil),        // 17: wfm.SearchAgentsWorkingScheduleRequest
+	(*SearchAgentsWorkingScheduleResponse)(nil),       // 18: wfm.SearchAgentsWorkingScheduleResponse
+	(*Holiday)(nil),                                   // 19: wfm.Holiday
+	(*AgentScheduleShiftPause)(nil),                   // 20: wfm.AgentScheduleShiftPause
+	(*AgentScheduleShiftSkill)(nil),                   // 21: wfm.AgentScheduleShiftSkill
+	(*AgentScheduleShift)(nil),                        // 22: wfm.AgentScheduleShift
+	(*AgentScheduleLocalTime)(nil),                    // 23: wfm.AgentScheduleLocalTime
+	(*AgentSchedule)(nil),                             // 24: wfm.AgentSchedule
+	(*AgentWorkingSchedule)(nil),                      // 25: wfm.AgentWorkingSchedule
+	nil,                                               // 26: wfm.CreateAgentsWorkingScheduleShiftsRequest.ItemsEntry
+	nil,                                               // 27: wfm.CreateAgentsWorkingScheduleShiftsRequest.SegmentsEntry
+	(*FilterBetween)(nil),                             // 28: wfm.FilterBetween
+	(*LookupEntity)(nil),                              // 29: wfm.LookupEntity
 }
 var file_agent_working_schedule_proto_depIdxs = []int32{
-	11, // 0: wfm.CreateAgentsWorkingScheduleShiftsRequest.date:type_name -> wfm.FilterBetween
-	12, // 1: wfm.CreateAgentsWorkingScheduleShiftsRequest.agents:type_name -> wfm.LookupEntity
-	10, // 2: wfm.CreateAgentsWorkingScheduleShiftsRequest.items:type_name -> wfm.CreateAgentsWorkingScheduleShiftsRequest.ItemsEntry
-	9,  // 3: wfm.CreateAgentsWorkingScheduleShiftsResponse.items:type_name -> wfm.AgentWorkingSchedule
-	11, // 4: wfm.SearchAgentsWorkingScheduleRequest.date:type_name -> wfm.FilterBetween
-	4,  // 5: wfm.SearchAgentsWorkingScheduleResponse.holidays:type_name -> wfm.Holiday
-	9,  // 6: wfm.SearchAgentsWorkingScheduleResponse.items:type_name -> wfm.AgentWorkingSchedule
-	12, // 7: wfm.AgentScheduleShiftPause.created_by:type_name -> wfm.LookupEntity
-	12, // 8: wfm.AgentScheduleShiftPause.updated_by:type_name -> wfm.LookupEntity
-	12, // 9: wfm.AgentScheduleShiftPause.cause:type_name -> wfm.LookupEntity
-	12, // 10: wfm.AgentScheduleShiftSkill.skill:type_name -> wfm.LookupEntity
-	12, // 11: wfm.AgentScheduleShift.created_by:type_name -> wfm.LookupEntity
-	12, // 12: wfm.AgentScheduleShift.updated_by:type_name -> wfm.LookupEntity
-	5,  // 13: wfm.AgentScheduleShift.pauses:type_name -> wfm.AgentScheduleShiftPause
-	6,  // 14: wfm.AgentScheduleShift.skills:type_name -> wfm.AgentScheduleShiftSkill
-	13, // 15: wfm.AgentSchedule.absence:type_name -> wfm.AbsenceType
-	7,  // 16: wfm.AgentSchedule.shift:type_name -> wfm.AgentScheduleShift
-	12, // 17: wfm.AgentWorkingSchedule.agent:type_name -> wfm.LookupEntity
-	8,  // 18: wfm.AgentWorkingSchedule.schedule:type_name -> wfm.AgentSchedule
-	7,  // 19: wfm.CreateAgentsWorkingScheduleShiftsRequest.ItemsEntry.value:type_name -> wfm.AgentScheduleShift
-	0,  // 20: wfm.AgentWorkingScheduleService.CreateAgentsWorkingScheduleShifts:input_type -> wfm.CreateAgentsWorkingScheduleShiftsRequest
-	2,  // 21: wfm.AgentWorkingScheduleService.SearchAgentsWorkingSchedule:input_type -> wfm.SearchAgentsWorkingScheduleRequest
-	1,  // 22: wfm.AgentWorkingScheduleService.CreateAgentsWorkingScheduleShifts:output_type -> wfm.CreateAgentsWorkingScheduleShiftsResponse
-	3,  // 23: wfm.AgentWorkingScheduleService.SearchAgentsWorkingSchedule:output_type -> wfm.SearchAgentsWorkingScheduleResponse
-	22, // [22:24] is the sub-list for method output_type
-	20, // [20:22] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	28, // 0: wfm.CreateAgentsWorkingScheduleShiftsRequest.date:type_name -> wfm.FilterBetween
+	29, // 1: wfm.CreateAgentsWorkingScheduleShiftsRequest.agents:type_name -> wfm.LookupEntity
+	26, // 2: wfm.CreateAgentsWorkingScheduleShiftsRequest.items:type_name -> wfm.CreateAgentsWorkingScheduleShiftsRequest.ItemsEntry
+	2,  // 3: wfm.CreateAgentsWorkingScheduleShiftsRequest.mode:type_name -> wfm.ShiftConflictMode
+	5,  // 4: wfm.CreateAgentsWorkingScheduleShiftsRequest.place_pauses:type_name -> wfm.PausePlacement
+	27, // 5: wfm.CreateAgentsWorkingScheduleShiftsRequest.segments:type_name -> wfm.CreateAgentsWorkingScheduleShiftsRequest.SegmentsEntry
+	22, // 6: wfm.AgentScheduleShifts.items:type_name -> wfm.AgentScheduleShift
+	25, // 7: wfm.CreateAgentsWorkingScheduleShiftsResponse.items:type_name -> wfm.AgentWorkingSchedule
+	12, // 8: wfm.CreateAgentsWorkingScheduleShiftsResponse.created:type_name -> wfm.AgentScheduleDates
+	12, // 9: wfm.CreateAgentsWorkingScheduleShiftsResponse.replaced:type_name -> wfm.AgentScheduleDates
+	12, // 10: wfm.CreateAgentsWorkingScheduleShiftsResponse.skipped:type_name -> wfm.AgentScheduleDates
+	1,  // 11: wfm.ImportAgentsWorkingScheduleShiftsRequest.format:type_name -> wfm.SpreadsheetFormat
+	2,  // 12: wfm.ImportAgentsWorkingScheduleShiftsRequest.mode:type_name -> wfm.ShiftConflictMode
+	9,  // 13: wfm.ImportAgentsWorkingScheduleShiftsResponse.errors:type_name -> wfm.ImportRowError
+	25, // 14: wfm.ImportAgentsWorkingScheduleShiftsResponse.items:type_name -> wfm.AgentWorkingSchedule
+	12, // 15: wfm.ImportAgentsWorkingScheduleShiftsResponse.created:type_name -> wfm.AgentScheduleDates
+	12, // 16: wfm.ImportAgentsWorkingScheduleShiftsResponse.replaced:type_name -> wfm.AgentScheduleDates
+	12, // 17: wfm.ImportAgentsWorkingScheduleShiftsResponse.skipped:type_name -> wfm.AgentScheduleDates
+	28, // 18: wfm.ExportAgentsWorkingScheduleRequest.date:type_name -> wfm.FilterBetween
+	1,  // 19: wfm.ExportAgentsWorkingScheduleRequest.format:type_name -> wfm.SpreadsheetFormat
+	0,  // 20: wfm.ExportAgentsWorkingScheduleRequest.layout:type_name -> wfm.ScheduleExportLayout
+	29, // 21: wfm.AgentScheduleDates.agent:type_name -> wfm.LookupEntity
+	22, // 22: wfm.UpdateAgentWorkingScheduleShiftRequest.item:type_name -> wfm.AgentScheduleShift
+	25, // 23: wfm.UpdateAgentWorkingScheduleShiftResponse.item:type_name -> wfm.AgentWorkingSchedule
+	28, // 24: wfm.DeleteAgentsWorkingScheduleShiftsRequest.date:type_name -> wfm.FilterBetween
+	28, // 25: wfm.SearchAgentsWorkingScheduleRequest.date:type_name -> wfm.FilterBetween
+	19, // 26: wfm.SearchAgentsWorkingScheduleResponse.holidays:type_name -> wfm.Holiday
+	25, // 27: wfm.SearchAgentsWorkingScheduleResponse.items:type_name -> wfm.AgentWorkingSchedule
+	29, // 28: wfm.AgentScheduleShiftPause.created_by:type_name -> wfm.LookupEntity
+	29, // 29: wfm.AgentScheduleShiftPause.updated_by:type_name -> wfm.LookupEntity
+	29, // 30: wfm.AgentScheduleShiftPause.cause:type_name -> wfm.LookupEntity
+	23, // 31: wfm.AgentScheduleShiftPause.local:type_name -> wfm.AgentScheduleLocalTime
+	29, // 32: wfm.AgentScheduleShiftSkill.skill:type_name -> wfm.LookupEntity
+	29, // 33: wfm.AgentScheduleShift.created_by:type_name -> wfm.LookupEntity
+	29, // 34: wfm.AgentScheduleShift.updated_by:type_name -> wfm.LookupEntity
+	20, // 35: wfm.AgentScheduleShift.pauses:type_name -> wfm.AgentScheduleShiftPause
+	21, // 36: wfm.AgentScheduleShift.skills:type_name -> wfm.AgentScheduleShiftSkill
+	23, // 37: wfm.AgentScheduleShift.local:type_name -> wfm.AgentScheduleLocalTime
+	29, // 38: wfm.AgentScheduleShift.roster_pattern:type_name -> wfm.LookupEntity
+	29, // 39: wfm.AgentSchedule.absence:type_name -> wfm.LookupEntity
+	22, // 40: wfm.AgentSchedule.shift:type_name -> wfm.AgentScheduleShift
+	29, // 41: wfm.AgentWorkingSchedule.agent:type_name -> wfm.LookupEntity
+	24, // 42: wfm.AgentWorkingSchedule.schedule:type_name -> wfm.AgentSchedule
+	22, // 43: wfm.CreateAgentsWorkingScheduleShiftsRequest.ItemsEntry.value:type_name -> wfm.AgentScheduleShift
+	4,  // 44: wfm.CreateAgentsWorkingScheduleShiftsRequest.SegmentsEntry.value:type_name -> wfm.AgentScheduleShifts
+	3,  // 45: wfm.AgentWorkingScheduleService.CreateAgentsWorkingScheduleShifts:input_type -> wfm.CreateAgentsWorkingScheduleShiftsRequest
+	7,  // 46: wfm.AgentWorkingScheduleService.ImportAgentsWorkingScheduleShifts:input_type -> wfm.ImportAgentsWorkingScheduleShiftsRequest
+	10, // 47: wfm.AgentWorkingScheduleService.ExportAgentsWorkingSchedule:input_type -> wfm.ExportAgentsWorkingScheduleRequest
+	17, // 48: wfm.AgentWorkingScheduleService.SearchAgentsWorkingSchedule:input_type -> wfm.SearchAgentsWorkingScheduleRequest
+	13, // 49: wfm.AgentWorkingScheduleService.UpdateAgentWorkingScheduleShift:input_type -> wfm.UpdateAgentWorkingScheduleShiftRequest
+	15, // 50: wfm.AgentWorkingScheduleService.DeleteAgentsWorkingScheduleShifts:input_type -> wfm.DeleteAgentsWorkingScheduleShiftsRequest
+	6,  // 51: wfm.AgentWorkingScheduleService.CreateAgentsWorkingScheduleShifts:output_type -> wfm.CreateAgentsWorkingScheduleShiftsResponse
+	8,  // 52: wfm.AgentWorkingScheduleService.ImportAgentsWorkingScheduleShifts:output_type -> wfm.ImportAgentsWorkingScheduleShiftsResponse
+	11, // 53: wfm.AgentWorkingScheduleService.ExportAgentsWorkingSchedule:output_type -> wfm.ExportAgentsWorkingScheduleResponse
+	18, // 54: wfm.AgentWorkingScheduleService.SearchAgentsWorkingSchedule:output_type -> wfm.SearchAgentsWorkingScheduleResponse
+	14, // 55: wfm.AgentWorkingScheduleService.UpdateAgentWorkingScheduleShift:output_type -> wfm.UpdateAgentWorkingScheduleShiftResponse
+	16, // 56: wfm.AgentWorkingScheduleService.DeleteAgentsWorkingScheduleShifts:output_type -> wfm.DeleteAgentsWorkingScheduleShiftsResponse
+	51, // [51:57] is the sub-list for method output_type
+	45, // [45:51] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_agent_working_schedule_proto_init() }
@@ -1063,7 +2636,7 @@ func file_agent_working_schedule_proto_init() {
 			}
 		}
 		file_agent_working_schedule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAgentsWorkingScheduleShiftsResponse); i {
+			switch v := v.(*AgentScheduleShifts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1075,7 +2648,7 @@ func file_agent_working_schedule_proto_init() {
 			}
 		}
 		file_agent_working_schedule_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchAgentsWorkingScheduleRequest); i {
+			switch v := v.(*PausePlacement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1087,7 +2660,7 @@ func file_agent_working_schedule_proto_init() {
 			}
 		}
 		file_agent_working_schedule_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchAgentsWorkingScheduleResponse); i {
+			switch v := v.(*CreateAgentsWorkingScheduleShiftsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1099,7 +2672,7 @@ func file_agent_working_schedule_proto_init() {
 			}
 		}
 		file_agent_working_schedule_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Holiday); i {
+			switch v := v.(*ImportAgentsWorkingScheduleShiftsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1111,7 +2684,7 @@ func file_agent_working_schedule_proto_init() {
 			}
 		}
 		file_agent_working_schedule_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentScheduleShiftPause); i {
+			switch v := v.(*ImportAgentsWorkingScheduleShiftsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1123,7 +2696,7 @@ func file_agent_working_schedule_proto_init() {
 			}
 		}
 		file_agent_working_schedule_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentScheduleShiftSkill); i {
+			switch v := v.(*ImportRowError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1135,7 +2708,7 @@ func file_agent_working_schedule_proto_init() {
 			}
 		}
 		file_agent_working_schedule_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentScheduleShift); i {
+			switch v := v.(*ExportAgentsWorkingScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1147,7 +2720,7 @@ func file_agent_working_schedule_proto_init() {
 			}
 		}
 		file_agent_working_schedule_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentSchedule); i {
+			switch v := v.(*ExportAgentsWorkingScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1159,6 +2732,162 @@ func file_agent_working_schedule_proto_init() {
 			}
 		}
 		file_agent_working_schedule_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentScheduleDates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAgentWorkingScheduleShiftRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAgentWorkingScheduleShiftResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAgentsWorkingScheduleShiftsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAgentsWorkingScheduleShiftsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAgentsWorkingScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchAgentsWorkingScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Holiday); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentScheduleShiftPause); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentScheduleShiftSkill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentScheduleShift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentScheduleLocalTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_agent_working_schedule_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AgentWorkingSchedule); i {
 			case 0:
 				return &v.state
@@ -1171,9 +2900,12 @@ func file_agent_working_schedule_proto_init() {
 			}
 		}
 	}
+	file_agent_working_schedule_proto_msgTypes[0].OneofWrappers = []interface{}{}
 	file_agent_working_schedule_proto_msgTypes[2].OneofWrappers = []interface{}{}
-	file_agent_working_schedule_proto_msgTypes[5].OneofWrappers = []interface{}{}
-	file_agent_working_schedule_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_agent_working_schedule_proto_msgTypes[7].OneofWrappers = []interface{}{}
+	file_agent_working_schedule_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_agent_working_schedule_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_agent_working_schedule_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*AgentSchedule_Absence)(nil),
 		(*AgentSchedule_Shift)(nil),
 	}
@@ -1182,13 +2914,14 @@ func file_agent_working_schedule_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_agent_working_schedule_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_agent_working_schedule_proto_goTypes,
 		DependencyIndexes: file_agent_working_schedule_proto_depIdxs,
+		EnumInfos:         file_agent_working_schedule_proto_enumTypes,
 		MessageInfos:      file_agent_working_schedule_proto_msgTypes,
 	}.Build()
 	File_agent_working_schedule_proto = out.File
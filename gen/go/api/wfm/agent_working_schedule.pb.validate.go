@@ -170,6 +170,99 @@ func (m *CreateAgentsWorkingScheduleShiftsRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Mode
+
+	{
+		sorted_keys := make([]int64, len(m.GetSegments()))
+		i := 0
+		for key := range m.GetSegments() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetSegments()[key]
+			_ = val
+
+			// no validation rules for Segments[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, CreateAgentsWorkingScheduleShiftsRequestValidationError{
+							field:  fmt.Sprintf("Segments[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, CreateAgentsWorkingScheduleShiftsRequestValidationError{
+							field:  fmt.Sprintf("Segments[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return CreateAgentsWorkingScheduleShiftsRequestValidationError{
+						field:  fmt.Sprintf("Segments[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if m.PlacePauses != nil {
+
+		if all {
+			switch v := interface{}(m.GetPlacePauses()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAgentsWorkingScheduleShiftsRequestValidationError{
+						field:  "PlacePauses",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAgentsWorkingScheduleShiftsRequestValidationError{
+						field:  "PlacePauses",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPlacePauses()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAgentsWorkingScheduleShiftsRequestValidationError{
+					field:  "PlacePauses",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ShiftTemplateId != nil {
+		// no validation rules for ShiftTemplateId
+	}
+
+	if m.AnchorDate != nil {
+		// no validation rules for AnchorDate
+	}
+
+	if m.RosterPatternId != nil {
+		// no validation rules for RosterPatternId
+	}
+
 	if len(errors) > 0 {
 		return CreateAgentsWorkingScheduleShiftsRequestMultiError(errors)
 	}
@@ -215,15 +308,1800 @@ func (e CreateAgentsWorkingScheduleShiftsRequestValidationError) Reason() string
 func (e CreateAgentsWorkingScheduleShiftsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAgentsWorkingScheduleShiftsRequestValidationError) Key() bool { return e.key }
+func (e CreateAgentsWorkingScheduleShiftsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAgentsWorkingScheduleShiftsRequestValidationError) ErrorName() string {
+	return "CreateAgentsWorkingScheduleShiftsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAgentsWorkingScheduleShiftsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAgentsWorkingScheduleShiftsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAgentsWorkingScheduleShiftsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAgentsWorkingScheduleShiftsRequestValidationError{}
+
+// Validate checks the field values on AgentScheduleShifts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AgentScheduleShifts) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgentScheduleShifts with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgentScheduleShiftsMultiError, or nil if none found.
+func (m *AgentScheduleShifts) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgentScheduleShifts) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AgentScheduleShiftsValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AgentScheduleShiftsValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AgentScheduleShiftsValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AgentScheduleShiftsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgentScheduleShiftsMultiError is an error wrapping multiple validation
+// errors returned by AgentScheduleShifts.ValidateAll() if the designated
+// constraints aren't met.
+type AgentScheduleShiftsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgentScheduleShiftsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgentScheduleShiftsMultiError) AllErrors() []error { return m }
+
+// AgentScheduleShiftsValidationError is the validation error returned by
+// AgentScheduleShifts.Validate if the designated constraints aren't met.
+type AgentScheduleShiftsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgentScheduleShiftsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgentScheduleShiftsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgentScheduleShiftsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgentScheduleShiftsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgentScheduleShiftsValidationError) ErrorName() string {
+	return "AgentScheduleShiftsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgentScheduleShiftsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgentScheduleShifts.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgentScheduleShiftsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgentScheduleShiftsValidationError{}
+
+// Validate checks the field values on PausePlacement with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PausePlacement) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PausePlacement with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PausePlacementMultiError,
+// or nil if none found.
+func (m *PausePlacement) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PausePlacement) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MinStartOffset
+
+	// no validation rules for MaxStartOffset
+
+	// no validation rules for MinEndOffset
+
+	// no validation rules for MaxEndOffset
+
+	if m.PauseTemplateId != nil {
+		// no validation rules for PauseTemplateId
+	}
+
+	if len(errors) > 0 {
+		return PausePlacementMultiError(errors)
+	}
+
+	return nil
+}
+
+// PausePlacementMultiError is an error wrapping multiple validation errors
+// returned by PausePlacement.ValidateAll() if the designated constraints
+// aren't met.
+type PausePlacementMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PausePlacementMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PausePlacementMultiError) AllErrors() []error { return m }
+
+// PausePlacementValidationError is the validation error returned by
+// PausePlacement.Validate if the designated constraints aren't met.
+type PausePlacementValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PausePlacementValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PausePlacementValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PausePlacementValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PausePlacementValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PausePlacementValidationError) ErrorName() string { return "PausePlacementValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PausePlacementValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPausePlacement.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PausePlacementValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PausePlacementValidationError{}
+
+// Validate checks the field values on
+// CreateAgentsWorkingScheduleShiftsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateAgentsWorkingScheduleShiftsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// CreateAgentsWorkingScheduleShiftsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// CreateAgentsWorkingScheduleShiftsResponseMultiError, or nil if none found.
+func (m *CreateAgentsWorkingScheduleShiftsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAgentsWorkingScheduleShiftsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAgentsWorkingScheduleShiftsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCreated() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAgentsWorkingScheduleShiftsResponseValidationError{
+					field:  fmt.Sprintf("Created[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetReplaced() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Replaced[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Replaced[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAgentsWorkingScheduleShiftsResponseValidationError{
+					field:  fmt.Sprintf("Replaced[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSkipped() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateAgentsWorkingScheduleShiftsResponseValidationError{
+					field:  fmt.Sprintf("Skipped[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateAgentsWorkingScheduleShiftsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAgentsWorkingScheduleShiftsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateAgentsWorkingScheduleShiftsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAgentsWorkingScheduleShiftsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAgentsWorkingScheduleShiftsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAgentsWorkingScheduleShiftsResponseMultiError) AllErrors() []error { return m }
+
+// CreateAgentsWorkingScheduleShiftsResponseValidationError is the validation
+// error returned by CreateAgentsWorkingScheduleShiftsResponse.Validate if the
+// designated constraints aren't met.
+type CreateAgentsWorkingScheduleShiftsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) ErrorName() string {
+	return "CreateAgentsWorkingScheduleShiftsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAgentsWorkingScheduleShiftsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAgentsWorkingScheduleShiftsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAgentsWorkingScheduleShiftsResponseValidationError{}
+
+// Validate checks the field values on ImportAgentsWorkingScheduleShiftsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *ImportAgentsWorkingScheduleShiftsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ImportAgentsWorkingScheduleShiftsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// ImportAgentsWorkingScheduleShiftsRequestMultiError, or nil if none found.
+func (m *ImportAgentsWorkingScheduleShiftsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportAgentsWorkingScheduleShiftsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkingScheduleId
+
+	// no validation rules for File
+
+	// no validation rules for Format
+
+	// no validation rules for DryRun
+
+	// no validation rules for Mode
+
+	if len(errors) > 0 {
+		return ImportAgentsWorkingScheduleShiftsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportAgentsWorkingScheduleShiftsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// ImportAgentsWorkingScheduleShiftsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportAgentsWorkingScheduleShiftsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportAgentsWorkingScheduleShiftsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportAgentsWorkingScheduleShiftsRequestMultiError) AllErrors() []error { return m }
+
+// ImportAgentsWorkingScheduleShiftsRequestValidationError is the validation
+// error returned by ImportAgentsWorkingScheduleShiftsRequest.Validate if the
+// designated constraints aren't met.
+type ImportAgentsWorkingScheduleShiftsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportAgentsWorkingScheduleShiftsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportAgentsWorkingScheduleShiftsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportAgentsWorkingScheduleShiftsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportAgentsWorkingScheduleShiftsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportAgentsWorkingScheduleShiftsRequestValidationError) ErrorName() string {
+	return "ImportAgentsWorkingScheduleShiftsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportAgentsWorkingScheduleShiftsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportAgentsWorkingScheduleShiftsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportAgentsWorkingScheduleShiftsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportAgentsWorkingScheduleShiftsRequestValidationError{}
+
+// Validate checks the field values on
+// ImportAgentsWorkingScheduleShiftsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportAgentsWorkingScheduleShiftsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// ImportAgentsWorkingScheduleShiftsResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// ImportAgentsWorkingScheduleShiftsResponseMultiError, or nil if none found.
+func (m *ImportAgentsWorkingScheduleShiftsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportAgentsWorkingScheduleShiftsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportAgentsWorkingScheduleShiftsResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Rows
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportAgentsWorkingScheduleShiftsResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetCreated() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportAgentsWorkingScheduleShiftsResponseValidationError{
+					field:  fmt.Sprintf("Created[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetReplaced() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Replaced[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Replaced[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportAgentsWorkingScheduleShiftsResponseValidationError{
+					field:  fmt.Sprintf("Replaced[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetSkipped() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportAgentsWorkingScheduleShiftsResponseValidationError{
+						field:  fmt.Sprintf("Skipped[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportAgentsWorkingScheduleShiftsResponseValidationError{
+					field:  fmt.Sprintf("Skipped[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportAgentsWorkingScheduleShiftsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportAgentsWorkingScheduleShiftsResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// ImportAgentsWorkingScheduleShiftsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ImportAgentsWorkingScheduleShiftsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportAgentsWorkingScheduleShiftsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportAgentsWorkingScheduleShiftsResponseMultiError) AllErrors() []error { return m }
+
+// ImportAgentsWorkingScheduleShiftsResponseValidationError is the validation
+// error returned by ImportAgentsWorkingScheduleShiftsResponse.Validate if the
+// designated constraints aren't met.
+type ImportAgentsWorkingScheduleShiftsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportAgentsWorkingScheduleShiftsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportAgentsWorkingScheduleShiftsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportAgentsWorkingScheduleShiftsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportAgentsWorkingScheduleShiftsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportAgentsWorkingScheduleShiftsResponseValidationError) ErrorName() string {
+	return "ImportAgentsWorkingScheduleShiftsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportAgentsWorkingScheduleShiftsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportAgentsWorkingScheduleShiftsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportAgentsWorkingScheduleShiftsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportAgentsWorkingScheduleShiftsResponseValidationError{}
+
+// Validate checks the field values on ImportRowError with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ImportRowError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRowError with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ImportRowErrorMultiError,
+// or nil if none found.
+func (m *ImportRowError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRowError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Row
+
+	// no validation rules for Column
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ImportRowErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRowErrorMultiError is an error wrapping multiple validation errors
+// returned by ImportRowError.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRowErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRowErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRowErrorMultiError) AllErrors() []error { return m }
+
+// ImportRowErrorValidationError is the validation error returned by
+// ImportRowError.Validate if the designated constraints aren't met.
+type ImportRowErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRowErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRowErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRowErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRowErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRowErrorValidationError) ErrorName() string { return "ImportRowErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRowErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRowError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRowErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRowErrorValidationError{}
+
+// Validate checks the field values on ExportAgentsWorkingScheduleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ExportAgentsWorkingScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportAgentsWorkingScheduleRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ExportAgentsWorkingScheduleRequestMultiError, or nil if none found.
+func (m *ExportAgentsWorkingScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportAgentsWorkingScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkingScheduleId
+
+	// no validation rules for Format
+
+	// no validation rules for Layout
+
+	if m.Date != nil {
+
+		if all {
+			switch v := interface{}(m.GetDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportAgentsWorkingScheduleRequestValidationError{
+						field:  "Date",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportAgentsWorkingScheduleRequestValidationError{
+						field:  "Date",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportAgentsWorkingScheduleRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportAgentsWorkingScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportAgentsWorkingScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ExportAgentsWorkingScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportAgentsWorkingScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportAgentsWorkingScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportAgentsWorkingScheduleRequestMultiError) AllErrors() []error { return m }
+
+// ExportAgentsWorkingScheduleRequestValidationError is the validation error
+// returned by ExportAgentsWorkingScheduleRequest.Validate if the designated
+// constraints aren't met.
+type ExportAgentsWorkingScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportAgentsWorkingScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportAgentsWorkingScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportAgentsWorkingScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportAgentsWorkingScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportAgentsWorkingScheduleRequestValidationError) ErrorName() string {
+	return "ExportAgentsWorkingScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportAgentsWorkingScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportAgentsWorkingScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportAgentsWorkingScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportAgentsWorkingScheduleRequestValidationError{}
+
+// Validate checks the field values on ExportAgentsWorkingScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ExportAgentsWorkingScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportAgentsWorkingScheduleResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ExportAgentsWorkingScheduleResponseMultiError, or nil if none found.
+func (m *ExportAgentsWorkingScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportAgentsWorkingScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for File
+
+	// no validation rules for FileName
+
+	// no validation rules for ContentType
+
+	if len(errors) > 0 {
+		return ExportAgentsWorkingScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportAgentsWorkingScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ExportAgentsWorkingScheduleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportAgentsWorkingScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportAgentsWorkingScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportAgentsWorkingScheduleResponseMultiError) AllErrors() []error { return m }
+
+// ExportAgentsWorkingScheduleResponseValidationError is the validation error
+// returned by ExportAgentsWorkingScheduleResponse.Validate if the designated
+// constraints aren't met.
+type ExportAgentsWorkingScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportAgentsWorkingScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportAgentsWorkingScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportAgentsWorkingScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportAgentsWorkingScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportAgentsWorkingScheduleResponseValidationError) ErrorName() string {
+	return "ExportAgentsWorkingScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportAgentsWorkingScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportAgentsWorkingScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportAgentsWorkingScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportAgentsWorkingScheduleResponseValidationError{}
+
+// Validate checks the field values on AgentScheduleDates with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AgentScheduleDates) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgentScheduleDates with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgentScheduleDatesMultiError, or nil if none found.
+func (m *AgentScheduleDates) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgentScheduleDates) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAgent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentScheduleDatesValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentScheduleDatesValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAgent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentScheduleDatesValidationError{
+				field:  "Agent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AgentScheduleDatesMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgentScheduleDatesMultiError is an error wrapping multiple validation errors
+// returned by AgentScheduleDates.ValidateAll() if the designated constraints
+// aren't met.
+type AgentScheduleDatesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgentScheduleDatesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgentScheduleDatesMultiError) AllErrors() []error { return m }
+
+// AgentScheduleDatesValidationError is the validation error returned by
+// AgentScheduleDates.Validate if the designated constraints aren't met.
+type AgentScheduleDatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgentScheduleDatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgentScheduleDatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgentScheduleDatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgentScheduleDatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgentScheduleDatesValidationError) ErrorName() string {
+	return "AgentScheduleDatesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgentScheduleDatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgentScheduleDates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgentScheduleDatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgentScheduleDatesValidationError{}
+
+// Validate checks the field values on UpdateAgentWorkingScheduleShiftRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateAgentWorkingScheduleShiftRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateAgentWorkingScheduleShiftRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// UpdateAgentWorkingScheduleShiftRequestMultiError, or nil if none found.
+func (m *UpdateAgentWorkingScheduleShiftRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAgentWorkingScheduleShiftRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkingScheduleId
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAgentWorkingScheduleShiftRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAgentWorkingScheduleShiftRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAgentWorkingScheduleShiftRequestValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAgentWorkingScheduleShiftRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAgentWorkingScheduleShiftRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateAgentWorkingScheduleShiftRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAgentWorkingScheduleShiftRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAgentWorkingScheduleShiftRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAgentWorkingScheduleShiftRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAgentWorkingScheduleShiftRequestValidationError is the validation
+// error returned by UpdateAgentWorkingScheduleShiftRequest.Validate if the
+// designated constraints aren't met.
+type UpdateAgentWorkingScheduleShiftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAgentWorkingScheduleShiftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAgentWorkingScheduleShiftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAgentWorkingScheduleShiftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAgentWorkingScheduleShiftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAgentWorkingScheduleShiftRequestValidationError) ErrorName() string {
+	return "UpdateAgentWorkingScheduleShiftRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAgentWorkingScheduleShiftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAgentWorkingScheduleShiftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAgentWorkingScheduleShiftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAgentWorkingScheduleShiftRequestValidationError{}
+
+// Validate checks the field values on UpdateAgentWorkingScheduleShiftResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *UpdateAgentWorkingScheduleShiftResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// UpdateAgentWorkingScheduleShiftResponse with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// UpdateAgentWorkingScheduleShiftResponseMultiError, or nil if none found.
+func (m *UpdateAgentWorkingScheduleShiftResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAgentWorkingScheduleShiftResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAgentWorkingScheduleShiftResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAgentWorkingScheduleShiftResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAgentWorkingScheduleShiftResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAgentWorkingScheduleShiftResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAgentWorkingScheduleShiftResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// UpdateAgentWorkingScheduleShiftResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAgentWorkingScheduleShiftResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAgentWorkingScheduleShiftResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAgentWorkingScheduleShiftResponseMultiError) AllErrors() []error { return m }
+
+// UpdateAgentWorkingScheduleShiftResponseValidationError is the validation
+// error returned by UpdateAgentWorkingScheduleShiftResponse.Validate if the
+// designated constraints aren't met.
+type UpdateAgentWorkingScheduleShiftResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAgentWorkingScheduleShiftResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAgentWorkingScheduleShiftResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAgentWorkingScheduleShiftResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAgentWorkingScheduleShiftResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAgentWorkingScheduleShiftResponseValidationError) ErrorName() string {
+	return "UpdateAgentWorkingScheduleShiftResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAgentWorkingScheduleShiftResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAgentWorkingScheduleShiftResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAgentWorkingScheduleShiftResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAgentWorkingScheduleShiftResponseValidationError{}
+
+// Validate checks the field values on DeleteAgentsWorkingScheduleShiftsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *DeleteAgentsWorkingScheduleShiftsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// DeleteAgentsWorkingScheduleShiftsRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// DeleteAgentsWorkingScheduleShiftsRequestMultiError, or nil if none found.
+func (m *DeleteAgentsWorkingScheduleShiftsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAgentsWorkingScheduleShiftsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkingScheduleId
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteAgentsWorkingScheduleShiftsRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteAgentsWorkingScheduleShiftsRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteAgentsWorkingScheduleShiftsRequestValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteAgentsWorkingScheduleShiftsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAgentsWorkingScheduleShiftsRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// DeleteAgentsWorkingScheduleShiftsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAgentsWorkingScheduleShiftsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAgentsWorkingScheduleShiftsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAgentsWorkingScheduleShiftsRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAgentsWorkingScheduleShiftsRequestValidationError is the validation
+// error returned by DeleteAgentsWorkingScheduleShiftsRequest.Validate if the
+// designated constraints aren't met.
+type DeleteAgentsWorkingScheduleShiftsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAgentsWorkingScheduleShiftsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAgentsWorkingScheduleShiftsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAgentsWorkingScheduleShiftsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAgentsWorkingScheduleShiftsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAgentsWorkingScheduleShiftsRequestValidationError) ErrorName() string {
-	return "CreateAgentsWorkingScheduleShiftsRequestValidationError"
+func (e DeleteAgentsWorkingScheduleShiftsRequestValidationError) ErrorName() string {
+	return "DeleteAgentsWorkingScheduleShiftsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAgentsWorkingScheduleShiftsRequestValidationError) Error() string {
+func (e DeleteAgentsWorkingScheduleShiftsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -235,14 +2113,14 @@ func (e CreateAgentsWorkingScheduleShiftsRequestValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAgentsWorkingScheduleShiftsRequest.%s: %s%s",
+		"invalid %sDeleteAgentsWorkingScheduleShiftsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAgentsWorkingScheduleShiftsRequestValidationError{}
+var _ error = DeleteAgentsWorkingScheduleShiftsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -250,81 +2128,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAgentsWorkingScheduleShiftsRequestValidationError{}
+} = DeleteAgentsWorkingScheduleShiftsRequestValidationError{}
 
 // Validate checks the field values on
-// CreateAgentsWorkingScheduleShiftsResponse with the rules defined in the
+// DeleteAgentsWorkingScheduleShiftsResponse with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *CreateAgentsWorkingScheduleShiftsResponse) Validate() error {
+func (m *DeleteAgentsWorkingScheduleShiftsResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// CreateAgentsWorkingScheduleShiftsResponse with the rules defined in the
+// DeleteAgentsWorkingScheduleShiftsResponse with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
 // a list of violation errors wrapped in
-// CreateAgentsWorkingScheduleShiftsResponseMultiError, or nil if none found.
-func (m *CreateAgentsWorkingScheduleShiftsResponse) ValidateAll() error {
+// DeleteAgentsWorkingScheduleShiftsResponseMultiError, or nil if none found.
+func (m *DeleteAgentsWorkingScheduleShiftsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateAgentsWorkingScheduleShiftsResponse) validate(all bool) error {
+func (m *DeleteAgentsWorkingScheduleShiftsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateAgentsWorkingScheduleShiftsResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateAgentsWorkingScheduleShiftsResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CreateAgentsWorkingScheduleShiftsResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return CreateAgentsWorkingScheduleShiftsResponseMultiError(errors)
+		return DeleteAgentsWorkingScheduleShiftsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateAgentsWorkingScheduleShiftsResponseMultiError is an error wrapping
+// DeleteAgentsWorkingScheduleShiftsResponseMultiError is an error wrapping
 // multiple validation errors returned by
-// CreateAgentsWorkingScheduleShiftsResponse.ValidateAll() if the designated
+// DeleteAgentsWorkingScheduleShiftsResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CreateAgentsWorkingScheduleShiftsResponseMultiError []error
+type DeleteAgentsWorkingScheduleShiftsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateAgentsWorkingScheduleShiftsResponseMultiError) Error() string {
+func (m DeleteAgentsWorkingScheduleShiftsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -333,12 +2177,12 @@ func (m CreateAgentsWorkingScheduleShiftsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateAgentsWorkingScheduleShiftsResponseMultiError) AllErrors() []error { return m }
+func (m DeleteAgentsWorkingScheduleShiftsResponseMultiError) AllErrors() []error { return m }
 
-// CreateAgentsWorkingScheduleShiftsResponseValidationError is the validation
-// error returned by CreateAgentsWorkingScheduleShiftsResponse.Validate if the
+// DeleteAgentsWorkingScheduleShiftsResponseValidationError is the validation
+// error returned by DeleteAgentsWorkingScheduleShiftsResponse.Validate if the
 // designated constraints aren't met.
-type CreateAgentsWorkingScheduleShiftsResponseValidationError struct {
+type DeleteAgentsWorkingScheduleShiftsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -346,24 +2190,24 @@ type CreateAgentsWorkingScheduleShiftsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Field() string { return e.field }
+func (e DeleteAgentsWorkingScheduleShiftsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Reason() string { return e.reason }
+func (e DeleteAgentsWorkingScheduleShiftsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Cause() error { return e.cause }
+func (e DeleteAgentsWorkingScheduleShiftsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Key() bool { return e.key }
+func (e DeleteAgentsWorkingScheduleShiftsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) ErrorName() string {
-	return "CreateAgentsWorkingScheduleShiftsResponseValidationError"
+func (e DeleteAgentsWorkingScheduleShiftsResponseValidationError) ErrorName() string {
+	return "DeleteAgentsWorkingScheduleShiftsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Error() string {
+func (e DeleteAgentsWorkingScheduleShiftsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -375,14 +2219,14 @@ func (e CreateAgentsWorkingScheduleShiftsResponseValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateAgentsWorkingScheduleShiftsResponse.%s: %s%s",
+		"invalid %sDeleteAgentsWorkingScheduleShiftsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateAgentsWorkingScheduleShiftsResponseValidationError{}
+var _ error = DeleteAgentsWorkingScheduleShiftsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -390,7 +2234,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateAgentsWorkingScheduleShiftsResponseValidationError{}
+} = DeleteAgentsWorkingScheduleShiftsResponseValidationError{}
 
 // Validate checks the field values on SearchAgentsWorkingScheduleRequest with
 // the rules defined in the proto definition for this message. If any rules
@@ -901,6 +2745,39 @@ func (m *AgentScheduleShiftPause) validate(all bool) error {
 
 	// no validation rules for End
 
+	// no validation rules for StartAt
+
+	// no validation rules for EndAt
+
+	if all {
+		switch v := interface{}(m.GetLocal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentScheduleShiftPauseValidationError{
+					field:  "Local",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentScheduleShiftPauseValidationError{
+					field:  "Local",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentScheduleShiftPauseValidationError{
+				field:  "Local",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.Cause != nil {
 
 		if all {
@@ -1309,6 +3186,68 @@ func (m *AgentScheduleShift) validate(all bool) error {
 
 	}
 
+	// no validation rules for StartAt
+
+	// no validation rules for EndAt
+
+	if all {
+		switch v := interface{}(m.GetLocal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentScheduleShiftValidationError{
+					field:  "Local",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentScheduleShiftValidationError{
+					field:  "Local",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentScheduleShiftValidationError{
+				field:  "Local",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRosterPattern()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentScheduleShiftValidationError{
+					field:  "RosterPattern",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentScheduleShiftValidationError{
+					field:  "RosterPattern",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRosterPattern()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentScheduleShiftValidationError{
+				field:  "RosterPattern",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return AgentScheduleShiftMultiError(errors)
 	}
@@ -1389,6 +3328,114 @@ var _ interface {
 	ErrorName() string
 } = AgentScheduleShiftValidationError{}
 
+// Validate checks the field values on AgentScheduleLocalTime with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AgentScheduleLocalTime) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgentScheduleLocalTime with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AgentScheduleLocalTimeMultiError, or nil if none found.
+func (m *AgentScheduleLocalTime) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgentScheduleLocalTime) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	if len(errors) > 0 {
+		return AgentScheduleLocalTimeMultiError(errors)
+	}
+
+	return nil
+}
+
+// AgentScheduleLocalTimeMultiError is an error wrapping multiple validation
+// errors returned by AgentScheduleLocalTime.ValidateAll() if the designated
+// constraints aren't met.
+type AgentScheduleLocalTimeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AgentScheduleLocalTimeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AgentScheduleLocalTimeMultiError) AllErrors() []error { return m }
+
+// AgentScheduleLocalTimeValidationError is the validation error returned by
+// AgentScheduleLocalTime.Validate if the designated constraints aren't met.
+type AgentScheduleLocalTimeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AgentScheduleLocalTimeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AgentScheduleLocalTimeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AgentScheduleLocalTimeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AgentScheduleLocalTimeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AgentScheduleLocalTimeValidationError) ErrorName() string {
+	return "AgentScheduleLocalTimeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AgentScheduleLocalTimeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAgentScheduleLocalTime.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AgentScheduleLocalTimeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AgentScheduleLocalTimeValidationError{}
+
 // Validate checks the field values on AgentSchedule with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1415,6 +3462,8 @@ func (m *AgentSchedule) validate(all bool) error {
 
 	// no validation rules for Locked
 
+	// no validation rules for Carryover
+
 	switch v := m.Type.(type) {
 	case *AgentSchedule_Absence:
 		if v == nil {
@@ -1427,7 +3476,36 @@ func (m *AgentSchedule) validate(all bool) error {
 			}
 			errors = append(errors, err)
 		}
-		// no validation rules for Absence
+
+		if all {
+			switch v := interface{}(m.GetAbsence()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AgentScheduleValidationError{
+						field:  "Absence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AgentScheduleValidationError{
+						field:  "Absence",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAbsence()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AgentScheduleValidationError{
+					field:  "Absence",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	case *AgentSchedule_Shift:
 		if v == nil {
 			err := AgentScheduleValidationError{
@@ -1473,6 +3551,14 @@ func (m *AgentSchedule) validate(all bool) error {
 		_ = v // ensures v is used
 	}
 
+	if m.AbsenceStart != nil {
+		// no validation rules for AbsenceStart
+	}
+
+	if m.AbsenceEnd != nil {
+		// no validation rules for AbsenceEnd
+	}
+
 	if len(errors) > 0 {
 		return AgentScheduleMultiError(errors)
 	}
@@ -1636,6 +3722,8 @@ func (m *AgentWorkingSchedule) validate(all bool) error {
 
 	}
 
+	// no validation rules for Timezone
+
 	if len(errors) > 0 {
 		return AgentWorkingScheduleMultiError(errors)
 	}
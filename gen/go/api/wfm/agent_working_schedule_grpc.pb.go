@@ -20,7 +20,11 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	AgentWorkingScheduleService_CreateAgentsWorkingScheduleShifts_FullMethodName = "/wfm.AgentWorkingScheduleService/CreateAgentsWorkingScheduleShifts"
+	AgentWorkingScheduleService_ImportAgentsWorkingScheduleShifts_FullMethodName = "/wfm.AgentWorkingScheduleService/ImportAgentsWorkingScheduleShifts"
+	AgentWorkingScheduleService_ExportAgentsWorkingSchedule_FullMethodName       = "/wfm.AgentWorkingScheduleService/ExportAgentsWorkingSchedule"
 	AgentWorkingScheduleService_SearchAgentsWorkingSchedule_FullMethodName       = "/wfm.AgentWorkingScheduleService/SearchAgentsWorkingSchedule"
+	AgentWorkingScheduleService_UpdateAgentWorkingScheduleShift_FullMethodName   = "/wfm.AgentWorkingScheduleService/UpdateAgentWorkingScheduleShift"
+	AgentWorkingScheduleService_DeleteAgentsWorkingScheduleShifts_FullMethodName = "/wfm.AgentWorkingScheduleService/DeleteAgentsWorkingScheduleShifts"
 )
 
 // AgentWorkingScheduleServiceClient is the client API for AgentWorkingScheduleService service.
@@ -28,7 +32,15 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AgentWorkingScheduleServiceClient interface {
 	CreateAgentsWorkingScheduleShifts(ctx context.Context, in *CreateAgentsWorkingScheduleShiftsRequest, opts ...grpc.CallOption) (*CreateAgentsWorkingScheduleShiftsResponse, error)
+	// Imports agent shifts from a CSV or XLSX roster.
+	ImportAgentsWorkingScheduleShifts(ctx context.Context, in *ImportAgentsWorkingScheduleShiftsRequest, opts ...grpc.CallOption) (*ImportAgentsWorkingScheduleShiftsResponse, error)
+	// Exports agent shifts to a CSV or XLSX file.
+	ExportAgentsWorkingSchedule(ctx context.Context, in *ExportAgentsWorkingScheduleRequest, opts ...grpc.CallOption) (*ExportAgentsWorkingScheduleResponse, error)
 	SearchAgentsWorkingSchedule(ctx context.Context, in *SearchAgentsWorkingScheduleRequest, opts ...grpc.CallOption) (*SearchAgentsWorkingScheduleResponse, error)
+	// Updates a single agent shift, including its pauses and skills.
+	UpdateAgentWorkingScheduleShift(ctx context.Context, in *UpdateAgentWorkingScheduleShiftRequest, opts ...grpc.CallOption) (*UpdateAgentWorkingScheduleShiftResponse, error)
+	// Deletes shifts of a desired set of agents within a date range.
+	DeleteAgentsWorkingScheduleShifts(ctx context.Context, in *DeleteAgentsWorkingScheduleShiftsRequest, opts ...grpc.CallOption) (*DeleteAgentsWorkingScheduleShiftsResponse, error)
 }
 
 type agentWorkingScheduleServiceClient struct {
@@ -48,6 +60,24 @@ func (c *agentWorkingScheduleServiceClient) CreateAgentsWorkingScheduleShifts(ct
 	return out, nil
 }
 
+func (c *agentWorkingScheduleServiceClient) ImportAgentsWorkingScheduleShifts(ctx context.Context, in *ImportAgentsWorkingScheduleShiftsRequest, opts ...grpc.CallOption) (*ImportAgentsWorkingScheduleShiftsResponse, error) {
+	out := new(ImportAgentsWorkingScheduleShiftsResponse)
+	err := c.cc.Invoke(ctx, AgentWorkingScheduleService_ImportAgentsWorkingScheduleShifts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentWorkingScheduleServiceClient) ExportAgentsWorkingSchedule(ctx context.Context, in *ExportAgentsWorkingScheduleRequest, opts ...grpc.CallOption) (*ExportAgentsWorkingScheduleResponse, error) {
+	out := new(ExportAgentsWorkingScheduleResponse)
+	err := c.cc.Invoke(ctx, AgentWorkingScheduleService_ExportAgentsWorkingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentWorkingScheduleServiceClient) SearchAgentsWorkingSchedule(ctx context.Context, in *SearchAgentsWorkingScheduleRequest, opts ...grpc.CallOption) (*SearchAgentsWorkingScheduleResponse, error) {
 	out := new(SearchAgentsWorkingScheduleResponse)
 	err := c.cc.Invoke(ctx, AgentWorkingScheduleService_SearchAgentsWorkingSchedule_FullMethodName, in, out, opts...)
@@ -57,12 +87,38 @@ func (c *agentWorkingScheduleServiceClient) SearchAgentsWorkingSchedule(ctx cont
 	return out, nil
 }
 
+func (c *agentWorkingScheduleServiceClient) UpdateAgentWorkingScheduleShift(ctx context.Context, in *UpdateAgentWorkingScheduleShiftRequest, opts ...grpc.CallOption) (*UpdateAgentWorkingScheduleShiftResponse, error) {
+	out := new(UpdateAgentWorkingScheduleShiftResponse)
+	err := c.cc.Invoke(ctx, AgentWorkingScheduleService_UpdateAgentWorkingScheduleShift_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentWorkingScheduleServiceClient) DeleteAgentsWorkingScheduleShifts(ctx context.Context, in *DeleteAgentsWorkingScheduleShiftsRequest, opts ...grpc.CallOption) (*DeleteAgentsWorkingScheduleShiftsResponse, error) {
+	out := new(DeleteAgentsWorkingScheduleShiftsResponse)
+	err := c.cc.Invoke(ctx, AgentWorkingScheduleService_DeleteAgentsWorkingScheduleShifts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentWorkingScheduleServiceServer is the server API for AgentWorkingScheduleService service.
 // All implementations must embed UnimplementedAgentWorkingScheduleServiceServer
 // for forward compatibility
 type AgentWorkingScheduleServiceServer interface {
 	CreateAgentsWorkingScheduleShifts(context.Context, *CreateAgentsWorkingScheduleShiftsRequest) (*CreateAgentsWorkingScheduleShiftsResponse, error)
+	// Imports agent shifts from a CSV or XLSX roster.
+	ImportAgentsWorkingScheduleShifts(context.Context, *ImportAgentsWorkingScheduleShiftsRequest) (*ImportAgentsWorkingScheduleShiftsResponse, error)
+	// Exports agent shifts to a CSV or XLSX file.
+	ExportAgentsWorkingSchedule(context.Context, *ExportAgentsWorkingScheduleRequest) (*ExportAgentsWorkingScheduleResponse, error)
 	SearchAgentsWorkingSchedule(context.Context, *SearchAgentsWorkingScheduleRequest) (*SearchAgentsWorkingScheduleResponse, error)
+	// Updates a single agent shift, including its pauses and skills.
+	UpdateAgentWorkingScheduleShift(context.Context, *UpdateAgentWorkingScheduleShiftRequest) (*UpdateAgentWorkingScheduleShiftResponse, error)
+	// Deletes shifts of a desired set of agents within a date range.
+	DeleteAgentsWorkingScheduleShifts(context.Context, *DeleteAgentsWorkingScheduleShiftsRequest) (*DeleteAgentsWorkingScheduleShiftsResponse, error)
 	mustEmbedUnimplementedAgentWorkingScheduleServiceServer()
 }
 
@@ -73,9 +129,21 @@ type UnimplementedAgentWorkingScheduleServiceServer struct {
 func (UnimplementedAgentWorkingScheduleServiceServer) CreateAgentsWorkingScheduleShifts(context.Context, *CreateAgentsWorkingScheduleShiftsRequest) (*CreateAgentsWorkingScheduleShiftsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAgentsWorkingScheduleShifts not implemented")
 }
+func (UnimplementedAgentWorkingScheduleServiceServer) ImportAgentsWorkingScheduleShifts(context.Context, *ImportAgentsWorkingScheduleShiftsRequest) (*ImportAgentsWorkingScheduleShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAgentsWorkingScheduleShifts not implemented")
+}
+func (UnimplementedAgentWorkingScheduleServiceServer) ExportAgentsWorkingSchedule(context.Context, *ExportAgentsWorkingScheduleRequest) (*ExportAgentsWorkingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAgentsWorkingSchedule not implemented")
+}
 func (UnimplementedAgentWorkingScheduleServiceServer) SearchAgentsWorkingSchedule(context.Context, *SearchAgentsWorkingScheduleRequest) (*SearchAgentsWorkingScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchAgentsWorkingSchedule not implemented")
 }
+func (UnimplementedAgentWorkingScheduleServiceServer) UpdateAgentWorkingScheduleShift(context.Context, *UpdateAgentWorkingScheduleShiftRequest) (*UpdateAgentWorkingScheduleShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentWorkingScheduleShift not implemented")
+}
+func (UnimplementedAgentWorkingScheduleServiceServer) DeleteAgentsWorkingScheduleShifts(context.Context, *DeleteAgentsWorkingScheduleShiftsRequest) (*DeleteAgentsWorkingScheduleShiftsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAgentsWorkingScheduleShifts not implemented")
+}
 func (UnimplementedAgentWorkingScheduleServiceServer) mustEmbedUnimplementedAgentWorkingScheduleServiceServer() {
 }
 
@@ -108,6 +176,42 @@ func _AgentWorkingScheduleService_CreateAgentsWorkingScheduleShifts_Handler(srv
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentWorkingScheduleService_ImportAgentsWorkingScheduleShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAgentsWorkingScheduleShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentWorkingScheduleServiceServer).ImportAgentsWorkingScheduleShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentWorkingScheduleService_ImportAgentsWorkingScheduleShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentWorkingScheduleServiceServer).ImportAgentsWorkingScheduleShifts(ctx, req.(*ImportAgentsWorkingScheduleShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentWorkingScheduleService_ExportAgentsWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAgentsWorkingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentWorkingScheduleServiceServer).ExportAgentsWorkingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentWorkingScheduleService_ExportAgentsWorkingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentWorkingScheduleServiceServer).ExportAgentsWorkingSchedule(ctx, req.(*ExportAgentsWorkingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AgentWorkingScheduleService_SearchAgentsWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchAgentsWorkingScheduleRequest)
 	if err := dec(in); err != nil {
@@ -126,6 +230,42 @@ func _AgentWorkingScheduleService_SearchAgentsWorkingSchedule_Handler(srv interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AgentWorkingScheduleService_UpdateAgentWorkingScheduleShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAgentWorkingScheduleShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentWorkingScheduleServiceServer).UpdateAgentWorkingScheduleShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentWorkingScheduleService_UpdateAgentWorkingScheduleShift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentWorkingScheduleServiceServer).UpdateAgentWorkingScheduleShift(ctx, req.(*UpdateAgentWorkingScheduleShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentWorkingScheduleService_DeleteAgentsWorkingScheduleShifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAgentsWorkingScheduleShiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentWorkingScheduleServiceServer).DeleteAgentsWorkingScheduleShifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AgentWorkingScheduleService_DeleteAgentsWorkingScheduleShifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentWorkingScheduleServiceServer).DeleteAgentsWorkingScheduleShifts(ctx, req.(*DeleteAgentsWorkingScheduleShiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AgentWorkingScheduleService_ServiceDesc is the grpc.ServiceDesc for AgentWorkingScheduleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -137,10 +277,26 @@ var AgentWorkingScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAgentsWorkingScheduleShifts",
 			Handler:    _AgentWorkingScheduleService_CreateAgentsWorkingScheduleShifts_Handler,
 		},
+		{
+			MethodName: "ImportAgentsWorkingScheduleShifts",
+			Handler:    _AgentWorkingScheduleService_ImportAgentsWorkingScheduleShifts_Handler,
+		},
+		{
+			MethodName: "ExportAgentsWorkingSchedule",
+			Handler:    _AgentWorkingScheduleService_ExportAgentsWorkingSchedule_Handler,
+		},
 		{
 			MethodName: "SearchAgentsWorkingSchedule",
 			Handler:    _AgentWorkingScheduleService_SearchAgentsWorkingSchedule_Handler,
 		},
+		{
+			MethodName: "UpdateAgentWorkingScheduleShift",
+			Handler:    _AgentWorkingScheduleService_UpdateAgentWorkingScheduleShift_Handler,
+		},
+		{
+			MethodName: "DeleteAgentsWorkingScheduleShifts",
+			Handler:    _AgentWorkingScheduleService_DeleteAgentsWorkingScheduleShifts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "agent_working_schedule.proto",
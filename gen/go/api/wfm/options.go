@@ -28,6 +28,67 @@ type HttpBinding struct {
 }
 
 var WebitelAPI = WebitelServicesInfo{
+	"AbsenceTypeService": WebitelServices{
+		ObjClass:           "wfm_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"CreateAbsenceType": WebitelMethod{
+				Access: 0,
+				Input:  "CreateAbsenceTypeRequest",
+				Output: "CreateAbsenceTypeResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/absence_types",
+						Method: "POST",
+					},
+				},
+			},
+			"ReadAbsenceType": WebitelMethod{
+				Access: 1,
+				Input:  "ReadAbsenceTypeRequest",
+				Output: "ReadAbsenceTypeResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/absence_types/{id}",
+						Method: "GET",
+					},
+				},
+			},
+			"SearchAbsenceType": WebitelMethod{
+				Access: 1,
+				Input:  "SearchAbsenceTypeRequest",
+				Output: "SearchAbsenceTypeResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/absence_types",
+						Method: "GET",
+					},
+				},
+			},
+			"UpdateAbsenceType": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateAbsenceTypeRequest",
+				Output: "UpdateAbsenceTypeResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/absence_types/{item.id}",
+						Method: "PUT",
+					},
+				},
+			},
+			"DeleteAbsenceType": WebitelMethod{
+				Access: 3,
+				Input:  "DeleteAbsenceTypeRequest",
+				Output: "DeleteAbsenceTypeResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/absence_types/{id}",
+						Method: "DELETE",
+					},
+				},
+			},
+		},
+	},
 	"AgentAbsenceService": WebitelServices{
 		ObjClass:           "agent_absences",
 		AdditionalLicenses: []string{},
@@ -65,6 +126,17 @@ var WebitelAPI = WebitelServicesInfo{
 					},
 				},
 			},
+			"ReadAgentAbsenceBalance": WebitelMethod{
+				Access: 1,
+				Input:  "ReadAgentAbsenceBalanceRequest",
+				Output: "ReadAgentAbsenceBalanceResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/agents/{agent_id}/absence_balances",
+						Method: "GET",
+					},
+				},
+			},
 			"UpdateAgentAbsence": WebitelMethod{
 				Access: 2,
 				Input:  "UpdateAgentAbsenceRequest",
@@ -111,60 +183,176 @@ var WebitelAPI = WebitelServicesInfo{
 			},
 		},
 	},
-	"AgentWorkingConditionsService": WebitelServices{
-		ObjClass:           "cc_agent",
+	"AgentWorkingScheduleService": WebitelServices{
+		ObjClass:           "working_schedules",
 		AdditionalLicenses: []string{},
 		WebitelMethods: map[string]WebitelMethod{
-			"ReadAgentWorkingConditions": WebitelMethod{
+			"CreateAgentsWorkingScheduleShifts": WebitelMethod{
+				Access: 0,
+				Input:  "CreateAgentsWorkingScheduleShiftsRequest",
+				Output: "CreateAgentsWorkingScheduleShiftsResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/agents/working_schedules/{working_schedule_id}",
+						Method: "POST",
+					},
+				},
+			},
+			"ImportAgentsWorkingScheduleShifts": WebitelMethod{
+				Access: 0,
+				Input:  "ImportAgentsWorkingScheduleShiftsRequest",
+				Output: "ImportAgentsWorkingScheduleShiftsResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/agents/working_schedules/{working_schedule_id}/import",
+						Method: "POST",
+					},
+				},
+			},
+			"ExportAgentsWorkingSchedule": WebitelMethod{
 				Access: 1,
-				Input:  "ReadAgentWorkingConditionsRequest",
-				Output: "ReadAgentWorkingConditionsResponse",
+				Input:  "ExportAgentsWorkingScheduleRequest",
+				Output: "ExportAgentsWorkingScheduleResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/wfm/agents/{agent_id}/conditions",
+						Path:   "/wfm/agents/working_schedules/{working_schedule_id}/export",
 						Method: "GET",
 					},
 				},
 			},
-			"UpdateAgentWorkingConditions": WebitelMethod{
+			"SearchAgentsWorkingSchedule": WebitelMethod{
+				Access: 1,
+				Input:  "SearchAgentsWorkingScheduleRequest",
+				Output: "SearchAgentsWorkingScheduleResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/agents/working_schedules/{working_schedule_id}",
+						Method: "GET",
+					},
+				},
+			},
+			"UpdateAgentWorkingScheduleShift": WebitelMethod{
 				Access: 2,
-				Input:  "UpdateAgentWorkingConditionsRequest",
-				Output: "UpdateAgentWorkingConditionsResponse",
+				Input:  "UpdateAgentWorkingScheduleShiftRequest",
+				Output: "UpdateAgentWorkingScheduleShiftResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/wfm/agents/{agent_id}/conditions",
+						Path:   "/wfm/agents/working_schedules/{working_schedule_id}/shifts/{item.id}",
 						Method: "PUT",
 					},
 				},
 			},
+			"DeleteAgentsWorkingScheduleShifts": WebitelMethod{
+				Access: 2,
+				Input:  "DeleteAgentsWorkingScheduleShiftsRequest",
+				Output: "DeleteAgentsWorkingScheduleShiftsResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/agents/working_schedules/{working_schedule_id}/shifts",
+						Method: "DELETE",
+					},
+				},
+			},
 		},
 	},
-	"AgentWorkingScheduleService": WebitelServices{
+	"AgentCalendarService": WebitelServices{
 		ObjClass:           "working_schedules",
 		AdditionalLicenses: []string{},
 		WebitelMethods: map[string]WebitelMethod{
-			"CreateAgentsWorkingScheduleShifts": WebitelMethod{
+			"ReadAgentCalendar": WebitelMethod{
+				Access: 1,
+				Input:  "ReadAgentCalendarRequest",
+				Output: "HttpBody",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/agents/{agent_id}/calendar",
+						Method: "GET",
+					},
+				},
+			},
+			"CreateAgentCalendarFeed": WebitelMethod{
 				Access: 0,
-				Input:  "CreateAgentsWorkingScheduleShiftsRequest",
-				Output: "CreateAgentsWorkingScheduleShiftsResponse",
+				Input:  "CreateAgentCalendarFeedRequest",
+				Output: "CreateAgentCalendarFeedResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/wfm/agents/working_schedules/{working_schedule_id}",
+						Path:   "/wfm/agents/{agent_id}/calendar/feed",
 						Method: "POST",
 					},
 				},
 			},
-			"SearchAgentsWorkingSchedule": WebitelMethod{
+			"ReadAgentCalendarFeed": WebitelMethod{
 				Access: 1,
-				Input:  "SearchAgentsWorkingScheduleRequest",
-				Output: "SearchAgentsWorkingScheduleResponse",
+				Input:  "ReadAgentCalendarFeedRequest",
+				Output: "ReadAgentCalendarFeedResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/wfm/agents/working_schedules/{working_schedule_id}",
+						Path:   "/wfm/agents/{agent_id}/calendar/feed",
+						Method: "GET",
+					},
+				},
+			},
+			"DeleteAgentCalendarFeed": WebitelMethod{
+				Access: 3,
+				Input:  "DeleteAgentCalendarFeedRequest",
+				Output: "DeleteAgentCalendarFeedResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/agents/{agent_id}/calendar/feed",
+						Method: "DELETE",
+					},
+				},
+			},
+			"ReadMySchedule": WebitelMethod{
+				Access: 1,
+				Input:  "ReadMyScheduleRequest",
+				Output: "ReadMyScheduleResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/my/schedule",
+						Method: "GET",
+					},
+				},
+			},
+			"SubscribeAgentCalendarFeed": WebitelMethod{
+				Access: 1,
+				Input:  "SubscribeAgentCalendarFeedRequest",
+				Output: "HttpBody",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/calendar_feeds/{token}",
+						Method: "GET",
+					},
+				},
+			},
+		},
+	},
+	"AgentWorkingConditionsService": WebitelServices{
+		ObjClass:           "cc_agent",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"ReadAgentWorkingConditions": WebitelMethod{
+				Access: 1,
+				Input:  "ReadAgentWorkingConditionsRequest",
+				Output: "ReadAgentWorkingConditionsResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/agents/{agent_id}/conditions",
 						Method: "GET",
 					},
 				},
 			},
+			"UpdateAgentWorkingConditions": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateAgentWorkingConditionsRequest",
+				Output: "UpdateAgentWorkingConditionsResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/agents/{agent_id}/conditions",
+						Method: "PUT",
+					},
+				},
+			},
 		},
 	},
 	"ForecastCalculationService": WebitelServices{
@@ -300,122 +488,61 @@ var WebitelAPI = WebitelServicesInfo{
 			},
 		},
 	},
-	"ShiftTemplateService": WebitelServices{
-		ObjClass:           "wfm_lookups",
-		AdditionalLicenses: []string{},
-		WebitelMethods: map[string]WebitelMethod{
-			"CreateShiftTemplate": WebitelMethod{
-				Access: 0,
-				Input:  "CreateShiftTemplateRequest",
-				Output: "CreateShiftTemplateResponse",
-				HttpBindings: []*HttpBinding{
-					{
-						Path:   "/wfm/lookups/shift_templates",
-						Method: "POST",
-					},
-				},
-			},
-			"ReadShiftTemplate": WebitelMethod{
-				Access: 1,
-				Input:  "ReadShiftTemplateRequest",
-				Output: "ReadShiftTemplateResponse",
-				HttpBindings: []*HttpBinding{
-					{
-						Path:   "/wfm/lookups/shift_templates/{id}",
-						Method: "GET",
-					},
-				},
-			},
-			"SearchShiftTemplate": WebitelMethod{
-				Access: 1,
-				Input:  "SearchShiftTemplateRequest",
-				Output: "SearchShiftTemplateResponse",
-				HttpBindings: []*HttpBinding{
-					{
-						Path:   "/wfm/lookups/shift_templates",
-						Method: "GET",
-					},
-				},
-			},
-			"UpdateShiftTemplate": WebitelMethod{
-				Access: 2,
-				Input:  "UpdateShiftTemplateRequest",
-				Output: "UpdateShiftTemplateResponse",
-				HttpBindings: []*HttpBinding{
-					{
-						Path:   "/wfm/lookups/shift_templates/{item.id}",
-						Method: "PUT",
-					},
-				},
-			},
-			"DeleteShiftTemplate": WebitelMethod{
-				Access: 3,
-				Input:  "DeleteShiftTemplateRequest",
-				Output: "DeleteShiftTemplateResponse",
-				HttpBindings: []*HttpBinding{
-					{
-						Path:   "/wfm/lookups/shift_templates/{id}",
-						Method: "DELETE",
-					},
-				},
-			},
-		},
-	},
-	"WorkingConditionService": WebitelServices{
+	"RosterPatternService": WebitelServices{
 		ObjClass:           "wfm_lookups",
 		AdditionalLicenses: []string{},
 		WebitelMethods: map[string]WebitelMethod{
-			"CreateWorkingCondition": WebitelMethod{
+			"CreateRosterPattern": WebitelMethod{
 				Access: 0,
-				Input:  "CreateWorkingConditionRequest",
-				Output: "CreateWorkingConditionResponse",
+				Input:  "CreateRosterPatternRequest",
+				Output: "CreateRosterPatternResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/wfm/lookups/working_conditions",
+						Path:   "/wfm/lookups/roster_patterns",
 						Method: "POST",
 					},
 				},
 			},
-			"ReadWorkingCondition": WebitelMethod{
+			"ReadRosterPattern": WebitelMethod{
 				Access: 1,
-				Input:  "ReadWorkingConditionRequest",
-				Output: "ReadWorkingConditionResponse",
+				Input:  "ReadRosterPatternRequest",
+				Output: "ReadRosterPatternResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/wfm/lookups/working_conditions/{id}",
+						Path:   "/wfm/lookups/roster_patterns/{id}",
 						Method: "GET",
 					},
 				},
 			},
-			"SearchWorkingCondition": WebitelMethod{
+			"SearchRosterPattern": WebitelMethod{
 				Access: 1,
-				Input:  "SearchWorkingConditionRequest",
-				Output: "SearchWorkingConditionResponse",
+				Input:  "SearchRosterPatternRequest",
+				Output: "SearchRosterPatternResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/wfm/lookups/working_conditions",
+						Path:   "/wfm/lookups/roster_patterns",
 						Method: "GET",
 					},
 				},
 			},
-			"UpdateWorkingCondition": WebitelMethod{
+			"UpdateRosterPattern": WebitelMethod{
 				Access: 2,
-				Input:  "UpdateWorkingConditionRequest",
-				Output: "UpdateWorkingConditionResponse",
+				Input:  "UpdateRosterPatternRequest",
+				Output: "UpdateRosterPatternResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/wfm/lookups/working_conditions/{item.id}",
+						Path:   "/wfm/lookups/roster_patterns/{item.id}",
 						Method: "PUT",
 					},
 				},
 			},
-			"DeleteWorkingCondition": WebitelMethod{
+			"DeleteRosterPattern": WebitelMethod{
 				Access: 3,
-				Input:  "DeleteWorkingConditionRequest",
-				Output: "DeleteWorkingConditionResponse",
+				Input:  "DeleteRosterPatternRequest",
+				Output: "DeleteRosterPatternResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/wfm/lookups/working_conditions/{id}",
+						Path:   "/wfm/lookups/roster_patterns/{id}",
 						Method: "DELETE",
 					},
 				},
@@ -459,6 +586,17 @@ var WebitelAPI = WebitelServicesInfo{
 					},
 				},
 			},
+			"ReadWorkingScheduleCoverage": WebitelMethod{
+				Access: 1,
+				Input:  "ReadWorkingScheduleCoverageRequest",
+				Output: "ReadWorkingScheduleCoverageResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{id}/coverage",
+						Method: "GET",
+					},
+				},
+			},
 			"SearchWorkingSchedule": WebitelMethod{
 				Access: 1,
 				Input:  "SearchWorkingScheduleRequest",
@@ -503,14 +641,440 @@ var WebitelAPI = WebitelServicesInfo{
 					},
 				},
 			},
-			"DeleteWorkingSchedule": WebitelMethod{
-				Access: 3,
-				Input:  "DeleteWorkingScheduleRequest",
-				Output: "DeleteWorkingScheduleResponse",
+			"SubmitWorkingSchedule": WebitelMethod{
+				Access: 2,
+				Input:  "SubmitWorkingScheduleRequest",
+				Output: "SubmitWorkingScheduleResponse",
 				HttpBindings: []*HttpBinding{
 					{
-						Path:   "/wfm/lookups/working_schedules/{id}",
-						Method: "DELETE",
+						Path:   "/wfm/lookups/working_schedules/{id}/submit",
+						Method: "POST",
+					},
+				},
+			},
+			"ApproveWorkingSchedule": WebitelMethod{
+				Access: 2,
+				Input:  "ApproveWorkingScheduleRequest",
+				Output: "ApproveWorkingScheduleResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{id}/approve",
+						Method: "POST",
+					},
+				},
+			},
+			"RejectWorkingSchedule": WebitelMethod{
+				Access: 2,
+				Input:  "RejectWorkingScheduleRequest",
+				Output: "RejectWorkingScheduleResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{id}/reject",
+						Method: "POST",
+					},
+				},
+			},
+			"ArchiveWorkingSchedule": WebitelMethod{
+				Access: 2,
+				Input:  "ArchiveWorkingScheduleRequest",
+				Output: "ArchiveWorkingScheduleResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{id}/archive",
+						Method: "POST",
+					},
+				},
+			},
+			"GenerateWorkingSchedule": WebitelMethod{
+				Access: 2,
+				Input:  "GenerateWorkingScheduleRequest",
+				Output: "GenerateWorkingScheduleResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{id}/generate",
+						Method: "POST",
+					},
+				},
+			},
+			"PlaceWorkingSchedulePauses": WebitelMethod{
+				Access: 2,
+				Input:  "PlaceWorkingSchedulePausesRequest",
+				Output: "PlaceWorkingSchedulePausesResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{id}/pauses",
+						Method: "POST",
+					},
+				},
+			},
+			"CloneWorkingSchedule": WebitelMethod{
+				Access: 0,
+				Input:  "CloneWorkingScheduleRequest",
+				Output: "CloneWorkingScheduleResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{id}/clone",
+						Method: "POST",
+					},
+				},
+			},
+			"ValidateWorkingSchedule": WebitelMethod{
+				Access: 1,
+				Input:  "ValidateWorkingScheduleRequest",
+				Output: "ValidateWorkingScheduleResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{id}/violations",
+						Method: "GET",
+					},
+				},
+			},
+			"DeleteWorkingSchedule": WebitelMethod{
+				Access: 3,
+				Input:  "DeleteWorkingScheduleRequest",
+				Output: "DeleteWorkingScheduleResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{id}",
+						Method: "DELETE",
+					},
+				},
+			},
+		},
+	},
+	"ShiftSwapService": WebitelServices{
+		ObjClass:           "working_schedules",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"CreateShiftSwap": WebitelMethod{
+				Access: 0,
+				Input:  "CreateShiftSwapRequest",
+				Output: "CreateShiftSwapResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/shift_swaps",
+						Method: "POST",
+					},
+				},
+			},
+			"ReadShiftSwap": WebitelMethod{
+				Access: 1,
+				Input:  "ReadShiftSwapRequest",
+				Output: "ReadShiftSwapResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/shift_swaps/{id}",
+						Method: "GET",
+					},
+				},
+			},
+			"SearchShiftSwap": WebitelMethod{
+				Access: 1,
+				Input:  "SearchShiftSwapRequest",
+				Output: "SearchShiftSwapResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/shift_swaps",
+						Method: "GET",
+					},
+				},
+			},
+			"AcceptShiftSwap": WebitelMethod{
+				Access: 2,
+				Input:  "AcceptShiftSwapRequest",
+				Output: "AcceptShiftSwapResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/shift_swaps/{id}/accept",
+						Method: "POST",
+					},
+				},
+			},
+			"ValidateShiftSwap": WebitelMethod{
+				Access: 1,
+				Input:  "ValidateShiftSwapRequest",
+				Output: "ValidateShiftSwapResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/shift_swaps/{id}/violations",
+						Method: "GET",
+					},
+				},
+			},
+			"ApproveShiftSwap": WebitelMethod{
+				Access: 2,
+				Input:  "ApproveShiftSwapRequest",
+				Output: "ApproveShiftSwapResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/shift_swaps/{id}/approve",
+						Method: "POST",
+					},
+				},
+			},
+			"RejectShiftSwap": WebitelMethod{
+				Access: 2,
+				Input:  "RejectShiftSwapRequest",
+				Output: "RejectShiftSwapResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/shift_swaps/{id}/reject",
+						Method: "POST",
+					},
+				},
+			},
+			"CancelShiftSwap": WebitelMethod{
+				Access: 2,
+				Input:  "CancelShiftSwapRequest",
+				Output: "CancelShiftSwapResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/shift_swaps/{id}/cancel",
+						Method: "POST",
+					},
+				},
+			},
+		},
+	},
+	"ShiftTemplateService": WebitelServices{
+		ObjClass:           "wfm_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"CreateShiftTemplate": WebitelMethod{
+				Access: 0,
+				Input:  "CreateShiftTemplateRequest",
+				Output: "CreateShiftTemplateResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/shift_templates",
+						Method: "POST",
+					},
+				},
+			},
+			"ReadShiftTemplate": WebitelMethod{
+				Access: 1,
+				Input:  "ReadShiftTemplateRequest",
+				Output: "ReadShiftTemplateResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/shift_templates/{id}",
+						Method: "GET",
+					},
+				},
+			},
+			"SearchShiftTemplate": WebitelMethod{
+				Access: 1,
+				Input:  "SearchShiftTemplateRequest",
+				Output: "SearchShiftTemplateResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/shift_templates",
+						Method: "GET",
+					},
+				},
+			},
+			"UpdateShiftTemplate": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateShiftTemplateRequest",
+				Output: "UpdateShiftTemplateResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/shift_templates/{item.id}",
+						Method: "PUT",
+					},
+				},
+			},
+			"DeleteShiftTemplate": WebitelMethod{
+				Access: 3,
+				Input:  "DeleteShiftTemplateRequest",
+				Output: "DeleteShiftTemplateResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/shift_templates/{id}",
+						Method: "DELETE",
+					},
+				},
+			},
+		},
+	},
+	"TimeOffRequestService": WebitelServices{
+		ObjClass:           "agent_absences",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"CreateTimeOffRequest": WebitelMethod{
+				Access: 0,
+				Input:  "CreateTimeOffRequestRequest",
+				Output: "CreateTimeOffRequestResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/time_off_requests",
+						Method: "POST",
+					},
+				},
+			},
+			"ReadTimeOffRequest": WebitelMethod{
+				Access: 1,
+				Input:  "ReadTimeOffRequestRequest",
+				Output: "ReadTimeOffRequestResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/time_off_requests/{id}",
+						Method: "GET",
+					},
+				},
+			},
+			"SearchTimeOffRequest": WebitelMethod{
+				Access: 1,
+				Input:  "SearchTimeOffRequestRequest",
+				Output: "SearchTimeOffRequestResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/time_off_requests",
+						Method: "GET",
+					},
+				},
+			},
+			"ApproveTimeOffRequest": WebitelMethod{
+				Access: 2,
+				Input:  "ApproveTimeOffRequestRequest",
+				Output: "ApproveTimeOffRequestResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/time_off_requests/{id}/approve",
+						Method: "POST",
+					},
+				},
+			},
+			"RejectTimeOffRequest": WebitelMethod{
+				Access: 2,
+				Input:  "RejectTimeOffRequestRequest",
+				Output: "RejectTimeOffRequestResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/time_off_requests/{id}/reject",
+						Method: "POST",
+					},
+				},
+			},
+			"CancelTimeOffRequest": WebitelMethod{
+				Access: 2,
+				Input:  "CancelTimeOffRequestRequest",
+				Output: "CancelTimeOffRequestResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/time_off_requests/{id}/cancel",
+						Method: "POST",
+					},
+				},
+			},
+		},
+	},
+	"WorkingConditionService": WebitelServices{
+		ObjClass:           "wfm_lookups",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"CreateWorkingCondition": WebitelMethod{
+				Access: 0,
+				Input:  "CreateWorkingConditionRequest",
+				Output: "CreateWorkingConditionResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_conditions",
+						Method: "POST",
+					},
+				},
+			},
+			"ReadWorkingCondition": WebitelMethod{
+				Access: 1,
+				Input:  "ReadWorkingConditionRequest",
+				Output: "ReadWorkingConditionResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_conditions/{id}",
+						Method: "GET",
+					},
+				},
+			},
+			"SearchWorkingCondition": WebitelMethod{
+				Access: 1,
+				Input:  "SearchWorkingConditionRequest",
+				Output: "SearchWorkingConditionResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_conditions",
+						Method: "GET",
+					},
+				},
+			},
+			"UpdateWorkingCondition": WebitelMethod{
+				Access: 2,
+				Input:  "UpdateWorkingConditionRequest",
+				Output: "UpdateWorkingConditionResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_conditions/{item.id}",
+						Method: "PUT",
+					},
+				},
+			},
+			"DeleteWorkingCondition": WebitelMethod{
+				Access: 3,
+				Input:  "DeleteWorkingConditionRequest",
+				Output: "DeleteWorkingConditionResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_conditions/{id}",
+						Method: "DELETE",
+					},
+				},
+			},
+		},
+	},
+	"WorkingScheduleSnapshotService": WebitelServices{
+		ObjClass:           "working_schedules",
+		AdditionalLicenses: []string{},
+		WebitelMethods: map[string]WebitelMethod{
+			"CreateWorkingScheduleSnapshot": WebitelMethod{
+				Access: 2,
+				Input:  "CreateWorkingScheduleSnapshotRequest",
+				Output: "CreateWorkingScheduleSnapshotResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{working_schedule_id}/snapshots",
+						Method: "POST",
+					},
+				},
+			},
+			"ReadWorkingScheduleSnapshot": WebitelMethod{
+				Access: 1,
+				Input:  "ReadWorkingScheduleSnapshotRequest",
+				Output: "ReadWorkingScheduleSnapshotResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{working_schedule_id}/snapshots/{id}",
+						Method: "GET",
+					},
+				},
+			},
+			"SearchWorkingScheduleSnapshot": WebitelMethod{
+				Access: 1,
+				Input:  "SearchWorkingScheduleSnapshotRequest",
+				Output: "SearchWorkingScheduleSnapshotResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{working_schedule_id}/snapshots",
+						Method: "GET",
+					},
+				},
+			},
+			"DiffWorkingScheduleSnapshot": WebitelMethod{
+				Access: 1,
+				Input:  "DiffWorkingScheduleSnapshotRequest",
+				Output: "DiffWorkingScheduleSnapshotResponse",
+				HttpBindings: []*HttpBinding{
+					{
+						Path:   "/wfm/lookups/working_schedules/{working_schedule_id}/snapshots/{id}/diff",
+						Method: "GET",
 					},
 				},
 			},
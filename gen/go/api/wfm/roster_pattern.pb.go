@@ -0,0 +1,1100 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: roster_pattern.proto
+
+package wfm
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateRosterPatternRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *RosterPattern `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CreateRosterPatternRequest) Reset() {
+	*x = CreateRosterPatternRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRosterPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRosterPatternRequest) ProtoMessage() {}
+
+func (x *CreateRosterPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRosterPatternRequest.ProtoReflect.Descriptor instead.
+func (*CreateRosterPatternRequest) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateRosterPatternRequest) GetItem() *RosterPattern {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type CreateRosterPatternResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *RosterPattern `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CreateRosterPatternResponse) Reset() {
+	*x = CreateRosterPatternResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRosterPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRosterPatternResponse) ProtoMessage() {}
+
+func (x *CreateRosterPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRosterPatternResponse.ProtoReflect.Descriptor instead.
+func (*CreateRosterPatternResponse) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRosterPatternResponse) GetItem() *RosterPattern {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ReadRosterPatternRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *ReadRosterPatternRequest) Reset() {
+	*x = ReadRosterPatternRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadRosterPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRosterPatternRequest) ProtoMessage() {}
+
+func (x *ReadRosterPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRosterPatternRequest.ProtoReflect.Descriptor instead.
+func (*ReadRosterPatternRequest) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadRosterPatternRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReadRosterPatternRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type ReadRosterPatternResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *RosterPattern `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ReadRosterPatternResponse) Reset() {
+	*x = ReadRosterPatternResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadRosterPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadRosterPatternResponse) ProtoMessage() {}
+
+func (x *ReadRosterPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadRosterPatternResponse.ProtoReflect.Descriptor instead.
+func (*ReadRosterPatternResponse) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReadRosterPatternResponse) GetItem() *RosterPattern {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type SearchRosterPatternRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Q      *string  `protobuf:"bytes,1,opt,name=q,proto3,oneof" json:"q,omitempty"`
+	Page   *int32   `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Size   *int32   `protobuf:"varint,3,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	Sort   *string  `protobuf:"bytes,4,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	Fields []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Patterns assigned to the team.
+	TeamId *int64 `protobuf:"varint,6,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
+	// Patterns assigned to the agent directly or by the team of the agent.
+	AgentId *int64 `protobuf:"varint,7,opt,name=agent_id,json=agentId,proto3,oneof" json:"agent_id,omitempty"`
+}
+
+func (x *SearchRosterPatternRequest) Reset() {
+	*x = SearchRosterPatternRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRosterPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRosterPatternRequest) ProtoMessage() {}
+
+func (x *SearchRosterPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRosterPatternRequest.ProtoReflect.Descriptor instead.
+func (*SearchRosterPatternRequest) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchRosterPatternRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *SearchRosterPatternRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *SearchRosterPatternRequest) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *SearchRosterPatternRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+func (x *SearchRosterPatternRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SearchRosterPatternRequest) GetTeamId() int64 {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return 0
+}
+
+func (x *SearchRosterPatternRequest) GetAgentId() int64 {
+	if x != nil && x.AgentId != nil {
+		return *x.AgentId
+	}
+	return 0
+}
+
+type SearchRosterPatternResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*RosterPattern `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next  bool             `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *SearchRosterPatternResponse) Reset() {
+	*x = SearchRosterPatternResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchRosterPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRosterPatternResponse) ProtoMessage() {}
+
+func (x *SearchRosterPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRosterPatternResponse.ProtoReflect.Descriptor instead.
+func (*SearchRosterPatternResponse) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchRosterPatternResponse) GetItems() []*RosterPattern {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchRosterPatternResponse) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+type UpdateRosterPatternRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *RosterPattern `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *UpdateRosterPatternRequest) Reset() {
+	*x = UpdateRosterPatternRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRosterPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRosterPatternRequest) ProtoMessage() {}
+
+func (x *UpdateRosterPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRosterPatternRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRosterPatternRequest) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateRosterPatternRequest) GetItem() *RosterPattern {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type UpdateRosterPatternResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *RosterPattern `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *UpdateRosterPatternResponse) Reset() {
+	*x = UpdateRosterPatternResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRosterPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRosterPatternResponse) ProtoMessage() {}
+
+func (x *UpdateRosterPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRosterPatternResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRosterPatternResponse) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateRosterPatternResponse) GetItem() *RosterPattern {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type DeleteRosterPatternRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteRosterPatternRequest) Reset() {
+	*x = DeleteRosterPatternRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRosterPatternRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRosterPatternRequest) ProtoMessage() {}
+
+func (x *DeleteRosterPatternRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRosterPatternRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRosterPatternRequest) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteRosterPatternRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteRosterPatternResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteRosterPatternResponse) Reset() {
+	*x = DeleteRosterPatternResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRosterPatternResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRosterPatternResponse) ProtoMessage() {}
+
+func (x *DeleteRosterPatternResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRosterPatternResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRosterPatternResponse) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteRosterPatternResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// RosterPattern is a named weekly set of shifts, which can be applied to any working schedule.
+// Shifts created from the pattern keep a reference to it.
+type RosterPattern struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId    int64         `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	CreatedAt   int64         `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy   *LookupEntity `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt   int64         `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy   *LookupEntity `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Name        string        `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Description *string       `protobuf:"bytes,8,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Agents of the team get the pattern, when it is applied without explicit agents.
+	Team *LookupEntity `protobuf:"bytes,9,opt,name=team,proto3" json:"team,omitempty"`
+	// Agents, that get the pattern, when it is applied without explicit agents.
+	Agents []*LookupEntity `protobuf:"bytes,10,rep,name=agents,proto3" json:"agents,omitempty"`
+	// Shifts with pauses and skills, split shifts have multiple segments per day.
+	// Map key is a day of week: 0 - Sunday, ..., 6 - Saturday.
+	Shifts map[int64]*AgentScheduleShifts `protobuf:"bytes,11,rep,name=shifts,proto3" json:"shifts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *RosterPattern) Reset() {
+	*x = RosterPattern{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_roster_pattern_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RosterPattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RosterPattern) ProtoMessage() {}
+
+func (x *RosterPattern) ProtoReflect() protoreflect.Message {
+	mi := &file_roster_pattern_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RosterPattern.ProtoReflect.Descriptor instead.
+func (*RosterPattern) Descriptor() ([]byte, []int) {
+	return file_roster_pattern_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RosterPattern) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RosterPattern) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *RosterPattern) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RosterPattern) GetCreatedBy() *LookupEntity {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *RosterPattern) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *RosterPattern) GetUpdatedBy() *LookupEntity {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *RosterPattern) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RosterPattern) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *RosterPattern) GetTeam() *LookupEntity {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+func (x *RosterPattern) GetAgents() []*LookupEntity {
+	if x != nil {
+		return x.Agents
+	}
+	return nil
+}
+
+func (x *RosterPattern) GetShifts() map[int64]*AgentScheduleShifts {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+var File_roster_pattern_proto protoreflect.FileDescriptor
+
+var file_roster_pattern_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x77, 0x66, 0x6d, 0x1a, 0x1b, 0x62, 0x75, 0x66,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1c, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4c,
+	0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x45, 0x0a, 0x1b,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x22, 0xc7, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x8e, 0x01, 0x0a,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x76, 0xba,
+	0x48, 0x73, 0x92, 0x01, 0x70, 0x18, 0x01, 0x22, 0x6c, 0x72, 0x6a, 0x10, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x52, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x04, 0x74, 0x65, 0x61, 0x6d, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x06, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x43, 0x0a,
+	0x19, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x22, 0xb3, 0x03, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfa, 0x01, 0x48, 0x00, 0x52, 0x01, 0x71, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x8e, 0x01,
+	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0x76,
+	0xba, 0x48, 0x73, 0x92, 0x01, 0x70, 0x18, 0x01, 0x22, 0x6c, 0x72, 0x6a, 0x10, 0x01, 0x52, 0x02,
+	0x69, 0x64, 0x52, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x06,
+	0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x25,
+	0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x04, 0x52, 0x06, 0x74, 0x65, 0x61, 0x6d,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x48, 0x05, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x04,
+	0x0a, 0x02, 0x5f, 0x71, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x1b, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x4c, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x22, 0x45, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x38, 0x0a, 0x1a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x2d, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xe4, 0x05, 0x0a, 0x0d, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfa,
+	0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x36, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05,
+	0x10, 0xe8, 0x07, 0x18, 0x01, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x4f, 0x0a,
+	0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x17, 0xba,
+	0x48, 0x14, 0x9a, 0x01, 0x11, 0x08, 0x01, 0x10, 0x07, 0x22, 0x06, 0x22, 0x04, 0x18, 0x06, 0x28,
+	0x00, 0x2a, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x1a, 0x53,
+	0x0a, 0x0b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x3a, 0xa4, 0x01, 0xba, 0x48, 0xa0, 0x01, 0x1a, 0x9d, 0x01, 0x0a, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x73, 0x12, 0x2b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x73, 0x20, 0x73, 0x68,
+	0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x69, 0x72, 0x20, 0x73, 0x68, 0x69, 0x66, 0x74, 0x20, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x1a, 0x66, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x2e,
+	0x61, 0x6c, 0x6c, 0x28, 0x64, 0x2c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x73, 0x5b, 0x64, 0x5d, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x61, 0x6c, 0x6c, 0x28,
+	0x73, 0x2c, 0x20, 0x73, 0x2e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x73, 0x2e, 0x61, 0x6c, 0x6c, 0x28,
+	0x70, 0x2c, 0x20, 0x70, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x3e, 0x3d, 0x20, 0x73, 0x2e,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x26, 0x26, 0x20, 0x70, 0x2e, 0x65, 0x6e, 0x64, 0x20, 0x3c,
+	0x3d, 0x20, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x29, 0x29, 0x29, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xd4, 0x05, 0x0a, 0x14, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1f, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b,
+	0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f,
+	0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x12, 0x81, 0x01, 0x0a, 0x11,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x12, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2d, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x77,
+	0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12,
+	0x82, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1f, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x90, 0xb5, 0x18, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x73, 0x2f, 0x72, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1f, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72,
+	0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x35, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x1a, 0x26,
+	0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x72, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x74,
+	0x65, 0x6d, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0x87, 0x01, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x1f,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74, 0x65,
+	0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2d, 0x90, 0xb5, 0x18, 0x03, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x2a, 0x21, 0x2f,
+	0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x72, 0x6f, 0x73, 0x74,
+	0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
+	0x1a, 0x0f, 0x8a, 0xb5, 0x18, 0x0b, 0x77, 0x66, 0x6d, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x73, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77,
+	0x66, 0x6d, 0x3b, 0x77, 0x66, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_roster_pattern_proto_rawDescOnce sync.Once
+	file_roster_pattern_proto_rawDescData = file_roster_pattern_proto_rawDesc
+)
+
+func file_roster_pattern_proto_rawDescGZIP() []byte {
+	file_roster_pattern_proto_rawDescOnce.Do(func() {
+		file_roster_pattern_proto_rawDescData = protoimpl.X.CompressGZIP(file_roster_pattern_proto_rawDescData)
+	})
+	return file_roster_pattern_proto_rawDescData
+}
+
+var file_roster_pattern_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_roster_pattern_proto_goTypes = []interface{}{
+	(*CreateRosterPatternRequest)(nil),  // 0: wfm.CreateRosterPatternRequest
+	(*CreateRosterPatternResponse)(nil), // 1: wfm.CreateRosterPatternResponse
+	(*ReadRosterPatternRequest)(nil),    // 2: wfm.ReadRosterPatternRequest
+	(*ReadRosterPatternResponse)(nil),   // 3: wfm.ReadRosterPatternResponse
+	(*SearchRosterPatternRequest)(nil),  // 4: wfm.SearchRosterPatternRequest
+	(*SearchRosterPatternResponse)(nil), // 5: wfm.SearchRosterPatternResponse
+	(*UpdateRosterPatternRequest)(nil),  // 6: wfm.UpdateRosterPatternRequest
+	(*UpdateRosterPatternResponse)(nil), // 7: wfm.UpdateRosterPatternResponse
+	(*DeleteRosterPatternRequest)(nil),  // 8: wfm.DeleteRosterPatternRequest
+	(*DeleteRosterPatternResponse)(nil), // 9: wfm.DeleteRosterPatternResponse
+	(*RosterPattern)(nil),               // 10: wfm.RosterPattern
+	nil,                                 // 11: wfm.RosterPattern.ShiftsEntry
+	(*LookupEntity)(nil),                // 12: wfm.LookupEntity
+	(*AgentScheduleShifts)(nil),         // 13: wfm.AgentScheduleShifts
+}
+var file_roster_pattern_proto_depIdxs = []int32{
+	10, // 0: wfm.CreateRosterPatternRequest.item:type_name -> wfm.RosterPattern
+	10, // 1: wfm.CreateRosterPatternResponse.item:type_name -> wfm.RosterPattern
+	10, // 2: wfm.ReadRosterPatternResponse.item:type_name -> wfm.RosterPattern
+	10, // 3: wfm.SearchRosterPatternResponse.items:type_name -> wfm.RosterPattern
+	10, // 4: wfm.UpdateRosterPatternRequest.item:type_name -> wfm.RosterPattern
+	10, // 5: wfm.UpdateRosterPatternResponse.item:type_name -> wfm.RosterPattern
+	12, // 6: wfm.RosterPattern.created_by:type_name -> wfm.LookupEntity
+	12, // 7: wfm.RosterPattern.updated_by:type_name -> wfm.LookupEntity
+	12, // 8: wfm.RosterPattern.team:type_name -> wfm.LookupEntity
+	12, // 9: wfm.RosterPattern.agents:type_name -> wfm.LookupEntity
+	11, // 10: wfm.RosterPattern.shifts:type_name -> wfm.RosterPattern.ShiftsEntry
+	13, // 11: wfm.RosterPattern.ShiftsEntry.value:type_name -> wfm.AgentScheduleShifts
+	0,  // 12: wfm.RosterPatternService.CreateRosterPattern:input_type -> wfm.CreateRosterPatternRequest
+	2,  // 13: wfm.RosterPatternService.ReadRosterPattern:input_type -> wfm.ReadRosterPatternRequest
+	4,  // 14: wfm.RosterPatternService.SearchRosterPattern:input_type -> wfm.SearchRosterPatternRequest
+	6,  // 15: wfm.RosterPatternService.UpdateRosterPattern:input_type -> wfm.UpdateRosterPatternRequest
+	8,  // 16: wfm.RosterPatternService.DeleteRosterPattern:input_type -> wfm.DeleteRosterPatternRequest
+	1,  // 17: wfm.RosterPatternService.CreateRosterPattern:output_type -> wfm.CreateRosterPatternResponse
+	3,  // 18: wfm.RosterPatternService.ReadRosterPattern:output_type -> wfm.ReadRosterPatternResponse
+	5,  // 19: wfm.RosterPatternService.SearchRosterPattern:output_type -> wfm.SearchRosterPatternResponse
+	7,  // 20: wfm.RosterPatternService.UpdateRosterPattern:output_type -> wfm.UpdateRosterPatternResponse
+	9,  // 21: wfm.RosterPatternService.DeleteRosterPattern:output_type -> wfm.DeleteRosterPatternResponse
+	17, // [17:22] is the sub-list for method output_type
+	12, // [12:17] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_roster_pattern_proto_init() }
+func file_roster_pattern_proto_init() {
+	if File_roster_pattern_proto != nil {
+		return
+	}
+	file_lookup_proto_init()
+	file_agent_working_schedule_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_roster_pattern_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRosterPatternRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roster_pattern_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRosterPatternResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roster_pattern_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRosterPatternRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roster_pattern_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRosterPatternResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roster_pattern_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRosterPatternRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roster_pattern_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRosterPatternResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roster_pattern_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRosterPatternRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roster_pattern_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRosterPatternResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roster_pattern_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRosterPatternRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roster_pattern_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRosterPatternResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_roster_pattern_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RosterPattern); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_roster_pattern_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_roster_pattern_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_roster_pattern_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_roster_pattern_proto_goTypes,
+		DependencyIndexes: file_roster_pattern_proto_depIdxs,
+		MessageInfos:      file_roster_pattern_proto_msgTypes,
+	}.Build()
+	File_roster_pattern_proto = out.File
+	file_roster_pattern_proto_rawDesc = nil
+	file_roster_pattern_proto_goTypes = nil
+	file_roster_pattern_proto_depIdxs = nil
+}
@@ -0,0 +1,1552 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: roster_pattern.proto
+
+package wfm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateRosterPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRosterPatternRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRosterPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRosterPatternRequestMultiError, or nil if none found.
+func (m *CreateRosterPatternRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRosterPatternRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRosterPatternRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRosterPatternRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRosterPatternRequestValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRosterPatternRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRosterPatternRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateRosterPatternRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateRosterPatternRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRosterPatternRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRosterPatternRequestMultiError) AllErrors() []error { return m }
+
+// CreateRosterPatternRequestValidationError is the validation error returned
+// by CreateRosterPatternRequest.Validate if the designated constraints aren't met.
+type CreateRosterPatternRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRosterPatternRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRosterPatternRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRosterPatternRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRosterPatternRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRosterPatternRequestValidationError) ErrorName() string {
+	return "CreateRosterPatternRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRosterPatternRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRosterPatternRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRosterPatternRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRosterPatternRequestValidationError{}
+
+// Validate checks the field values on CreateRosterPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateRosterPatternResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRosterPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateRosterPatternResponseMultiError, or nil if none found.
+func (m *CreateRosterPatternResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRosterPatternResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateRosterPatternResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateRosterPatternResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateRosterPatternResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateRosterPatternResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateRosterPatternResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateRosterPatternResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateRosterPatternResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRosterPatternResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRosterPatternResponseMultiError) AllErrors() []error { return m }
+
+// CreateRosterPatternResponseValidationError is the validation error returned
+// by CreateRosterPatternResponse.Validate if the designated constraints
+// aren't met.
+type CreateRosterPatternResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRosterPatternResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRosterPatternResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRosterPatternResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRosterPatternResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRosterPatternResponseValidationError) ErrorName() string {
+	return "CreateRosterPatternResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateRosterPatternResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRosterPatternResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRosterPatternResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRosterPatternResponseValidationError{}
+
+// Validate checks the field values on ReadRosterPatternRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadRosterPatternRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadRosterPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadRosterPatternRequestMultiError, or nil if none found.
+func (m *ReadRosterPatternRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadRosterPatternRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ReadRosterPatternRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadRosterPatternRequestMultiError is an error wrapping multiple validation
+// errors returned by ReadRosterPatternRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReadRosterPatternRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadRosterPatternRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadRosterPatternRequestMultiError) AllErrors() []error { return m }
+
+// ReadRosterPatternRequestValidationError is the validation error returned by
+// ReadRosterPatternRequest.Validate if the designated constraints aren't met.
+type ReadRosterPatternRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadRosterPatternRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadRosterPatternRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadRosterPatternRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadRosterPatternRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadRosterPatternRequestValidationError) ErrorName() string {
+	return "ReadRosterPatternRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadRosterPatternRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadRosterPatternRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadRosterPatternRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadRosterPatternRequestValidationError{}
+
+// Validate checks the field values on ReadRosterPatternResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadRosterPatternResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadRosterPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadRosterPatternResponseMultiError, or nil if none found.
+func (m *ReadRosterPatternResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadRosterPatternResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadRosterPatternResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadRosterPatternResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadRosterPatternResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReadRosterPatternResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadRosterPatternResponseMultiError is an error wrapping multiple validation
+// errors returned by ReadRosterPatternResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReadRosterPatternResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadRosterPatternResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadRosterPatternResponseMultiError) AllErrors() []error { return m }
+
+// ReadRosterPatternResponseValidationError is the validation error returned by
+// ReadRosterPatternResponse.Validate if the designated constraints aren't met.
+type ReadRosterPatternResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadRosterPatternResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadRosterPatternResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadRosterPatternResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadRosterPatternResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadRosterPatternResponseValidationError) ErrorName() string {
+	return "ReadRosterPatternResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadRosterPatternResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadRosterPatternResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadRosterPatternResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadRosterPatternResponseValidationError{}
+
+// Validate checks the field values on SearchRosterPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchRosterPatternRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchRosterPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchRosterPatternRequestMultiError, or nil if none found.
+func (m *SearchRosterPatternRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchRosterPatternRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Q != nil {
+		// no validation rules for Q
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.Size != nil {
+		// no validation rules for Size
+	}
+
+	if m.Sort != nil {
+		// no validation rules for Sort
+	}
+
+	if m.TeamId != nil {
+		// no validation rules for TeamId
+	}
+
+	if m.AgentId != nil {
+		// no validation rules for AgentId
+	}
+
+	if len(errors) > 0 {
+		return SearchRosterPatternRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchRosterPatternRequestMultiError is an error wrapping multiple
+// validation errors returned by SearchRosterPatternRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SearchRosterPatternRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchRosterPatternRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchRosterPatternRequestMultiError) AllErrors() []error { return m }
+
+// SearchRosterPatternRequestValidationError is the validation error returned
+// by SearchRosterPatternRequest.Validate if the designated constraints aren't met.
+type SearchRosterPatternRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchRosterPatternRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchRosterPatternRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchRosterPatternRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchRosterPatternRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchRosterPatternRequestValidationError) ErrorName() string {
+	return "SearchRosterPatternRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchRosterPatternRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchRosterPatternRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchRosterPatternRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchRosterPatternRequestValidationError{}
+
+// Validate checks the field values on SearchRosterPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchRosterPatternResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchRosterPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchRosterPatternResponseMultiError, or nil if none found.
+func (m *SearchRosterPatternResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchRosterPatternResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchRosterPatternResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchRosterPatternResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchRosterPatternResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Next
+
+	if len(errors) > 0 {
+		return SearchRosterPatternResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchRosterPatternResponseMultiError is an error wrapping multiple
+// validation errors returned by SearchRosterPatternResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SearchRosterPatternResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchRosterPatternResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchRosterPatternResponseMultiError) AllErrors() []error { return m }
+
+// SearchRosterPatternResponseValidationError is the validation error returned
+// by SearchRosterPatternResponse.Validate if the designated constraints
+// aren't met.
+type SearchRosterPatternResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchRosterPatternResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchRosterPatternResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchRosterPatternResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchRosterPatternResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchRosterPatternResponseValidationError) ErrorName() string {
+	return "SearchRosterPatternResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchRosterPatternResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchRosterPatternResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchRosterPatternResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchRosterPatternResponseValidationError{}
+
+// Validate checks the field values on UpdateRosterPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRosterPatternRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRosterPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRosterPatternRequestMultiError, or nil if none found.
+func (m *UpdateRosterPatternRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRosterPatternRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRosterPatternRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRosterPatternRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRosterPatternRequestValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRosterPatternRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRosterPatternRequestMultiError is an error wrapping multiple
+// validation errors returned by UpdateRosterPatternRequest.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateRosterPatternRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRosterPatternRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRosterPatternRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRosterPatternRequestValidationError is the validation error returned
+// by UpdateRosterPatternRequest.Validate if the designated constraints aren't met.
+type UpdateRosterPatternRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRosterPatternRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRosterPatternRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRosterPatternRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRosterPatternRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRosterPatternRequestValidationError) ErrorName() string {
+	return "UpdateRosterPatternRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRosterPatternRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRosterPatternRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRosterPatternRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRosterPatternRequestValidationError{}
+
+// Validate checks the field values on UpdateRosterPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRosterPatternResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRosterPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRosterPatternResponseMultiError, or nil if none found.
+func (m *UpdateRosterPatternResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRosterPatternResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRosterPatternResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRosterPatternResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRosterPatternResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRosterPatternResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRosterPatternResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateRosterPatternResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateRosterPatternResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRosterPatternResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRosterPatternResponseMultiError) AllErrors() []error { return m }
+
+// UpdateRosterPatternResponseValidationError is the validation error returned
+// by UpdateRosterPatternResponse.Validate if the designated constraints
+// aren't met.
+type UpdateRosterPatternResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRosterPatternResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRosterPatternResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRosterPatternResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRosterPatternResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRosterPatternResponseValidationError) ErrorName() string {
+	return "UpdateRosterPatternResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRosterPatternResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRosterPatternResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRosterPatternResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRosterPatternResponseValidationError{}
+
+// Validate checks the field values on DeleteRosterPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRosterPatternRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRosterPatternRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRosterPatternRequestMultiError, or nil if none found.
+func (m *DeleteRosterPatternRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRosterPatternRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteRosterPatternRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRosterPatternRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteRosterPatternRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteRosterPatternRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRosterPatternRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRosterPatternRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRosterPatternRequestValidationError is the validation error returned
+// by DeleteRosterPatternRequest.Validate if the designated constraints aren't met.
+type DeleteRosterPatternRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRosterPatternRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRosterPatternRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRosterPatternRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRosterPatternRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRosterPatternRequestValidationError) ErrorName() string {
+	return "DeleteRosterPatternRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRosterPatternRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRosterPatternRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRosterPatternRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRosterPatternRequestValidationError{}
+
+// Validate checks the field values on DeleteRosterPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteRosterPatternResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRosterPatternResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteRosterPatternResponseMultiError, or nil if none found.
+func (m *DeleteRosterPatternResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRosterPatternResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteRosterPatternResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteRosterPatternResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteRosterPatternResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteRosterPatternResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRosterPatternResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRosterPatternResponseMultiError) AllErrors() []error { return m }
+
+// DeleteRosterPatternResponseValidationError is the validation error returned
+// by DeleteRosterPatternResponse.Validate if the designated constraints
+// aren't met.
+type DeleteRosterPatternResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRosterPatternResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRosterPatternResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRosterPatternResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRosterPatternResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRosterPatternResponseValidationError) ErrorName() string {
+	return "DeleteRosterPatternResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRosterPatternResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRosterPatternResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRosterPatternResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRosterPatternResponseValidationError{}
+
+// Validate checks the field values on RosterPattern with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RosterPattern) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RosterPattern with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RosterPatternMultiError, or
+// nil if none found.
+func (m *RosterPattern) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RosterPattern) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DomainId
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetCreatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RosterPatternValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RosterPatternValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RosterPatternValidationError{
+				field:  "CreatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedAt
+
+	if all {
+		switch v := interface{}(m.GetUpdatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RosterPatternValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RosterPatternValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RosterPatternValidationError{
+				field:  "UpdatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetTeam()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RosterPatternValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RosterPatternValidationError{
+					field:  "Team",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTeam()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RosterPatternValidationError{
+				field:  "Team",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAgents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RosterPatternValidationError{
+						field:  fmt.Sprintf("Agents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RosterPatternValidationError{
+						field:  fmt.Sprintf("Agents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RosterPatternValidationError{
+					field:  fmt.Sprintf("Agents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	{
+		sorted_keys := make([]int64, len(m.GetShifts()))
+		i := 0
+		for key := range m.GetShifts() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetShifts()[key]
+			_ = val
+
+			// no validation rules for Shifts[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, RosterPatternValidationError{
+							field:  fmt.Sprintf("Shifts[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, RosterPatternValidationError{
+							field:  fmt.Sprintf("Shifts[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return RosterPatternValidationError{
+						field:  fmt.Sprintf("Shifts[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if m.Description != nil {
+		// no validation rules for Description
+	}
+
+	if len(errors) > 0 {
+		return RosterPatternMultiError(errors)
+	}
+
+	return nil
+}
+
+// RosterPatternMultiError is an error wrapping multiple validation errors
+// returned by RosterPattern.ValidateAll() if the designated constraints
+// aren't met.
+type RosterPatternMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RosterPatternMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RosterPatternMultiError) AllErrors() []error { return m }
+
+// RosterPatternValidationError is the validation error returned by
+// RosterPattern.Validate if the designated constraints aren't met.
+type RosterPatternValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RosterPatternValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RosterPatternValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RosterPatternValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RosterPatternValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RosterPatternValidationError) ErrorName() string { return "RosterPatternValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RosterPatternValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRosterPattern.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RosterPatternValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RosterPatternValidationError{}
@@ -0,0 +1,257 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: roster_pattern.proto
+
+package wfm
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RosterPatternService_CreateRosterPattern_FullMethodName = "/wfm.RosterPatternService/CreateRosterPattern"
+	RosterPatternService_ReadRosterPattern_FullMethodName   = "/wfm.RosterPatternService/ReadRosterPattern"
+	RosterPatternService_SearchRosterPattern_FullMethodName = "/wfm.RosterPatternService/SearchRosterPattern"
+	RosterPatternService_UpdateRosterPattern_FullMethodName = "/wfm.RosterPatternService/UpdateRosterPattern"
+	RosterPatternService_DeleteRosterPattern_FullMethodName = "/wfm.RosterPatternService/DeleteRosterPattern"
+)
+
+// RosterPatternServiceClient is the client API for RosterPatternService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RosterPatternServiceClient interface {
+	CreateRosterPattern(ctx context.Context, in *CreateRosterPatternRequest, opts ...grpc.CallOption) (*CreateRosterPatternResponse, error)
+	ReadRosterPattern(ctx context.Context, in *ReadRosterPatternRequest, opts ...grpc.CallOption) (*ReadRosterPatternResponse, error)
+	SearchRosterPattern(ctx context.Context, in *SearchRosterPatternRequest, opts ...grpc.CallOption) (*SearchRosterPatternResponse, error)
+	UpdateRosterPattern(ctx context.Context, in *UpdateRosterPatternRequest, opts ...grpc.CallOption) (*UpdateRosterPatternResponse, error)
+	DeleteRosterPattern(ctx context.Context, in *DeleteRosterPatternRequest, opts ...grpc.CallOption) (*DeleteRosterPatternResponse, error)
+}
+
+type rosterPatternServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRosterPatternServiceClient(cc grpc.ClientConnInterface) RosterPatternServiceClient {
+	return &rosterPatternServiceClient{cc}
+}
+
+func (c *rosterPatternServiceClient) CreateRosterPattern(ctx context.Context, in *CreateRosterPatternRequest, opts ...grpc.CallOption) (*CreateRosterPatternResponse, error) {
+	out := new(CreateRosterPatternResponse)
+	err := c.cc.Invoke(ctx, RosterPatternService_CreateRosterPattern_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rosterPatternServiceClient) ReadRosterPattern(ctx context.Context, in *ReadRosterPatternRequest, opts ...grpc.CallOption) (*ReadRosterPatternResponse, error) {
+	out := new(ReadRosterPatternResponse)
+	err := c.cc.Invoke(ctx, RosterPatternService_ReadRosterPattern_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rosterPatternServiceClient) SearchRosterPattern(ctx context.Context, in *SearchRosterPatternRequest, opts ...grpc.CallOption) (*SearchRosterPatternResponse, error) {
+	out := new(SearchRosterPatternResponse)
+	err := c.cc.Invoke(ctx, RosterPatternService_SearchRosterPattern_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rosterPatternServiceClient) UpdateRosterPattern(ctx context.Context, in *UpdateRosterPatternRequest, opts ...grpc.CallOption) (*UpdateRosterPatternResponse, error) {
+	out := new(UpdateRosterPatternResponse)
+	err := c.cc.Invoke(ctx, RosterPatternService_UpdateRosterPattern_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rosterPatternServiceClient) DeleteRosterPattern(ctx context.Context, in *DeleteRosterPatternRequest, opts ...grpc.CallOption) (*DeleteRosterPatternResponse, error) {
+	out := new(DeleteRosterPatternResponse)
+	err := c.cc.Invoke(ctx, RosterPatternService_DeleteRosterPattern_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RosterPatternServiceServer is the server API for RosterPatternService service.
+// All implementations must embed UnimplementedRosterPatternServiceServer
+// for forward compatibility
+type RosterPatternServiceServer interface {
+	CreateRosterPattern(context.Context, *CreateRosterPatternRequest) (*CreateRosterPatternResponse, error)
+	ReadRosterPattern(context.Context, *ReadRosterPatternRequest) (*ReadRosterPatternResponse, error)
+	SearchRosterPattern(context.Context, *SearchRosterPatternRequest) (*SearchRosterPatternResponse, error)
+	UpdateRosterPattern(context.Context, *UpdateRosterPatternRequest) (*UpdateRosterPatternResponse, error)
+	DeleteRosterPattern(context.Context, *DeleteRosterPatternRequest) (*DeleteRosterPatternResponse, error)
+	mustEmbedUnimplementedRosterPatternServiceServer()
+}
+
+// UnimplementedRosterPatternServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRosterPatternServiceServer struct {
+}
+
+func (UnimplementedRosterPatternServiceServer) CreateRosterPattern(context.Context, *CreateRosterPatternRequest) (*CreateRosterPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRosterPattern not implemented")
+}
+func (UnimplementedRosterPatternServiceServer) ReadRosterPattern(context.Context, *ReadRosterPatternRequest) (*ReadRosterPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadRosterPattern not implemented")
+}
+func (UnimplementedRosterPatternServiceServer) SearchRosterPattern(context.Context, *SearchRosterPatternRequest) (*SearchRosterPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchRosterPattern not implemented")
+}
+func (UnimplementedRosterPatternServiceServer) UpdateRosterPattern(context.Context, *UpdateRosterPatternRequest) (*UpdateRosterPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRosterPattern not implemented")
+}
+func (UnimplementedRosterPatternServiceServer) DeleteRosterPattern(context.Context, *DeleteRosterPatternRequest) (*DeleteRosterPatternResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRosterPattern not implemented")
+}
+func (UnimplementedRosterPatternServiceServer) mustEmbedUnimplementedRosterPatternServiceServer() {}
+
+// UnsafeRosterPatternServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RosterPatternServiceServer will
+// result in compilation errors.
+type UnsafeRosterPatternServiceServer interface {
+	mustEmbedUnimplementedRosterPatternServiceServer()
+}
+
+func RegisterRosterPatternServiceServer(s grpc.ServiceRegistrar, srv RosterPatternServiceServer) {
+	s.RegisterService(&RosterPatternService_ServiceDesc, srv)
+}
+
+func _RosterPatternService_CreateRosterPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRosterPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterPatternServiceServer).CreateRosterPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterPatternService_CreateRosterPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterPatternServiceServer).CreateRosterPattern(ctx, req.(*CreateRosterPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RosterPatternService_ReadRosterPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadRosterPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterPatternServiceServer).ReadRosterPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterPatternService_ReadRosterPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterPatternServiceServer).ReadRosterPattern(ctx, req.(*ReadRosterPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RosterPatternService_SearchRosterPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRosterPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterPatternServiceServer).SearchRosterPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterPatternService_SearchRosterPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterPatternServiceServer).SearchRosterPattern(ctx, req.(*SearchRosterPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RosterPatternService_UpdateRosterPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRosterPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterPatternServiceServer).UpdateRosterPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterPatternService_UpdateRosterPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterPatternServiceServer).UpdateRosterPattern(ctx, req.(*UpdateRosterPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RosterPatternService_DeleteRosterPattern_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRosterPatternRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RosterPatternServiceServer).DeleteRosterPattern(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RosterPatternService_DeleteRosterPattern_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RosterPatternServiceServer).DeleteRosterPattern(ctx, req.(*DeleteRosterPatternRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RosterPatternService_ServiceDesc is the grpc.ServiceDesc for RosterPatternService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RosterPatternService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wfm.RosterPatternService",
+	HandlerType: (*RosterPatternServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRosterPattern",
+			Handler:    _RosterPatternService_CreateRosterPattern_Handler,
+		},
+		{
+			MethodName: "ReadRosterPattern",
+			Handler:    _RosterPatternService_ReadRosterPattern_Handler,
+		},
+		{
+			MethodName: "SearchRosterPattern",
+			Handler:    _RosterPatternService_SearchRosterPattern_Handler,
+		},
+		{
+			MethodName: "UpdateRosterPattern",
+			Handler:    _RosterPatternService_UpdateRosterPattern_Handler,
+		},
+		{
+			MethodName: "DeleteRosterPattern",
+			Handler:    _RosterPatternService_DeleteRosterPattern_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "roster_pattern.proto",
+}
@@ -0,0 +1,1768 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: shift_swap.proto
+
+package wfm
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ShiftSwapState int32
+
+const (
+	ShiftSwapState_SHIFT_SWAP_STATE_UNSPECIFIED ShiftSwapState = 0
+	// Offered and waits for another agent.
+	ShiftSwapState_SHIFT_SWAP_STATE_OPEN ShiftSwapState = 1
+	// Accepted by another agent and waits for the supervisor approval.
+	ShiftSwapState_SHIFT_SWAP_STATE_ACCEPTED  ShiftSwapState = 2
+	ShiftSwapState_SHIFT_SWAP_STATE_APPROVED  ShiftSwapState = 3
+	ShiftSwapState_SHIFT_SWAP_STATE_REJECTED  ShiftSwapState = 4
+	ShiftSwapState_SHIFT_SWAP_STATE_CANCELLED ShiftSwapState = 5
+)
+
+// Enum value maps for ShiftSwapState.
+var (
+	ShiftSwapState_name = map[int32]string{
+		0: "SHIFT_SWAP_STATE_UNSPECIFIED",
+		1: "SHIFT_SWAP_STATE_OPEN",
+		2: "SHIFT_SWAP_STATE_ACCEPTED",
+		3: "SHIFT_SWAP_STATE_APPROVED",
+		4: "SHIFT_SWAP_STATE_REJECTED",
+		5: "SHIFT_SWAP_STATE_CANCELLED",
+	}
+	ShiftSwapState_value = map[string]int32{
+		"SHIFT_SWAP_STATE_UNSPECIFIED": 0,
+		"SHIFT_SWAP_STATE_OPEN":        1,
+		"SHIFT_SWAP_STATE_ACCEPTED":    2,
+		"SHIFT_SWAP_STATE_APPROVED":    3,
+		"SHIFT_SWAP_STATE_REJECTED":    4,
+		"SHIFT_SWAP_STATE_CANCELLED":   5,
+	}
+)
+
+func (x ShiftSwapState) Enum() *ShiftSwapState {
+	p := new(ShiftSwapState)
+	*p = x
+	return p
+}
+
+func (x ShiftSwapState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShiftSwapState) Descriptor() protoreflect.EnumDescriptor {
+	return file_shift_swap_proto_enumTypes[0].Descriptor()
+}
+
+func (ShiftSwapState) Type() protoreflect.EnumType {
+	return &file_shift_swap_proto_enumTypes[0]
+}
+
+func (x ShiftSwapState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShiftSwapState.Descriptor instead.
+func (ShiftSwapState) EnumDescriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{0}
+}
+
+type CreateShiftSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ShiftSwap `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CreateShiftSwapRequest) Reset() {
+	*x = CreateShiftSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShiftSwapRequest) ProtoMessage() {}
+
+func (x *CreateShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*CreateShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateShiftSwapRequest) GetItem() *ShiftSwap {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type CreateShiftSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ShiftSwap `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CreateShiftSwapResponse) Reset() {
+	*x = CreateShiftSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShiftSwapResponse) ProtoMessage() {}
+
+func (x *CreateShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*CreateShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateShiftSwapResponse) GetItem() *ShiftSwap {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ReadShiftSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReadShiftSwapRequest) Reset() {
+	*x = ReadShiftSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadShiftSwapRequest) ProtoMessage() {}
+
+func (x *ReadShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*ReadShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadShiftSwapRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReadShiftSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ShiftSwap `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ReadShiftSwapResponse) Reset() {
+	*x = ReadShiftSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadShiftSwapResponse) ProtoMessage() {}
+
+func (x *ReadShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*ReadShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReadShiftSwapResponse) GetItem() *ShiftSwap {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type SearchShiftSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Q                 *string  `protobuf:"bytes,1,opt,name=q,proto3,oneof" json:"q,omitempty"` // Searches by name of the offering agent.
+	Page              *int32   `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Size              *int32   `protobuf:"varint,3,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	Sort              *string  `protobuf:"bytes,4,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	Fields            []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	WorkingScheduleId []int64  `protobuf:"varint,6,rep,packed,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
+	// Searches swaps offered by or accepted by the agents.
+	AgentId []int64          `protobuf:"varint,7,rep,packed,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	State   []ShiftSwapState `protobuf:"varint,8,rep,packed,name=state,proto3,enum=wfm.ShiftSwapState" json:"state,omitempty"`
+}
+
+func (x *SearchShiftSwapRequest) Reset() {
+	*x = SearchShiftSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchShiftSwapRequest) ProtoMessage() {}
+
+func (x *SearchShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*SearchShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchShiftSwapRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *SearchShiftSwapRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *SearchShiftSwapRequest) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *SearchShiftSwapRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+func (x *SearchShiftSwapRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SearchShiftSwapRequest) GetWorkingScheduleId() []int64 {
+	if x != nil {
+		return x.WorkingScheduleId
+	}
+	return nil
+}
+
+func (x *SearchShiftSwapRequest) GetAgentId() []int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return nil
+}
+
+func (x *SearchShiftSwapRequest) GetState() []ShiftSwapState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type SearchShiftSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*ShiftSwap `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next  bool         `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *SearchShiftSwapResponse) Reset() {
+	*x = SearchShiftSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchShiftSwapResponse) ProtoMessage() {}
+
+func (x *SearchShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*SearchShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchShiftSwapResponse) GetItems() []*ShiftSwap {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchShiftSwapResponse) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+type AcceptShiftSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Shift of the signed-in agent proposed in exchange, the offered shift is taken over without exchange if omitted.
+	ShiftId *int64 `protobuf:"varint,2,opt,name=shift_id,json=shiftId,proto3,oneof" json:"shift_id,omitempty"`
+}
+
+func (x *AcceptShiftSwapRequest) Reset() {
+	*x = AcceptShiftSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptShiftSwapRequest) ProtoMessage() {}
+
+func (x *AcceptShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*AcceptShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AcceptShiftSwapRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AcceptShiftSwapRequest) GetShiftId() int64 {
+	if x != nil && x.ShiftId != nil {
+		return *x.ShiftId
+	}
+	return 0
+}
+
+type AcceptShiftSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ShiftSwap `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *AcceptShiftSwapResponse) Reset() {
+	*x = AcceptShiftSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AcceptShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptShiftSwapResponse) ProtoMessage() {}
+
+func (x *AcceptShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*AcceptShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcceptShiftSwapResponse) GetItem() *ShiftSwap {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ValidateShiftSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ValidateShiftSwapRequest) Reset() {
+	*x = ValidateShiftSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateShiftSwapRequest) ProtoMessage() {}
+
+func (x *ValidateShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*ValidateShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ValidateShiftSwapRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ValidateShiftSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*WorkingScheduleViolation `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Reports if there are no violations with an error severity.
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *ValidateShiftSwapResponse) Reset() {
+	*x = ValidateShiftSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateShiftSwapResponse) ProtoMessage() {}
+
+func (x *ValidateShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*ValidateShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidateShiftSwapResponse) GetItems() []*WorkingScheduleViolation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ValidateShiftSwapResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type ApproveShiftSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comment *string `protobuf:"bytes,2,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+}
+
+func (x *ApproveShiftSwapRequest) Reset() {
+	*x = ApproveShiftSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveShiftSwapRequest) ProtoMessage() {}
+
+func (x *ApproveShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*ApproveShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ApproveShiftSwapRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApproveShiftSwapRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type ApproveShiftSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ShiftSwap `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ApproveShiftSwapResponse) Reset() {
+	*x = ApproveShiftSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveShiftSwapResponse) ProtoMessage() {}
+
+func (x *ApproveShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*ApproveShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ApproveShiftSwapResponse) GetItem() *ShiftSwap {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type RejectShiftSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comment *string `protobuf:"bytes,2,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+}
+
+func (x *RejectShiftSwapRequest) Reset() {
+	*x = RejectShiftSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectShiftSwapRequest) ProtoMessage() {}
+
+func (x *RejectShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*RejectShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RejectShiftSwapRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RejectShiftSwapRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type RejectShiftSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ShiftSwap `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *RejectShiftSwapResponse) Reset() {
+	*x = RejectShiftSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectShiftSwapResponse) ProtoMessage() {}
+
+func (x *RejectShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*RejectShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RejectShiftSwapResponse) GetItem() *ShiftSwap {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type CancelShiftSwapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelShiftSwapRequest) Reset() {
+	*x = CancelShiftSwapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelShiftSwapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShiftSwapRequest) ProtoMessage() {}
+
+func (x *CancelShiftSwapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShiftSwapRequest.ProtoReflect.Descriptor instead.
+func (*CancelShiftSwapRequest) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CancelShiftSwapRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelShiftSwapResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *ShiftSwap `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CancelShiftSwapResponse) Reset() {
+	*x = CancelShiftSwapResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelShiftSwapResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelShiftSwapResponse) ProtoMessage() {}
+
+func (x *CancelShiftSwapResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelShiftSwapResponse.ProtoReflect.Descriptor instead.
+func (*CancelShiftSwapResponse) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CancelShiftSwapResponse) GetItem() *ShiftSwap {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// ShiftSwapShift is a shift offered or proposed in exchange.
+type ShiftSwapShift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date  int64 `protobuf:"varint,2,opt,name=date,proto3" json:"date,omitempty"`
+	Start int64 `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End   int64 `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *ShiftSwapShift) Reset() {
+	*x = ShiftSwapShift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShiftSwapShift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftSwapShift) ProtoMessage() {}
+
+func (x *ShiftSwapShift) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftSwapShift.ProtoReflect.Descriptor instead.
+func (*ShiftSwapShift) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ShiftSwapShift) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShiftSwapShift) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *ShiftSwapShift) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *ShiftSwapShift) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// ShiftSwap is an offer of an agent to give away a shift. Another agent accepts it,
+// optionally proposing own shift in exchange, and a supervisor approves the swap.
+type ShiftSwap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId        int64         `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	CreatedAt       int64         `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy       *LookupEntity `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt       int64         `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy       *LookupEntity `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	WorkingSchedule *LookupEntity `protobuf:"bytes,7,opt,name=working_schedule,json=workingSchedule,proto3" json:"working_schedule,omitempty"`
+	// Agent, who offers the shift.
+	Agent *LookupEntity   `protobuf:"bytes,8,opt,name=agent,proto3" json:"agent,omitempty"`
+	Shift *ShiftSwapShift `protobuf:"bytes,9,opt,name=shift,proto3" json:"shift,omitempty"`
+	// Agent, whom the offer is addressed to, any agent of the working schedule can accept the offer if omitted.
+	TargetAgent *LookupEntity `protobuf:"bytes,10,opt,name=target_agent,json=targetAgent,proto3" json:"target_agent,omitempty"`
+	// Agent, who accepted the offer.
+	CounterAgent *LookupEntity `protobuf:"bytes,11,opt,name=counter_agent,json=counterAgent,proto3" json:"counter_agent,omitempty"`
+	// Shift proposed in exchange by the counter agent.
+	CounterShift *ShiftSwapShift `protobuf:"bytes,12,opt,name=counter_shift,json=counterShift,proto3" json:"counter_shift,omitempty"`
+	State        ShiftSwapState  `protobuf:"varint,13,opt,name=state,proto3,enum=wfm.ShiftSwapState" json:"state,omitempty"`
+	// Offering agent's note.
+	Note *string `protobuf:"bytes,14,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	// Supervisor's comment on approval or rejection.
+	Comment    *string       `protobuf:"bytes,15,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	AcceptedAt int64         `protobuf:"varint,16,opt,name=accepted_at,json=acceptedAt,proto3" json:"accepted_at,omitempty"`
+	DecidedAt  int64         `protobuf:"varint,17,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	DecidedBy  *LookupEntity `protobuf:"bytes,18,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+}
+
+func (x *ShiftSwap) Reset() {
+	*x = ShiftSwap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_shift_swap_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShiftSwap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShiftSwap) ProtoMessage() {}
+
+func (x *ShiftSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_shift_swap_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShiftSwap.ProtoReflect.Descriptor instead.
+func (*ShiftSwap) Descriptor() ([]byte, []int) {
+	return file_shift_swap_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ShiftSwap) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ShiftSwap) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *ShiftSwap) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ShiftSwap) GetCreatedBy() *LookupEntity {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ShiftSwap) GetUpdatedBy() *LookupEntity {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetWorkingSchedule() *LookupEntity {
+	if x != nil {
+		return x.WorkingSchedule
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetAgent() *LookupEntity {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetShift() *ShiftSwapShift {
+	if x != nil {
+		return x.Shift
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetTargetAgent() *LookupEntity {
+	if x != nil {
+		return x.TargetAgent
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetCounterAgent() *LookupEntity {
+	if x != nil {
+		return x.CounterAgent
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetCounterShift() *ShiftSwapShift {
+	if x != nil {
+		return x.CounterShift
+	}
+	return nil
+}
+
+func (x *ShiftSwap) GetState() ShiftSwapState {
+	if x != nil {
+		return x.State
+	}
+	return ShiftSwapState_SHIFT_SWAP_STATE_UNSPECIFIED
+}
+
+func (x *ShiftSwap) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *ShiftSwap) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *ShiftSwap) GetAcceptedAt() int64 {
+	if x != nil {
+		return x.AcceptedAt
+	}
+	return 0
+}
+
+func (x *ShiftSwap) GetDecidedAt() int64 {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return 0
+}
+
+func (x *ShiftSwap) GetDecidedBy() *LookupEntity {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return nil
+}
+
+var File_shift_swap_proto protoreflect.FileDescriptor
+
+var file_shift_swap_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x03, 0x77, 0x66, 0x6d, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c,
+	0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x44, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x42, 0x06, 0xba, 0x48,
+	0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3d, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x32, 0x0a, 0x14, 0x52, 0x65, 0x61,
+	0x64, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a,
+	0x15, 0x52, 0x65, 0x61, 0x64, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xd7, 0x02, 0x0a, 0x16, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfa, 0x01, 0x48, 0x00, 0x52, 0x01,
+	0x71, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x01, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x02, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x12, 0xba, 0x48, 0x0f, 0x92, 0x01, 0x0c,
+	0x18, 0x01, 0x22, 0x08, 0x82, 0x01, 0x05, 0x10, 0x01, 0x22, 0x01, 0x00, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x71, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x73, 0x6f, 0x72, 0x74, 0x22, 0x53, 0x0a, 0x17, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x24, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x6a, 0x0a, 0x16, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x27, 0x0a, 0x08, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x07, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x68, 0x69,
+	0x66, 0x74, 0x5f, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x17, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x22, 0x36, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x19,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x56, 0x69,
+	0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x22, 0x60, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x18, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x5f, 0x0a, 0x16, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x17, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x34, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3d, 0x0a, 0x17,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x65, 0x0a, 0x0e, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x68, 0x69, 0x66, 0x74, 0x12, 0x17, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
+	0x6e, 0x64, 0x22, 0x86, 0x06, 0x0a, 0x09, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x3c, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0f, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x27, 0x0a,
+	0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x68, 0x69, 0x66, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x05, 0x73, 0x68, 0x69, 0x66, 0x74, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12,
+	0x36, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x0d, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x68,
+	0x69, 0x66, 0x74, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x04,
+	0x6e, 0x6f, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f,
+	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x64, 0x65, 0x63,
+	0x69, 0x64, 0x65, 0x64, 0x42, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x42,
+	0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2a, 0xca, 0x01, 0x0a, 0x0e,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20,
+	0x0a, 0x1c, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x19, 0x0a, 0x15, 0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53,
+	0x48, 0x49, 0x46, 0x54, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48,
+	0x49, 0x46, 0x54, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41,
+	0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x49,
+	0x46, 0x54, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45,
+	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x48, 0x49, 0x46,
+	0x54, 0x5f, 0x53, 0x57, 0x41, 0x50, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e,
+	0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x32, 0xe2, 0x07, 0x0a, 0x10, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6d, 0x0a,
+	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x12, 0x1b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x90, 0xb5, 0x18,
+	0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x77, 0x66, 0x6d,
+	0x2f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x12, 0x69, 0x0a, 0x0d,
+	0x52, 0x65, 0x61, 0x64, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x19, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x12, 0x15, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6a, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x12, 0x10, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x12, 0x79, 0x0a, 0x0f, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2b, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a,
+	0x22, 0x1c, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x73, 0x77, 0x61,
+	0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x80,
+	0x01, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x12, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2c, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
+	0x20, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x7d, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x53, 0x68, 0x69, 0x66,
+	0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2c, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01,
+	0x2a, 0x22, 0x1d, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x12, 0x79, 0x0a, 0x0f, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53,
+	0x77, 0x61, 0x70, 0x12, 0x1b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x68, 0x69,
+	0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b,
+	0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f,
+	0x77, 0x66, 0x6d, 0x2f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x79, 0x0a, 0x0f, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61, 0x70, 0x12, 0x1b,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x68, 0x69, 0x66, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x90, 0xb5, 0x18, 0x02, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x1a, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x23, 0x5a,
+	0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d, 0x3b, 0x77,
+	0x66, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_shift_swap_proto_rawDescOnce sync.Once
+	file_shift_swap_proto_rawDescData = file_shift_swap_proto_rawDesc
+)
+
+func file_shift_swap_proto_rawDescGZIP() []byte {
+	file_shift_swap_proto_rawDescOnce.Do(func() {
+		file_shift_swap_proto_rawDescData = protoimpl.X.CompressGZIP(file_shift_swap_proto_rawDescData)
+	})
+	return file_shift_swap_proto_rawDescData
+}
+
+var file_shift_swap_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_shift_swap_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_shift_swap_proto_goTypes = []interface{}{
+	(ShiftSwapState)(0),               // 0: wfm.ShiftSwapState
+	(*CreateShiftSwapRequest)(nil),    // 1: wfm.CreateShiftSwapRequest
+	(*CreateShiftSwapResponse)(nil),   // 2: wfm.CreateShiftSwapResponse
+	(*ReadShiftSwapRequest)(nil),      // 3: wfm.ReadShiftSwapRequest
+	(*ReadShiftSwapResponse)(nil),     // 4: wfm.ReadShiftSwapResponse
+	(*SearchShiftSwapRequest)(nil),    // 5: wfm.SearchShiftSwapRequest
+	(*SearchShiftSwapResponse)(nil),   // 6: wfm.SearchShiftSwapResponse
+	(*AcceptShiftSwapRequest)(nil),    // 7: wfm.AcceptShiftSwapRequest
+	(*AcceptShiftSwapResponse)(nil),   // 8: wfm.AcceptShiftSwapResponse
+	(*ValidateShiftSwapRequest)(nil),  // 9: wfm.ValidateShiftSwapRequest
+	(*ValidateShiftSwapResponse)(nil), // 10: wfm.ValidateShiftSwapResponse
+	(*ApproveShiftSwapRequest)(nil),   // 11: wfm.ApproveShiftSwapRequest
+	(*ApproveShiftSwapResponse)(nil),  // 12: wfm.ApproveShiftSwapResponse
+	(*RejectShiftSwapRequest)(nil),    // 13: wfm.RejectShiftSwapRequest
+	(*RejectShiftSwapResponse)(nil),   // 14: wfm.RejectShiftSwapResponse
+	(*CancelShiftSwapRequest)(nil),    // 15: wfm.CancelShiftSwapRequest
+	(*CancelShiftSwapResponse)(nil),   // 16: wfm.CancelShiftSwapResponse
+	(*ShiftSwapShift)(nil),            // 17: wfm.ShiftSwapShift
+	(*ShiftSwap)(nil),                 // 18: wfm.ShiftSwap
+	(*WorkingScheduleViolation)(nil),  // 19: wfm.WorkingScheduleViolation
+	(*LookupEntity)(nil),              // 20: wfm.LookupEntity
+}
+var file_shift_swap_proto_depIdxs = []int32{
+	18, // 0: wfm.CreateShiftSwapRequest.item:type_name -> wfm.ShiftSwap
+	18, // 1: wfm.CreateShiftSwapResponse.item:type_name -> wfm.ShiftSwap
+	18, // 2: wfm.ReadShiftSwapResponse.item:type_name -> wfm.ShiftSwap
+	0,  // 3: wfm.SearchShiftSwapRequest.state:type_name -> wfm.ShiftSwapState
+	18, // 4: wfm.SearchShiftSwapResponse.items:type_name -> wfm.ShiftSwap
+	18, // 5: wfm.AcceptShiftSwapResponse.item:type_name -> wfm.ShiftSwap
+	19, // 6: wfm.ValidateShiftSwapResponse.items:type_name -> wfm.WorkingScheduleViolation
+	18, // 7: wfm.ApproveShiftSwapResponse.item:type_name -> wfm.ShiftSwap
+	18, // 8: wfm.RejectShiftSwapResponse.item:type_name -> wfm.ShiftSwap
+	18, // 9: wfm.CancelShiftSwapResponse.item:type_name -> wfm.ShiftSwap
+	20, // 10: wfm.ShiftSwap.created_by:type_name -> wfm.LookupEntity
+	20, // 11: wfm.ShiftSwap.updated_by:type_name -> wfm.LookupEntity
+	20, // 12: wfm.ShiftSwap.working_schedule:type_name -> wfm.LookupEntity
+	20, // 13: wfm.ShiftSwap.agent:type_name -> wfm.LookupEntity
+	17, // 14: wfm.ShiftSwap.shift:type_name -> wfm.ShiftSwapShift
+	20, // 15: wfm.ShiftSwap.target_agent:type_name -> wfm.LookupEntity
+	20, // 16: wfm.ShiftSwap.counter_agent:type_name -> wfm.LookupEntity
+	17, // 17: wfm.ShiftSwap.counter_shift:type_name -> wfm.ShiftSwapShift
+	0,  // 18: wfm.ShiftSwap.state:type_name -> wfm.ShiftSwapState
+	20, // 19: wfm.ShiftSwap.decided_by:type_name -> wfm.LookupEntity
+	1,  // 20: wfm.ShiftSwapService.CreateShiftSwap:input_type -> wfm.CreateShiftSwapRequest
+	3,  // 21: wfm.ShiftSwapService.ReadShiftSwap:input_type -> wfm.ReadShiftSwapRequest
+	5,  // 22: wfm.ShiftSwapService.SearchShiftSwap:input_type -> wfm.SearchShiftSwapRequest
+	7,  // 23: wfm.ShiftSwapService.AcceptShiftSwap:input_type -> wfm.AcceptShiftSwapRequest
+	9,  // 24: wfm.ShiftSwapService.ValidateShiftSwap:input_type -> wfm.ValidateShiftSwapRequest
+	11, // 25: wfm.ShiftSwapService.ApproveShiftSwap:input_type -> wfm.ApproveShiftSwapRequest
+	13, // 26: wfm.ShiftSwapService.RejectShiftSwap:input_type -> wfm.RejectShiftSwapRequest
+	15, // 27: wfm.ShiftSwapService.CancelShiftSwap:input_type -> wfm.CancelShiftSwapRequest
+	2,  // 28: wfm.ShiftSwapService.CreateShiftSwap:output_type -> wfm.CreateShiftSwapResponse
+	4,  // 29: wfm.ShiftSwapService.ReadShiftSwap:output_type -> wfm.ReadShiftSwapResponse
+	6,  // 30: wfm.ShiftSwapService.SearchShiftSwap:output_type -> wfm.SearchShiftSwapResponse
+	8,  // 31: wfm.ShiftSwapService.AcceptShiftSwap:output_type -> wfm.AcceptShiftSwapResponse
+	10, // 32: wfm.ShiftSwapService.ValidateShiftSwap:output_type -> wfm.ValidateShiftSwapResponse
+	12, // 33: wfm.ShiftSwapService.ApproveShiftSwap:output_type -> wfm.ApproveShiftSwapResponse
+	14, // 34: wfm.ShiftSwapService.RejectShiftSwap:output_type -> wfm.RejectShiftSwapResponse
+	16, // 35: wfm.ShiftSwapService.CancelShiftSwap:output_type -> wfm.CancelShiftSwapResponse
+	28, // [28:36] is the sub-list for method output_type
+	20, // [20:28] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_shift_swap_proto_init() }
+func file_shift_swap_proto_init() {
+	if File_shift_swap_proto != nil {
+		return
+	}
+	file_lookup_proto_init()
+	file_working_schedule_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_shift_swap_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShiftSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateShiftSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadShiftSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadShiftSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchShiftSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchShiftSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptShiftSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AcceptShiftSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateShiftSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateShiftSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveShiftSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveShiftSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectShiftSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectShiftSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelShiftSwapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelShiftSwapResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShiftSwapShift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_shift_swap_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShiftSwap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_shift_swap_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_shift_swap_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_shift_swap_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_shift_swap_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_shift_swap_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_shift_swap_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_shift_swap_proto_goTypes,
+		DependencyIndexes: file_shift_swap_proto_depIdxs,
+		EnumInfos:         file_shift_swap_proto_enumTypes,
+		MessageInfos:      file_shift_swap_proto_msgTypes,
+	}.Build()
+	File_shift_swap_proto = out.File
+	file_shift_swap_proto_rawDesc = nil
+	file_shift_swap_proto_goTypes = nil
+	file_shift_swap_proto_depIdxs = nil
+}
@@ -0,0 +1,2473 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: shift_swap.proto
+
+package wfm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShiftSwapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateShiftSwapRequestMultiError, or nil if none found.
+func (m *CreateShiftSwapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShiftSwapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateShiftSwapRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateShiftSwapRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateShiftSwapRequestValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateShiftSwapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateShiftSwapRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateShiftSwapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShiftSwapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateShiftSwapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateShiftSwapRequestMultiError) AllErrors() []error { return m }
+
+// CreateShiftSwapRequestValidationError is the validation error returned by
+// CreateShiftSwapRequest.Validate if the designated constraints aren't met.
+type CreateShiftSwapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateShiftSwapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateShiftSwapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateShiftSwapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateShiftSwapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateShiftSwapRequestValidationError) ErrorName() string {
+	return "CreateShiftSwapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateShiftSwapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateShiftSwapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateShiftSwapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateShiftSwapRequestValidationError{}
+
+// Validate checks the field values on CreateShiftSwapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateShiftSwapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateShiftSwapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateShiftSwapResponseMultiError, or nil if none found.
+func (m *CreateShiftSwapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateShiftSwapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateShiftSwapResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateShiftSwapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateShiftSwapResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateShiftSwapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateShiftSwapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateShiftSwapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateShiftSwapResponseMultiError) AllErrors() []error { return m }
+
+// CreateShiftSwapResponseValidationError is the validation error returned by
+// CreateShiftSwapResponse.Validate if the designated constraints aren't met.
+type CreateShiftSwapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateShiftSwapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateShiftSwapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateShiftSwapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateShiftSwapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateShiftSwapResponseValidationError) ErrorName() string {
+	return "CreateShiftSwapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateShiftSwapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateShiftSwapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateShiftSwapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateShiftSwapResponseValidationError{}
+
+// Validate checks the field values on ReadShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadShiftSwapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadShiftSwapRequestMultiError, or nil if none found.
+func (m *ReadShiftSwapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadShiftSwapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ReadShiftSwapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadShiftSwapRequestMultiError is an error wrapping multiple validation
+// errors returned by ReadShiftSwapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReadShiftSwapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadShiftSwapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadShiftSwapRequestMultiError) AllErrors() []error { return m }
+
+// ReadShiftSwapRequestValidationError is the validation error returned by
+// ReadShiftSwapRequest.Validate if the designated constraints aren't met.
+type ReadShiftSwapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadShiftSwapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadShiftSwapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadShiftSwapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadShiftSwapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadShiftSwapRequestValidationError) ErrorName() string {
+	return "ReadShiftSwapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadShiftSwapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadShiftSwapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadShiftSwapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadShiftSwapRequestValidationError{}
+
+// Validate checks the field values on ReadShiftSwapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadShiftSwapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadShiftSwapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadShiftSwapResponseMultiError, or nil if none found.
+func (m *ReadShiftSwapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadShiftSwapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadShiftSwapResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReadShiftSwapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadShiftSwapResponseMultiError is an error wrapping multiple validation
+// errors returned by ReadShiftSwapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReadShiftSwapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadShiftSwapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadShiftSwapResponseMultiError) AllErrors() []error { return m }
+
+// ReadShiftSwapResponseValidationError is the validation error returned by
+// ReadShiftSwapResponse.Validate if the designated constraints aren't met.
+type ReadShiftSwapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadShiftSwapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadShiftSwapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadShiftSwapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadShiftSwapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadShiftSwapResponseValidationError) ErrorName() string {
+	return "ReadShiftSwapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadShiftSwapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadShiftSwapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadShiftSwapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadShiftSwapResponseValidationError{}
+
+// Validate checks the field values on SearchShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchShiftSwapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchShiftSwapRequestMultiError, or nil if none found.
+func (m *SearchShiftSwapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchShiftSwapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Q != nil {
+		// no validation rules for Q
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.Size != nil {
+		// no validation rules for Size
+	}
+
+	if m.Sort != nil {
+		// no validation rules for Sort
+	}
+
+	if len(errors) > 0 {
+		return SearchShiftSwapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchShiftSwapRequestMultiError is an error wrapping multiple validation
+// errors returned by SearchShiftSwapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchShiftSwapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchShiftSwapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchShiftSwapRequestMultiError) AllErrors() []error { return m }
+
+// SearchShiftSwapRequestValidationError is the validation error returned by
+// SearchShiftSwapRequest.Validate if the designated constraints aren't met.
+type SearchShiftSwapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchShiftSwapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchShiftSwapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchShiftSwapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchShiftSwapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchShiftSwapRequestValidationError) ErrorName() string {
+	return "SearchShiftSwapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchShiftSwapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchShiftSwapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchShiftSwapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchShiftSwapRequestValidationError{}
+
+// Validate checks the field values on SearchShiftSwapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchShiftSwapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchShiftSwapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchShiftSwapResponseMultiError, or nil if none found.
+func (m *SearchShiftSwapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchShiftSwapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchShiftSwapResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchShiftSwapResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchShiftSwapResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Next
+
+	if len(errors) > 0 {
+		return SearchShiftSwapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchShiftSwapResponseMultiError is an error wrapping multiple validation
+// errors returned by SearchShiftSwapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchShiftSwapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchShiftSwapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchShiftSwapResponseMultiError) AllErrors() []error { return m }
+
+// SearchShiftSwapResponseValidationError is the validation error returned by
+// SearchShiftSwapResponse.Validate if the designated constraints aren't met.
+type SearchShiftSwapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchShiftSwapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchShiftSwapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchShiftSwapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchShiftSwapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchShiftSwapResponseValidationError) ErrorName() string {
+	return "SearchShiftSwapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchShiftSwapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchShiftSwapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchShiftSwapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchShiftSwapResponseValidationError{}
+
+// Validate checks the field values on AcceptShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptShiftSwapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptShiftSwapRequestMultiError, or nil if none found.
+func (m *AcceptShiftSwapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptShiftSwapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.ShiftId != nil {
+		// no validation rules for ShiftId
+	}
+
+	if len(errors) > 0 {
+		return AcceptShiftSwapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptShiftSwapRequestMultiError is an error wrapping multiple validation
+// errors returned by AcceptShiftSwapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptShiftSwapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptShiftSwapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptShiftSwapRequestMultiError) AllErrors() []error { return m }
+
+// AcceptShiftSwapRequestValidationError is the validation error returned by
+// AcceptShiftSwapRequest.Validate if the designated constraints aren't met.
+type AcceptShiftSwapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptShiftSwapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptShiftSwapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptShiftSwapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptShiftSwapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptShiftSwapRequestValidationError) ErrorName() string {
+	return "AcceptShiftSwapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptShiftSwapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptShiftSwapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptShiftSwapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptShiftSwapRequestValidationError{}
+
+// Validate checks the field values on AcceptShiftSwapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptShiftSwapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptShiftSwapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptShiftSwapResponseMultiError, or nil if none found.
+func (m *AcceptShiftSwapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptShiftSwapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptShiftSwapResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AcceptShiftSwapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptShiftSwapResponseMultiError is an error wrapping multiple validation
+// errors returned by AcceptShiftSwapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptShiftSwapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptShiftSwapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptShiftSwapResponseMultiError) AllErrors() []error { return m }
+
+// AcceptShiftSwapResponseValidationError is the validation error returned by
+// AcceptShiftSwapResponse.Validate if the designated constraints aren't met.
+type AcceptShiftSwapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptShiftSwapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptShiftSwapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptShiftSwapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptShiftSwapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptShiftSwapResponseValidationError) ErrorName() string {
+	return "AcceptShiftSwapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptShiftSwapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptShiftSwapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptShiftSwapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptShiftSwapResponseValidationError{}
+
+// Validate checks the field values on ValidateShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateShiftSwapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateShiftSwapRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateShiftSwapRequestMultiError, or nil if none found.
+func (m *ValidateShiftSwapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateShiftSwapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ValidateShiftSwapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateShiftSwapRequestMultiError is an error wrapping multiple validation
+// errors returned by ValidateShiftSwapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ValidateShiftSwapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateShiftSwapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateShiftSwapRequestMultiError) AllErrors() []error { return m }
+
+// ValidateShiftSwapRequestValidationError is the validation error returned by
+// ValidateShiftSwapRequest.Validate if the designated constraints aren't met.
+type ValidateShiftSwapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateShiftSwapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateShiftSwapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateShiftSwapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateShiftSwapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateShiftSwapRequestValidationError) ErrorName() string {
+	return "ValidateShiftSwapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateShiftSwapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateShiftSwapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateShiftSwapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateShiftSwapRequestValidationError{}
+
+// Validate checks the field values on ValidateShiftSwapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateShiftSwapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateShiftSwapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ValidateShiftSwapResponseMultiError, or nil if none found.
+func (m *ValidateShiftSwapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateShiftSwapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateShiftSwapResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateShiftSwapResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateShiftSwapResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Valid
+
+	if len(errors) > 0 {
+		return ValidateShiftSwapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateShiftSwapResponseMultiError is an error wrapping multiple validation
+// errors returned by ValidateShiftSwapResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ValidateShiftSwapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateShiftSwapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateShiftSwapResponseMultiError) AllErrors() []error { return m }
+
+// ValidateShiftSwapResponseValidationError is the validation error returned by
+// ValidateShiftSwapResponse.Validate if the designated constraints aren't met.
+type ValidateShiftSwapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateShiftSwapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateShiftSwapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateShiftSwapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateShiftSwapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateShiftSwapResponseValidationError) ErrorName() string {
+	return "ValidateShiftSwapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateShiftSwapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateShiftSwapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateShiftSwapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateShiftSwapResponseValidationError{}
+
+// Validate checks the field values on ApproveShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveShiftSwapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveShiftSwapRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveShiftSwapRequestMultiError, or nil if none found.
+func (m *ApproveShiftSwapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveShiftSwapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Comment != nil {
+		// no validation rules for Comment
+	}
+
+	if len(errors) > 0 {
+		return ApproveShiftSwapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveShiftSwapRequestMultiError is an error wrapping multiple validation
+// errors returned by ApproveShiftSwapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveShiftSwapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveShiftSwapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveShiftSwapRequestMultiError) AllErrors() []error { return m }
+
+// ApproveShiftSwapRequestValidationError is the validation error returned by
+// ApproveShiftSwapRequest.Validate if the designated constraints aren't met.
+type ApproveShiftSwapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveShiftSwapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveShiftSwapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveShiftSwapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveShiftSwapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveShiftSwapRequestValidationError) ErrorName() string {
+	return "ApproveShiftSwapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveShiftSwapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveShiftSwapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveShiftSwapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveShiftSwapRequestValidationError{}
+
+// Validate checks the field values on ApproveShiftSwapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveShiftSwapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveShiftSwapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveShiftSwapResponseMultiError, or nil if none found.
+func (m *ApproveShiftSwapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveShiftSwapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveShiftSwapResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApproveShiftSwapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveShiftSwapResponseMultiError is an error wrapping multiple validation
+// errors returned by ApproveShiftSwapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ApproveShiftSwapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveShiftSwapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveShiftSwapResponseMultiError) AllErrors() []error { return m }
+
+// ApproveShiftSwapResponseValidationError is the validation error returned by
+// ApproveShiftSwapResponse.Validate if the designated constraints aren't met.
+type ApproveShiftSwapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveShiftSwapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveShiftSwapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveShiftSwapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveShiftSwapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveShiftSwapResponseValidationError) ErrorName() string {
+	return "ApproveShiftSwapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveShiftSwapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveShiftSwapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveShiftSwapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveShiftSwapResponseValidationError{}
+
+// Validate checks the field values on RejectShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectShiftSwapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectShiftSwapRequestMultiError, or nil if none found.
+func (m *RejectShiftSwapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectShiftSwapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Comment != nil {
+		// no validation rules for Comment
+	}
+
+	if len(errors) > 0 {
+		return RejectShiftSwapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectShiftSwapRequestMultiError is an error wrapping multiple validation
+// errors returned by RejectShiftSwapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RejectShiftSwapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectShiftSwapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectShiftSwapRequestMultiError) AllErrors() []error { return m }
+
+// RejectShiftSwapRequestValidationError is the validation error returned by
+// RejectShiftSwapRequest.Validate if the designated constraints aren't met.
+type RejectShiftSwapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectShiftSwapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectShiftSwapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectShiftSwapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectShiftSwapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectShiftSwapRequestValidationError) ErrorName() string {
+	return "RejectShiftSwapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectShiftSwapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectShiftSwapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectShiftSwapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectShiftSwapRequestValidationError{}
+
+// Validate checks the field values on RejectShiftSwapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectShiftSwapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectShiftSwapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectShiftSwapResponseMultiError, or nil if none found.
+func (m *RejectShiftSwapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectShiftSwapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RejectShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RejectShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RejectShiftSwapResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RejectShiftSwapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectShiftSwapResponseMultiError is an error wrapping multiple validation
+// errors returned by RejectShiftSwapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RejectShiftSwapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectShiftSwapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectShiftSwapResponseMultiError) AllErrors() []error { return m }
+
+// RejectShiftSwapResponseValidationError is the validation error returned by
+// RejectShiftSwapResponse.Validate if the designated constraints aren't met.
+type RejectShiftSwapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectShiftSwapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectShiftSwapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectShiftSwapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectShiftSwapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectShiftSwapResponseValidationError) ErrorName() string {
+	return "RejectShiftSwapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectShiftSwapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectShiftSwapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectShiftSwapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectShiftSwapResponseValidationError{}
+
+// Validate checks the field values on CancelShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelShiftSwapRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelShiftSwapRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelShiftSwapRequestMultiError, or nil if none found.
+func (m *CancelShiftSwapRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelShiftSwapRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelShiftSwapRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelShiftSwapRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelShiftSwapRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelShiftSwapRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelShiftSwapRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelShiftSwapRequestMultiError) AllErrors() []error { return m }
+
+// CancelShiftSwapRequestValidationError is the validation error returned by
+// CancelShiftSwapRequest.Validate if the designated constraints aren't met.
+type CancelShiftSwapRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelShiftSwapRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelShiftSwapRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelShiftSwapRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelShiftSwapRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelShiftSwapRequestValidationError) ErrorName() string {
+	return "CancelShiftSwapRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelShiftSwapRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelShiftSwapRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelShiftSwapRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelShiftSwapRequestValidationError{}
+
+// Validate checks the field values on CancelShiftSwapResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelShiftSwapResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelShiftSwapResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelShiftSwapResponseMultiError, or nil if none found.
+func (m *CancelShiftSwapResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelShiftSwapResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelShiftSwapResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelShiftSwapResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelShiftSwapResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelShiftSwapResponseMultiError is an error wrapping multiple validation
+// errors returned by CancelShiftSwapResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CancelShiftSwapResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelShiftSwapResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelShiftSwapResponseMultiError) AllErrors() []error { return m }
+
+// CancelShiftSwapResponseValidationError is the validation error returned by
+// CancelShiftSwapResponse.Validate if the designated constraints aren't met.
+type CancelShiftSwapResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelShiftSwapResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelShiftSwapResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelShiftSwapResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelShiftSwapResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelShiftSwapResponseValidationError) ErrorName() string {
+	return "CancelShiftSwapResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelShiftSwapResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelShiftSwapResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelShiftSwapResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelShiftSwapResponseValidationError{}
+
+// Validate checks the field values on ShiftSwapShift with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ShiftSwapShift) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShiftSwapShift with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShiftSwapShiftMultiError,
+// or nil if none found.
+func (m *ShiftSwapShift) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShiftSwapShift) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Date
+
+	// no validation rules for Start
+
+	// no validation rules for End
+
+	if len(errors) > 0 {
+		return ShiftSwapShiftMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShiftSwapShiftMultiError is an error wrapping multiple validation errors
+// returned by ShiftSwapShift.ValidateAll() if the designated constraints
+// aren't met.
+type ShiftSwapShiftMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShiftSwapShiftMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShiftSwapShiftMultiError) AllErrors() []error { return m }
+
+// ShiftSwapShiftValidationError is the validation error returned by
+// ShiftSwapShift.Validate if the designated constraints aren't met.
+type ShiftSwapShiftValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShiftSwapShiftValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShiftSwapShiftValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShiftSwapShiftValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShiftSwapShiftValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShiftSwapShiftValidationError) ErrorName() string { return "ShiftSwapShiftValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShiftSwapShiftValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShiftSwapShift.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShiftSwapShiftValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShiftSwapShiftValidationError{}
+
+// Validate checks the field values on ShiftSwap with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ShiftSwap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShiftSwap with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShiftSwapMultiError, or nil
+// if none found.
+func (m *ShiftSwap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShiftSwap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DomainId
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetCreatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftSwapValidationError{
+				field:  "CreatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedAt
+
+	if all {
+		switch v := interface{}(m.GetUpdatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftSwapValidationError{
+				field:  "UpdatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWorkingSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "WorkingSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "WorkingSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkingSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftSwapValidationError{
+				field:  "WorkingSchedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAgent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAgent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftSwapValidationError{
+				field:  "Agent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetShift()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "Shift",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "Shift",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShift()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftSwapValidationError{
+				field:  "Shift",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTargetAgent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "TargetAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "TargetAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTargetAgent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftSwapValidationError{
+				field:  "TargetAgent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCounterAgent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "CounterAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "CounterAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCounterAgent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftSwapValidationError{
+				field:  "CounterAgent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCounterShift()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "CounterShift",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "CounterShift",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCounterShift()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftSwapValidationError{
+				field:  "CounterShift",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for State
+
+	// no validation rules for AcceptedAt
+
+	// no validation rules for DecidedAt
+
+	if all {
+		switch v := interface{}(m.GetDecidedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "DecidedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftSwapValidationError{
+					field:  "DecidedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecidedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftSwapValidationError{
+				field:  "DecidedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Note != nil {
+		// no validation rules for Note
+	}
+
+	if m.Comment != nil {
+		// no validation rules for Comment
+	}
+
+	if len(errors) > 0 {
+		return ShiftSwapMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShiftSwapMultiError is an error wrapping multiple validation errors returned
+// by ShiftSwap.ValidateAll() if the designated constraints aren't met.
+type ShiftSwapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShiftSwapMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShiftSwapMultiError) AllErrors() []error { return m }
+
+// ShiftSwapValidationError is the validation error returned by
+// ShiftSwap.Validate if the designated constraints aren't met.
+type ShiftSwapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShiftSwapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShiftSwapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShiftSwapValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShiftSwapValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShiftSwapValidationError) ErrorName() string { return "ShiftSwapValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShiftSwapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShiftSwap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShiftSwapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShiftSwapValidationError{}
@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: shift_swap.proto
+
+package wfm
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ShiftSwapService_CreateShiftSwap_FullMethodName   = "/wfm.ShiftSwapService/CreateShiftSwap"
+	ShiftSwapService_ReadShiftSwap_FullMethodName     = "/wfm.ShiftSwapService/ReadShiftSwap"
+	ShiftSwapService_SearchShiftSwap_FullMethodName   = "/wfm.ShiftSwapService/SearchShiftSwap"
+	ShiftSwapService_AcceptShiftSwap_FullMethodName   = "/wfm.ShiftSwapService/AcceptShiftSwap"
+	ShiftSwapService_ValidateShiftSwap_FullMethodName = "/wfm.ShiftSwapService/ValidateShiftSwap"
+	ShiftSwapService_ApproveShiftSwap_FullMethodName  = "/wfm.ShiftSwapService/ApproveShiftSwap"
+	ShiftSwapService_RejectShiftSwap_FullMethodName   = "/wfm.ShiftSwapService/RejectShiftSwap"
+	ShiftSwapService_CancelShiftSwap_FullMethodName   = "/wfm.ShiftSwapService/CancelShiftSwap"
+)
+
+// ShiftSwapServiceClient is the client API for ShiftSwapService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ShiftSwapServiceClient interface {
+	// Offers a shift of the signed-in agent, the shift should belong to an active working schedule.
+	CreateShiftSwap(ctx context.Context, in *CreateShiftSwapRequest, opts ...grpc.CallOption) (*CreateShiftSwapResponse, error)
+	ReadShiftSwap(ctx context.Context, in *ReadShiftSwapRequest, opts ...grpc.CallOption) (*ReadShiftSwapResponse, error)
+	// Searches shift swaps by working schedules, agents and swap states.
+	SearchShiftSwap(ctx context.Context, in *SearchShiftSwapRequest, opts ...grpc.CallOption) (*SearchShiftSwapResponse, error)
+	// Accepts an open offer on behalf of the signed-in agent, optionally proposing an exchange.
+	AcceptShiftSwap(ctx context.Context, in *AcceptShiftSwapRequest, opts ...grpc.CallOption) (*AcceptShiftSwapResponse, error)
+	// Checks skills, absences, rest rules and working condition limits of both agents as if the swap is approved.
+	ValidateShiftSwap(ctx context.Context, in *ValidateShiftSwapRequest, opts ...grpc.CallOption) (*ValidateShiftSwapResponse, error)
+	// Approves an accepted swap without violations and reassigns the shifts between the agents.
+	ApproveShiftSwap(ctx context.Context, in *ApproveShiftSwapRequest, opts ...grpc.CallOption) (*ApproveShiftSwapResponse, error)
+	// Rejects an open or accepted swap.
+	RejectShiftSwap(ctx context.Context, in *RejectShiftSwapRequest, opts ...grpc.CallOption) (*RejectShiftSwapResponse, error)
+	// Cancels an open or accepted swap offered by the signed-in agent.
+	CancelShiftSwap(ctx context.Context, in *CancelShiftSwapRequest, opts ...grpc.CallOption) (*CancelShiftSwapResponse, error)
+}
+
+type shiftSwapServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewShiftSwapServiceClient(cc grpc.ClientConnInterface) ShiftSwapServiceClient {
+	return &shiftSwapServiceClient{cc}
+}
+
+func (c *shiftSwapServiceClient) CreateShiftSwap(ctx context.Context, in *CreateShiftSwapRequest, opts ...grpc.CallOption) (*CreateShiftSwapResponse, error) {
+	out := new(CreateShiftSwapResponse)
+	err := c.cc.Invoke(ctx, ShiftSwapService_CreateShiftSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapServiceClient) ReadShiftSwap(ctx context.Context, in *ReadShiftSwapRequest, opts ...grpc.CallOption) (*ReadShiftSwapResponse, error) {
+	out := new(ReadShiftSwapResponse)
+	err := c.cc.Invoke(ctx, ShiftSwapService_ReadShiftSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapServiceClient) SearchShiftSwap(ctx context.Context, in *SearchShiftSwapRequest, opts ...grpc.CallOption) (*SearchShiftSwapResponse, error) {
+	out := new(SearchShiftSwapResponse)
+	err := c.cc.Invoke(ctx, ShiftSwapService_SearchShiftSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapServiceClient) AcceptShiftSwap(ctx context.Context, in *AcceptShiftSwapRequest, opts ...grpc.CallOption) (*AcceptShiftSwapResponse, error) {
+	out := new(AcceptShiftSwapResponse)
+	err := c.cc.Invoke(ctx, ShiftSwapService_AcceptShiftSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapServiceClient) ValidateShiftSwap(ctx context.Context, in *ValidateShiftSwapRequest, opts ...grpc.CallOption) (*ValidateShiftSwapResponse, error) {
+	out := new(ValidateShiftSwapResponse)
+	err := c.cc.Invoke(ctx, ShiftSwapService_ValidateShiftSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapServiceClient) ApproveShiftSwap(ctx context.Context, in *ApproveShiftSwapRequest, opts ...grpc.CallOption) (*ApproveShiftSwapResponse, error) {
+	out := new(ApproveShiftSwapResponse)
+	err := c.cc.Invoke(ctx, ShiftSwapService_ApproveShiftSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapServiceClient) RejectShiftSwap(ctx context.Context, in *RejectShiftSwapRequest, opts ...grpc.CallOption) (*RejectShiftSwapResponse, error) {
+	out := new(RejectShiftSwapResponse)
+	err := c.cc.Invoke(ctx, ShiftSwapService_RejectShiftSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *shiftSwapServiceClient) CancelShiftSwap(ctx context.Context, in *CancelShiftSwapRequest, opts ...grpc.CallOption) (*CancelShiftSwapResponse, error) {
+	out := new(CancelShiftSwapResponse)
+	err := c.cc.Invoke(ctx, ShiftSwapService_CancelShiftSwap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ShiftSwapServiceServer is the server API for ShiftSwapService service.
+// All implementations must embed UnimplementedShiftSwapServiceServer
+// for forward compatibility
+type ShiftSwapServiceServer interface {
+	// Offers a shift of the signed-in agent, the shift should belong to an active working schedule.
+	CreateShiftSwap(context.Context, *CreateShiftSwapRequest) (*CreateShiftSwapResponse, error)
+	ReadShiftSwap(context.Context, *ReadShiftSwapRequest) (*ReadShiftSwapResponse, error)
+	// Searches shift swaps by working schedules, agents and swap states.
+	SearchShiftSwap(context.Context, *SearchShiftSwapRequest) (*SearchShiftSwapResponse, error)
+	// Accepts an open offer on behalf of the signed-in agent, optionally proposing an exchange.
+	AcceptShiftSwap(context.Context, *AcceptShiftSwapRequest) (*AcceptShiftSwapResponse, error)
+	// Checks skills, absences, rest rules and working condition limits of both agents as if the swap is approved.
+	ValidateShiftSwap(context.Context, *ValidateShiftSwapRequest) (*ValidateShiftSwapResponse, error)
+	// Approves an accepted swap without violations and reassigns the shifts between the agents.
+	ApproveShiftSwap(context.Context, *ApproveShiftSwapRequest) (*ApproveShiftSwapResponse, error)
+	// Rejects an open or accepted swap.
+	RejectShiftSwap(context.Context, *RejectShiftSwapRequest) (*RejectShiftSwapResponse, error)
+	// Cancels an open or accepted swap offered by the signed-in agent.
+	CancelShiftSwap(context.Context, *CancelShiftSwapRequest) (*CancelShiftSwapResponse, error)
+	mustEmbedUnimplementedShiftSwapServiceServer()
+}
+
+// UnimplementedShiftSwapServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedShiftSwapServiceServer struct {
+}
+
+func (UnimplementedShiftSwapServiceServer) CreateShiftSwap(context.Context, *CreateShiftSwapRequest) (*CreateShiftSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShiftSwap not implemented")
+}
+func (UnimplementedShiftSwapServiceServer) ReadShiftSwap(context.Context, *ReadShiftSwapRequest) (*ReadShiftSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadShiftSwap not implemented")
+}
+func (UnimplementedShiftSwapServiceServer) SearchShiftSwap(context.Context, *SearchShiftSwapRequest) (*SearchShiftSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchShiftSwap not implemented")
+}
+func (UnimplementedShiftSwapServiceServer) AcceptShiftSwap(context.Context, *AcceptShiftSwapRequest) (*AcceptShiftSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptShiftSwap not implemented")
+}
+func (UnimplementedShiftSwapServiceServer) ValidateShiftSwap(context.Context, *ValidateShiftSwapRequest) (*ValidateShiftSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateShiftSwap not implemented")
+}
+func (UnimplementedShiftSwapServiceServer) ApproveShiftSwap(context.Context, *ApproveShiftSwapRequest) (*ApproveShiftSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveShiftSwap not implemented")
+}
+func (UnimplementedShiftSwapServiceServer) RejectShiftSwap(context.Context, *RejectShiftSwapRequest) (*RejectShiftSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectShiftSwap not implemented")
+}
+func (UnimplementedShiftSwapServiceServer) CancelShiftSwap(context.Context, *CancelShiftSwapRequest) (*CancelShiftSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelShiftSwap not implemented")
+}
+func (UnimplementedShiftSwapServiceServer) mustEmbedUnimplementedShiftSwapServiceServer() {}
+
+// UnsafeShiftSwapServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ShiftSwapServiceServer will
+// result in compilation errors.
+type UnsafeShiftSwapServiceServer interface {
+	mustEmbedUnimplementedShiftSwapServiceServer()
+}
+
+func RegisterShiftSwapServiceServer(s grpc.ServiceRegistrar, srv ShiftSwapServiceServer) {
+	s.RegisterService(&ShiftSwapService_ServiceDesc, srv)
+}
+
+func _ShiftSwapService_CreateShiftSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShiftSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapServiceServer).CreateShiftSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapService_CreateShiftSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapServiceServer).CreateShiftSwap(ctx, req.(*CreateShiftSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapService_ReadShiftSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadShiftSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapServiceServer).ReadShiftSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapService_ReadShiftSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapServiceServer).ReadShiftSwap(ctx, req.(*ReadShiftSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapService_SearchShiftSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchShiftSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapServiceServer).SearchShiftSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapService_SearchShiftSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapServiceServer).SearchShiftSwap(ctx, req.(*SearchShiftSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapService_AcceptShiftSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptShiftSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapServiceServer).AcceptShiftSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapService_AcceptShiftSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapServiceServer).AcceptShiftSwap(ctx, req.(*AcceptShiftSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapService_ValidateShiftSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateShiftSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapServiceServer).ValidateShiftSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapService_ValidateShiftSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapServiceServer).ValidateShiftSwap(ctx, req.(*ValidateShiftSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapService_ApproveShiftSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveShiftSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapServiceServer).ApproveShiftSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapService_ApproveShiftSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapServiceServer).ApproveShiftSwap(ctx, req.(*ApproveShiftSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapService_RejectShiftSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectShiftSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapServiceServer).RejectShiftSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapService_RejectShiftSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapServiceServer).RejectShiftSwap(ctx, req.(*RejectShiftSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ShiftSwapService_CancelShiftSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelShiftSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShiftSwapServiceServer).CancelShiftSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShiftSwapService_CancelShiftSwap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShiftSwapServiceServer).CancelShiftSwap(ctx, req.(*CancelShiftSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ShiftSwapService_ServiceDesc is the grpc.ServiceDesc for ShiftSwapService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ShiftSwapService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wfm.ShiftSwapService",
+	HandlerType: (*ShiftSwapServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateShiftSwap",
+			Handler:    _ShiftSwapService_CreateShiftSwap_Handler,
+		},
+		{
+			MethodName: "ReadShiftSwap",
+			Handler:    _ShiftSwapService_ReadShiftSwap_Handler,
+		},
+		{
+			MethodName: "SearchShiftSwap",
+			Handler:    _ShiftSwapService_SearchShiftSwap_Handler,
+		},
+		{
+			MethodName: "AcceptShiftSwap",
+			Handler:    _ShiftSwapService_AcceptShiftSwap_Handler,
+		},
+		{
+			MethodName: "ValidateShiftSwap",
+			Handler:    _ShiftSwapService_ValidateShiftSwap_Handler,
+		},
+		{
+			MethodName: "ApproveShiftSwap",
+			Handler:    _ShiftSwapService_ApproveShiftSwap_Handler,
+		},
+		{
+			MethodName: "RejectShiftSwap",
+			Handler:    _ShiftSwapService_RejectShiftSwap_Handler,
+		},
+		{
+			MethodName: "CancelShiftSwap",
+			Handler:    _ShiftSwapService_CancelShiftSwap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shift_swap.proto",
+}
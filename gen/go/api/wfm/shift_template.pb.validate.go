@@ -1368,6 +1368,137 @@ var _ interface {
 	ErrorName() string
 } = ShiftTemplateTimeValidationError{}
 
+// Validate checks the field values on ShiftTemplateRotationDay with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ShiftTemplateRotationDay) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ShiftTemplateRotationDay with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ShiftTemplateRotationDayMultiError, or nil if none found.
+func (m *ShiftTemplateRotationDay) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ShiftTemplateRotationDay) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShiftTemplateRotationDayValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShiftTemplateRotationDayValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShiftTemplateRotationDayValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ShiftTemplateRotationDayMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShiftTemplateRotationDayMultiError is an error wrapping multiple validation
+// errors returned by ShiftTemplateRotationDay.ValidateAll() if the designated
+// constraints aren't met.
+type ShiftTemplateRotationDayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShiftTemplateRotationDayMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShiftTemplateRotationDayMultiError) AllErrors() []error { return m }
+
+// ShiftTemplateRotationDayValidationError is the validation error returned by
+// ShiftTemplateRotationDay.Validate if the designated constraints aren't met.
+type ShiftTemplateRotationDayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShiftTemplateRotationDayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShiftTemplateRotationDayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShiftTemplateRotationDayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShiftTemplateRotationDayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShiftTemplateRotationDayValidationError) ErrorName() string {
+	return "ShiftTemplateRotationDayValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ShiftTemplateRotationDayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShiftTemplateRotationDay.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShiftTemplateRotationDayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShiftTemplateRotationDayValidationError{}
+
 // Validate checks the field values on ShiftTemplate with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1492,6 +1623,40 @@ func (m *ShiftTemplate) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetRotation() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ShiftTemplateValidationError{
+						field:  fmt.Sprintf("Rotation[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ShiftTemplateValidationError{
+						field:  fmt.Sprintf("Rotation[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ShiftTemplateValidationError{
+					field:  fmt.Sprintf("Rotation[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if m.Description != nil {
 		// no validation rules for Description
 	}
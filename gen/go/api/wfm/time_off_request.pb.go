@@ -0,0 +1,1354 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: time_off_request.proto
+
+package wfm
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TimeOffRequestState int32
+
+const (
+	TimeOffRequestState_TIME_OFF_REQUEST_STATE_UNSPECIFIED TimeOffRequestState = 0
+	TimeOffRequestState_TIME_OFF_REQUEST_STATE_PENDING     TimeOffRequestState = 1
+	TimeOffRequestState_TIME_OFF_REQUEST_STATE_APPROVED    TimeOffRequestState = 2
+	TimeOffRequestState_TIME_OFF_REQUEST_STATE_REJECTED    TimeOffRequestState = 3
+	TimeOffRequestState_TIME_OFF_REQUEST_STATE_CANCELLED   TimeOffRequestState = 4
+)
+
+// Enum value maps for TimeOffRequestState.
+var (
+	TimeOffRequestState_name = map[int32]string{
+		0: "TIME_OFF_REQUEST_STATE_UNSPECIFIED",
+		1: "TIME_OFF_REQUEST_STATE_PENDING",
+		2: "TIME_OFF_REQUEST_STATE_APPROVED",
+		3: "TIME_OFF_REQUEST_STATE_REJECTED",
+		4: "TIME_OFF_REQUEST_STATE_CANCELLED",
+	}
+	TimeOffRequestState_value = map[string]int32{
+		"TIME_OFF_REQUEST_STATE_UNSPECIFIED": 0,
+		"TIME_OFF_REQUEST_STATE_PENDING":     1,
+		"TIME_OFF_REQUEST_STATE_APPROVED":    2,
+		"TIME_OFF_REQUEST_STATE_REJECTED":    3,
+		"TIME_OFF_REQUEST_STATE_CANCELLED":   4,
+	}
+)
+
+func (x TimeOffRequestState) Enum() *TimeOffRequestState {
+	p := new(TimeOffRequestState)
+	*p = x
+	return p
+}
+
+func (x TimeOffRequestState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeOffRequestState) Descriptor() protoreflect.EnumDescriptor {
+	return file_time_off_request_proto_enumTypes[0].Descriptor()
+}
+
+func (TimeOffRequestState) Type() protoreflect.EnumType {
+	return &file_time_off_request_proto_enumTypes[0]
+}
+
+func (x TimeOffRequestState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeOffRequestState.Descriptor instead.
+func (TimeOffRequestState) EnumDescriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{0}
+}
+
+type CreateTimeOffRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *TimeOffRequest `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CreateTimeOffRequestRequest) Reset() {
+	*x = CreateTimeOffRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTimeOffRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimeOffRequestRequest) ProtoMessage() {}
+
+func (x *CreateTimeOffRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimeOffRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreateTimeOffRequestRequest) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateTimeOffRequestRequest) GetItem() *TimeOffRequest {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type CreateTimeOffRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *TimeOffRequest `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CreateTimeOffRequestResponse) Reset() {
+	*x = CreateTimeOffRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateTimeOffRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTimeOffRequestResponse) ProtoMessage() {}
+
+func (x *CreateTimeOffRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTimeOffRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreateTimeOffRequestResponse) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTimeOffRequestResponse) GetItem() *TimeOffRequest {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ReadTimeOffRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReadTimeOffRequestRequest) Reset() {
+	*x = ReadTimeOffRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadTimeOffRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTimeOffRequestRequest) ProtoMessage() {}
+
+func (x *ReadTimeOffRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadTimeOffRequestRequest.ProtoReflect.Descriptor instead.
+func (*ReadTimeOffRequestRequest) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadTimeOffRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReadTimeOffRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *TimeOffRequest `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ReadTimeOffRequestResponse) Reset() {
+	*x = ReadTimeOffRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadTimeOffRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadTimeOffRequestResponse) ProtoMessage() {}
+
+func (x *ReadTimeOffRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadTimeOffRequestResponse.ProtoReflect.Descriptor instead.
+func (*ReadTimeOffRequestResponse) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReadTimeOffRequestResponse) GetItem() *TimeOffRequest {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type SearchTimeOffRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Q            *string               `protobuf:"bytes,1,opt,name=q,proto3,oneof" json:"q,omitempty"` // Searches by agent name.
+	Page         *int32                `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Size         *int32                `protobuf:"varint,3,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	Sort         *string               `protobuf:"bytes,4,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	Fields       []string              `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	AgentId      []int64               `protobuf:"varint,6,rep,packed,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	TeamId       []int64               `protobuf:"varint,7,rep,packed,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	SupervisorId []int64               `protobuf:"varint,8,rep,packed,name=supervisor_id,json=supervisorId,proto3" json:"supervisor_id,omitempty"`
+	State        []TimeOffRequestState `protobuf:"varint,9,rep,packed,name=state,proto3,enum=wfm.TimeOffRequestState" json:"state,omitempty"`
+}
+
+func (x *SearchTimeOffRequestRequest) Reset() {
+	*x = SearchTimeOffRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchTimeOffRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTimeOffRequestRequest) ProtoMessage() {}
+
+func (x *SearchTimeOffRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTimeOffRequestRequest.ProtoReflect.Descriptor instead.
+func (*SearchTimeOffRequestRequest) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchTimeOffRequestRequest) GetQ() string {
+	if x != nil && x.Q != nil {
+		return *x.Q
+	}
+	return ""
+}
+
+func (x *SearchTimeOffRequestRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *SearchTimeOffRequestRequest) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *SearchTimeOffRequestRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+func (x *SearchTimeOffRequestRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *SearchTimeOffRequestRequest) GetAgentId() []int64 {
+	if x != nil {
+		return x.AgentId
+	}
+	return nil
+}
+
+func (x *SearchTimeOffRequestRequest) GetTeamId() []int64 {
+	if x != nil {
+		return x.TeamId
+	}
+	return nil
+}
+
+func (x *SearchTimeOffRequestRequest) GetSupervisorId() []int64 {
+	if x != nil {
+		return x.SupervisorId
+	}
+	return nil
+}
+
+func (x *SearchTimeOffRequestRequest) GetState() []TimeOffRequestState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+type SearchTimeOffRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*TimeOffRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next  bool              `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *SearchTimeOffRequestResponse) Reset() {
+	*x = SearchTimeOffRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchTimeOffRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTimeOffRequestResponse) ProtoMessage() {}
+
+func (x *SearchTimeOffRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTimeOffRequestResponse.ProtoReflect.Descriptor instead.
+func (*SearchTimeOffRequestResponse) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchTimeOffRequestResponse) GetItems() []*TimeOffRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchTimeOffRequestResponse) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+type ApproveTimeOffRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comment *string `protobuf:"bytes,2,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+}
+
+func (x *ApproveTimeOffRequestRequest) Reset() {
+	*x = ApproveTimeOffRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveTimeOffRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTimeOffRequestRequest) ProtoMessage() {}
+
+func (x *ApproveTimeOffRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTimeOffRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveTimeOffRequestRequest) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ApproveTimeOffRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ApproveTimeOffRequestRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type ApproveTimeOffRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *TimeOffRequest `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ApproveTimeOffRequestResponse) Reset() {
+	*x = ApproveTimeOffRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveTimeOffRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveTimeOffRequestResponse) ProtoMessage() {}
+
+func (x *ApproveTimeOffRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveTimeOffRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveTimeOffRequestResponse) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ApproveTimeOffRequestResponse) GetItem() *TimeOffRequest {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type RejectTimeOffRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comment *string `protobuf:"bytes,2,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+}
+
+func (x *RejectTimeOffRequestRequest) Reset() {
+	*x = RejectTimeOffRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectTimeOffRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTimeOffRequestRequest) ProtoMessage() {}
+
+func (x *RejectTimeOffRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTimeOffRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectTimeOffRequestRequest) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RejectTimeOffRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RejectTimeOffRequestRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+type RejectTimeOffRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *TimeOffRequest `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *RejectTimeOffRequestResponse) Reset() {
+	*x = RejectTimeOffRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectTimeOffRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectTimeOffRequestResponse) ProtoMessage() {}
+
+func (x *RejectTimeOffRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectTimeOffRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectTimeOffRequestResponse) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RejectTimeOffRequestResponse) GetItem() *TimeOffRequest {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type CancelTimeOffRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelTimeOffRequestRequest) Reset() {
+	*x = CancelTimeOffRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTimeOffRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTimeOffRequestRequest) ProtoMessage() {}
+
+func (x *CancelTimeOffRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTimeOffRequestRequest.ProtoReflect.Descriptor instead.
+func (*CancelTimeOffRequestRequest) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CancelTimeOffRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CancelTimeOffRequestResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *TimeOffRequest `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CancelTimeOffRequestResponse) Reset() {
+	*x = CancelTimeOffRequestResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelTimeOffRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTimeOffRequestResponse) ProtoMessage() {}
+
+func (x *CancelTimeOffRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTimeOffRequestResponse.ProtoReflect.Descriptor instead.
+func (*CancelTimeOffRequestResponse) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CancelTimeOffRequestResponse) GetItem() *TimeOffRequest {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type TimeOffRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId  int64               `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	CreatedAt int64               `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy *LookupEntity       `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt int64               `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedBy *LookupEntity       `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	Agent     *LookupEntity       `protobuf:"bytes,7,opt,name=agent,proto3" json:"agent,omitempty"`
+	DateFrom  int64               `protobuf:"varint,9,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo    int64               `protobuf:"varint,10,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	State     TimeOffRequestState `protobuf:"varint,11,opt,name=state,proto3,enum=wfm.TimeOffRequestState" json:"state,omitempty"`
+	// Agent's note to the supervisor.
+	Note *string `protobuf:"bytes,12,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	// Supervisor's comment on approval or rejection.
+	Comment   *string       `protobuf:"bytes,13,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+	DecidedAt int64         `protobuf:"varint,14,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	DecidedBy *LookupEntity `protobuf:"bytes,15,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"`
+	// Absence type from the domain catalogue.
+	Type *LookupEntity `protobuf:"bytes,16,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *TimeOffRequest) Reset() {
+	*x = TimeOffRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_time_off_request_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimeOffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeOffRequest) ProtoMessage() {}
+
+func (x *TimeOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_time_off_request_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeOffRequest.ProtoReflect.Descriptor instead.
+func (*TimeOffRequest) Descriptor() ([]byte, []int) {
+	return file_time_off_request_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TimeOffRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TimeOffRequest) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *TimeOffRequest) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *TimeOffRequest) GetCreatedBy() *LookupEntity {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *TimeOffRequest) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *TimeOffRequest) GetUpdatedBy() *LookupEntity {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return nil
+}
+
+func (x *TimeOffRequest) GetAgent() *LookupEntity {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *TimeOffRequest) GetDateFrom() int64 {
+	if x != nil {
+		return x.DateFrom
+	}
+	return 0
+}
+
+func (x *TimeOffRequest) GetDateTo() int64 {
+	if x != nil {
+		return x.DateTo
+	}
+	return 0
+}
+
+func (x *TimeOffRequest) GetState() TimeOffRequestState {
+	if x != nil {
+		return x.State
+	}
+	return TimeOffRequestState_TIME_OFF_REQUEST_STATE_UNSPECIFIED
+}
+
+func (x *TimeOffRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *TimeOffRequest) GetComment() string {
+	if x != nil && x.Comment != nil {
+		return *x.Comment
+	}
+	return ""
+}
+
+func (x *TimeOffRequest) GetDecidedAt() int64 {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return 0
+}
+
+func (x *TimeOffRequest) GetDecidedBy() *LookupEntity {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return nil
+}
+
+func (x *TimeOffRequest) GetType() *LookupEntity {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+var File_time_off_request_proto protoreflect.FileDescriptor
+
+var file_time_off_request_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x77, 0x66, 0x6d, 0x1a, 0x1b, 0x62,
+	0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x13, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x62, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4f,
+	0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x47, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0x37, 0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01,
+	0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x45, 0x0a, 0x1a, 0x52, 0x65, 0x61,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0xef, 0x02, 0x0a, 0x1b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x4f,
+	0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0xfa, 0x01, 0x48, 0x00, 0x52, 0x01, 0x71, 0x88, 0x01, 0x01, 0x12,
+	0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x06, 0x74, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x70, 0x65,
+	0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x0c, 0x73, 0x75, 0x70, 0x65, 0x72, 0x76, 0x69, 0x73, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x42, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x12, 0xba, 0x48, 0x0f, 0x92, 0x01, 0x0c, 0x18, 0x01,
+	0x22, 0x08, 0x82, 0x01, 0x05, 0x10, 0x01, 0x22, 0x01, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x71, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f,
+	0x72, 0x74, 0x22, 0x5d, 0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65,
+	0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x65, 0x78,
+	0x74, 0x22, 0x65, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x1d, 0x41, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x22, 0x64, 0x0a, 0x1b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x47, 0x0a, 0x1c, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x22, 0x39, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4f,
+	0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x1c,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xb1, 0x05, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x08, 0x64, 0x61,
+	0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x30, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x3a, 0x5c, 0xba, 0x48, 0x59, 0x1a, 0x57, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x20, 0x73, 0x68,
+	0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20,
+	0x74, 0x68, 0x61, 0x6e, 0x20, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x1a, 0x1e,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x20, 0x3e, 0x3d, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x2a, 0xd1, 0x01, 0x0a, 0x13, 0x54, 0x69,
+	0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x26, 0x0a, 0x22, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x5f, 0x52, 0x45,
+	0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x54, 0x49, 0x4d,
+	0x45, 0x5f, 0x4f, 0x46, 0x46, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a,
+	0x1f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x4f, 0x46, 0x46, 0x5f, 0x52,
+	0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x4a,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x54, 0x49, 0x4d, 0x45, 0x5f,
+	0x4f, 0x46, 0x46, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x32, 0xe8, 0x06,
+	0x0a, 0x15, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d,
+	0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6f, 0x66, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x7e, 0x0a, 0x12,
+	0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x27, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
+	0x1b, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7f, 0x0a, 0x14,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x90, 0xb5, 0x18, 0x01, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x92, 0x01,
+	0x0a, 0x15, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32,
+	0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f,
+	0x77, 0x66, 0x6d, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x31, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a, 0x22,
+	0x22, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x8e, 0x01, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69,
+	0x6d, 0x65, 0x4f, 0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x4f,
+	0x66, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x31, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01, 0x2a,
+	0x22, 0x22, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x1a, 0x12, 0x8a, 0xb5, 0x18, 0x0e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f,
+	0x61, 0x62, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d, 0x3b, 0x77, 0x66, 0x6d, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_time_off_request_proto_rawDescOnce sync.Once
+	file_time_off_request_proto_rawDescData = file_time_off_request_proto_rawDesc
+)
+
+func file_time_off_request_proto_rawDescGZIP() []byte {
+	file_time_off_request_proto_rawDescOnce.Do(func() {
+		file_time_off_request_proto_rawDescData = protoimpl.X.CompressGZIP(file_time_off_request_proto_rawDescData)
+	})
+	return file_time_off_request_proto_rawDescData
+}
+
+var file_time_off_request_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_time_off_request_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_time_off_request_proto_goTypes = []interface{}{
+	(TimeOffRequestState)(0),              // 0: wfm.TimeOffRequestState
+	(*CreateTimeOffRequestRequest)(nil),   // 1: wfm.CreateTimeOffRequestRequest
+	(*CreateTimeOffRequestResponse)(nil),  // 2: wfm.CreateTimeOffRequestResponse
+	(*ReadTimeOffRequestRequest)(nil),     // 3: wfm.ReadTimeOffRequestRequest
+	(*ReadTimeOffRequestResponse)(nil),    // 4: wfm.ReadTimeOffRequestResponse
+	(*SearchTimeOffRequestRequest)(nil),   // 5: wfm.SearchTimeOffRequestRequest
+	(*SearchTimeOffRequestResponse)(nil),  // 6: wfm.SearchTimeOffRequestResponse
+	(*ApproveTimeOffRequestRequest)(nil),  // 7: wfm.ApproveTimeOffRequestRequest
+	(*ApproveTimeOffRequestResponse)(nil), // 8: wfm.ApproveTimeOffRequestResponse
+	(*RejectTimeOffRequestRequest)(nil),   // 9: wfm.RejectTimeOffRequestRequest
+	(*RejectTimeOffRequestResponse)(nil),  // 10: wfm.RejectTimeOffRequestResponse
+	(*CancelTimeOffRequestRequest)(nil),   // 11: wfm.CancelTimeOffRequestRequest
+	(*CancelTimeOffRequestResponse)(nil),  // 12: wfm.CancelTimeOffRequestResponse
+	(*TimeOffRequest)(nil),                // 13: wfm.TimeOffRequest
+	(*LookupEntity)(nil),                  // 14: wfm.LookupEntity
+}
+var file_time_off_request_proto_depIdxs = []int32{
+	13, // 0: wfm.CreateTimeOffRequestRequest.item:type_name -> wfm.TimeOffRequest
+	13, // 1: wfm.CreateTimeOffRequestResponse.item:type_name -> wfm.TimeOffRequest
+	13, // 2: wfm.ReadTimeOffRequestResponse.item:type_name -> wfm.TimeOffRequest
+	0,  // 3: wfm.SearchTimeOffRequestRequest.state:type_name -> wfm.TimeOffRequestState
+	13, // 4: wfm.SearchTimeOffRequestResponse.items:type_name -> wfm.TimeOffRequest
+	13, // 5: wfm.ApproveTimeOffRequestResponse.item:type_name -> wfm.TimeOffRequest
+	13, // 6: wfm.RejectTimeOffRequestResponse.item:type_name -> wfm.TimeOffRequest
+	13, // 7: wfm.CancelTimeOffRequestResponse.item:type_name -> wfm.TimeOffRequest
+	14, // 8: wfm.TimeOffRequest.created_by:type_name -> wfm.LookupEntity
+	14, // 9: wfm.TimeOffRequest.updated_by:type_name -> wfm.LookupEntity
+	14, // 10: wfm.TimeOffRequest.agent:type_name -> wfm.LookupEntity
+	0,  // 11: wfm.TimeOffRequest.state:type_name -> wfm.TimeOffRequestState
+	14, // 12: wfm.TimeOffRequest.decided_by:type_name -> wfm.LookupEntity
+	14, // 13: wfm.TimeOffRequest.type:type_name -> wfm.LookupEntity
+	1,  // 14: wfm.TimeOffRequestService.CreateTimeOffRequest:input_type -> wfm.CreateTimeOffRequestRequest
+	3,  // 15: wfm.TimeOffRequestService.ReadTimeOffRequest:input_type -> wfm.ReadTimeOffRequestRequest
+	5,  // 16: wfm.TimeOffRequestService.SearchTimeOffRequest:input_type -> wfm.SearchTimeOffRequestRequest
+	7,  // 17: wfm.TimeOffRequestService.ApproveTimeOffRequest:input_type -> wfm.ApproveTimeOffRequestRequest
+	9,  // 18: wfm.TimeOffRequestService.RejectTimeOffRequest:input_type -> wfm.RejectTimeOffRequestRequest
+	11, // 19: wfm.TimeOffRequestService.CancelTimeOffRequest:input_type -> wfm.CancelTimeOffRequestRequest
+	2,  // 20: wfm.TimeOffRequestService.CreateTimeOffRequest:output_type -> wfm.CreateTimeOffRequestResponse
+	4,  // 21: wfm.TimeOffRequestService.ReadTimeOffRequest:output_type -> wfm.ReadTimeOffRequestResponse
+	6,  // 22: wfm.TimeOffRequestService.SearchTimeOffRequest:output_type -> wfm.SearchTimeOffRequestResponse
+	8,  // 23: wfm.TimeOffRequestService.ApproveTimeOffRequest:output_type -> wfm.ApproveTimeOffRequestResponse
+	10, // 24: wfm.TimeOffRequestService.RejectTimeOffRequest:output_type -> wfm.RejectTimeOffRequestResponse
+	12, // 25: wfm.TimeOffRequestService.CancelTimeOffRequest:output_type -> wfm.CancelTimeOffRequestResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_time_off_request_proto_init() }
+func file_time_off_request_proto_init() {
+	if File_time_off_request_proto != nil {
+		return
+	}
+	file_lookup_proto_init()
+	file_agent_absence_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_time_off_request_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTimeOffRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTimeOffRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadTimeOffRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadTimeOffRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchTimeOffRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchTimeOffRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveTimeOffRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveTimeOffRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectTimeOffRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectTimeOffRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTimeOffRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelTimeOffRequestResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_time_off_request_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimeOffRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_time_off_request_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_time_off_request_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_time_off_request_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_time_off_request_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_time_off_request_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_time_off_request_proto_goTypes,
+		DependencyIndexes: file_time_off_request_proto_depIdxs,
+		EnumInfos:         file_time_off_request_proto_enumTypes,
+		MessageInfos:      file_time_off_request_proto_msgTypes,
+	}.Build()
+	File_time_off_request_proto = out.File
+	file_time_off_request_proto_rawDesc = nil
+	file_time_off_request_proto_goTypes = nil
+	file_time_off_request_proto_depIdxs = nil
+}
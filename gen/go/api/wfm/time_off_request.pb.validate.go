@@ -0,0 +1,1781 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: time_off_request.proto
+
+package wfm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTimeOffRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTimeOffRequestRequestMultiError, or nil if none found.
+func (m *CreateTimeOffRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTimeOffRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTimeOffRequestRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTimeOffRequestRequestValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTimeOffRequestRequestValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTimeOffRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTimeOffRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateTimeOffRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateTimeOffRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTimeOffRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTimeOffRequestRequestMultiError) AllErrors() []error { return m }
+
+// CreateTimeOffRequestRequestValidationError is the validation error returned
+// by CreateTimeOffRequestRequest.Validate if the designated constraints
+// aren't met.
+type CreateTimeOffRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTimeOffRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTimeOffRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTimeOffRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTimeOffRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTimeOffRequestRequestValidationError) ErrorName() string {
+	return "CreateTimeOffRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTimeOffRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTimeOffRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTimeOffRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTimeOffRequestRequestValidationError{}
+
+// Validate checks the field values on CreateTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTimeOffRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTimeOffRequestResponseMultiError, or nil if none found.
+func (m *CreateTimeOffRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTimeOffRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTimeOffRequestResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTimeOffRequestResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTimeOffRequestResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTimeOffRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTimeOffRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateTimeOffRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateTimeOffRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTimeOffRequestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTimeOffRequestResponseMultiError) AllErrors() []error { return m }
+
+// CreateTimeOffRequestResponseValidationError is the validation error returned
+// by CreateTimeOffRequestResponse.Validate if the designated constraints
+// aren't met.
+type CreateTimeOffRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTimeOffRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTimeOffRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTimeOffRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTimeOffRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTimeOffRequestResponseValidationError) ErrorName() string {
+	return "CreateTimeOffRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTimeOffRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTimeOffRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTimeOffRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTimeOffRequestResponseValidationError{}
+
+// Validate checks the field values on ReadTimeOffRequestRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadTimeOffRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadTimeOffRequestRequestMultiError, or nil if none found.
+func (m *ReadTimeOffRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadTimeOffRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ReadTimeOffRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadTimeOffRequestRequestMultiError is an error wrapping multiple validation
+// errors returned by ReadTimeOffRequestRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ReadTimeOffRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadTimeOffRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadTimeOffRequestRequestMultiError) AllErrors() []error { return m }
+
+// ReadTimeOffRequestRequestValidationError is the validation error returned by
+// ReadTimeOffRequestRequest.Validate if the designated constraints aren't met.
+type ReadTimeOffRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadTimeOffRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadTimeOffRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadTimeOffRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadTimeOffRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadTimeOffRequestRequestValidationError) ErrorName() string {
+	return "ReadTimeOffRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadTimeOffRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadTimeOffRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadTimeOffRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadTimeOffRequestRequestValidationError{}
+
+// Validate checks the field values on ReadTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadTimeOffRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadTimeOffRequestResponseMultiError, or nil if none found.
+func (m *ReadTimeOffRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadTimeOffRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadTimeOffRequestResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadTimeOffRequestResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadTimeOffRequestResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReadTimeOffRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadTimeOffRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by ReadTimeOffRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ReadTimeOffRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadTimeOffRequestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadTimeOffRequestResponseMultiError) AllErrors() []error { return m }
+
+// ReadTimeOffRequestResponseValidationError is the validation error returned
+// by ReadTimeOffRequestResponse.Validate if the designated constraints aren't met.
+type ReadTimeOffRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadTimeOffRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadTimeOffRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadTimeOffRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadTimeOffRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadTimeOffRequestResponseValidationError) ErrorName() string {
+	return "ReadTimeOffRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadTimeOffRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadTimeOffRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadTimeOffRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadTimeOffRequestResponseValidationError{}
+
+// Validate checks the field values on SearchTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchTimeOffRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchTimeOffRequestRequestMultiError, or nil if none found.
+func (m *SearchTimeOffRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchTimeOffRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Q != nil {
+		// no validation rules for Q
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.Size != nil {
+		// no validation rules for Size
+	}
+
+	if m.Sort != nil {
+		// no validation rules for Sort
+	}
+
+	if len(errors) > 0 {
+		return SearchTimeOffRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchTimeOffRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by SearchTimeOffRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type SearchTimeOffRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchTimeOffRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchTimeOffRequestRequestMultiError) AllErrors() []error { return m }
+
+// SearchTimeOffRequestRequestValidationError is the validation error returned
+// by SearchTimeOffRequestRequest.Validate if the designated constraints
+// aren't met.
+type SearchTimeOffRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchTimeOffRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchTimeOffRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchTimeOffRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchTimeOffRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchTimeOffRequestRequestValidationError) ErrorName() string {
+	return "SearchTimeOffRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchTimeOffRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchTimeOffRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchTimeOffRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchTimeOffRequestRequestValidationError{}
+
+// Validate checks the field values on SearchTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SearchTimeOffRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SearchTimeOffRequestResponseMultiError, or nil if none found.
+func (m *SearchTimeOffRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchTimeOffRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchTimeOffRequestResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchTimeOffRequestResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchTimeOffRequestResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Next
+
+	if len(errors) > 0 {
+		return SearchTimeOffRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchTimeOffRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by SearchTimeOffRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SearchTimeOffRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchTimeOffRequestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchTimeOffRequestResponseMultiError) AllErrors() []error { return m }
+
+// SearchTimeOffRequestResponseValidationError is the validation error returned
+// by SearchTimeOffRequestResponse.Validate if the designated constraints
+// aren't met.
+type SearchTimeOffRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchTimeOffRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchTimeOffRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchTimeOffRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchTimeOffRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchTimeOffRequestResponseValidationError) ErrorName() string {
+	return "SearchTimeOffRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchTimeOffRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchTimeOffRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchTimeOffRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchTimeOffRequestResponseValidationError{}
+
+// Validate checks the field values on ApproveTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveTimeOffRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApproveTimeOffRequestRequestMultiError, or nil if none found.
+func (m *ApproveTimeOffRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveTimeOffRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Comment != nil {
+		// no validation rules for Comment
+	}
+
+	if len(errors) > 0 {
+		return ApproveTimeOffRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveTimeOffRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by ApproveTimeOffRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ApproveTimeOffRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveTimeOffRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveTimeOffRequestRequestMultiError) AllErrors() []error { return m }
+
+// ApproveTimeOffRequestRequestValidationError is the validation error returned
+// by ApproveTimeOffRequestRequest.Validate if the designated constraints
+// aren't met.
+type ApproveTimeOffRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveTimeOffRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveTimeOffRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveTimeOffRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveTimeOffRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveTimeOffRequestRequestValidationError) ErrorName() string {
+	return "ApproveTimeOffRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveTimeOffRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveTimeOffRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveTimeOffRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveTimeOffRequestRequestValidationError{}
+
+// Validate checks the field values on ApproveTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveTimeOffRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveTimeOffRequestResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ApproveTimeOffRequestResponseMultiError, or nil if none found.
+func (m *ApproveTimeOffRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveTimeOffRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveTimeOffRequestResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveTimeOffRequestResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveTimeOffRequestResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApproveTimeOffRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveTimeOffRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by ApproveTimeOffRequestResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ApproveTimeOffRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveTimeOffRequestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveTimeOffRequestResponseMultiError) AllErrors() []error { return m }
+
+// ApproveTimeOffRequestResponseValidationError is the validation error
+// returned by ApproveTimeOffRequestResponse.Validate if the designated
+// constraints aren't met.
+type ApproveTimeOffRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveTimeOffRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveTimeOffRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveTimeOffRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveTimeOffRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveTimeOffRequestResponseValidationError) ErrorName() string {
+	return "ApproveTimeOffRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveTimeOffRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveTimeOffRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveTimeOffRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveTimeOffRequestResponseValidationError{}
+
+// Validate checks the field values on RejectTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectTimeOffRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectTimeOffRequestRequestMultiError, or nil if none found.
+func (m *RejectTimeOffRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectTimeOffRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if m.Comment != nil {
+		// no validation rules for Comment
+	}
+
+	if len(errors) > 0 {
+		return RejectTimeOffRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectTimeOffRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by RejectTimeOffRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RejectTimeOffRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectTimeOffRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectTimeOffRequestRequestMultiError) AllErrors() []error { return m }
+
+// RejectTimeOffRequestRequestValidationError is the validation error returned
+// by RejectTimeOffRequestRequest.Validate if the designated constraints
+// aren't met.
+type RejectTimeOffRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectTimeOffRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectTimeOffRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectTimeOffRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectTimeOffRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectTimeOffRequestRequestValidationError) ErrorName() string {
+	return "RejectTimeOffRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectTimeOffRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectTimeOffRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectTimeOffRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectTimeOffRequestRequestValidationError{}
+
+// Validate checks the field values on RejectTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectTimeOffRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectTimeOffRequestResponseMultiError, or nil if none found.
+func (m *RejectTimeOffRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectTimeOffRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RejectTimeOffRequestResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RejectTimeOffRequestResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RejectTimeOffRequestResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RejectTimeOffRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectTimeOffRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by RejectTimeOffRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type RejectTimeOffRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectTimeOffRequestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectTimeOffRequestResponseMultiError) AllErrors() []error { return m }
+
+// RejectTimeOffRequestResponseValidationError is the validation error returned
+// by RejectTimeOffRequestResponse.Validate if the designated constraints
+// aren't met.
+type RejectTimeOffRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectTimeOffRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectTimeOffRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectTimeOffRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectTimeOffRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectTimeOffRequestResponseValidationError) ErrorName() string {
+	return "RejectTimeOffRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectTimeOffRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectTimeOffRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectTimeOffRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectTimeOffRequestResponseValidationError{}
+
+// Validate checks the field values on CancelTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelTimeOffRequestRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelTimeOffRequestRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelTimeOffRequestRequestMultiError, or nil if none found.
+func (m *CancelTimeOffRequestRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelTimeOffRequestRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return CancelTimeOffRequestRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelTimeOffRequestRequestMultiError is an error wrapping multiple
+// validation errors returned by CancelTimeOffRequestRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CancelTimeOffRequestRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelTimeOffRequestRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelTimeOffRequestRequestMultiError) AllErrors() []error { return m }
+
+// CancelTimeOffRequestRequestValidationError is the validation error returned
+// by CancelTimeOffRequestRequest.Validate if the designated constraints
+// aren't met.
+type CancelTimeOffRequestRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelTimeOffRequestRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelTimeOffRequestRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelTimeOffRequestRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelTimeOffRequestRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelTimeOffRequestRequestValidationError) ErrorName() string {
+	return "CancelTimeOffRequestRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelTimeOffRequestRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelTimeOffRequestRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelTimeOffRequestRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelTimeOffRequestRequestValidationError{}
+
+// Validate checks the field values on CancelTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelTimeOffRequestResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelTimeOffRequestResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelTimeOffRequestResponseMultiError, or nil if none found.
+func (m *CancelTimeOffRequestResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelTimeOffRequestResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CancelTimeOffRequestResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CancelTimeOffRequestResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CancelTimeOffRequestResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CancelTimeOffRequestResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelTimeOffRequestResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelTimeOffRequestResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CancelTimeOffRequestResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelTimeOffRequestResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelTimeOffRequestResponseMultiError) AllErrors() []error { return m }
+
+// CancelTimeOffRequestResponseValidationError is the validation error returned
+// by CancelTimeOffRequestResponse.Validate if the designated constraints
+// aren't met.
+type CancelTimeOffRequestResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelTimeOffRequestResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelTimeOffRequestResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelTimeOffRequestResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelTimeOffRequestResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelTimeOffRequestResponseValidationError) ErrorName() string {
+	return "CancelTimeOffRequestResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelTimeOffRequestResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelTimeOffRequestResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelTimeOffRequestResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelTimeOffRequestResponseValidationError{}
+
+// Validate checks the field values on TimeOffRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimeOffRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimeOffRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimeOffRequestMultiError,
+// or nil if none found.
+func (m *TimeOffRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimeOffRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DomainId
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetCreatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeOffRequestValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeOffRequestValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeOffRequestValidationError{
+				field:  "CreatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UpdatedAt
+
+	if all {
+		switch v := interface{}(m.GetUpdatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeOffRequestValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeOffRequestValidationError{
+					field:  "UpdatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeOffRequestValidationError{
+				field:  "UpdatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAgent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeOffRequestValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeOffRequestValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAgent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeOffRequestValidationError{
+				field:  "Agent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DateFrom
+
+	// no validation rules for DateTo
+
+	// no validation rules for State
+
+	// no validation rules for DecidedAt
+
+	if all {
+		switch v := interface{}(m.GetDecidedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeOffRequestValidationError{
+					field:  "DecidedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeOffRequestValidationError{
+					field:  "DecidedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDecidedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeOffRequestValidationError{
+				field:  "DecidedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetType()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimeOffRequestValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimeOffRequestValidationError{
+					field:  "Type",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetType()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimeOffRequestValidationError{
+				field:  "Type",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Note != nil {
+		// no validation rules for Note
+	}
+
+	if m.Comment != nil {
+		// no validation rules for Comment
+	}
+
+	if len(errors) > 0 {
+		return TimeOffRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimeOffRequestMultiError is an error wrapping multiple validation errors
+// returned by TimeOffRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TimeOffRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimeOffRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimeOffRequestMultiError) AllErrors() []error { return m }
+
+// TimeOffRequestValidationError is the validation error returned by
+// TimeOffRequest.Validate if the designated constraints aren't met.
+type TimeOffRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimeOffRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimeOffRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimeOffRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimeOffRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimeOffRequestValidationError) ErrorName() string { return "TimeOffRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimeOffRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimeOffRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimeOffRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimeOffRequestValidationError{}
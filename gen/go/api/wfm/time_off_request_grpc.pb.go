@@ -0,0 +1,304 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: time_off_request.proto
+
+package wfm
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	TimeOffRequestService_CreateTimeOffRequest_FullMethodName  = "/wfm.TimeOffRequestService/CreateTimeOffRequest"
+	TimeOffRequestService_ReadTimeOffRequest_FullMethodName    = "/wfm.TimeOffRequestService/ReadTimeOffRequest"
+	TimeOffRequestService_SearchTimeOffRequest_FullMethodName  = "/wfm.TimeOffRequestService/SearchTimeOffRequest"
+	TimeOffRequestService_ApproveTimeOffRequest_FullMethodName = "/wfm.TimeOffRequestService/ApproveTimeOffRequest"
+	TimeOffRequestService_RejectTimeOffRequest_FullMethodName  = "/wfm.TimeOffRequestService/RejectTimeOffRequest"
+	TimeOffRequestService_CancelTimeOffRequest_FullMethodName  = "/wfm.TimeOffRequestService/CancelTimeOffRequest"
+)
+
+// TimeOffRequestServiceClient is the client API for TimeOffRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TimeOffRequestServiceClient interface {
+	// Submits a time-off request on behalf of the signed-in agent.
+	CreateTimeOffRequest(ctx context.Context, in *CreateTimeOffRequestRequest, opts ...grpc.CallOption) (*CreateTimeOffRequestResponse, error)
+	ReadTimeOffRequest(ctx context.Context, in *ReadTimeOffRequestRequest, opts ...grpc.CallOption) (*ReadTimeOffRequestResponse, error)
+	// Searches time-off requests by agents, their teams, supervisors and request states.
+	SearchTimeOffRequest(ctx context.Context, in *SearchTimeOffRequestRequest, opts ...grpc.CallOption) (*SearchTimeOffRequestResponse, error)
+	// Approves a pending time-off request and creates agent absences for its days.
+	ApproveTimeOffRequest(ctx context.Context, in *ApproveTimeOffRequestRequest, opts ...grpc.CallOption) (*ApproveTimeOffRequestResponse, error)
+	// Rejects a pending time-off request.
+	RejectTimeOffRequest(ctx context.Context, in *RejectTimeOffRequestRequest, opts ...grpc.CallOption) (*RejectTimeOffRequestResponse, error)
+	// Cancels a pending time-off request of the signed-in agent.
+	CancelTimeOffRequest(ctx context.Context, in *CancelTimeOffRequestRequest, opts ...grpc.CallOption) (*CancelTimeOffRequestResponse, error)
+}
+
+type timeOffRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimeOffRequestServiceClient(cc grpc.ClientConnInterface) TimeOffRequestServiceClient {
+	return &timeOffRequestServiceClient{cc}
+}
+
+func (c *timeOffRequestServiceClient) CreateTimeOffRequest(ctx context.Context, in *CreateTimeOffRequestRequest, opts ...grpc.CallOption) (*CreateTimeOffRequestResponse, error) {
+	out := new(CreateTimeOffRequestResponse)
+	err := c.cc.Invoke(ctx, TimeOffRequestService_CreateTimeOffRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeOffRequestServiceClient) ReadTimeOffRequest(ctx context.Context, in *ReadTimeOffRequestRequest, opts ...grpc.CallOption) (*ReadTimeOffRequestResponse, error) {
+	out := new(ReadTimeOffRequestResponse)
+	err := c.cc.Invoke(ctx, TimeOffRequestService_ReadTimeOffRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeOffRequestServiceClient) SearchTimeOffRequest(ctx context.Context, in *SearchTimeOffRequestRequest, opts ...grpc.CallOption) (*SearchTimeOffRequestResponse, error) {
+	out := new(SearchTimeOffRequestResponse)
+	err := c.cc.Invoke(ctx, TimeOffRequestService_SearchTimeOffRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeOffRequestServiceClient) ApproveTimeOffRequest(ctx context.Context, in *ApproveTimeOffRequestRequest, opts ...grpc.CallOption) (*ApproveTimeOffRequestResponse, error) {
+	out := new(ApproveTimeOffRequestResponse)
+	err := c.cc.Invoke(ctx, TimeOffRequestService_ApproveTimeOffRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeOffRequestServiceClient) RejectTimeOffRequest(ctx context.Context, in *RejectTimeOffRequestRequest, opts ...grpc.CallOption) (*RejectTimeOffRequestResponse, error) {
+	out := new(RejectTimeOffRequestResponse)
+	err := c.cc.Invoke(ctx, TimeOffRequestService_RejectTimeOffRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timeOffRequestServiceClient) CancelTimeOffRequest(ctx context.Context, in *CancelTimeOffRequestRequest, opts ...grpc.CallOption) (*CancelTimeOffRequestResponse, error) {
+	out := new(CancelTimeOffRequestResponse)
+	err := c.cc.Invoke(ctx, TimeOffRequestService_CancelTimeOffRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimeOffRequestServiceServer is the server API for TimeOffRequestService service.
+// All implementations must embed UnimplementedTimeOffRequestServiceServer
+// for forward compatibility
+type TimeOffRequestServiceServer interface {
+	// Submits a time-off request on behalf of the signed-in agent.
+	CreateTimeOffRequest(context.Context, *CreateTimeOffRequestRequest) (*CreateTimeOffRequestResponse, error)
+	ReadTimeOffRequest(context.Context, *ReadTimeOffRequestRequest) (*ReadTimeOffRequestResponse, error)
+	// Searches time-off requests by agents, their teams, supervisors and request states.
+	SearchTimeOffRequest(context.Context, *SearchTimeOffRequestRequest) (*SearchTimeOffRequestResponse, error)
+	// Approves a pending time-off request and creates agent absences for its days.
+	ApproveTimeOffRequest(context.Context, *ApproveTimeOffRequestRequest) (*ApproveTimeOffRequestResponse, error)
+	// Rejects a pending time-off request.
+	RejectTimeOffRequest(context.Context, *RejectTimeOffRequestRequest) (*RejectTimeOffRequestResponse, error)
+	// Cancels a pending time-off request of the signed-in agent.
+	CancelTimeOffRequest(context.Context, *CancelTimeOffRequestRequest) (*CancelTimeOffRequestResponse, error)
+	mustEmbedUnimplementedTimeOffRequestServiceServer()
+}
+
+// UnimplementedTimeOffRequestServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTimeOffRequestServiceServer struct {
+}
+
+func (UnimplementedTimeOffRequestServiceServer) CreateTimeOffRequest(context.Context, *CreateTimeOffRequestRequest) (*CreateTimeOffRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTimeOffRequest not implemented")
+}
+func (UnimplementedTimeOffRequestServiceServer) ReadTimeOffRequest(context.Context, *ReadTimeOffRequestRequest) (*ReadTimeOffRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadTimeOffRequest not implemented")
+}
+func (UnimplementedTimeOffRequestServiceServer) SearchTimeOffRequest(context.Context, *SearchTimeOffRequestRequest) (*SearchTimeOffRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTimeOffRequest not implemented")
+}
+func (UnimplementedTimeOffRequestServiceServer) ApproveTimeOffRequest(context.Context, *ApproveTimeOffRequestRequest) (*ApproveTimeOffRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTimeOffRequest not implemented")
+}
+func (UnimplementedTimeOffRequestServiceServer) RejectTimeOffRequest(context.Context, *RejectTimeOffRequestRequest) (*RejectTimeOffRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTimeOffRequest not implemented")
+}
+func (UnimplementedTimeOffRequestServiceServer) CancelTimeOffRequest(context.Context, *CancelTimeOffRequestRequest) (*CancelTimeOffRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTimeOffRequest not implemented")
+}
+func (UnimplementedTimeOffRequestServiceServer) mustEmbedUnimplementedTimeOffRequestServiceServer() {}
+
+// UnsafeTimeOffRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimeOffRequestServiceServer will
+// result in compilation errors.
+type UnsafeTimeOffRequestServiceServer interface {
+	mustEmbedUnimplementedTimeOffRequestServiceServer()
+}
+
+func RegisterTimeOffRequestServiceServer(s grpc.ServiceRegistrar, srv TimeOffRequestServiceServer) {
+	s.RegisterService(&TimeOffRequestService_ServiceDesc, srv)
+}
+
+func _TimeOffRequestService_CreateTimeOffRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTimeOffRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeOffRequestServiceServer).CreateTimeOffRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeOffRequestService_CreateTimeOffRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeOffRequestServiceServer).CreateTimeOffRequest(ctx, req.(*CreateTimeOffRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeOffRequestService_ReadTimeOffRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadTimeOffRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeOffRequestServiceServer).ReadTimeOffRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeOffRequestService_ReadTimeOffRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeOffRequestServiceServer).ReadTimeOffRequest(ctx, req.(*ReadTimeOffRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeOffRequestService_SearchTimeOffRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTimeOffRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeOffRequestServiceServer).SearchTimeOffRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeOffRequestService_SearchTimeOffRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeOffRequestServiceServer).SearchTimeOffRequest(ctx, req.(*SearchTimeOffRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeOffRequestService_ApproveTimeOffRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTimeOffRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeOffRequestServiceServer).ApproveTimeOffRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeOffRequestService_ApproveTimeOffRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeOffRequestServiceServer).ApproveTimeOffRequest(ctx, req.(*ApproveTimeOffRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeOffRequestService_RejectTimeOffRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTimeOffRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeOffRequestServiceServer).RejectTimeOffRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeOffRequestService_RejectTimeOffRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeOffRequestServiceServer).RejectTimeOffRequest(ctx, req.(*RejectTimeOffRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimeOffRequestService_CancelTimeOffRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTimeOffRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimeOffRequestServiceServer).CancelTimeOffRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimeOffRequestService_CancelTimeOffRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimeOffRequestServiceServer).CancelTimeOffRequest(ctx, req.(*CancelTimeOffRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimeOffRequestService_ServiceDesc is the grpc.ServiceDesc for TimeOffRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimeOffRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wfm.TimeOffRequestService",
+	HandlerType: (*TimeOffRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTimeOffRequest",
+			Handler:    _TimeOffRequestService_CreateTimeOffRequest_Handler,
+		},
+		{
+			MethodName: "ReadTimeOffRequest",
+			Handler:    _TimeOffRequestService_ReadTimeOffRequest_Handler,
+		},
+		{
+			MethodName: "SearchTimeOffRequest",
+			Handler:    _TimeOffRequestService_SearchTimeOffRequest_Handler,
+		},
+		{
+			MethodName: "ApproveTimeOffRequest",
+			Handler:    _TimeOffRequestService_ApproveTimeOffRequest_Handler,
+		},
+		{
+			MethodName: "RejectTimeOffRequest",
+			Handler:    _TimeOffRequestService_RejectTimeOffRequest_Handler,
+		},
+		{
+			MethodName: "CancelTimeOffRequest",
+			Handler:    _TimeOffRequestService_CancelTimeOffRequest_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "time_off_request.proto",
+}
@@ -23,6 +23,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type WorkingScheduleCloneShifts int32
+
+const (
+	// Same as WORKING_SCHEDULE_CLONE_SHIFTS_NONE.
+	WorkingScheduleCloneShifts_WORKING_SCHEDULE_CLONE_SHIFTS_UNSPECIFIED WorkingScheduleCloneShifts = 0
+	// Only agents and extra skills are copied.
+	WorkingScheduleCloneShifts_WORKING_SCHEDULE_CLONE_SHIFTS_NONE WorkingScheduleCloneShifts = 1
+	// Shifts of the whole source weeks are repeated over the new period on the same weekdays.
+	WorkingScheduleCloneShifts_WORKING_SCHEDULE_CLONE_SHIFTS_WEEKDAY WorkingScheduleCloneShifts = 2
+	// Shifts of the first source weeks are repeated over the new period on the same weekdays.
+	WorkingScheduleCloneShifts_WORKING_SCHEDULE_CLONE_SHIFTS_ROLLING WorkingScheduleCloneShifts = 3
+)
+
+// Enum value maps for WorkingScheduleCloneShifts.
+var (
+	WorkingScheduleCloneShifts_name = map[int32]string{
+		0: "WORKING_SCHEDULE_CLONE_SHIFTS_UNSPECIFIED",
+		1: "WORKING_SCHEDULE_CLONE_SHIFTS_NONE",
+		2: "WORKING_SCHEDULE_CLONE_SHIFTS_WEEKDAY",
+		3: "WORKING_SCHEDULE_CLONE_SHIFTS_ROLLING",
+	}
+	WorkingScheduleCloneShifts_value = map[string]int32{
+		"WORKING_SCHEDULE_CLONE_SHIFTS_UNSPECIFIED": 0,
+		"WORKING_SCHEDULE_CLONE_SHIFTS_NONE":        1,
+		"WORKING_SCHEDULE_CLONE_SHIFTS_WEEKDAY":     2,
+		"WORKING_SCHEDULE_CLONE_SHIFTS_ROLLING":     3,
+	}
+)
+
+func (x WorkingScheduleCloneShifts) Enum() *WorkingScheduleCloneShifts {
+	p := new(WorkingScheduleCloneShifts)
+	*p = x
+	return p
+}
+
+func (x WorkingScheduleCloneShifts) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WorkingScheduleCloneShifts) Descriptor() protoreflect.EnumDescriptor {
+	return file_working_schedule_proto_enumTypes[0].Descriptor()
+}
+
+func (WorkingScheduleCloneShifts) Type() protoreflect.EnumType {
+	return &file_working_schedule_proto_enumTypes[0]
+}
+
+func (x WorkingScheduleCloneShifts) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WorkingScheduleCloneShifts.Descriptor instead.
+func (WorkingScheduleCloneShifts) EnumDescriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{0}
+}
+
 type WorkingScheduleState int32
 
 const (
@@ -62,11 +118,11 @@ func (x WorkingScheduleState) String() string {
 }
 
 func (WorkingScheduleState) Descriptor() protoreflect.EnumDescriptor {
-	return file_working_schedule_proto_enumTypes[0].Descriptor()
+	return file_working_schedule_proto_enumTypes[1].Descriptor()
 }
 
 func (WorkingScheduleState) Type() protoreflect.EnumType {
-	return &file_working_schedule_proto_enumTypes[0]
+	return &file_working_schedule_proto_enumTypes[1]
 }
 
 func (x WorkingScheduleState) Number() protoreflect.EnumNumber {
@@ -75,7 +131,57 @@ func (x WorkingScheduleState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WorkingScheduleState.Descriptor instead.
 func (WorkingScheduleState) EnumDescriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{0}
+	return file_working_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+type ViolationSeverity int32
+
+const (
+	ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED ViolationSeverity = 0
+	ViolationSeverity_VIOLATION_SEVERITY_WARNING     ViolationSeverity = 1
+	// Blocks working schedule from leaving the draft state.
+	ViolationSeverity_VIOLATION_SEVERITY_ERROR ViolationSeverity = 2
+)
+
+// Enum value maps for ViolationSeverity.
+var (
+	ViolationSeverity_name = map[int32]string{
+		0: "VIOLATION_SEVERITY_UNSPECIFIED",
+		1: "VIOLATION_SEVERITY_WARNING",
+		2: "VIOLATION_SEVERITY_ERROR",
+	}
+	ViolationSeverity_value = map[string]int32{
+		"VIOLATION_SEVERITY_UNSPECIFIED": 0,
+		"VIOLATION_SEVERITY_WARNING":     1,
+		"VIOLATION_SEVERITY_ERROR":       2,
+	}
+)
+
+func (x ViolationSeverity) Enum() *ViolationSeverity {
+	p := new(ViolationSeverity)
+	*p = x
+	return p
+}
+
+func (x ViolationSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ViolationSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_working_schedule_proto_enumTypes[2].Descriptor()
+}
+
+func (ViolationSeverity) Type() protoreflect.EnumType {
+	return &file_working_schedule_proto_enumTypes[2]
+}
+
+func (x ViolationSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ViolationSeverity.Descriptor instead.
+func (ViolationSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{2}
 }
 
 type CreateWorkingScheduleRequest struct {
@@ -376,6 +482,127 @@ func (x *ReadWorkingScheduleForecastResponse) GetItems() map[int64]*WorkingSched
 	return nil
 }
 
+type ReadWorkingScheduleCoverageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to the working schedule period.
+	Date *FilterBetween `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Interval length in minutes, defaults to 60.
+	Granularity *int32 `protobuf:"varint,3,opt,name=granularity,proto3,oneof" json:"granularity,omitempty"`
+	// Counts only shifts with any of the skills.
+	SkillId []int64 `protobuf:"varint,4,rep,packed,name=skill_id,json=skillId,proto3" json:"skill_id,omitempty"`
+}
+
+func (x *ReadWorkingScheduleCoverageRequest) Reset() {
+	*x = ReadWorkingScheduleCoverageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadWorkingScheduleCoverageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadWorkingScheduleCoverageRequest) ProtoMessage() {}
+
+func (x *ReadWorkingScheduleCoverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadWorkingScheduleCoverageRequest.ProtoReflect.Descriptor instead.
+func (*ReadWorkingScheduleCoverageRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReadWorkingScheduleCoverageRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReadWorkingScheduleCoverageRequest) GetDate() *FilterBetween {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *ReadWorkingScheduleCoverageRequest) GetGranularity() int32 {
+	if x != nil && x.Granularity != nil {
+		return *x.Granularity
+	}
+	return 0
+}
+
+func (x *ReadWorkingScheduleCoverageRequest) GetSkillId() []int64 {
+	if x != nil {
+		return x.SkillId
+	}
+	return nil
+}
+
+type ReadWorkingScheduleCoverageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*WorkingScheduleStaffing `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ReadWorkingScheduleCoverageResponse) Reset() {
+	*x = ReadWorkingScheduleCoverageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadWorkingScheduleCoverageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadWorkingScheduleCoverageResponse) ProtoMessage() {}
+
+func (x *ReadWorkingScheduleCoverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadWorkingScheduleCoverageResponse.ProtoReflect.Descriptor instead.
+func (*ReadWorkingScheduleCoverageResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReadWorkingScheduleCoverageResponse) GetItems() []*WorkingScheduleStaffing {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 type SearchWorkingScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -391,7 +618,7 @@ type SearchWorkingScheduleRequest struct {
 func (x *SearchWorkingScheduleRequest) Reset() {
 	*x = SearchWorkingScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[6]
+		mi := &file_working_schedule_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -404,7 +631,7 @@ func (x *SearchWorkingScheduleRequest) String() string {
 func (*SearchWorkingScheduleRequest) ProtoMessage() {}
 
 func (x *SearchWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[6]
+	mi := &file_working_schedule_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,7 +644,7 @@ func (x *SearchWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchWorkingScheduleRequest.ProtoReflect.Descriptor instead.
 func (*SearchWorkingScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{6}
+	return file_working_schedule_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SearchWorkingScheduleRequest) GetQ() string {
@@ -467,7 +694,7 @@ type SearchWorkingScheduleResponse struct {
 func (x *SearchWorkingScheduleResponse) Reset() {
 	*x = SearchWorkingScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[7]
+		mi := &file_working_schedule_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -480,7 +707,7 @@ func (x *SearchWorkingScheduleResponse) String() string {
 func (*SearchWorkingScheduleResponse) ProtoMessage() {}
 
 func (x *SearchWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[7]
+	mi := &file_working_schedule_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +720,7 @@ func (x *SearchWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchWorkingScheduleResponse.ProtoReflect.Descriptor instead.
 func (*SearchWorkingScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{7}
+	return file_working_schedule_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SearchWorkingScheduleResponse) GetItems() []*WorkingSchedule {
@@ -521,7 +748,7 @@ type UpdateWorkingScheduleRequest struct {
 func (x *UpdateWorkingScheduleRequest) Reset() {
 	*x = UpdateWorkingScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[8]
+		mi := &file_working_schedule_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -534,7 +761,7 @@ func (x *UpdateWorkingScheduleRequest) String() string {
 func (*UpdateWorkingScheduleRequest) ProtoMessage() {}
 
 func (x *UpdateWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[8]
+	mi := &file_working_schedule_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -547,7 +774,7 @@ func (x *UpdateWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWorkingScheduleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWorkingScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{8}
+	return file_working_schedule_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateWorkingScheduleRequest) GetItem() *WorkingSchedule {
@@ -568,7 +795,7 @@ type UpdateWorkingScheduleResponse struct {
 func (x *UpdateWorkingScheduleResponse) Reset() {
 	*x = UpdateWorkingScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[9]
+		mi := &file_working_schedule_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -581,7 +808,7 @@ func (x *UpdateWorkingScheduleResponse) String() string {
 func (*UpdateWorkingScheduleResponse) ProtoMessage() {}
 
 func (x *UpdateWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[9]
+	mi := &file_working_schedule_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -594,7 +821,7 @@ func (x *UpdateWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWorkingScheduleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateWorkingScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{9}
+	return file_working_schedule_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateWorkingScheduleResponse) GetItem() *WorkingSchedule {
@@ -616,7 +843,7 @@ type UpdateWorkingScheduleAddAgentsRequest struct {
 func (x *UpdateWorkingScheduleAddAgentsRequest) Reset() {
 	*x = UpdateWorkingScheduleAddAgentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[10]
+		mi := &file_working_schedule_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -629,7 +856,7 @@ func (x *UpdateWorkingScheduleAddAgentsRequest) String() string {
 func (*UpdateWorkingScheduleAddAgentsRequest) ProtoMessage() {}
 
 func (x *UpdateWorkingScheduleAddAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[10]
+	mi := &file_working_schedule_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -642,7 +869,7 @@ func (x *UpdateWorkingScheduleAddAgentsRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use UpdateWorkingScheduleAddAgentsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWorkingScheduleAddAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{10}
+	return file_working_schedule_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *UpdateWorkingScheduleAddAgentsRequest) GetId() int64 {
@@ -670,7 +897,7 @@ type UpdateWorkingScheduleAddAgentsResponse struct {
 func (x *UpdateWorkingScheduleAddAgentsResponse) Reset() {
 	*x = UpdateWorkingScheduleAddAgentsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[11]
+		mi := &file_working_schedule_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -683,7 +910,7 @@ func (x *UpdateWorkingScheduleAddAgentsResponse) String() string {
 func (*UpdateWorkingScheduleAddAgentsResponse) ProtoMessage() {}
 
 func (x *UpdateWorkingScheduleAddAgentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[11]
+	mi := &file_working_schedule_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -696,7 +923,7 @@ func (x *UpdateWorkingScheduleAddAgentsResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use UpdateWorkingScheduleAddAgentsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateWorkingScheduleAddAgentsResponse) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{11}
+	return file_working_schedule_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateWorkingScheduleAddAgentsResponse) GetAgents() []*LookupEntity {
@@ -718,7 +945,7 @@ type UpdateWorkingScheduleRemoveAgentRequest struct {
 func (x *UpdateWorkingScheduleRemoveAgentRequest) Reset() {
 	*x = UpdateWorkingScheduleRemoveAgentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[12]
+		mi := &file_working_schedule_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -731,7 +958,7 @@ func (x *UpdateWorkingScheduleRemoveAgentRequest) String() string {
 func (*UpdateWorkingScheduleRemoveAgentRequest) ProtoMessage() {}
 
 func (x *UpdateWorkingScheduleRemoveAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[12]
+	mi := &file_working_schedule_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +971,7 @@ func (x *UpdateWorkingScheduleRemoveAgentRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use UpdateWorkingScheduleRemoveAgentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWorkingScheduleRemoveAgentRequest) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{12}
+	return file_working_schedule_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateWorkingScheduleRemoveAgentRequest) GetId() int64 {
@@ -772,7 +999,7 @@ type UpdateWorkingScheduleRemoveAgentResponse struct {
 func (x *UpdateWorkingScheduleRemoveAgentResponse) Reset() {
 	*x = UpdateWorkingScheduleRemoveAgentResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[13]
+		mi := &file_working_schedule_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -785,7 +1012,7 @@ func (x *UpdateWorkingScheduleRemoveAgentResponse) String() string {
 func (*UpdateWorkingScheduleRemoveAgentResponse) ProtoMessage() {}
 
 func (x *UpdateWorkingScheduleRemoveAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[13]
+	mi := &file_working_schedule_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,7 +1025,7 @@ func (x *UpdateWorkingScheduleRemoveAgentResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use UpdateWorkingScheduleRemoveAgentResponse.ProtoReflect.Descriptor instead.
 func (*UpdateWorkingScheduleRemoveAgentResponse) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{13}
+	return file_working_schedule_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateWorkingScheduleRemoveAgentResponse) GetId() int64 {
@@ -808,7 +1035,7 @@ func (x *UpdateWorkingScheduleRemoveAgentResponse) GetId() int64 {
 	return 0
 }
 
-type DeleteWorkingScheduleRequest struct {
+type SubmitWorkingScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -816,23 +1043,23 @@ type DeleteWorkingScheduleRequest struct {
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *DeleteWorkingScheduleRequest) Reset() {
-	*x = DeleteWorkingScheduleRequest{}
+func (x *SubmitWorkingScheduleRequest) Reset() {
+	*x = SubmitWorkingScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[14]
+		mi := &file_working_schedule_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteWorkingScheduleRequest) String() string {
+func (x *SubmitWorkingScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkingScheduleRequest) ProtoMessage() {}
+func (*SubmitWorkingScheduleRequest) ProtoMessage() {}
 
-func (x *DeleteWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[14]
+func (x *SubmitWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -843,43 +1070,43 @@ func (x *DeleteWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkingScheduleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWorkingScheduleRequest) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use SubmitWorkingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SubmitWorkingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DeleteWorkingScheduleRequest) GetId() int64 {
+func (x *SubmitWorkingScheduleRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteWorkingScheduleResponse struct {
+type SubmitWorkingScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Item *WorkingSchedule `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 }
 
-func (x *DeleteWorkingScheduleResponse) Reset() {
-	*x = DeleteWorkingScheduleResponse{}
+func (x *SubmitWorkingScheduleResponse) Reset() {
+	*x = SubmitWorkingScheduleResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[15]
+		mi := &file_working_schedule_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteWorkingScheduleResponse) String() string {
+func (x *SubmitWorkingScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkingScheduleResponse) ProtoMessage() {}
+func (*SubmitWorkingScheduleResponse) ProtoMessage() {}
 
-func (x *DeleteWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[15]
+func (x *SubmitWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,43 +1117,43 @@ func (x *DeleteWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkingScheduleResponse.ProtoReflect.Descriptor instead.
-func (*DeleteWorkingScheduleResponse) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SubmitWorkingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SubmitWorkingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteWorkingScheduleResponse) GetId() int64 {
+func (x *SubmitWorkingScheduleResponse) GetItem() *WorkingSchedule {
 	if x != nil {
-		return x.Id
+		return x.Item
 	}
-	return 0
+	return nil
 }
 
-type WorkingScheduleForecast struct {
+type ApproveWorkingScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Forecast []*WorkingScheduleForecast_Forecast `protobuf:"bytes,1,rep,name=forecast,proto3" json:"forecast,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *WorkingScheduleForecast) Reset() {
-	*x = WorkingScheduleForecast{}
+func (x *ApproveWorkingScheduleRequest) Reset() {
+	*x = ApproveWorkingScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[16]
+		mi := &file_working_schedule_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WorkingScheduleForecast) String() string {
+func (x *ApproveWorkingScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WorkingScheduleForecast) ProtoMessage() {}
+func (*ApproveWorkingScheduleRequest) ProtoMessage() {}
 
-func (x *WorkingScheduleForecast) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[16]
+func (x *ApproveWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -937,28 +1164,1062 @@ func (x *WorkingScheduleForecast) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WorkingScheduleForecast.ProtoReflect.Descriptor instead.
-func (*WorkingScheduleForecast) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ApproveWorkingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ApproveWorkingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *WorkingScheduleForecast) GetForecast() []*WorkingScheduleForecast_Forecast {
+func (x *ApproveWorkingScheduleRequest) GetId() int64 {
 	if x != nil {
-		return x.Forecast
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-type WorkingSchedule struct {
+type ApproveWorkingScheduleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	DomainId             int64                `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
-	CreatedAt            int64                `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	CreatedBy            *LookupEntity        `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
-	UpdatedAt            int64                `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Item *WorkingSchedule `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ApproveWorkingScheduleResponse) Reset() {
+	*x = ApproveWorkingScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveWorkingScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveWorkingScheduleResponse) ProtoMessage() {}
+
+func (x *ApproveWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveWorkingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ApproveWorkingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ApproveWorkingScheduleResponse) GetItem() *WorkingSchedule {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type RejectWorkingScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RejectWorkingScheduleRequest) Reset() {
+	*x = RejectWorkingScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectWorkingScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectWorkingScheduleRequest) ProtoMessage() {}
+
+func (x *RejectWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectWorkingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*RejectWorkingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RejectWorkingScheduleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RejectWorkingScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *WorkingSchedule `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *RejectWorkingScheduleResponse) Reset() {
+	*x = RejectWorkingScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectWorkingScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectWorkingScheduleResponse) ProtoMessage() {}
+
+func (x *RejectWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectWorkingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*RejectWorkingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RejectWorkingScheduleResponse) GetItem() *WorkingSchedule {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ArchiveWorkingScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ArchiveWorkingScheduleRequest) Reset() {
+	*x = ArchiveWorkingScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveWorkingScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveWorkingScheduleRequest) ProtoMessage() {}
+
+func (x *ArchiveWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveWorkingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveWorkingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ArchiveWorkingScheduleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ArchiveWorkingScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *WorkingSchedule `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *ArchiveWorkingScheduleResponse) Reset() {
+	*x = ArchiveWorkingScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveWorkingScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveWorkingScheduleResponse) ProtoMessage() {}
+
+func (x *ArchiveWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveWorkingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveWorkingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ArchiveWorkingScheduleResponse) GetItem() *WorkingSchedule {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type GenerateWorkingScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to the working schedule period.
+	Date *FilterBetween `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Overrides shift templates from agents working conditions.
+	ShiftTemplateId *int64 `protobuf:"varint,3,opt,name=shift_template_id,json=shiftTemplateId,proto3,oneof" json:"shift_template_id,omitempty"`
+}
+
+func (x *GenerateWorkingScheduleRequest) Reset() {
+	*x = GenerateWorkingScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateWorkingScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateWorkingScheduleRequest) ProtoMessage() {}
+
+func (x *GenerateWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateWorkingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GenerateWorkingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GenerateWorkingScheduleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GenerateWorkingScheduleRequest) GetDate() *FilterBetween {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *GenerateWorkingScheduleRequest) GetShiftTemplateId() int64 {
+	if x != nil && x.ShiftTemplateId != nil {
+		return *x.ShiftTemplateId
+	}
+	return 0
+}
+
+type GenerateWorkingScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items    []*AgentWorkingSchedule    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Staffing []*WorkingScheduleStaffing `protobuf:"bytes,2,rep,name=staffing,proto3" json:"staffing,omitempty"`
+}
+
+func (x *GenerateWorkingScheduleResponse) Reset() {
+	*x = GenerateWorkingScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateWorkingScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateWorkingScheduleResponse) ProtoMessage() {}
+
+func (x *GenerateWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateWorkingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GenerateWorkingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GenerateWorkingScheduleResponse) GetItems() []*AgentWorkingSchedule {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GenerateWorkingScheduleResponse) GetStaffing() []*WorkingScheduleStaffing {
+	if x != nil {
+		return x.Staffing
+	}
+	return nil
+}
+
+type PlaceWorkingSchedulePausesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to the working schedule period.
+	Date *FilterBetween `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Defaults to all working schedule agents.
+	AgentIds  []int64         `protobuf:"varint,3,rep,packed,name=agent_ids,json=agentIds,proto3" json:"agent_ids,omitempty"`
+	Placement *PausePlacement `protobuf:"bytes,4,opt,name=placement,proto3" json:"placement,omitempty"`
+	// Replaces existing pauses, otherwise shifts with pauses are skipped.
+	Overwrite bool `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+}
+
+func (x *PlaceWorkingSchedulePausesRequest) Reset() {
+	*x = PlaceWorkingSchedulePausesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceWorkingSchedulePausesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceWorkingSchedulePausesRequest) ProtoMessage() {}
+
+func (x *PlaceWorkingSchedulePausesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceWorkingSchedulePausesRequest.ProtoReflect.Descriptor instead.
+func (*PlaceWorkingSchedulePausesRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PlaceWorkingSchedulePausesRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlaceWorkingSchedulePausesRequest) GetDate() *FilterBetween {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *PlaceWorkingSchedulePausesRequest) GetAgentIds() []int64 {
+	if x != nil {
+		return x.AgentIds
+	}
+	return nil
+}
+
+func (x *PlaceWorkingSchedulePausesRequest) GetPlacement() *PausePlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *PlaceWorkingSchedulePausesRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type PlaceWorkingSchedulePausesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items    []*AgentWorkingSchedule    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Staffing []*WorkingScheduleStaffing `protobuf:"bytes,2,rep,name=staffing,proto3" json:"staffing,omitempty"`
+}
+
+func (x *PlaceWorkingSchedulePausesResponse) Reset() {
+	*x = PlaceWorkingSchedulePausesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaceWorkingSchedulePausesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaceWorkingSchedulePausesResponse) ProtoMessage() {}
+
+func (x *PlaceWorkingSchedulePausesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaceWorkingSchedulePausesResponse.ProtoReflect.Descriptor instead.
+func (*PlaceWorkingSchedulePausesResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PlaceWorkingSchedulePausesResponse) GetItems() []*AgentWorkingSchedule {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PlaceWorkingSchedulePausesResponse) GetStaffing() []*WorkingScheduleStaffing {
+	if x != nil {
+		return x.Staffing
+	}
+	return nil
+}
+
+type CloneWorkingScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Source working schedule.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Defaults to the name of the source working schedule.
+	Name        *string                    `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	StartDateAt int64                      `protobuf:"varint,3,opt,name=start_date_at,json=startDateAt,proto3" json:"start_date_at,omitempty"`
+	EndDateAt   int64                      `protobuf:"varint,4,opt,name=end_date_at,json=endDateAt,proto3" json:"end_date_at,omitempty"`
+	Shifts      WorkingScheduleCloneShifts `protobuf:"varint,5,opt,name=shifts,proto3,enum=wfm.WorkingScheduleCloneShifts" json:"shifts,omitempty"`
+	// Number of the first source weeks repeated over the new period.
+	Weeks *int32 `protobuf:"varint,6,opt,name=weeks,proto3,oneof" json:"weeks,omitempty"`
+}
+
+func (x *CloneWorkingScheduleRequest) Reset() {
+	*x = CloneWorkingScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneWorkingScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneWorkingScheduleRequest) ProtoMessage() {}
+
+func (x *CloneWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneWorkingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CloneWorkingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CloneWorkingScheduleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CloneWorkingScheduleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CloneWorkingScheduleRequest) GetStartDateAt() int64 {
+	if x != nil {
+		return x.StartDateAt
+	}
+	return 0
+}
+
+func (x *CloneWorkingScheduleRequest) GetEndDateAt() int64 {
+	if x != nil {
+		return x.EndDateAt
+	}
+	return 0
+}
+
+func (x *CloneWorkingScheduleRequest) GetShifts() WorkingScheduleCloneShifts {
+	if x != nil {
+		return x.Shifts
+	}
+	return WorkingScheduleCloneShifts_WORKING_SCHEDULE_CLONE_SHIFTS_UNSPECIFIED
+}
+
+func (x *CloneWorkingScheduleRequest) GetWeeks() int32 {
+	if x != nil && x.Weeks != nil {
+		return *x.Weeks
+	}
+	return 0
+}
+
+type CloneWorkingScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *WorkingSchedule `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CloneWorkingScheduleResponse) Reset() {
+	*x = CloneWorkingScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneWorkingScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneWorkingScheduleResponse) ProtoMessage() {}
+
+func (x *CloneWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneWorkingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CloneWorkingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CloneWorkingScheduleResponse) GetItem() *WorkingSchedule {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ValidateWorkingScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ValidateWorkingScheduleRequest) Reset() {
+	*x = ValidateWorkingScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateWorkingScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateWorkingScheduleRequest) ProtoMessage() {}
+
+func (x *ValidateWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateWorkingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*ValidateWorkingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ValidateWorkingScheduleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ValidateWorkingScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*WorkingScheduleViolation `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Reports if there are no violations with an error severity.
+	Valid bool `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+}
+
+func (x *ValidateWorkingScheduleResponse) Reset() {
+	*x = ValidateWorkingScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateWorkingScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateWorkingScheduleResponse) ProtoMessage() {}
+
+func (x *ValidateWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateWorkingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ValidateWorkingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ValidateWorkingScheduleResponse) GetItems() []*WorkingScheduleViolation {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ValidateWorkingScheduleResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+type DeleteWorkingScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWorkingScheduleRequest) Reset() {
+	*x = DeleteWorkingScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkingScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkingScheduleRequest) ProtoMessage() {}
+
+func (x *DeleteWorkingScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkingScheduleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkingScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteWorkingScheduleRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWorkingScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWorkingScheduleResponse) Reset() {
+	*x = DeleteWorkingScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkingScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkingScheduleResponse) ProtoMessage() {}
+
+func (x *DeleteWorkingScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkingScheduleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkingScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteWorkingScheduleResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type WorkingScheduleForecast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Forecast []*WorkingScheduleForecast_Forecast `protobuf:"bytes,1,rep,name=forecast,proto3" json:"forecast,omitempty"`
+}
+
+func (x *WorkingScheduleForecast) Reset() {
+	*x = WorkingScheduleForecast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkingScheduleForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingScheduleForecast) ProtoMessage() {}
+
+func (x *WorkingScheduleForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingScheduleForecast.ProtoReflect.Descriptor instead.
+func (*WorkingScheduleForecast) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WorkingScheduleForecast) GetForecast() []*WorkingScheduleForecast_Forecast {
+	if x != nil {
+		return x.Forecast
+	}
+	return nil
+}
+
+type WorkingScheduleStaffing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp int64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Required  int64 `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Scheduled int64 `protobuf:"varint,3,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	// Difference between available (scheduled and not on pause) and required agents,
+	// negative value means understaffing.
+	Delta   int64 `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	OnPause int64 `protobuf:"varint,5,opt,name=on_pause,json=onPause,proto3" json:"on_pause,omitempty"`
+}
+
+func (x *WorkingScheduleStaffing) Reset() {
+	*x = WorkingScheduleStaffing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkingScheduleStaffing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingScheduleStaffing) ProtoMessage() {}
+
+func (x *WorkingScheduleStaffing) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingScheduleStaffing.ProtoReflect.Descriptor instead.
+func (*WorkingScheduleStaffing) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WorkingScheduleStaffing) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *WorkingScheduleStaffing) GetRequired() int64 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+func (x *WorkingScheduleStaffing) GetScheduled() int64 {
+	if x != nil {
+		return x.Scheduled
+	}
+	return 0
+}
+
+func (x *WorkingScheduleStaffing) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *WorkingScheduleStaffing) GetOnPause() int64 {
+	if x != nil {
+		return x.OnPause
+	}
+	return 0
+}
+
+type WorkingScheduleViolation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Agent *LookupEntity `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Date  int64         `protobuf:"varint,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Identifier of the violated rule, e.g. shift.absence.
+	Rule     string            `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	Severity ViolationSeverity `protobuf:"varint,4,opt,name=severity,proto3,enum=wfm.ViolationSeverity" json:"severity,omitempty"`
+	Message  string            `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *WorkingScheduleViolation) Reset() {
+	*x = WorkingScheduleViolation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkingScheduleViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingScheduleViolation) ProtoMessage() {}
+
+func (x *WorkingScheduleViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingScheduleViolation.ProtoReflect.Descriptor instead.
+func (*WorkingScheduleViolation) Descriptor() ([]byte, []int) {
+	return file_working_schedule_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WorkingScheduleViolation) GetAgent() *LookupEntity {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *WorkingScheduleViolation) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *WorkingScheduleViolation) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *WorkingScheduleViolation) GetSeverity() ViolationSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return ViolationSeverity_VIOLATION_SEVERITY_UNSPECIFIED
+}
+
+func (x *WorkingScheduleViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type WorkingSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                   int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId             int64                `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	CreatedAt            int64                `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy            *LookupEntity        `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	UpdatedAt            int64                `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	UpdatedBy            *LookupEntity        `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
 	Name                 string               `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
 	State                WorkingScheduleState `protobuf:"varint,8,opt,name=state,proto3,enum=wfm.WorkingScheduleState" json:"state,omitempty"`
@@ -972,12 +2233,19 @@ type WorkingSchedule struct {
 	BlockOutsideActivity bool                 `protobuf:"varint,16,opt,name=block_outside_activity,json=blockOutsideActivity,proto3" json:"block_outside_activity,omitempty"`
 	Agents               []*LookupEntity      `protobuf:"bytes,17,rep,name=agents,proto3" json:"agents,omitempty"`
 	TotalAgents          int64                `protobuf:"varint,18,opt,name=total_agents,json=totalAgents,proto3" json:"total_agents,omitempty"`
+	// Time of the last state transition.
+	StateChangedAt int64 `protobuf:"varint,19,opt,name=state_changed_at,json=stateChangedAt,proto3" json:"state_changed_at,omitempty"`
+	// User who made the last state transition.
+	StateChangedBy *LookupEntity `protobuf:"bytes,20,opt,name=state_changed_by,json=stateChangedBy,proto3" json:"state_changed_by,omitempty"`
+	// IANA timezone of the attached calendar.
+	// Shift and pause minutes of the working schedule are local to it.
+	Timezone string `protobuf:"bytes,21,opt,name=timezone,proto3" json:"timezone,omitempty"`
 }
 
 func (x *WorkingSchedule) Reset() {
 	*x = WorkingSchedule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[17]
+		mi := &file_working_schedule_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -990,7 +2258,7 @@ func (x *WorkingSchedule) String() string {
 func (*WorkingSchedule) ProtoMessage() {}
 
 func (x *WorkingSchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[17]
+	mi := &file_working_schedule_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1003,7 +2271,7 @@ func (x *WorkingSchedule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkingSchedule.ProtoReflect.Descriptor instead.
 func (*WorkingSchedule) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{17}
+	return file_working_schedule_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *WorkingSchedule) GetId() int64 {
@@ -1132,6 +2400,27 @@ func (x *WorkingSchedule) GetTotalAgents() int64 {
 	return 0
 }
 
+func (x *WorkingSchedule) GetStateChangedAt() int64 {
+	if x != nil {
+		return x.StateChangedAt
+	}
+	return 0
+}
+
+func (x *WorkingSchedule) GetStateChangedBy() *LookupEntity {
+	if x != nil {
+		return x.StateChangedBy
+	}
+	return nil
+}
+
+func (x *WorkingSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
 type WorkingScheduleForecast_Forecast struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1144,7 +2433,7 @@ type WorkingScheduleForecast_Forecast struct {
 func (x *WorkingScheduleForecast_Forecast) Reset() {
 	*x = WorkingScheduleForecast_Forecast{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_working_schedule_proto_msgTypes[19]
+		mi := &file_working_schedule_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1157,7 +2446,7 @@ func (x *WorkingScheduleForecast_Forecast) String() string {
 func (*WorkingScheduleForecast_Forecast) ProtoMessage() {}
 
 func (x *WorkingScheduleForecast_Forecast) ProtoReflect() protoreflect.Message {
-	mi := &file_working_schedule_proto_msgTypes[19]
+	mi := &file_working_schedule_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1170,7 +2459,7 @@ func (x *WorkingScheduleForecast_Forecast) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WorkingScheduleForecast_Forecast.ProtoReflect.Descriptor instead.
 func (*WorkingScheduleForecast_Forecast) Descriptor() ([]byte, []int) {
-	return file_working_schedule_proto_rawDescGZIP(), []int{16, 0}
+	return file_working_schedule_proto_rawDescGZIP(), []int{34, 0}
 }
 
 func (x *WorkingScheduleForecast_Forecast) GetHour() int64 {
@@ -1199,282 +2488,573 @@ var file_working_schedule_proto_rawDesc = []byte{
 	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x0c, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x50, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
-	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x30, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x1a, 0x1c, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50,
+	0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d,
+	0x22, 0x49, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0xc2, 0x02, 0x0a, 0x1a,
+	0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x87, 0x02, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0xee, 0x01, 0xba, 0x48, 0xea, 0x01, 0x92, 0x01,
+	0xe6, 0x01, 0x18, 0x01, 0x22, 0xe1, 0x01, 0x72, 0xde, 0x01, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x52, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x52, 0x0d,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0b, 0x65,
+	0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x73, 0x6b,
+	0x69, 0x6c, 0x6c, 0x73, 0x52, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x73,
+	0x69, 0x64, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x06, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x22, 0x47, 0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x5c, 0x0a, 0x22, 0x52, 0x65, 0x61,
+	0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x23, 0x52, 0x65, 0x61, 0x64,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x49, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x56, 0x0a, 0x0a, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xc6, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a,
+	0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x32, 0x03, 0x0f, 0x1e, 0x3c, 0x48, 0x00,
+	0x52, 0x0b, 0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x19, 0x0a, 0x08, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x49, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x67, 0x72, 0x61, 0x6e, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x22, 0x59, 0x0a, 0x23, 0x52,
+	0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x52,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xc5, 0x03, 0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfa, 0x01, 0x48, 0x00,
+	0x52, 0x01, 0x71, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x01, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48,
+	0x02, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x87, 0x02, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0xee, 0x01, 0xba, 0x48, 0xea, 0x01, 0x92, 0x01, 0xe6, 0x01, 0x18,
+	0x01, 0x22, 0xe1, 0x01, 0x72, 0xde, 0x01, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x52, 0x09, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x52, 0x0d, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x61, 0x74, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x73, 0x52, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x52, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42, 0x04, 0x0a,
+	0x02, 0x5f, 0x71, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x5f,
+	0x0a, 0x1d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
 	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x22, 0x49, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x9e, 0x02,
-	0x0a, 0x1a, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01,
-	0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0xe3, 0x01, 0x0a, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0xca, 0x01, 0xba, 0x48, 0xc6, 0x01,
-	0x92, 0x01, 0xc2, 0x01, 0x18, 0x01, 0x22, 0xbd, 0x01, 0x72, 0xba, 0x01, 0x10, 0x01, 0x52, 0x02,
-	0x69, 0x64, 0x52, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d,
-	0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x52,
-	0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0d, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x64,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f,
-	0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x75,
-	0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x52, 0x06,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x0a,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x47,
-	0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a,
-	0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66,
-	0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x5c, 0x0a, 0x22, 0x52, 0x65, 0x61, 0x64, 0x57,
-	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f,
-	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66,
-	0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x52,
-	0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xc8, 0x01, 0x0a, 0x23, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f,
-	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x77,
-	0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x1a, 0x56, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x32, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72,
-	0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0xa1, 0x03, 0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48,
-	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfa, 0x01, 0x48, 0x00, 0x52, 0x01, 0x71, 0x88, 0x01, 0x01,
-	0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07,
-	0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x01, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x02, 0x52, 0x04, 0x73, 0x69, 0x7a,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x03, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0xe3, 0x01,
-	0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x42, 0xca,
-	0x01, 0xba, 0x48, 0xc6, 0x01, 0x92, 0x01, 0xc2, 0x01, 0x18, 0x01, 0x22, 0xbd, 0x01, 0x72, 0xba,
-	0x01, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x52, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
-	0x69, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x04, 0x74, 0x65, 0x61, 0x6d, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
-	0x65, 0x5f, 0x61, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61,
-	0x74, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74,
-	0x52, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x52, 0x0c, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x52, 0x16, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x79, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x62, 0x79, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x52,
-	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x52, 0x06, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x73, 0x42, 0x04, 0x0a, 0x02, 0x5f, 0x71, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x73, 0x6f, 0x72, 0x74, 0x22, 0x5f, 0x0a, 0x1d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f,
-	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x50, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22,
+	0x50, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x22, 0x49, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x62, 0x0a, 0x25,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0x53, 0x0a, 0x26, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6c, 0x0a, 0x27, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
 	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x6d, 0x6f, 0x76, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x08,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x28, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x3a, 0x0a, 0x1c, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x1d, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3b, 0x0a, 0x1d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x4a, 0x0a, 0x1e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
-	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x49, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22,
+	0x3a, 0x0a, 0x1c, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49, 0x0a, 0x1d, 0x52,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04,
+	0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3b, 0x0a, 0x1d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
 	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x22, 0x62, 0x0a, 0x25, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x06, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66,
-	0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06,
-	0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x53, 0x0a, 0x26, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41,
-	0x64, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x29, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x4a, 0x0a, 0x1e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22,
+	0xb4, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a,
+	0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x11, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x68,
+	0x69, 0x66, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x73,
+	0x74, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x66, 0x66, 0x69, 0x6e, 0x67, 0x22, 0xe7, 0x01, 0x0a, 0x21, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x25, 0x0a, 0x09, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x03, 0x42, 0x08, 0xba, 0x48, 0x05, 0x92, 0x01, 0x02, 0x18, 0x01, 0x52, 0x08, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22,
+	0x8f, 0x01, 0x0a, 0x22, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x66, 0x66,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x73, 0x74, 0x61, 0x66, 0x66, 0x69, 0x6e,
+	0x67, 0x22, 0xfd, 0x03, 0x0a, 0x1b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2e, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12,
+	0x2a, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x09, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x41, 0x0a, 0x06, 0x73,
+	0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x24,
+	0x0a, 0x05, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xba,
+	0x48, 0x06, 0x1a, 0x04, 0x18, 0x34, 0x28, 0x01, 0x48, 0x01, 0x52, 0x05, 0x77, 0x65, 0x65, 0x6b,
+	0x73, 0x88, 0x01, 0x01, 0x3a, 0xc7, 0x01, 0xba, 0x48, 0xc3, 0x01, 0x1a, 0x60, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x30, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74,
+	0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x61, 0x74, 0x1a, 0x26, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x20, 0x3e, 0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x1a, 0x5f, 0x0a,
+	0x05, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x12, 0x2f, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x20, 0x73, 0x68,
+	0x6f, 0x75, 0x6c, 0x64, 0x20, 0x62, 0x65, 0x20, 0x73, 0x65, 0x74, 0x20, 0x66, 0x6f, 0x72, 0x20,
+	0x74, 0x68, 0x65, 0x20, 0x72, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x20, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x73, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x1a, 0x25, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x29, 0x20, 0x3d, 0x3d, 0x20, 0x28, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x33, 0x29, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x77, 0x65, 0x65, 0x6b,
+	0x73, 0x22, 0x48, 0x0a, 0x1c, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x3c, 0x0a, 0x1e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01,
+	0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6c, 0x0a, 0x1f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x2f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x17, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x41, 0x0a, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
+	0x2e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63,
+	0x61, 0x73, 0x74, 0x1a, 0x36, 0x0a, 0x08, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68,
+	0x6f, 0x75, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x17,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x66, 0x66, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65,
+	0x22, 0xb9, 0x01, 0x0a, 0x18, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a,
+	0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x05, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x32,
+	0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x56, 0x69, 0x6f, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xcf, 0x06, 0x0a,
+	0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x6c, 0x0a, 0x27, 0x55,
+	0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d,
+	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x21, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba,
+	0x48, 0x0a, 0xc8, 0x01, 0x01, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfa, 0x01, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1e, 0x0a,
+	0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x22, 0x0a,
+	0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x41,
+	0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x41,
+	0x74, 0x12, 0x34, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c,
+	0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f,
+	0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x72,
+	0x61, 0x53, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x6f, 0x75, 0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x75,
+	0x74, 0x73, 0x69, 0x64, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a,
+	0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3b, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x15,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x2a, 0xc9,
+	0x01, 0x0a, 0x1a, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x73, 0x12, 0x2d, 0x0a,
+	0x29, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
+	0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22,
+	0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45,
+	0x5f, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x53, 0x5f, 0x4e, 0x4f,
+	0x4e, 0x45, 0x10, 0x01, 0x12, 0x29, 0x0a, 0x25, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x53,
+	0x48, 0x49, 0x46, 0x54, 0x53, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x44, 0x41, 0x59, 0x10, 0x02, 0x12,
+	0x29, 0x0a, 0x25, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x45, 0x5f, 0x43, 0x4c, 0x4f, 0x4e, 0x45, 0x5f, 0x53, 0x48, 0x49, 0x46, 0x54, 0x53,
+	0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x2a, 0xcc, 0x01, 0x0a, 0x14, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x57,
+	0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x22,
+	0x0a, 0x1e, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55,
+	0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43,
+	0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x52, 0x41,
+	0x46, 0x54, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f,
+	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41,
+	0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x75, 0x0a, 0x11, 0x56, 0x69, 0x6f,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x22,
+	0x0a, 0x1e, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x56, 0x45,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x02,
+	0x32, 0xdc, 0x15, 0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x90, 0xb5,
+	0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x77, 0x66,
+	0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x13,
+	0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x25, 0x12, 0x23, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xaa, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46,
+	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x27, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x28, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x90, 0xb5, 0x18, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x66, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0xaa, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x12, 0x27, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x8a, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2a, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e,
+	0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x97,
+	0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x25, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00,
-	0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x3a, 0x0a, 0x28, 0x55, 0x70, 0x64,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x37, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x1a, 0x28,
+	0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b,
+	0x69, 0x74, 0x65, 0x6d, 0x2e, 0x69, 0x64, 0x7d, 0x12, 0xb4, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x3a, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57,
+	0x6c, 0x65, 0x41, 0x64, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f,
+	0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0xc2, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
 	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x2f, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x17, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x41,
-	0x0a, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x2e, 0x46,
-	0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x08, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73,
-	0x74, 0x1a, 0x36, 0x0a, 0x08, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x6f, 0x75, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x6f, 0x75,
-	0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xcc, 0x05, 0x0a, 0x0f, 0x57, 0x6f,
-	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
-	0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x0a, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x21, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8,
-	0x01, 0x01, 0x72, 0x05, 0x10, 0x01, 0x18, 0xfa, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x2f, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x25, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x2d, 0x0a, 0x08, 0x63, 0x61, 0x6c, 0x65, 0x6e,
-	0x64, 0x61, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x08, 0x63, 0x61,
-	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x6e,
-	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x41, 0x74, 0x12, 0x1e,
-	0x0a, 0x0b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x41, 0x74, 0x12, 0x34,
-	0x0a, 0x0c, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x73, 0x6b, 0x69, 0x6c, 0x6c, 0x73, 0x18, 0x0f,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
-	0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x53, 0x6b,
-	0x69, 0x6c, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6f, 0x75,
-	0x74, 0x73, 0x69, 0x64, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4f, 0x75, 0x74, 0x73, 0x69,
-	0x64, 0x65, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x29, 0x0a, 0x06, 0x61, 0x67,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d,
-	0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x06, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2a, 0xcc, 0x01, 0x0a, 0x14, 0x57, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x26, 0x0a, 0x22, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48,
-	0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x57, 0x4f, 0x52,
-	0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e,
-	0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02,
-	0x12, 0x20, 0x0a, 0x1c, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43, 0x48, 0x45,
-	0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x52, 0x41, 0x46, 0x54,
-	0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x57, 0x4f, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x43,
-	0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x52, 0x43,
-	0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x04, 0x32, 0xad, 0x0a, 0x0a, 0x16, 0x57, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x77,
-	0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x6d, 0x6f, 0x76, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x41, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x2a, 0x35, 0x2f,
+	0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x7d, 0x12, 0x99, 0x01, 0x0a, 0x15, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21,
+	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x12, 0x9d, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
 	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x23, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30,
+	0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x12, 0x99, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x77, 0x66, 0x6d,
+	0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x39, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a,
+	0x22, 0x2a, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x9d, 0x01, 0x0a,
+	0x16, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3a, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22,
+	0x2b, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x12, 0xa1, 0x01, 0x0a,
+	0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x23, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
 	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x2d, 0x90, 0xb5, 0x18, 0x00, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a,
-	0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73,
+	0x6e, 0x73, 0x65, 0x22, 0x3b, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x3a,
+	0x01, 0x2a, 0x22, 0x2c, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73,
 	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x77, 0x66, 0x6d,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x66,
-	0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x90,
-	0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x77, 0x66, 0x6d, 0x2f,
-	0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f,
-	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xaa,
-	0x01, 0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x27,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x38, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f,
-	0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x66, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x12, 0x8a, 0x01, 0x0a, 0x15,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x90, 0xb5,
-	0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c,
+	0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0xa8, 0x01, 0x0a, 0x1a, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x73, 0x12,
+	0x26, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x50, 0x61, 0x75, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x39, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22,
+	0x2a, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x73, 0x12, 0x95, 0x01, 0x0a, 0x14,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x6f,
+	0x6e, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x90, 0xb5, 0x18, 0x00, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c,
 	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x97, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6c,
+	0x6f, 0x6e, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12,
+	0x23, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
 	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
-	0x6c, 0x65, 0x12, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57,
-	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x90, 0xb5, 0x18, 0x02, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x1a, 0x28, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c,
-	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x74, 0x65, 0x6d, 0x2e, 0x69,
-	0x64, 0x7d, 0x12, 0xb4, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x41,
-	0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
-	0x65, 0x41, 0x64, 0x64, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2b, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f,
-	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39,
-	0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f,
-	0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b,
-	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69,
-	0x64, 0x7d, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0xc2, 0x01, 0x0a, 0x20, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
-	0x75, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x2c,
-	0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x77,
-	0x66, 0x6d, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x67,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x41, 0x90, 0xb5, 0x18,
-	0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x2a, 0x35, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f,
-	0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x61, 0x67, 0x65,
-	0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x8f,
-	0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
-	0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x66,
-	0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2f, 0x90, 0xb5, 0x18, 0x03, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x2a, 0x23, 0x2f, 0x77, 0x66,
-	0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x1a, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63,
-	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d, 0x3b, 0x77, 0x66, 0x6d, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x90, 0xb5, 0x18, 0x01,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x12, 0x2e, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f,
+	0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x76, 0x69, 0x6f, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x21, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x90, 0xb5, 0x18, 0x03, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x25, 0x2a, 0x23, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
+	0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x1a, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42,
+	0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d,
+	0x3b, 0x77, 0x66, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1489,74 +3069,135 @@ func file_working_schedule_proto_rawDescGZIP() []byte {
 	return file_working_schedule_proto_rawDescData
 }
 
-var file_working_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_working_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_working_schedule_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_working_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_working_schedule_proto_goTypes = []interface{}{
-	(WorkingScheduleState)(0),                        // 0: wfm.WorkingScheduleState
-	(*CreateWorkingScheduleRequest)(nil),             // 1: wfm.CreateWorkingScheduleRequest
-	(*CreateWorkingScheduleResponse)(nil),            // 2: wfm.CreateWorkingScheduleResponse
-	(*ReadWorkingScheduleRequest)(nil),               // 3: wfm.ReadWorkingScheduleRequest
-	(*ReadWorkingScheduleResponse)(nil),              // 4: wfm.ReadWorkingScheduleResponse
-	(*ReadWorkingScheduleForecastRequest)(nil),       // 5: wfm.ReadWorkingScheduleForecastRequest
-	(*ReadWorkingScheduleForecastResponse)(nil),      // 6: wfm.ReadWorkingScheduleForecastResponse
-	(*SearchWorkingScheduleRequest)(nil),             // 7: wfm.SearchWorkingScheduleRequest
-	(*SearchWorkingScheduleResponse)(nil),            // 8: wfm.SearchWorkingScheduleResponse
-	(*UpdateWorkingScheduleRequest)(nil),             // 9: wfm.UpdateWorkingScheduleRequest
-	(*UpdateWorkingScheduleResponse)(nil),            // 10: wfm.UpdateWorkingScheduleResponse
-	(*UpdateWorkingScheduleAddAgentsRequest)(nil),    // 11: wfm.UpdateWorkingScheduleAddAgentsRequest
-	(*UpdateWorkingScheduleAddAgentsResponse)(nil),   // 12: wfm.UpdateWorkingScheduleAddAgentsResponse
-	(*UpdateWorkingScheduleRemoveAgentRequest)(nil),  // 13: wfm.UpdateWorkingScheduleRemoveAgentRequest
-	(*UpdateWorkingScheduleRemoveAgentResponse)(nil), // 14: wfm.UpdateWorkingScheduleRemoveAgentResponse
-	(*DeleteWorkingScheduleRequest)(nil),             // 15: wfm.DeleteWorkingScheduleRequest
-	(*DeleteWorkingScheduleResponse)(nil),            // 16: wfm.DeleteWorkingScheduleResponse
-	(*WorkingScheduleForecast)(nil),                  // 17: wfm.WorkingScheduleForecast
-	(*WorkingSchedule)(nil),                          // 18: wfm.WorkingSchedule
-	nil,                                              // 19: wfm.ReadWorkingScheduleForecastResponse.ItemsEntry
-	(*WorkingScheduleForecast_Forecast)(nil),         // 20: wfm.WorkingScheduleForecast.Forecast
-	(*FilterBetween)(nil),                            // 21: wfm.FilterBetween
-	(*LookupEntity)(nil),                             // 22: wfm.LookupEntity
+	(WorkingScheduleCloneShifts)(0),                  // 0: wfm.WorkingScheduleCloneShifts
+	(WorkingScheduleState)(0),                        // 1: wfm.WorkingScheduleState
+	(ViolationSeverity)(0),                           // 2: wfm.ViolationSeverity
+	(*CreateWorkingScheduleRequest)(nil),             // 3: wfm.CreateWorkingScheduleRequest
+	(*CreateWorkingScheduleResponse)(nil),            // 4: wfm.CreateWorkingScheduleResponse
+	(*ReadWorkingScheduleRequest)(nil),               // 5: wfm.ReadWorkingScheduleRequest
+	(*ReadWorkingScheduleResponse)(nil),              // 6: wfm.ReadWorkingScheduleResponse
+	(*ReadWorkingScheduleForecastRequest)(nil),       // 7: wfm.ReadWorkingScheduleForecastRequest
+	(*ReadWorkingScheduleForecastResponse)(nil),      // 8: wfm.ReadWorkingScheduleForecastResponse
+	(*ReadWorkingScheduleCoverageRequest)(nil),       // 9: wfm.ReadWorkingScheduleCoverageRequest
+	(*ReadWorkingScheduleCoverageResponse)(nil),      // 10: wfm.ReadWorkingScheduleCoverageResponse
+	(*SearchWorkingScheduleRequest)(nil),             // 11: wfm.SearchWorkingScheduleRequest
+	(*SearchWorkingScheduleResponse)(nil),            // 12: wfm.SearchWorkingScheduleResponse
+	(*UpdateWorkingScheduleRequest)(nil),             // 13: wfm.UpdateWorkingScheduleRequest
+	(*UpdateWorkingScheduleResponse)(nil),            // 14: wfm.UpdateWorkingScheduleResponse
+	(*UpdateWorkingScheduleAddAgentsRequest)(nil),    // 15: wfm.UpdateWorkingScheduleAddAgentsRequest
+	(*UpdateWorkingScheduleAddAgentsResponse)(nil),   // 16: wfm.UpdateWorkingScheduleAddAgentsResponse
+	(*UpdateWorkingScheduleRemoveAgentRequest)(nil),  // 17: wfm.UpdateWorkingScheduleRemoveAgentRequest
+	(*UpdateWorkingScheduleRemoveAgentResponse)(nil), // 18: wfm.UpdateWorkingScheduleRemoveAgentResponse
+	(*SubmitWorkingScheduleRequest)(nil),             // 19: wfm.SubmitWorkingScheduleRequest
+	(*SubmitWorkingScheduleResponse)(nil),            // 20: wfm.SubmitWorkingScheduleResponse
+	(*ApproveWorkingScheduleRequest)(nil),            // 21: wfm.ApproveWorkingScheduleRequest
+	(*ApproveWorkingScheduleResponse)(nil),           // 22: wfm.ApproveWorkingScheduleResponse
+	(*RejectWorkingScheduleRequest)(nil),             // 23: wfm.RejectWorkingScheduleRequest
+	(*RejectWorkingScheduleResponse)(nil),            // 24: wfm.RejectWorkingScheduleResponse
+	(*ArchiveWorkingScheduleRequest)(nil),            // 25: wfm.ArchiveWorkingScheduleRequest
+	(*ArchiveWorkingScheduleResponse)(nil),           // 26: wfm.ArchiveWorkingScheduleResponse
+	(*GenerateWorkingScheduleRequest)(nil),           // 27: wfm.GenerateWorkingScheduleRequest
+	(*GenerateWorkingScheduleResponse)(nil),          // 28: wfm.GenerateWorkingScheduleResponse
+	(*PlaceWorkingSchedulePausesRequest)(nil),        // 29: wfm.PlaceWorkingSchedulePausesRequest
+	(*PlaceWorkingSchedulePausesResponse)(nil),       // 30: wfm.PlaceWorkingSchedulePausesResponse
+	(*CloneWorkingScheduleRequest)(nil),              // 31: wfm.CloneWorkingScheduleRequest
+	(*CloneWorkingScheduleResponse)(nil),             // 32: wfm.CloneWorkingScheduleResponse
+	(*ValidateWorkingScheduleRequest)(nil),           // 33: wfm.ValidateWorkingScheduleRequest
+	(*ValidateWorkingScheduleResponse)(nil),          // 34: wfm.ValidateWorkingScheduleResponse
+	(*DeleteWorkingScheduleRequest)(nil),             // 35: wfm.DeleteWorkingScheduleRequest
+	(*DeleteWorkingScheduleResponse)(nil),            // 36: wfm.DeleteWorkingScheduleResponse
+	(*WorkingScheduleForecast)(nil),                  // 37: wfm.WorkingScheduleForecast
+	(*WorkingScheduleStaffing)(nil),                  // 38: wfm.WorkingScheduleStaffing
+	(*WorkingScheduleViolation)(nil),                 // 39: wfm.WorkingScheduleViolation
+	(*WorkingSchedule)(nil),                          // 40: wfm.WorkingSchedule
+	nil,                                              // 41: wfm.ReadWorkingScheduleForecastResponse.ItemsEntry
+	(*WorkingScheduleForecast_Forecast)(nil),         // 42: wfm.WorkingScheduleForecast.Forecast
+	(*FilterBetween)(nil),                            // 43: wfm.FilterBetween
+	(*LookupEntity)(nil),                             // 44: wfm.LookupEntity
+	(*AgentWorkingSchedule)(nil),                     // 45: wfm.AgentWorkingSchedule
+	(*PausePlacement)(nil),                           // 46: wfm.PausePlacement
 }
 var file_working_schedule_proto_depIdxs = []int32{
-	18, // 0: wfm.CreateWorkingScheduleRequest.item:type_name -> wfm.WorkingSchedule
-	18, // 1: wfm.CreateWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
-	18, // 2: wfm.ReadWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
-	21, // 3: wfm.ReadWorkingScheduleForecastRequest.date:type_name -> wfm.FilterBetween
-	19, // 4: wfm.ReadWorkingScheduleForecastResponse.items:type_name -> wfm.ReadWorkingScheduleForecastResponse.ItemsEntry
-	18, // 5: wfm.SearchWorkingScheduleResponse.items:type_name -> wfm.WorkingSchedule
-	18, // 6: wfm.UpdateWorkingScheduleRequest.item:type_name -> wfm.WorkingSchedule
-	18, // 7: wfm.UpdateWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
-	22, // 8: wfm.UpdateWorkingScheduleAddAgentsRequest.agents:type_name -> wfm.LookupEntity
-	22, // 9: wfm.UpdateWorkingScheduleAddAgentsResponse.agents:type_name -> wfm.LookupEntity
-	20, // 10: wfm.WorkingScheduleForecast.forecast:type_name -> wfm.WorkingScheduleForecast.Forecast
-	22, // 11: wfm.WorkingSchedule.created_by:type_name -> wfm.LookupEntity
-	22, // 12: wfm.WorkingSchedule.updated_by:type_name -> wfm.LookupEntity
-	0,  // 13: wfm.WorkingSchedule.state:type_name -> wfm.WorkingScheduleState
-	22, // 14: wfm.WorkingSchedule.team:type_name -> wfm.LookupEntity
-	22, // 15: wfm.WorkingSchedule.calendar:type_name -> wfm.LookupEntity
-	22, // 16: wfm.WorkingSchedule.extra_skills:type_name -> wfm.LookupEntity
-	22, // 17: wfm.WorkingSchedule.agents:type_name -> wfm.LookupEntity
-	17, // 18: wfm.ReadWorkingScheduleForecastResponse.ItemsEntry.value:type_name -> wfm.WorkingScheduleForecast
-	1,  // 19: wfm.WorkingScheduleService.CreateWorkingSchedule:input_type -> wfm.CreateWorkingScheduleRequest
-	3,  // 20: wfm.WorkingScheduleService.ReadWorkingSchedule:input_type -> wfm.ReadWorkingScheduleRequest
-	5,  // 21: wfm.WorkingScheduleService.ReadWorkingScheduleForecast:input_type -> wfm.ReadWorkingScheduleForecastRequest
-	7,  // 22: wfm.WorkingScheduleService.SearchWorkingSchedule:input_type -> wfm.SearchWorkingScheduleRequest
-	9,  // 23: wfm.WorkingScheduleService.UpdateWorkingSchedule:input_type -> wfm.UpdateWorkingScheduleRequest
-	11, // 24: wfm.WorkingScheduleService.UpdateWorkingScheduleAddAgents:input_type -> wfm.UpdateWorkingScheduleAddAgentsRequest
-	13, // 25: wfm.WorkingScheduleService.UpdateWorkingScheduleRemoveAgent:input_type -> wfm.UpdateWorkingScheduleRemoveAgentRequest
-	15, // 26: wfm.WorkingScheduleService.DeleteWorkingSchedule:input_type -> wfm.DeleteWorkingScheduleRequest
-	2,  // 27: wfm.WorkingScheduleService.CreateWorkingSchedule:output_type -> wfm.CreateWorkingScheduleResponse
-	4,  // 28: wfm.WorkingScheduleService.ReadWorkingSchedule:output_type -> wfm.ReadWorkingScheduleResponse
-	6,  // 29: wfm.WorkingScheduleService.ReadWorkingScheduleForecast:output_type -> wfm.ReadWorkingScheduleForecastResponse
-	8,  // 30: wfm.WorkingScheduleService.SearchWorkingSchedule:output_type -> wfm.SearchWorkingScheduleResponse
-	10, // 31: wfm.WorkingScheduleService.UpdateWorkingSchedule:output_type -> wfm.UpdateWorkingScheduleResponse
-	12, // 32: wfm.WorkingScheduleService.UpdateWorkingScheduleAddAgents:output_type -> wfm.UpdateWorkingScheduleAddAgentsResponse
-	14, // 33: wfm.WorkingScheduleService.UpdateWorkingScheduleRemoveAgent:output_type -> wfm.UpdateWorkingScheduleRemoveAgentResponse
-	16, // 34: wfm.WorkingScheduleService.DeleteWorkingSchedule:output_type -> wfm.DeleteWorkingScheduleResponse
-	27, // [27:35] is the sub-list for method output_type
-	19, // [19:27] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	40, // 0: wfm.CreateWorkingScheduleRequest.item:type_name -> wfm.WorkingSchedule
+	40, // 1: wfm.CreateWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
+	40, // 2: wfm.ReadWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
+	43, // 3: wfm.ReadWorkingScheduleForecastRequest.date:type_name -> wfm.FilterBetween
+	41, // 4: wfm.ReadWorkingScheduleForecastResponse.items:type_name -> wfm.ReadWorkingScheduleForecastResponse.ItemsEntry
+	43, // 5: wfm.ReadWorkingScheduleCoverageRequest.date:type_name -> wfm.FilterBetween
+	38, // 6: wfm.ReadWorkingScheduleCoverageResponse.items:type_name -> wfm.WorkingScheduleStaffing
+	40, // 7: wfm.SearchWorkingScheduleResponse.items:type_name -> wfm.WorkingSchedule
+	40, // 8: wfm.UpdateWorkingScheduleRequest.item:type_name -> wfm.WorkingSchedule
+	40, // 9: wfm.UpdateWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
+	44, // 10: wfm.UpdateWorkingScheduleAddAgentsRequest.agents:type_name -> wfm.LookupEntity
+	44, // 11: wfm.UpdateWorkingScheduleAddAgentsResponse.agents:type_name -> wfm.LookupEntity
+	40, // 12: wfm.SubmitWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
+	40, // 13: wfm.ApproveWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
+	40, // 14: wfm.RejectWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
+	40, // 15: wfm.ArchiveWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
+	43, // 16: wfm.GenerateWorkingScheduleRequest.date:type_name -> wfm.FilterBetween
+	45, // 17: wfm.GenerateWorkingScheduleResponse.items:type_name -> wfm.AgentWorkingSchedule
+	38, // 18: wfm.GenerateWorkingScheduleResponse.staffing:type_name -> wfm.WorkingScheduleStaffing
+	43, // 19: wfm.PlaceWorkingSchedulePausesRequest.date:type_name -> wfm.FilterBetween
+	46, // 20: wfm.PlaceWorkingSchedulePausesRequest.placement:type_name -> wfm.PausePlacement
+	45, // 21: wfm.PlaceWorkingSchedulePausesResponse.items:type_name -> wfm.AgentWorkingSchedule
+	38, // 22: wfm.PlaceWorkingSchedulePausesResponse.staffing:type_name -> wfm.WorkingScheduleStaffing
+	0,  // 23: wfm.CloneWorkingScheduleRequest.shifts:type_name -> wfm.WorkingScheduleCloneShifts
+	40, // 24: wfm.CloneWorkingScheduleResponse.item:type_name -> wfm.WorkingSchedule
+	39, // 25: wfm.ValidateWorkingScheduleResponse.items:type_name -> wfm.WorkingScheduleViolation
+	42, // 26: wfm.WorkingScheduleForecast.forecast:type_name -> wfm.WorkingScheduleForecast.Forecast
+	44, // 27: wfm.WorkingScheduleViolation.agent:type_name -> wfm.LookupEntity
+	2,  // 28: wfm.WorkingScheduleViolation.severity:type_name -> wfm.ViolationSeverity
+	44, // 29: wfm.WorkingSchedule.created_by:type_name -> wfm.LookupEntity
+	44, // 30: wfm.WorkingSchedule.updated_by:type_name -> wfm.LookupEntity
+	1,  // 31: wfm.WorkingSchedule.state:type_name -> wfm.WorkingScheduleState
+	44, // 32: wfm.WorkingSchedule.team:type_name -> wfm.LookupEntity
+	44, // 33: wfm.WorkingSchedule.calendar:type_name -> wfm.LookupEntity
+	44, // 34: wfm.WorkingSchedule.extra_skills:type_name -> wfm.LookupEntity
+	44, // 35: wfm.WorkingSchedule.agents:type_name -> wfm.LookupEntity
+	44, // 36: wfm.WorkingSchedule.state_changed_by:type_name -> wfm.LookupEntity
+	37, // 37: wfm.ReadWorkingScheduleForecastResponse.ItemsEntry.value:type_name -> wfm.WorkingScheduleForecast
+	3,  // 38: wfm.WorkingScheduleService.CreateWorkingSchedule:input_type -> wfm.CreateWorkingScheduleRequest
+	5,  // 39: wfm.WorkingScheduleService.ReadWorkingSchedule:input_type -> wfm.ReadWorkingScheduleRequest
+	7,  // 40: wfm.WorkingScheduleService.ReadWorkingScheduleForecast:input_type -> wfm.ReadWorkingScheduleForecastRequest
+	9,  // 41: wfm.WorkingScheduleService.ReadWorkingScheduleCoverage:input_type -> wfm.ReadWorkingScheduleCoverageRequest
+	11, // 42: wfm.WorkingScheduleService.SearchWorkingSchedule:input_type -> wfm.SearchWorkingScheduleRequest
+	13, // 43: wfm.WorkingScheduleService.UpdateWorkingSchedule:input_type -> wfm.UpdateWorkingScheduleRequest
+	15, // 44: wfm.WorkingScheduleService.UpdateWorkingScheduleAddAgents:input_type -> wfm.UpdateWorkingScheduleAddAgentsRequest
+	17, // 45: wfm.WorkingScheduleService.UpdateWorkingScheduleRemoveAgent:input_type -> wfm.UpdateWorkingScheduleRemoveAgentRequest
+	19, // 46: wfm.WorkingScheduleService.SubmitWorkingSchedule:input_type -> wfm.SubmitWorkingScheduleRequest
+	21, // 47: wfm.WorkingScheduleService.ApproveWorkingSchedule:input_type -> wfm.ApproveWorkingScheduleRequest
+	23, // 48: wfm.WorkingScheduleService.RejectWorkingSchedule:input_type -> wfm.RejectWorkingScheduleRequest
+	25, // 49: wfm.WorkingScheduleService.ArchiveWorkingSchedule:input_type -> wfm.ArchiveWorkingScheduleRequest
+	27, // 50: wfm.WorkingScheduleService.GenerateWorkingSchedule:input_type -> wfm.GenerateWorkingScheduleRequest
+	29, // 51: wfm.WorkingScheduleService.PlaceWorkingSchedulePauses:input_type -> wfm.PlaceWorkingSchedulePausesRequest
+	31, // 52: wfm.WorkingScheduleService.CloneWorkingSchedule:input_type -> wfm.CloneWorkingScheduleRequest
+	33, // 53: wfm.WorkingScheduleService.ValidateWorkingSchedule:input_type -> wfm.ValidateWorkingScheduleRequest
+	35, // 54: wfm.WorkingScheduleService.DeleteWorkingSchedule:input_type -> wfm.DeleteWorkingScheduleRequest
+	4,  // 55: wfm.WorkingScheduleService.CreateWorkingSchedule:output_type -> wfm.CreateWorkingScheduleResponse
+	6,  // 56: wfm.WorkingScheduleService.ReadWorkingSchedule:output_type -> wfm.ReadWorkingScheduleResponse
+	8,  // 57: wfm.WorkingScheduleService.ReadWorkingScheduleForecast:output_type -> wfm.ReadWorkingScheduleForecastResponse
+	10, // 58: wfm.WorkingScheduleService.ReadWorkingScheduleCoverage:output_type -> wfm.ReadWorkingScheduleCoverageResponse
+	12, // 59: wfm.WorkingScheduleService.SearchWorkingSchedule:output_type -> wfm.SearchWorkingScheduleResponse
+	14, // 60: wfm.WorkingScheduleService.UpdateWorkingSchedule:output_type -> wfm.UpdateWorkingScheduleResponse
+	16, // 61: wfm.WorkingScheduleService.UpdateWorkingScheduleAddAgents:output_type -> wfm.UpdateWorkingScheduleAddAgentsResponse
+	18, // 62: wfm.WorkingScheduleService.UpdateWorkingScheduleRemoveAgent:output_type -> wfm.UpdateWorkingScheduleRemoveAgentResponse
+	20, // 63: wfm.WorkingScheduleService.SubmitWorkingSchedule:output_type -> wfm.SubmitWorkingScheduleResponse
+	22, // 64: wfm.WorkingScheduleService.ApproveWorkingSchedule:output_type -> wfm.ApproveWorkingScheduleResponse
+	24, // 65: wfm.WorkingScheduleService.RejectWorkingSchedule:output_type -> wfm.RejectWorkingScheduleResponse
+	26, // 66: wfm.WorkingScheduleService.ArchiveWorkingSchedule:output_type -> wfm.ArchiveWorkingScheduleResponse
+	28, // 67: wfm.WorkingScheduleService.GenerateWorkingSchedule:output_type -> wfm.GenerateWorkingScheduleResponse
+	30, // 68: wfm.WorkingScheduleService.PlaceWorkingSchedulePauses:output_type -> wfm.PlaceWorkingSchedulePausesResponse
+	32, // 69: wfm.WorkingScheduleService.CloneWorkingSchedule:output_type -> wfm.CloneWorkingScheduleResponse
+	34, // 70: wfm.WorkingScheduleService.ValidateWorkingSchedule:output_type -> wfm.ValidateWorkingScheduleResponse
+	36, // 71: wfm.WorkingScheduleService.DeleteWorkingSchedule:output_type -> wfm.DeleteWorkingScheduleResponse
+	55, // [55:72] is the sub-list for method output_type
+	38, // [38:55] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_working_schedule_proto_init() }
@@ -1566,6 +3207,7 @@ func file_working_schedule_proto_init() {
 	}
 	file_lookup_proto_init()
 	file_filter_proto_init()
+	file_agent_working_schedule_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_working_schedule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateWorkingScheduleRequest); i {
@@ -1640,7 +3282,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchWorkingScheduleRequest); i {
+			switch v := v.(*ReadWorkingScheduleCoverageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1652,7 +3294,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchWorkingScheduleResponse); i {
+			switch v := v.(*ReadWorkingScheduleCoverageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1664,7 +3306,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkingScheduleRequest); i {
+			switch v := v.(*SearchWorkingScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1676,7 +3318,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkingScheduleResponse); i {
+			switch v := v.(*SearchWorkingScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1688,7 +3330,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkingScheduleAddAgentsRequest); i {
+			switch v := v.(*UpdateWorkingScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1700,7 +3342,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkingScheduleAddAgentsResponse); i {
+			switch v := v.(*UpdateWorkingScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1712,7 +3354,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkingScheduleRemoveAgentRequest); i {
+			switch v := v.(*UpdateWorkingScheduleAddAgentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1724,7 +3366,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkingScheduleRemoveAgentResponse); i {
+			switch v := v.(*UpdateWorkingScheduleAddAgentsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1736,7 +3378,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkingScheduleRequest); i {
+			switch v := v.(*UpdateWorkingScheduleRemoveAgentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1748,7 +3390,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkingScheduleResponse); i {
+			switch v := v.(*UpdateWorkingScheduleRemoveAgentResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1760,7 +3402,7 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkingScheduleForecast); i {
+			switch v := v.(*SubmitWorkingScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1772,7 +3414,19 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WorkingSchedule); i {
+			switch v := v.(*SubmitWorkingScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveWorkingScheduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1784,6 +3438,234 @@ func file_working_schedule_proto_init() {
 			}
 		}
 		file_working_schedule_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveWorkingScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectWorkingScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectWorkingScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveWorkingScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveWorkingScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateWorkingScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateWorkingScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceWorkingSchedulePausesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaceWorkingSchedulePausesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneWorkingScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneWorkingScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateWorkingScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateWorkingScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkingScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkingScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkingScheduleForecast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkingScheduleStaffing); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkingScheduleViolation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkingSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WorkingScheduleForecast_Forecast); i {
 			case 0:
 				return &v.state
@@ -1797,13 +3679,16 @@ func file_working_schedule_proto_init() {
 		}
 	}
 	file_working_schedule_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_working_schedule_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_working_schedule_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_working_schedule_proto_msgTypes[28].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_working_schedule_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   20,
+			NumEnums:      3,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
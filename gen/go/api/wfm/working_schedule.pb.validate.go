@@ -822,6 +822,285 @@ var _ interface {
 	ErrorName() string
 } = ReadWorkingScheduleForecastResponseValidationError{}
 
+// Validate checks the field values on ReadWorkingScheduleCoverageRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ReadWorkingScheduleCoverageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadWorkingScheduleCoverageRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ReadWorkingScheduleCoverageRequestMultiError, or nil if none found.
+func (m *ReadWorkingScheduleCoverageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadWorkingScheduleCoverageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadWorkingScheduleCoverageRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadWorkingScheduleCoverageRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadWorkingScheduleCoverageRequestValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Granularity != nil {
+		// no validation rules for Granularity
+	}
+
+	if len(errors) > 0 {
+		return ReadWorkingScheduleCoverageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadWorkingScheduleCoverageRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ReadWorkingScheduleCoverageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReadWorkingScheduleCoverageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadWorkingScheduleCoverageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadWorkingScheduleCoverageRequestMultiError) AllErrors() []error { return m }
+
+// ReadWorkingScheduleCoverageRequestValidationError is the validation error
+// returned by ReadWorkingScheduleCoverageRequest.Validate if the designated
+// constraints aren't met.
+type ReadWorkingScheduleCoverageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadWorkingScheduleCoverageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadWorkingScheduleCoverageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadWorkingScheduleCoverageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadWorkingScheduleCoverageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadWorkingScheduleCoverageRequestValidationError) ErrorName() string {
+	return "ReadWorkingScheduleCoverageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadWorkingScheduleCoverageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadWorkingScheduleCoverageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadWorkingScheduleCoverageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadWorkingScheduleCoverageRequestValidationError{}
+
+// Validate checks the field values on ReadWorkingScheduleCoverageResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ReadWorkingScheduleCoverageResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadWorkingScheduleCoverageResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ReadWorkingScheduleCoverageResponseMultiError, or nil if none found.
+func (m *ReadWorkingScheduleCoverageResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadWorkingScheduleCoverageResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadWorkingScheduleCoverageResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadWorkingScheduleCoverageResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadWorkingScheduleCoverageResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadWorkingScheduleCoverageResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadWorkingScheduleCoverageResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ReadWorkingScheduleCoverageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReadWorkingScheduleCoverageResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadWorkingScheduleCoverageResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadWorkingScheduleCoverageResponseMultiError) AllErrors() []error { return m }
+
+// ReadWorkingScheduleCoverageResponseValidationError is the validation error
+// returned by ReadWorkingScheduleCoverageResponse.Validate if the designated
+// constraints aren't met.
+type ReadWorkingScheduleCoverageResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadWorkingScheduleCoverageResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadWorkingScheduleCoverageResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadWorkingScheduleCoverageResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadWorkingScheduleCoverageResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadWorkingScheduleCoverageResponseValidationError) ErrorName() string {
+	return "ReadWorkingScheduleCoverageResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadWorkingScheduleCoverageResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadWorkingScheduleCoverageResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadWorkingScheduleCoverageResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadWorkingScheduleCoverageResponseValidationError{}
+
 // Validate checks the field values on SearchWorkingScheduleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1843,22 +2122,22 @@ var _ interface {
 	ErrorName() string
 } = UpdateWorkingScheduleRemoveAgentResponseValidationError{}
 
-// Validate checks the field values on DeleteWorkingScheduleRequest with the
+// Validate checks the field values on SubmitWorkingScheduleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteWorkingScheduleRequest) Validate() error {
+func (m *SubmitWorkingScheduleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteWorkingScheduleRequest with the
+// ValidateAll checks the field values on SubmitWorkingScheduleRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteWorkingScheduleRequestMultiError, or nil if none found.
-func (m *DeleteWorkingScheduleRequest) ValidateAll() error {
+// SubmitWorkingScheduleRequestMultiError, or nil if none found.
+func (m *SubmitWorkingScheduleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteWorkingScheduleRequest) validate(all bool) error {
+func (m *SubmitWorkingScheduleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1868,19 +2147,19 @@ func (m *DeleteWorkingScheduleRequest) validate(all bool) error {
 	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return DeleteWorkingScheduleRequestMultiError(errors)
+		return SubmitWorkingScheduleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteWorkingScheduleRequestMultiError is an error wrapping multiple
-// validation errors returned by DeleteWorkingScheduleRequest.ValidateAll() if
+// SubmitWorkingScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by SubmitWorkingScheduleRequest.ValidateAll() if
 // the designated constraints aren't met.
-type DeleteWorkingScheduleRequestMultiError []error
+type SubmitWorkingScheduleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteWorkingScheduleRequestMultiError) Error() string {
+func (m SubmitWorkingScheduleRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1889,12 +2168,12 @@ func (m DeleteWorkingScheduleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteWorkingScheduleRequestMultiError) AllErrors() []error { return m }
+func (m SubmitWorkingScheduleRequestMultiError) AllErrors() []error { return m }
 
-// DeleteWorkingScheduleRequestValidationError is the validation error returned
-// by DeleteWorkingScheduleRequest.Validate if the designated constraints
+// SubmitWorkingScheduleRequestValidationError is the validation error returned
+// by SubmitWorkingScheduleRequest.Validate if the designated constraints
 // aren't met.
-type DeleteWorkingScheduleRequestValidationError struct {
+type SubmitWorkingScheduleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1902,24 +2181,24 @@ type DeleteWorkingScheduleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteWorkingScheduleRequestValidationError) Field() string { return e.field }
+func (e SubmitWorkingScheduleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteWorkingScheduleRequestValidationError) Reason() string { return e.reason }
+func (e SubmitWorkingScheduleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteWorkingScheduleRequestValidationError) Cause() error { return e.cause }
+func (e SubmitWorkingScheduleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteWorkingScheduleRequestValidationError) Key() bool { return e.key }
+func (e SubmitWorkingScheduleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteWorkingScheduleRequestValidationError) ErrorName() string {
-	return "DeleteWorkingScheduleRequestValidationError"
+func (e SubmitWorkingScheduleRequestValidationError) ErrorName() string {
+	return "SubmitWorkingScheduleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteWorkingScheduleRequestValidationError) Error() string {
+func (e SubmitWorkingScheduleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1931,14 +2210,14 @@ func (e DeleteWorkingScheduleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteWorkingScheduleRequest.%s: %s%s",
+		"invalid %sSubmitWorkingScheduleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteWorkingScheduleRequestValidationError{}
+var _ error = SubmitWorkingScheduleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1946,34 +2225,2126 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteWorkingScheduleRequestValidationError{}
+} = SubmitWorkingScheduleRequestValidationError{}
 
-// Validate checks the field values on DeleteWorkingScheduleResponse with the
+// Validate checks the field values on SubmitWorkingScheduleResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteWorkingScheduleResponse) Validate() error {
+func (m *SubmitWorkingScheduleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteWorkingScheduleResponse with
+// ValidateAll checks the field values on SubmitWorkingScheduleResponse with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// DeleteWorkingScheduleResponseMultiError, or nil if none found.
-func (m *DeleteWorkingScheduleResponse) ValidateAll() error {
+// SubmitWorkingScheduleResponseMultiError, or nil if none found.
+func (m *SubmitWorkingScheduleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteWorkingScheduleResponse) validate(all bool) error {
+func (m *SubmitWorkingScheduleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
-	if len(errors) > 0 {
-		return DeleteWorkingScheduleResponseMultiError(errors)
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubmitWorkingScheduleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubmitWorkingScheduleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubmitWorkingScheduleResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubmitWorkingScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubmitWorkingScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by SubmitWorkingScheduleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type SubmitWorkingScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubmitWorkingScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubmitWorkingScheduleResponseMultiError) AllErrors() []error { return m }
+
+// SubmitWorkingScheduleResponseValidationError is the validation error
+// returned by SubmitWorkingScheduleResponse.Validate if the designated
+// constraints aren't met.
+type SubmitWorkingScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubmitWorkingScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubmitWorkingScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubmitWorkingScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubmitWorkingScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubmitWorkingScheduleResponseValidationError) ErrorName() string {
+	return "SubmitWorkingScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubmitWorkingScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubmitWorkingScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubmitWorkingScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubmitWorkingScheduleResponseValidationError{}
+
+// Validate checks the field values on ApproveWorkingScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveWorkingScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveWorkingScheduleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ApproveWorkingScheduleRequestMultiError, or nil if none found.
+func (m *ApproveWorkingScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveWorkingScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ApproveWorkingScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveWorkingScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by ApproveWorkingScheduleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ApproveWorkingScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveWorkingScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveWorkingScheduleRequestMultiError) AllErrors() []error { return m }
+
+// ApproveWorkingScheduleRequestValidationError is the validation error
+// returned by ApproveWorkingScheduleRequest.Validate if the designated
+// constraints aren't met.
+type ApproveWorkingScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveWorkingScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveWorkingScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveWorkingScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveWorkingScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveWorkingScheduleRequestValidationError) ErrorName() string {
+	return "ApproveWorkingScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveWorkingScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveWorkingScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveWorkingScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveWorkingScheduleRequestValidationError{}
+
+// Validate checks the field values on ApproveWorkingScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApproveWorkingScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApproveWorkingScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ApproveWorkingScheduleResponseMultiError, or nil if none found.
+func (m *ApproveWorkingScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApproveWorkingScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApproveWorkingScheduleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApproveWorkingScheduleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApproveWorkingScheduleResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ApproveWorkingScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApproveWorkingScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by ApproveWorkingScheduleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ApproveWorkingScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApproveWorkingScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApproveWorkingScheduleResponseMultiError) AllErrors() []error { return m }
+
+// ApproveWorkingScheduleResponseValidationError is the validation error
+// returned by ApproveWorkingScheduleResponse.Validate if the designated
+// constraints aren't met.
+type ApproveWorkingScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApproveWorkingScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApproveWorkingScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApproveWorkingScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApproveWorkingScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApproveWorkingScheduleResponseValidationError) ErrorName() string {
+	return "ApproveWorkingScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApproveWorkingScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApproveWorkingScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApproveWorkingScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApproveWorkingScheduleResponseValidationError{}
+
+// Validate checks the field values on RejectWorkingScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectWorkingScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectWorkingScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RejectWorkingScheduleRequestMultiError, or nil if none found.
+func (m *RejectWorkingScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectWorkingScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return RejectWorkingScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectWorkingScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by RejectWorkingScheduleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RejectWorkingScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectWorkingScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectWorkingScheduleRequestMultiError) AllErrors() []error { return m }
+
+// RejectWorkingScheduleRequestValidationError is the validation error returned
+// by RejectWorkingScheduleRequest.Validate if the designated constraints
+// aren't met.
+type RejectWorkingScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectWorkingScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectWorkingScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectWorkingScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectWorkingScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectWorkingScheduleRequestValidationError) ErrorName() string {
+	return "RejectWorkingScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectWorkingScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectWorkingScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectWorkingScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectWorkingScheduleRequestValidationError{}
+
+// Validate checks the field values on RejectWorkingScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RejectWorkingScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RejectWorkingScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RejectWorkingScheduleResponseMultiError, or nil if none found.
+func (m *RejectWorkingScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RejectWorkingScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RejectWorkingScheduleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RejectWorkingScheduleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RejectWorkingScheduleResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RejectWorkingScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RejectWorkingScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by RejectWorkingScheduleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RejectWorkingScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RejectWorkingScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RejectWorkingScheduleResponseMultiError) AllErrors() []error { return m }
+
+// RejectWorkingScheduleResponseValidationError is the validation error
+// returned by RejectWorkingScheduleResponse.Validate if the designated
+// constraints aren't met.
+type RejectWorkingScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RejectWorkingScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RejectWorkingScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RejectWorkingScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RejectWorkingScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RejectWorkingScheduleResponseValidationError) ErrorName() string {
+	return "RejectWorkingScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RejectWorkingScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRejectWorkingScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RejectWorkingScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RejectWorkingScheduleResponseValidationError{}
+
+// Validate checks the field values on ArchiveWorkingScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchiveWorkingScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchiveWorkingScheduleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ArchiveWorkingScheduleRequestMultiError, or nil if none found.
+func (m *ArchiveWorkingScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchiveWorkingScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ArchiveWorkingScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArchiveWorkingScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by ArchiveWorkingScheduleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ArchiveWorkingScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchiveWorkingScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchiveWorkingScheduleRequestMultiError) AllErrors() []error { return m }
+
+// ArchiveWorkingScheduleRequestValidationError is the validation error
+// returned by ArchiveWorkingScheduleRequest.Validate if the designated
+// constraints aren't met.
+type ArchiveWorkingScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchiveWorkingScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchiveWorkingScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchiveWorkingScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchiveWorkingScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchiveWorkingScheduleRequestValidationError) ErrorName() string {
+	return "ArchiveWorkingScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchiveWorkingScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchiveWorkingScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchiveWorkingScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchiveWorkingScheduleRequestValidationError{}
+
+// Validate checks the field values on ArchiveWorkingScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ArchiveWorkingScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchiveWorkingScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ArchiveWorkingScheduleResponseMultiError, or nil if none found.
+func (m *ArchiveWorkingScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchiveWorkingScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArchiveWorkingScheduleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArchiveWorkingScheduleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArchiveWorkingScheduleResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ArchiveWorkingScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArchiveWorkingScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by ArchiveWorkingScheduleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ArchiveWorkingScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchiveWorkingScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchiveWorkingScheduleResponseMultiError) AllErrors() []error { return m }
+
+// ArchiveWorkingScheduleResponseValidationError is the validation error
+// returned by ArchiveWorkingScheduleResponse.Validate if the designated
+// constraints aren't met.
+type ArchiveWorkingScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchiveWorkingScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchiveWorkingScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchiveWorkingScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchiveWorkingScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchiveWorkingScheduleResponseValidationError) ErrorName() string {
+	return "ArchiveWorkingScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchiveWorkingScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchiveWorkingScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchiveWorkingScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchiveWorkingScheduleResponseValidationError{}
+
+// Validate checks the field values on GenerateWorkingScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateWorkingScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateWorkingScheduleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateWorkingScheduleRequestMultiError, or nil if none found.
+func (m *GenerateWorkingScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateWorkingScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GenerateWorkingScheduleRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GenerateWorkingScheduleRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GenerateWorkingScheduleRequestValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ShiftTemplateId != nil {
+		// no validation rules for ShiftTemplateId
+	}
+
+	if len(errors) > 0 {
+		return GenerateWorkingScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateWorkingScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by GenerateWorkingScheduleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateWorkingScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateWorkingScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateWorkingScheduleRequestMultiError) AllErrors() []error { return m }
+
+// GenerateWorkingScheduleRequestValidationError is the validation error
+// returned by GenerateWorkingScheduleRequest.Validate if the designated
+// constraints aren't met.
+type GenerateWorkingScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateWorkingScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateWorkingScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateWorkingScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateWorkingScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateWorkingScheduleRequestValidationError) ErrorName() string {
+	return "GenerateWorkingScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateWorkingScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateWorkingScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateWorkingScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateWorkingScheduleRequestValidationError{}
+
+// Validate checks the field values on GenerateWorkingScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GenerateWorkingScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GenerateWorkingScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GenerateWorkingScheduleResponseMultiError, or nil if none found.
+func (m *GenerateWorkingScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GenerateWorkingScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GenerateWorkingScheduleResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GenerateWorkingScheduleResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GenerateWorkingScheduleResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStaffing() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GenerateWorkingScheduleResponseValidationError{
+						field:  fmt.Sprintf("Staffing[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GenerateWorkingScheduleResponseValidationError{
+						field:  fmt.Sprintf("Staffing[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GenerateWorkingScheduleResponseValidationError{
+					field:  fmt.Sprintf("Staffing[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GenerateWorkingScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GenerateWorkingScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by GenerateWorkingScheduleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GenerateWorkingScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GenerateWorkingScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GenerateWorkingScheduleResponseMultiError) AllErrors() []error { return m }
+
+// GenerateWorkingScheduleResponseValidationError is the validation error
+// returned by GenerateWorkingScheduleResponse.Validate if the designated
+// constraints aren't met.
+type GenerateWorkingScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GenerateWorkingScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GenerateWorkingScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GenerateWorkingScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GenerateWorkingScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GenerateWorkingScheduleResponseValidationError) ErrorName() string {
+	return "GenerateWorkingScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GenerateWorkingScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGenerateWorkingScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GenerateWorkingScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GenerateWorkingScheduleResponseValidationError{}
+
+// Validate checks the field values on PlaceWorkingSchedulePausesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PlaceWorkingSchedulePausesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaceWorkingSchedulePausesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PlaceWorkingSchedulePausesRequestMultiError, or nil if none found.
+func (m *PlaceWorkingSchedulePausesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaceWorkingSchedulePausesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlaceWorkingSchedulePausesRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlaceWorkingSchedulePausesRequestValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlaceWorkingSchedulePausesRequestValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPlacement()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PlaceWorkingSchedulePausesRequestValidationError{
+					field:  "Placement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PlaceWorkingSchedulePausesRequestValidationError{
+					field:  "Placement",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPlacement()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PlaceWorkingSchedulePausesRequestValidationError{
+				field:  "Placement",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Overwrite
+
+	if len(errors) > 0 {
+		return PlaceWorkingSchedulePausesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaceWorkingSchedulePausesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PlaceWorkingSchedulePausesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PlaceWorkingSchedulePausesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaceWorkingSchedulePausesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaceWorkingSchedulePausesRequestMultiError) AllErrors() []error { return m }
+
+// PlaceWorkingSchedulePausesRequestValidationError is the validation error
+// returned by PlaceWorkingSchedulePausesRequest.Validate if the designated
+// constraints aren't met.
+type PlaceWorkingSchedulePausesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaceWorkingSchedulePausesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaceWorkingSchedulePausesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaceWorkingSchedulePausesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaceWorkingSchedulePausesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaceWorkingSchedulePausesRequestValidationError) ErrorName() string {
+	return "PlaceWorkingSchedulePausesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaceWorkingSchedulePausesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaceWorkingSchedulePausesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaceWorkingSchedulePausesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaceWorkingSchedulePausesRequestValidationError{}
+
+// Validate checks the field values on PlaceWorkingSchedulePausesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PlaceWorkingSchedulePausesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PlaceWorkingSchedulePausesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PlaceWorkingSchedulePausesResponseMultiError, or nil if none found.
+func (m *PlaceWorkingSchedulePausesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PlaceWorkingSchedulePausesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaceWorkingSchedulePausesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaceWorkingSchedulePausesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaceWorkingSchedulePausesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStaffing() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlaceWorkingSchedulePausesResponseValidationError{
+						field:  fmt.Sprintf("Staffing[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlaceWorkingSchedulePausesResponseValidationError{
+						field:  fmt.Sprintf("Staffing[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlaceWorkingSchedulePausesResponseValidationError{
+					field:  fmt.Sprintf("Staffing[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PlaceWorkingSchedulePausesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PlaceWorkingSchedulePausesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PlaceWorkingSchedulePausesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PlaceWorkingSchedulePausesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlaceWorkingSchedulePausesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlaceWorkingSchedulePausesResponseMultiError) AllErrors() []error { return m }
+
+// PlaceWorkingSchedulePausesResponseValidationError is the validation error
+// returned by PlaceWorkingSchedulePausesResponse.Validate if the designated
+// constraints aren't met.
+type PlaceWorkingSchedulePausesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlaceWorkingSchedulePausesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlaceWorkingSchedulePausesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlaceWorkingSchedulePausesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlaceWorkingSchedulePausesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlaceWorkingSchedulePausesResponseValidationError) ErrorName() string {
+	return "PlaceWorkingSchedulePausesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlaceWorkingSchedulePausesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlaceWorkingSchedulePausesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlaceWorkingSchedulePausesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlaceWorkingSchedulePausesResponseValidationError{}
+
+// Validate checks the field values on CloneWorkingScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneWorkingScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneWorkingScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneWorkingScheduleRequestMultiError, or nil if none found.
+func (m *CloneWorkingScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneWorkingScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for StartDateAt
+
+	// no validation rules for EndDateAt
+
+	// no validation rules for Shifts
+
+	if m.Name != nil {
+		// no validation rules for Name
+	}
+
+	if m.Weeks != nil {
+		// no validation rules for Weeks
+	}
+
+	if len(errors) > 0 {
+		return CloneWorkingScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloneWorkingScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by CloneWorkingScheduleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CloneWorkingScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneWorkingScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneWorkingScheduleRequestMultiError) AllErrors() []error { return m }
+
+// CloneWorkingScheduleRequestValidationError is the validation error returned
+// by CloneWorkingScheduleRequest.Validate if the designated constraints
+// aren't met.
+type CloneWorkingScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneWorkingScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneWorkingScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneWorkingScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneWorkingScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneWorkingScheduleRequestValidationError) ErrorName() string {
+	return "CloneWorkingScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneWorkingScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneWorkingScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneWorkingScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneWorkingScheduleRequestValidationError{}
+
+// Validate checks the field values on CloneWorkingScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloneWorkingScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloneWorkingScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloneWorkingScheduleResponseMultiError, or nil if none found.
+func (m *CloneWorkingScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloneWorkingScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloneWorkingScheduleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloneWorkingScheduleResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloneWorkingScheduleResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CloneWorkingScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloneWorkingScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by CloneWorkingScheduleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CloneWorkingScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloneWorkingScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloneWorkingScheduleResponseMultiError) AllErrors() []error { return m }
+
+// CloneWorkingScheduleResponseValidationError is the validation error returned
+// by CloneWorkingScheduleResponse.Validate if the designated constraints
+// aren't met.
+type CloneWorkingScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloneWorkingScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloneWorkingScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloneWorkingScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloneWorkingScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloneWorkingScheduleResponseValidationError) ErrorName() string {
+	return "CloneWorkingScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloneWorkingScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloneWorkingScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloneWorkingScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloneWorkingScheduleResponseValidationError{}
+
+// Validate checks the field values on ValidateWorkingScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateWorkingScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateWorkingScheduleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ValidateWorkingScheduleRequestMultiError, or nil if none found.
+func (m *ValidateWorkingScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateWorkingScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ValidateWorkingScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateWorkingScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by ValidateWorkingScheduleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ValidateWorkingScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateWorkingScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateWorkingScheduleRequestMultiError) AllErrors() []error { return m }
+
+// ValidateWorkingScheduleRequestValidationError is the validation error
+// returned by ValidateWorkingScheduleRequest.Validate if the designated
+// constraints aren't met.
+type ValidateWorkingScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateWorkingScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateWorkingScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateWorkingScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateWorkingScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateWorkingScheduleRequestValidationError) ErrorName() string {
+	return "ValidateWorkingScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateWorkingScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateWorkingScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateWorkingScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateWorkingScheduleRequestValidationError{}
+
+// Validate checks the field values on ValidateWorkingScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ValidateWorkingScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ValidateWorkingScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ValidateWorkingScheduleResponseMultiError, or nil if none found.
+func (m *ValidateWorkingScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ValidateWorkingScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ValidateWorkingScheduleResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ValidateWorkingScheduleResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ValidateWorkingScheduleResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Valid
+
+	if len(errors) > 0 {
+		return ValidateWorkingScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ValidateWorkingScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by ValidateWorkingScheduleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ValidateWorkingScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ValidateWorkingScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ValidateWorkingScheduleResponseMultiError) AllErrors() []error { return m }
+
+// ValidateWorkingScheduleResponseValidationError is the validation error
+// returned by ValidateWorkingScheduleResponse.Validate if the designated
+// constraints aren't met.
+type ValidateWorkingScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ValidateWorkingScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ValidateWorkingScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ValidateWorkingScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ValidateWorkingScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ValidateWorkingScheduleResponseValidationError) ErrorName() string {
+	return "ValidateWorkingScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ValidateWorkingScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sValidateWorkingScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ValidateWorkingScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ValidateWorkingScheduleResponseValidationError{}
+
+// Validate checks the field values on DeleteWorkingScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWorkingScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWorkingScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteWorkingScheduleRequestMultiError, or nil if none found.
+func (m *DeleteWorkingScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWorkingScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteWorkingScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteWorkingScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteWorkingScheduleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteWorkingScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteWorkingScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteWorkingScheduleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteWorkingScheduleRequestValidationError is the validation error returned
+// by DeleteWorkingScheduleRequest.Validate if the designated constraints
+// aren't met.
+type DeleteWorkingScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteWorkingScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteWorkingScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteWorkingScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteWorkingScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteWorkingScheduleRequestValidationError) ErrorName() string {
+	return "DeleteWorkingScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteWorkingScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteWorkingScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteWorkingScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteWorkingScheduleRequestValidationError{}
+
+// Validate checks the field values on DeleteWorkingScheduleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteWorkingScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteWorkingScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteWorkingScheduleResponseMultiError, or nil if none found.
+func (m *DeleteWorkingScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteWorkingScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return DeleteWorkingScheduleResponseMultiError(errors)
 	}
 
 	return nil
@@ -2189,6 +4560,257 @@ var _ interface {
 	ErrorName() string
 } = WorkingScheduleForecastValidationError{}
 
+// Validate checks the field values on WorkingScheduleStaffing with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkingScheduleStaffing) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkingScheduleStaffing with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkingScheduleStaffingMultiError, or nil if none found.
+func (m *WorkingScheduleStaffing) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkingScheduleStaffing) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Timestamp
+
+	// no validation rules for Required
+
+	// no validation rules for Scheduled
+
+	// no validation rules for Delta
+
+	// no validation rules for OnPause
+
+	if len(errors) > 0 {
+		return WorkingScheduleStaffingMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkingScheduleStaffingMultiError is an error wrapping multiple validation
+// errors returned by WorkingScheduleStaffing.ValidateAll() if the designated
+// constraints aren't met.
+type WorkingScheduleStaffingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkingScheduleStaffingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkingScheduleStaffingMultiError) AllErrors() []error { return m }
+
+// WorkingScheduleStaffingValidationError is the validation error returned by
+// WorkingScheduleStaffing.Validate if the designated constraints aren't met.
+type WorkingScheduleStaffingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkingScheduleStaffingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkingScheduleStaffingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkingScheduleStaffingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkingScheduleStaffingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkingScheduleStaffingValidationError) ErrorName() string {
+	return "WorkingScheduleStaffingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkingScheduleStaffingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkingScheduleStaffing.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkingScheduleStaffingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkingScheduleStaffingValidationError{}
+
+// Validate checks the field values on WorkingScheduleViolation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkingScheduleViolation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkingScheduleViolation with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkingScheduleViolationMultiError, or nil if none found.
+func (m *WorkingScheduleViolation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkingScheduleViolation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAgent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkingScheduleViolationValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkingScheduleViolationValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAgent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkingScheduleViolationValidationError{
+				field:  "Agent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Date
+
+	// no validation rules for Rule
+
+	// no validation rules for Severity
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return WorkingScheduleViolationMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkingScheduleViolationMultiError is an error wrapping multiple validation
+// errors returned by WorkingScheduleViolation.ValidateAll() if the designated
+// constraints aren't met.
+type WorkingScheduleViolationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkingScheduleViolationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkingScheduleViolationMultiError) AllErrors() []error { return m }
+
+// WorkingScheduleViolationValidationError is the validation error returned by
+// WorkingScheduleViolation.Validate if the designated constraints aren't met.
+type WorkingScheduleViolationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkingScheduleViolationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkingScheduleViolationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkingScheduleViolationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkingScheduleViolationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkingScheduleViolationValidationError) ErrorName() string {
+	return "WorkingScheduleViolationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkingScheduleViolationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkingScheduleViolation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkingScheduleViolationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkingScheduleViolationValidationError{}
+
 // Validate checks the field values on WorkingSchedule with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -2419,6 +5041,39 @@ func (m *WorkingSchedule) validate(all bool) error {
 
 	// no validation rules for TotalAgents
 
+	// no validation rules for StateChangedAt
+
+	if all {
+		switch v := interface{}(m.GetStateChangedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkingScheduleValidationError{
+					field:  "StateChangedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkingScheduleValidationError{
+					field:  "StateChangedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStateChangedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkingScheduleValidationError{
+				field:  "StateChangedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timezone
+
 	if len(errors) > 0 {
 		return WorkingScheduleMultiError(errors)
 	}
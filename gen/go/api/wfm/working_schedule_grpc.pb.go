@@ -22,10 +22,19 @@ const (
 	WorkingScheduleService_CreateWorkingSchedule_FullMethodName            = "/wfm.WorkingScheduleService/CreateWorkingSchedule"
 	WorkingScheduleService_ReadWorkingSchedule_FullMethodName              = "/wfm.WorkingScheduleService/ReadWorkingSchedule"
 	WorkingScheduleService_ReadWorkingScheduleForecast_FullMethodName      = "/wfm.WorkingScheduleService/ReadWorkingScheduleForecast"
+	WorkingScheduleService_ReadWorkingScheduleCoverage_FullMethodName      = "/wfm.WorkingScheduleService/ReadWorkingScheduleCoverage"
 	WorkingScheduleService_SearchWorkingSchedule_FullMethodName            = "/wfm.WorkingScheduleService/SearchWorkingSchedule"
 	WorkingScheduleService_UpdateWorkingSchedule_FullMethodName            = "/wfm.WorkingScheduleService/UpdateWorkingSchedule"
 	WorkingScheduleService_UpdateWorkingScheduleAddAgents_FullMethodName   = "/wfm.WorkingScheduleService/UpdateWorkingScheduleAddAgents"
 	WorkingScheduleService_UpdateWorkingScheduleRemoveAgent_FullMethodName = "/wfm.WorkingScheduleService/UpdateWorkingScheduleRemoveAgent"
+	WorkingScheduleService_SubmitWorkingSchedule_FullMethodName            = "/wfm.WorkingScheduleService/SubmitWorkingSchedule"
+	WorkingScheduleService_ApproveWorkingSchedule_FullMethodName           = "/wfm.WorkingScheduleService/ApproveWorkingSchedule"
+	WorkingScheduleService_RejectWorkingSchedule_FullMethodName            = "/wfm.WorkingScheduleService/RejectWorkingSchedule"
+	WorkingScheduleService_ArchiveWorkingSchedule_FullMethodName           = "/wfm.WorkingScheduleService/ArchiveWorkingSchedule"
+	WorkingScheduleService_GenerateWorkingSchedule_FullMethodName          = "/wfm.WorkingScheduleService/GenerateWorkingSchedule"
+	WorkingScheduleService_PlaceWorkingSchedulePauses_FullMethodName       = "/wfm.WorkingScheduleService/PlaceWorkingSchedulePauses"
+	WorkingScheduleService_CloneWorkingSchedule_FullMethodName             = "/wfm.WorkingScheduleService/CloneWorkingSchedule"
+	WorkingScheduleService_ValidateWorkingSchedule_FullMethodName          = "/wfm.WorkingScheduleService/ValidateWorkingSchedule"
 	WorkingScheduleService_DeleteWorkingSchedule_FullMethodName            = "/wfm.WorkingScheduleService/DeleteWorkingSchedule"
 )
 
@@ -36,10 +45,29 @@ type WorkingScheduleServiceClient interface {
 	CreateWorkingSchedule(ctx context.Context, in *CreateWorkingScheduleRequest, opts ...grpc.CallOption) (*CreateWorkingScheduleResponse, error)
 	ReadWorkingSchedule(ctx context.Context, in *ReadWorkingScheduleRequest, opts ...grpc.CallOption) (*ReadWorkingScheduleResponse, error)
 	ReadWorkingScheduleForecast(ctx context.Context, in *ReadWorkingScheduleForecastRequest, opts ...grpc.CallOption) (*ReadWorkingScheduleForecastResponse, error)
+	// Compares scheduled agents with the forecast per interval.
+	ReadWorkingScheduleCoverage(ctx context.Context, in *ReadWorkingScheduleCoverageRequest, opts ...grpc.CallOption) (*ReadWorkingScheduleCoverageResponse, error)
 	SearchWorkingSchedule(ctx context.Context, in *SearchWorkingScheduleRequest, opts ...grpc.CallOption) (*SearchWorkingScheduleResponse, error)
 	UpdateWorkingSchedule(ctx context.Context, in *UpdateWorkingScheduleRequest, opts ...grpc.CallOption) (*UpdateWorkingScheduleResponse, error)
 	UpdateWorkingScheduleAddAgents(ctx context.Context, in *UpdateWorkingScheduleAddAgentsRequest, opts ...grpc.CallOption) (*UpdateWorkingScheduleAddAgentsResponse, error)
 	UpdateWorkingScheduleRemoveAgent(ctx context.Context, in *UpdateWorkingScheduleRemoveAgentRequest, opts ...grpc.CallOption) (*UpdateWorkingScheduleRemoveAgentResponse, error)
+	// Moves a draft working schedule to the pending state for review.
+	SubmitWorkingSchedule(ctx context.Context, in *SubmitWorkingScheduleRequest, opts ...grpc.CallOption) (*SubmitWorkingScheduleResponse, error)
+	// Activates a pending working schedule.
+	ApproveWorkingSchedule(ctx context.Context, in *ApproveWorkingScheduleRequest, opts ...grpc.CallOption) (*ApproveWorkingScheduleResponse, error)
+	// Returns a pending working schedule back to the draft state.
+	RejectWorkingSchedule(ctx context.Context, in *RejectWorkingScheduleRequest, opts ...grpc.CallOption) (*RejectWorkingScheduleResponse, error)
+	// Archives an active working schedule after its end date.
+	ArchiveWorkingSchedule(ctx context.Context, in *ArchiveWorkingScheduleRequest, opts ...grpc.CallOption) (*ArchiveWorkingScheduleResponse, error)
+	// Generates draft agent shifts covering the working schedule forecast.
+	GenerateWorkingSchedule(ctx context.Context, in *GenerateWorkingScheduleRequest, opts ...grpc.CallOption) (*GenerateWorkingScheduleResponse, error)
+	// Places pauses of pause templates into the working schedule shifts.
+	PlaceWorkingSchedulePauses(ctx context.Context, in *PlaceWorkingSchedulePausesRequest, opts ...grpc.CallOption) (*PlaceWorkingSchedulePausesResponse, error)
+	// Copies the working schedule with its agents and extra skills into a new draft working schedule.
+	// Shifts are optionally copied, source days falling on holidays are skipped.
+	CloneWorkingSchedule(ctx context.Context, in *CloneWorkingScheduleRequest, opts ...grpc.CallOption) (*CloneWorkingScheduleResponse, error)
+	// Checks working schedule shifts against absences, pauses and rest rules.
+	ValidateWorkingSchedule(ctx context.Context, in *ValidateWorkingScheduleRequest, opts ...grpc.CallOption) (*ValidateWorkingScheduleResponse, error)
 	DeleteWorkingSchedule(ctx context.Context, in *DeleteWorkingScheduleRequest, opts ...grpc.CallOption) (*DeleteWorkingScheduleResponse, error)
 }
 
@@ -78,6 +106,15 @@ func (c *workingScheduleServiceClient) ReadWorkingScheduleForecast(ctx context.C
 	return out, nil
 }
 
+func (c *workingScheduleServiceClient) ReadWorkingScheduleCoverage(ctx context.Context, in *ReadWorkingScheduleCoverageRequest, opts ...grpc.CallOption) (*ReadWorkingScheduleCoverageResponse, error) {
+	out := new(ReadWorkingScheduleCoverageResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleService_ReadWorkingScheduleCoverage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workingScheduleServiceClient) SearchWorkingSchedule(ctx context.Context, in *SearchWorkingScheduleRequest, opts ...grpc.CallOption) (*SearchWorkingScheduleResponse, error) {
 	out := new(SearchWorkingScheduleResponse)
 	err := c.cc.Invoke(ctx, WorkingScheduleService_SearchWorkingSchedule_FullMethodName, in, out, opts...)
@@ -114,6 +151,78 @@ func (c *workingScheduleServiceClient) UpdateWorkingScheduleRemoveAgent(ctx cont
 	return out, nil
 }
 
+func (c *workingScheduleServiceClient) SubmitWorkingSchedule(ctx context.Context, in *SubmitWorkingScheduleRequest, opts ...grpc.CallOption) (*SubmitWorkingScheduleResponse, error) {
+	out := new(SubmitWorkingScheduleResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleService_SubmitWorkingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingScheduleServiceClient) ApproveWorkingSchedule(ctx context.Context, in *ApproveWorkingScheduleRequest, opts ...grpc.CallOption) (*ApproveWorkingScheduleResponse, error) {
+	out := new(ApproveWorkingScheduleResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleService_ApproveWorkingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingScheduleServiceClient) RejectWorkingSchedule(ctx context.Context, in *RejectWorkingScheduleRequest, opts ...grpc.CallOption) (*RejectWorkingScheduleResponse, error) {
+	out := new(RejectWorkingScheduleResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleService_RejectWorkingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingScheduleServiceClient) ArchiveWorkingSchedule(ctx context.Context, in *ArchiveWorkingScheduleRequest, opts ...grpc.CallOption) (*ArchiveWorkingScheduleResponse, error) {
+	out := new(ArchiveWorkingScheduleResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleService_ArchiveWorkingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingScheduleServiceClient) GenerateWorkingSchedule(ctx context.Context, in *GenerateWorkingScheduleRequest, opts ...grpc.CallOption) (*GenerateWorkingScheduleResponse, error) {
+	out := new(GenerateWorkingScheduleResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleService_GenerateWorkingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingScheduleServiceClient) PlaceWorkingSchedulePauses(ctx context.Context, in *PlaceWorkingSchedulePausesRequest, opts ...grpc.CallOption) (*PlaceWorkingSchedulePausesResponse, error) {
+	out := new(PlaceWorkingSchedulePausesResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleService_PlaceWorkingSchedulePauses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingScheduleServiceClient) CloneWorkingSchedule(ctx context.Context, in *CloneWorkingScheduleRequest, opts ...grpc.CallOption) (*CloneWorkingScheduleResponse, error) {
+	out := new(CloneWorkingScheduleResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleService_CloneWorkingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingScheduleServiceClient) ValidateWorkingSchedule(ctx context.Context, in *ValidateWorkingScheduleRequest, opts ...grpc.CallOption) (*ValidateWorkingScheduleResponse, error) {
+	out := new(ValidateWorkingScheduleResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleService_ValidateWorkingSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workingScheduleServiceClient) DeleteWorkingSchedule(ctx context.Context, in *DeleteWorkingScheduleRequest, opts ...grpc.CallOption) (*DeleteWorkingScheduleResponse, error) {
 	out := new(DeleteWorkingScheduleResponse)
 	err := c.cc.Invoke(ctx, WorkingScheduleService_DeleteWorkingSchedule_FullMethodName, in, out, opts...)
@@ -130,10 +239,29 @@ type WorkingScheduleServiceServer interface {
 	CreateWorkingSchedule(context.Context, *CreateWorkingScheduleRequest) (*CreateWorkingScheduleResponse, error)
 	ReadWorkingSchedule(context.Context, *ReadWorkingScheduleRequest) (*ReadWorkingScheduleResponse, error)
 	ReadWorkingScheduleForecast(context.Context, *ReadWorkingScheduleForecastRequest) (*ReadWorkingScheduleForecastResponse, error)
+	// Compares scheduled agents with the forecast per interval.
+	ReadWorkingScheduleCoverage(context.Context, *ReadWorkingScheduleCoverageRequest) (*ReadWorkingScheduleCoverageResponse, error)
 	SearchWorkingSchedule(context.Context, *SearchWorkingScheduleRequest) (*SearchWorkingScheduleResponse, error)
 	UpdateWorkingSchedule(context.Context, *UpdateWorkingScheduleRequest) (*UpdateWorkingScheduleResponse, error)
 	UpdateWorkingScheduleAddAgents(context.Context, *UpdateWorkingScheduleAddAgentsRequest) (*UpdateWorkingScheduleAddAgentsResponse, error)
 	UpdateWorkingScheduleRemoveAgent(context.Context, *UpdateWorkingScheduleRemoveAgentRequest) (*UpdateWorkingScheduleRemoveAgentResponse, error)
+	// Moves a draft working schedule to the pending state for review.
+	SubmitWorkingSchedule(context.Context, *SubmitWorkingScheduleRequest) (*SubmitWorkingScheduleResponse, error)
+	// Activates a pending working schedule.
+	ApproveWorkingSchedule(context.Context, *ApproveWorkingScheduleRequest) (*ApproveWorkingScheduleResponse, error)
+	// Returns a pending working schedule back to the draft state.
+	RejectWorkingSchedule(context.Context, *RejectWorkingScheduleRequest) (*RejectWorkingScheduleResponse, error)
+	// Archives an active working schedule after its end date.
+	ArchiveWorkingSchedule(context.Context, *ArchiveWorkingScheduleRequest) (*ArchiveWorkingScheduleResponse, error)
+	// Generates draft agent shifts covering the working schedule forecast.
+	GenerateWorkingSchedule(context.Context, *GenerateWorkingScheduleRequest) (*GenerateWorkingScheduleResponse, error)
+	// Places pauses of pause templates into the working schedule shifts.
+	PlaceWorkingSchedulePauses(context.Context, *PlaceWorkingSchedulePausesRequest) (*PlaceWorkingSchedulePausesResponse, error)
+	// Copies the working schedule with its agents and extra skills into a new draft working schedule.
+	// Shifts are optionally copied, source days falling on holidays are skipped.
+	CloneWorkingSchedule(context.Context, *CloneWorkingScheduleRequest) (*CloneWorkingScheduleResponse, error)
+	// Checks working schedule shifts against absences, pauses and rest rules.
+	ValidateWorkingSchedule(context.Context, *ValidateWorkingScheduleRequest) (*ValidateWorkingScheduleResponse, error)
 	DeleteWorkingSchedule(context.Context, *DeleteWorkingScheduleRequest) (*DeleteWorkingScheduleResponse, error)
 	mustEmbedUnimplementedWorkingScheduleServiceServer()
 }
@@ -151,6 +279,9 @@ func (UnimplementedWorkingScheduleServiceServer) ReadWorkingSchedule(context.Con
 func (UnimplementedWorkingScheduleServiceServer) ReadWorkingScheduleForecast(context.Context, *ReadWorkingScheduleForecastRequest) (*ReadWorkingScheduleForecastResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadWorkingScheduleForecast not implemented")
 }
+func (UnimplementedWorkingScheduleServiceServer) ReadWorkingScheduleCoverage(context.Context, *ReadWorkingScheduleCoverageRequest) (*ReadWorkingScheduleCoverageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadWorkingScheduleCoverage not implemented")
+}
 func (UnimplementedWorkingScheduleServiceServer) SearchWorkingSchedule(context.Context, *SearchWorkingScheduleRequest) (*SearchWorkingScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchWorkingSchedule not implemented")
 }
@@ -163,6 +294,30 @@ func (UnimplementedWorkingScheduleServiceServer) UpdateWorkingScheduleAddAgents(
 func (UnimplementedWorkingScheduleServiceServer) UpdateWorkingScheduleRemoveAgent(context.Context, *UpdateWorkingScheduleRemoveAgentRequest) (*UpdateWorkingScheduleRemoveAgentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkingScheduleRemoveAgent not implemented")
 }
+func (UnimplementedWorkingScheduleServiceServer) SubmitWorkingSchedule(context.Context, *SubmitWorkingScheduleRequest) (*SubmitWorkingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitWorkingSchedule not implemented")
+}
+func (UnimplementedWorkingScheduleServiceServer) ApproveWorkingSchedule(context.Context, *ApproveWorkingScheduleRequest) (*ApproveWorkingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWorkingSchedule not implemented")
+}
+func (UnimplementedWorkingScheduleServiceServer) RejectWorkingSchedule(context.Context, *RejectWorkingScheduleRequest) (*RejectWorkingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectWorkingSchedule not implemented")
+}
+func (UnimplementedWorkingScheduleServiceServer) ArchiveWorkingSchedule(context.Context, *ArchiveWorkingScheduleRequest) (*ArchiveWorkingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveWorkingSchedule not implemented")
+}
+func (UnimplementedWorkingScheduleServiceServer) GenerateWorkingSchedule(context.Context, *GenerateWorkingScheduleRequest) (*GenerateWorkingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateWorkingSchedule not implemented")
+}
+func (UnimplementedWorkingScheduleServiceServer) PlaceWorkingSchedulePauses(context.Context, *PlaceWorkingSchedulePausesRequest) (*PlaceWorkingSchedulePausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceWorkingSchedulePauses not implemented")
+}
+func (UnimplementedWorkingScheduleServiceServer) CloneWorkingSchedule(context.Context, *CloneWorkingScheduleRequest) (*CloneWorkingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneWorkingSchedule not implemented")
+}
+func (UnimplementedWorkingScheduleServiceServer) ValidateWorkingSchedule(context.Context, *ValidateWorkingScheduleRequest) (*ValidateWorkingScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateWorkingSchedule not implemented")
+}
 func (UnimplementedWorkingScheduleServiceServer) DeleteWorkingSchedule(context.Context, *DeleteWorkingScheduleRequest) (*DeleteWorkingScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkingSchedule not implemented")
 }
@@ -234,6 +389,24 @@ func _WorkingScheduleService_ReadWorkingScheduleForecast_Handler(srv interface{}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkingScheduleService_ReadWorkingScheduleCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadWorkingScheduleCoverageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleServiceServer).ReadWorkingScheduleCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleService_ReadWorkingScheduleCoverage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleServiceServer).ReadWorkingScheduleCoverage(ctx, req.(*ReadWorkingScheduleCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkingScheduleService_SearchWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchWorkingScheduleRequest)
 	if err := dec(in); err != nil {
@@ -306,6 +479,150 @@ func _WorkingScheduleService_UpdateWorkingScheduleRemoveAgent_Handler(srv interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WorkingScheduleService_SubmitWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitWorkingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleServiceServer).SubmitWorkingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleService_SubmitWorkingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleServiceServer).SubmitWorkingSchedule(ctx, req.(*SubmitWorkingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingScheduleService_ApproveWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveWorkingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleServiceServer).ApproveWorkingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleService_ApproveWorkingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleServiceServer).ApproveWorkingSchedule(ctx, req.(*ApproveWorkingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingScheduleService_RejectWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectWorkingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleServiceServer).RejectWorkingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleService_RejectWorkingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleServiceServer).RejectWorkingSchedule(ctx, req.(*RejectWorkingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingScheduleService_ArchiveWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveWorkingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleServiceServer).ArchiveWorkingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleService_ArchiveWorkingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleServiceServer).ArchiveWorkingSchedule(ctx, req.(*ArchiveWorkingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingScheduleService_GenerateWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateWorkingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleServiceServer).GenerateWorkingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleService_GenerateWorkingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleServiceServer).GenerateWorkingSchedule(ctx, req.(*GenerateWorkingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingScheduleService_PlaceWorkingSchedulePauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceWorkingSchedulePausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleServiceServer).PlaceWorkingSchedulePauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleService_PlaceWorkingSchedulePauses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleServiceServer).PlaceWorkingSchedulePauses(ctx, req.(*PlaceWorkingSchedulePausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingScheduleService_CloneWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneWorkingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleServiceServer).CloneWorkingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleService_CloneWorkingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleServiceServer).CloneWorkingSchedule(ctx, req.(*CloneWorkingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingScheduleService_ValidateWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateWorkingScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleServiceServer).ValidateWorkingSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleService_ValidateWorkingSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleServiceServer).ValidateWorkingSchedule(ctx, req.(*ValidateWorkingScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _WorkingScheduleService_DeleteWorkingSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteWorkingScheduleRequest)
 	if err := dec(in); err != nil {
@@ -343,6 +660,10 @@ var WorkingScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReadWorkingScheduleForecast",
 			Handler:    _WorkingScheduleService_ReadWorkingScheduleForecast_Handler,
 		},
+		{
+			MethodName: "ReadWorkingScheduleCoverage",
+			Handler:    _WorkingScheduleService_ReadWorkingScheduleCoverage_Handler,
+		},
 		{
 			MethodName: "SearchWorkingSchedule",
 			Handler:    _WorkingScheduleService_SearchWorkingSchedule_Handler,
@@ -359,6 +680,38 @@ var WorkingScheduleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateWorkingScheduleRemoveAgent",
 			Handler:    _WorkingScheduleService_UpdateWorkingScheduleRemoveAgent_Handler,
 		},
+		{
+			MethodName: "SubmitWorkingSchedule",
+			Handler:    _WorkingScheduleService_SubmitWorkingSchedule_Handler,
+		},
+		{
+			MethodName: "ApproveWorkingSchedule",
+			Handler:    _WorkingScheduleService_ApproveWorkingSchedule_Handler,
+		},
+		{
+			MethodName: "RejectWorkingSchedule",
+			Handler:    _WorkingScheduleService_RejectWorkingSchedule_Handler,
+		},
+		{
+			MethodName: "ArchiveWorkingSchedule",
+			Handler:    _WorkingScheduleService_ArchiveWorkingSchedule_Handler,
+		},
+		{
+			MethodName: "GenerateWorkingSchedule",
+			Handler:    _WorkingScheduleService_GenerateWorkingSchedule_Handler,
+		},
+		{
+			MethodName: "PlaceWorkingSchedulePauses",
+			Handler:    _WorkingScheduleService_PlaceWorkingSchedulePauses_Handler,
+		},
+		{
+			MethodName: "CloneWorkingSchedule",
+			Handler:    _WorkingScheduleService_CloneWorkingSchedule_Handler,
+		},
+		{
+			MethodName: "ValidateWorkingSchedule",
+			Handler:    _WorkingScheduleService_ValidateWorkingSchedule_Handler,
+		},
 		{
 			MethodName: "DeleteWorkingSchedule",
 			Handler:    _WorkingScheduleService_DeleteWorkingSchedule_Handler,
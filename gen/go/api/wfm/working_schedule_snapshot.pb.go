@@ -0,0 +1,1186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: working_schedule_snapshot.proto
+
+package wfm
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/webitel/webitel-go-kit/cmd/protoc-gen-go-webitel/gen/go/proto/webitel"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ShiftDiffChange int32
+
+const (
+	ShiftDiffChange_SHIFT_DIFF_CHANGE_UNSPECIFIED ShiftDiffChange = 0
+	ShiftDiffChange_SHIFT_DIFF_CHANGE_ADDED       ShiftDiffChange = 1
+	ShiftDiffChange_SHIFT_DIFF_CHANGE_REMOVED     ShiftDiffChange = 2
+	// Times, pauses or skills of the shift are changed.
+	ShiftDiffChange_SHIFT_DIFF_CHANGE_CHANGED ShiftDiffChange = 3
+)
+
+// Enum value maps for ShiftDiffChange.
+var (
+	ShiftDiffChange_name = map[int32]string{
+		0: "SHIFT_DIFF_CHANGE_UNSPECIFIED",
+		1: "SHIFT_DIFF_CHANGE_ADDED",
+		2: "SHIFT_DIFF_CHANGE_REMOVED",
+		3: "SHIFT_DIFF_CHANGE_CHANGED",
+	}
+	ShiftDiffChange_value = map[string]int32{
+		"SHIFT_DIFF_CHANGE_UNSPECIFIED": 0,
+		"SHIFT_DIFF_CHANGE_ADDED":       1,
+		"SHIFT_DIFF_CHANGE_REMOVED":     2,
+		"SHIFT_DIFF_CHANGE_CHANGED":     3,
+	}
+)
+
+func (x ShiftDiffChange) Enum() *ShiftDiffChange {
+	p := new(ShiftDiffChange)
+	*p = x
+	return p
+}
+
+func (x ShiftDiffChange) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShiftDiffChange) Descriptor() protoreflect.EnumDescriptor {
+	return file_working_schedule_snapshot_proto_enumTypes[0].Descriptor()
+}
+
+func (ShiftDiffChange) Type() protoreflect.EnumType {
+	return &file_working_schedule_snapshot_proto_enumTypes[0]
+}
+
+func (x ShiftDiffChange) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShiftDiffChange.Descriptor instead.
+func (ShiftDiffChange) EnumDescriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+type CreateWorkingScheduleSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingScheduleId int64 `protobuf:"varint,1,opt,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
+	// Describes changes published by the snapshot.
+	Note *string `protobuf:"bytes,2,opt,name=note,proto3,oneof" json:"note,omitempty"`
+}
+
+func (x *CreateWorkingScheduleSnapshotRequest) Reset() {
+	*x = CreateWorkingScheduleSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkingScheduleSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkingScheduleSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateWorkingScheduleSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkingScheduleSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkingScheduleSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateWorkingScheduleSnapshotRequest) GetWorkingScheduleId() int64 {
+	if x != nil {
+		return x.WorkingScheduleId
+	}
+	return 0
+}
+
+func (x *CreateWorkingScheduleSnapshotRequest) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+type CreateWorkingScheduleSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *WorkingScheduleSnapshot `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *CreateWorkingScheduleSnapshotResponse) Reset() {
+	*x = CreateWorkingScheduleSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkingScheduleSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkingScheduleSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateWorkingScheduleSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkingScheduleSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkingScheduleSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateWorkingScheduleSnapshotResponse) GetItem() *WorkingScheduleSnapshot {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type ReadWorkingScheduleSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingScheduleId int64 `protobuf:"varint,1,opt,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
+	Id                int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReadWorkingScheduleSnapshotRequest) Reset() {
+	*x = ReadWorkingScheduleSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadWorkingScheduleSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadWorkingScheduleSnapshotRequest) ProtoMessage() {}
+
+func (x *ReadWorkingScheduleSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadWorkingScheduleSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*ReadWorkingScheduleSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReadWorkingScheduleSnapshotRequest) GetWorkingScheduleId() int64 {
+	if x != nil {
+		return x.WorkingScheduleId
+	}
+	return 0
+}
+
+func (x *ReadWorkingScheduleSnapshotRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ReadWorkingScheduleSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *WorkingScheduleSnapshot `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// Agent shifts at the time of the snapshot.
+	Shifts []*AgentWorkingSchedule `protobuf:"bytes,2,rep,name=shifts,proto3" json:"shifts,omitempty"`
+}
+
+func (x *ReadWorkingScheduleSnapshotResponse) Reset() {
+	*x = ReadWorkingScheduleSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadWorkingScheduleSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadWorkingScheduleSnapshotResponse) ProtoMessage() {}
+
+func (x *ReadWorkingScheduleSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadWorkingScheduleSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*ReadWorkingScheduleSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReadWorkingScheduleSnapshotResponse) GetItem() *WorkingScheduleSnapshot {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *ReadWorkingScheduleSnapshotResponse) GetShifts() []*AgentWorkingSchedule {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+type SearchWorkingScheduleSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingScheduleId int64    `protobuf:"varint,1,opt,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
+	Page              *int32   `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Size              *int32   `protobuf:"varint,3,opt,name=size,proto3,oneof" json:"size,omitempty"`
+	Sort              *string  `protobuf:"bytes,4,opt,name=sort,proto3,oneof" json:"sort,omitempty"`
+	Fields            []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *SearchWorkingScheduleSnapshotRequest) Reset() {
+	*x = SearchWorkingScheduleSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchWorkingScheduleSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWorkingScheduleSnapshotRequest) ProtoMessage() {}
+
+func (x *SearchWorkingScheduleSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWorkingScheduleSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*SearchWorkingScheduleSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchWorkingScheduleSnapshotRequest) GetWorkingScheduleId() int64 {
+	if x != nil {
+		return x.WorkingScheduleId
+	}
+	return 0
+}
+
+func (x *SearchWorkingScheduleSnapshotRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *SearchWorkingScheduleSnapshotRequest) GetSize() int32 {
+	if x != nil && x.Size != nil {
+		return *x.Size
+	}
+	return 0
+}
+
+func (x *SearchWorkingScheduleSnapshotRequest) GetSort() string {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return ""
+}
+
+func (x *SearchWorkingScheduleSnapshotRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type SearchWorkingScheduleSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*WorkingScheduleSnapshot `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Next  bool                       `protobuf:"varint,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *SearchWorkingScheduleSnapshotResponse) Reset() {
+	*x = SearchWorkingScheduleSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchWorkingScheduleSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchWorkingScheduleSnapshotResponse) ProtoMessage() {}
+
+func (x *SearchWorkingScheduleSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchWorkingScheduleSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*SearchWorkingScheduleSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SearchWorkingScheduleSnapshotResponse) GetItems() []*WorkingScheduleSnapshot {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchWorkingScheduleSnapshotResponse) GetNext() bool {
+	if x != nil {
+		return x.Next
+	}
+	return false
+}
+
+type DiffWorkingScheduleSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkingScheduleId int64 `protobuf:"varint,1,opt,name=working_schedule_id,json=workingScheduleId,proto3" json:"working_schedule_id,omitempty"`
+	// Snapshot to compare from.
+	Id int64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Snapshot to compare to, defaults to the current shifts of the working schedule.
+	ToId *int64 `protobuf:"varint,3,opt,name=to_id,json=toId,proto3,oneof" json:"to_id,omitempty"`
+}
+
+func (x *DiffWorkingScheduleSnapshotRequest) Reset() {
+	*x = DiffWorkingScheduleSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffWorkingScheduleSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffWorkingScheduleSnapshotRequest) ProtoMessage() {}
+
+func (x *DiffWorkingScheduleSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffWorkingScheduleSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*DiffWorkingScheduleSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DiffWorkingScheduleSnapshotRequest) GetWorkingScheduleId() int64 {
+	if x != nil {
+		return x.WorkingScheduleId
+	}
+	return 0
+}
+
+func (x *DiffWorkingScheduleSnapshotRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DiffWorkingScheduleSnapshotRequest) GetToId() int64 {
+	if x != nil && x.ToId != nil {
+		return *x.ToId
+	}
+	return 0
+}
+
+type DiffWorkingScheduleSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*WorkingScheduleAgentDiff `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *DiffWorkingScheduleSnapshotResponse) Reset() {
+	*x = DiffWorkingScheduleSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiffWorkingScheduleSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffWorkingScheduleSnapshotResponse) ProtoMessage() {}
+
+func (x *DiffWorkingScheduleSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffWorkingScheduleSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*DiffWorkingScheduleSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DiffWorkingScheduleSnapshotResponse) GetItems() []*WorkingScheduleAgentDiff {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// WorkingScheduleShiftDiff is a change of an agent shift between two versions of the working schedule.
+// Before is set unless the shift is added, after is set unless the shift is removed.
+type WorkingScheduleShiftDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date   int64               `protobuf:"varint,1,opt,name=date,proto3" json:"date,omitempty"`
+	Change ShiftDiffChange     `protobuf:"varint,2,opt,name=change,proto3,enum=wfm.ShiftDiffChange" json:"change,omitempty"`
+	Before *AgentScheduleShift `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	After  *AgentScheduleShift `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *WorkingScheduleShiftDiff) Reset() {
+	*x = WorkingScheduleShiftDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkingScheduleShiftDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingScheduleShiftDiff) ProtoMessage() {}
+
+func (x *WorkingScheduleShiftDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingScheduleShiftDiff.ProtoReflect.Descriptor instead.
+func (*WorkingScheduleShiftDiff) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WorkingScheduleShiftDiff) GetDate() int64 {
+	if x != nil {
+		return x.Date
+	}
+	return 0
+}
+
+func (x *WorkingScheduleShiftDiff) GetChange() ShiftDiffChange {
+	if x != nil {
+		return x.Change
+	}
+	return ShiftDiffChange_SHIFT_DIFF_CHANGE_UNSPECIFIED
+}
+
+func (x *WorkingScheduleShiftDiff) GetBefore() *AgentScheduleShift {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *WorkingScheduleShiftDiff) GetAfter() *AgentScheduleShift {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type WorkingScheduleAgentDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Agent  *LookupEntity               `protobuf:"bytes,1,opt,name=agent,proto3" json:"agent,omitempty"`
+	Shifts []*WorkingScheduleShiftDiff `protobuf:"bytes,2,rep,name=shifts,proto3" json:"shifts,omitempty"`
+}
+
+func (x *WorkingScheduleAgentDiff) Reset() {
+	*x = WorkingScheduleAgentDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkingScheduleAgentDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingScheduleAgentDiff) ProtoMessage() {}
+
+func (x *WorkingScheduleAgentDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingScheduleAgentDiff.ProtoReflect.Descriptor instead.
+func (*WorkingScheduleAgentDiff) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WorkingScheduleAgentDiff) GetAgent() *LookupEntity {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *WorkingScheduleAgentDiff) GetShifts() []*WorkingScheduleShiftDiff {
+	if x != nil {
+		return x.Shifts
+	}
+	return nil
+}
+
+// WorkingScheduleSnapshot is an immutable copy of all agent shifts of the working schedule at the time of publishing.
+type WorkingScheduleSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id              int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId        int64         `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	CreatedAt       int64         `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedBy       *LookupEntity `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	WorkingSchedule *LookupEntity `protobuf:"bytes,5,opt,name=working_schedule,json=workingSchedule,proto3" json:"working_schedule,omitempty"`
+	// Sequential number of the snapshot within the working schedule, starting from 1.
+	Version int32   `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	Note    *string `protobuf:"bytes,7,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	// Number of shifts in the snapshot.
+	Shifts int64 `protobuf:"varint,8,opt,name=shifts,proto3" json:"shifts,omitempty"`
+}
+
+func (x *WorkingScheduleSnapshot) Reset() {
+	*x = WorkingScheduleSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_working_schedule_snapshot_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkingScheduleSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkingScheduleSnapshot) ProtoMessage() {}
+
+func (x *WorkingScheduleSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_working_schedule_snapshot_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkingScheduleSnapshot.ProtoReflect.Descriptor instead.
+func (*WorkingScheduleSnapshot) Descriptor() ([]byte, []int) {
+	return file_working_schedule_snapshot_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *WorkingScheduleSnapshot) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkingScheduleSnapshot) GetDomainId() int64 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *WorkingScheduleSnapshot) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WorkingScheduleSnapshot) GetCreatedBy() *LookupEntity {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return nil
+}
+
+func (x *WorkingScheduleSnapshot) GetWorkingSchedule() *LookupEntity {
+	if x != nil {
+		return x.WorkingSchedule
+	}
+	return nil
+}
+
+func (x *WorkingScheduleSnapshot) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *WorkingScheduleSnapshot) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *WorkingScheduleSnapshot) GetShifts() int64 {
+	if x != nil {
+		return x.Shifts
+	}
+	return 0
+}
+
+var File_working_schedule_snapshot_proto protoreflect.FileDescriptor
+
+var file_working_schedule_snapshot_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x03, 0x77, 0x66, 0x6d, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1a, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0c, 0x6c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8e, 0x01, 0x0a, 0x24, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x11, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x72, 0x03, 0x18, 0xf4, 0x03, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x88, 0x01,
+	0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x59, 0x0a, 0x25, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52,
+	0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x7c, 0x0a, 0x22, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x13, 0x77,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x8a, 0x01, 0x0a, 0x23, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x69,
+	0x74, 0x65, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x66, 0x6d, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x31, 0x0a,
+	0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73,
+	0x22, 0xf2, 0x01, 0x0a, 0x24, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x13, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04,
+	0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x48, 0x01,
+	0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x6f, 0x0a, 0x25, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x22, 0x44, 0x69, 0x66, 0x66, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x13, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8,
+	0x01, 0x01, 0x22, 0x02, 0x20, 0x00, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0xc8, 0x01, 0x01, 0x22, 0x02, 0x20,
+	0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52,
+	0x04, 0x74, 0x6f, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x74, 0x6f, 0x5f,
+	0x69, 0x64, 0x22, 0x5a, 0x0a, 0x23, 0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x67,
+	0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xbc,
+	0x01, 0x0a, 0x18, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x2c, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x68, 0x69, 0x66, 0x74, 0x44, 0x69, 0x66, 0x66, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x2d,
+	0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x7a, 0x0a,
+	0x18, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x12, 0x27, 0x0a, 0x05, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c,
+	0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x05, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x68, 0x69, 0x66, 0x74, 0x44, 0x69, 0x66,
+	0x66, 0x52, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x22, 0xa9, 0x02, 0x0a, 0x17, 0x57, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x30, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b,
+	0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x12, 0x3c, 0x0a, 0x10, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x77, 0x66, 0x6d, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x04, 0x6e,
+	0x6f, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x68, 0x69, 0x66, 0x74, 0x73, 0x42, 0x07, 0x0a, 0x05,
+	0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x2a, 0x8f, 0x01, 0x0a, 0x0f, 0x53, 0x68, 0x69, 0x66, 0x74, 0x44,
+	0x69, 0x66, 0x66, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x1d, 0x53, 0x48, 0x49,
+	0x46, 0x54, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17,
+	0x53, 0x48, 0x49, 0x46, 0x54, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x49,
+	0x46, 0x54, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x48, 0x49, 0x46,
+	0x54, 0x5f, 0x44, 0x49, 0x46, 0x46, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x03, 0x32, 0xd1, 0x06, 0x0a, 0x1e, 0x57, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0xc5, 0x01, 0x0a, 0x1d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x29, 0x2e, 0x77,
+	0x66, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x4d, 0x90, 0xb5, 0x18, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x3a,
+	0x01, 0x2a, 0x22, 0x3e, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x12, 0xc1, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x12, 0x27, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4f, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x45, 0x12, 0x43, 0x2f, 0x77, 0x66, 0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f,
+	0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0xc2, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x29, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4a, 0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x40, 0x12, 0x3e, 0x2f, 0x77, 0x66,
+	0x6d, 0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0xc6, 0x01, 0x0a, 0x1b,
+	0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x27, 0x2e, 0x77, 0x66,
+	0x6d, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x57, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x77, 0x66, 0x6d, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54,
+	0x90, 0xb5, 0x18, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4a, 0x12, 0x48, 0x2f, 0x77, 0x66, 0x6d,
+	0x2f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x7d,
+	0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f,
+	0x64, 0x69, 0x66, 0x66, 0x1a, 0x15, 0x8a, 0xb5, 0x18, 0x11, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x23, 0x5a, 0x21, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x77, 0x66, 0x6d, 0x3b, 0x77, 0x66, 0x6d,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_working_schedule_snapshot_proto_rawDescOnce sync.Once
+	file_working_schedule_snapshot_proto_rawDescData = file_working_schedule_snapshot_proto_rawDesc
+)
+
+func file_working_schedule_snapshot_proto_rawDescGZIP() []byte {
+	file_working_schedule_snapshot_proto_rawDescOnce.Do(func() {
+		file_working_schedule_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(file_working_schedule_snapshot_proto_rawDescData)
+	})
+	return file_working_schedule_snapshot_proto_rawDescData
+}
+
+var file_working_schedule_snapshot_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_working_schedule_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_working_schedule_snapshot_proto_goTypes = []interface{}{
+	(ShiftDiffChange)(0),                          // 0: wfm.ShiftDiffChange
+	(*CreateWorkingScheduleSnapshotRequest)(nil),  // 1: wfm.CreateWorkingScheduleSnapshotRequest
+	(*CreateWorkingScheduleSnapshotResponse)(nil), // 2: wfm.CreateWorkingScheduleSnapshotResponse
+	(*ReadWorkingScheduleSnapshotRequest)(nil),    // 3: wfm.ReadWorkingScheduleSnapshotRequest
+	(*ReadWorkingScheduleSnapshotResponse)(nil),   // 4: wfm.ReadWorkingScheduleSnapshotResponse
+	(*SearchWorkingScheduleSnapshotRequest)(nil),  // 5: wfm.SearchWorkingScheduleSnapshotRequest
+	(*SearchWorkingScheduleSnapshotResponse)(nil), // 6: wfm.SearchWorkingScheduleSnapshotResponse
+	(*DiffWorkingScheduleSnapshotRequest)(nil),    // 7: wfm.DiffWorkingScheduleSnapshotRequest
+	(*DiffWorkingScheduleSnapshotResponse)(nil),   // 8: wfm.DiffWorkingScheduleSnapshotResponse
+	(*WorkingScheduleShiftDiff)(nil),              // 9: wfm.WorkingScheduleShiftDiff
+	(*WorkingScheduleAgentDiff)(nil),              // 10: wfm.WorkingScheduleAgentDiff
+	(*WorkingScheduleSnapshot)(nil),               // 11: wfm.WorkingScheduleSnapshot
+	(*AgentWorkingSchedule)(nil),                  // 12: wfm.AgentWorkingSchedule
+	(*AgentScheduleShift)(nil),                    // 13: wfm.AgentScheduleShift
+	(*LookupEntity)(nil),                          // 14: wfm.LookupEntity
+}
+var file_working_schedule_snapshot_proto_depIdxs = []int32{
+	11, // 0: wfm.CreateWorkingScheduleSnapshotResponse.item:type_name -> wfm.WorkingScheduleSnapshot
+	11, // 1: wfm.ReadWorkingScheduleSnapshotResponse.item:type_name -> wfm.WorkingScheduleSnapshot
+	12, // 2: wfm.ReadWorkingScheduleSnapshotResponse.shifts:type_name -> wfm.AgentWorkingSchedule
+	11, // 3: wfm.SearchWorkingScheduleSnapshotResponse.items:type_name -> wfm.WorkingScheduleSnapshot
+	10, // 4: wfm.DiffWorkingScheduleSnapshotResponse.items:type_name -> wfm.WorkingScheduleAgentDiff
+	0,  // 5: wfm.WorkingScheduleShiftDiff.change:type_name -> wfm.ShiftDiffChange
+	13, // 6: wfm.WorkingScheduleShiftDiff.before:type_name -> wfm.AgentScheduleShift
+	13, // 7: wfm.WorkingScheduleShiftDiff.after:type_name -> wfm.AgentScheduleShift
+	14, // 8: wfm.WorkingScheduleAgentDiff.agent:type_name -> wfm.LookupEntity
+	9,  // 9: wfm.WorkingScheduleAgentDiff.shifts:type_name -> wfm.WorkingScheduleShiftDiff
+	14, // 10: wfm.WorkingScheduleSnapshot.created_by:type_name -> wfm.LookupEntity
+	14, // 11: wfm.WorkingScheduleSnapshot.working_schedule:type_name -> wfm.LookupEntity
+	1,  // 12: wfm.WorkingScheduleSnapshotService.CreateWorkingScheduleSnapshot:input_type -> wfm.CreateWorkingScheduleSnapshotRequest
+	3,  // 13: wfm.WorkingScheduleSnapshotService.ReadWorkingScheduleSnapshot:input_type -> wfm.ReadWorkingScheduleSnapshotRequest
+	5,  // 14: wfm.WorkingScheduleSnapshotService.SearchWorkingScheduleSnapshot:input_type -> wfm.SearchWorkingScheduleSnapshotRequest
+	7,  // 15: wfm.WorkingScheduleSnapshotService.DiffWorkingScheduleSnapshot:input_type -> wfm.DiffWorkingScheduleSnapshotRequest
+	2,  // 16: wfm.WorkingScheduleSnapshotService.CreateWorkingScheduleSnapshot:output_type -> wfm.CreateWorkingScheduleSnapshotResponse
+	4,  // 17: wfm.WorkingScheduleSnapshotService.ReadWorkingScheduleSnapshot:output_type -> wfm.ReadWorkingScheduleSnapshotResponse
+	6,  // 18: wfm.WorkingScheduleSnapshotService.SearchWorkingScheduleSnapshot:output_type -> wfm.SearchWorkingScheduleSnapshotResponse
+	8,  // 19: wfm.WorkingScheduleSnapshotService.DiffWorkingScheduleSnapshot:output_type -> wfm.DiffWorkingScheduleSnapshotResponse
+	16, // [16:20] is the sub-list for method output_type
+	12, // [12:16] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
+}
+
+func init() { file_working_schedule_snapshot_proto_init() }
+func file_working_schedule_snapshot_proto_init() {
+	if File_working_schedule_snapshot_proto != nil {
+		return
+	}
+	file_lookup_proto_init()
+	file_agent_working_schedule_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_working_schedule_snapshot_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkingScheduleSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_snapshot_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkingScheduleSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_snapshot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWorkingScheduleSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_snapshot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadWorkingScheduleSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchWorkingScheduleSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchWorkingScheduleSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffWorkingScheduleSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_snapshot_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiffWorkingScheduleSnapshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_snapshot_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkingScheduleShiftDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_snapshot_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkingScheduleAgentDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_working_schedule_snapshot_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkingScheduleSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_working_schedule_snapshot_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_working_schedule_snapshot_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_working_schedule_snapshot_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_working_schedule_snapshot_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_working_schedule_snapshot_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_working_schedule_snapshot_proto_goTypes,
+		DependencyIndexes: file_working_schedule_snapshot_proto_depIdxs,
+		EnumInfos:         file_working_schedule_snapshot_proto_enumTypes,
+		MessageInfos:      file_working_schedule_snapshot_proto_msgTypes,
+	}.Build()
+	File_working_schedule_snapshot_proto = out.File
+	file_working_schedule_snapshot_proto_rawDesc = nil
+	file_working_schedule_snapshot_proto_goTypes = nil
+	file_working_schedule_snapshot_proto_depIdxs = nil
+}
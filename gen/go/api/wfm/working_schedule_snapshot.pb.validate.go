@@ -0,0 +1,1573 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: working_schedule_snapshot.proto
+
+package wfm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateWorkingScheduleSnapshotRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateWorkingScheduleSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWorkingScheduleSnapshotRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateWorkingScheduleSnapshotRequestMultiError, or nil if none found.
+func (m *CreateWorkingScheduleSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWorkingScheduleSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkingScheduleId
+
+	if m.Note != nil {
+		// no validation rules for Note
+	}
+
+	if len(errors) > 0 {
+		return CreateWorkingScheduleSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWorkingScheduleSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateWorkingScheduleSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWorkingScheduleSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWorkingScheduleSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWorkingScheduleSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// CreateWorkingScheduleSnapshotRequestValidationError is the validation error
+// returned by CreateWorkingScheduleSnapshotRequest.Validate if the designated
+// constraints aren't met.
+type CreateWorkingScheduleSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWorkingScheduleSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWorkingScheduleSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWorkingScheduleSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWorkingScheduleSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWorkingScheduleSnapshotRequestValidationError) ErrorName() string {
+	return "CreateWorkingScheduleSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWorkingScheduleSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWorkingScheduleSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWorkingScheduleSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWorkingScheduleSnapshotRequestValidationError{}
+
+// Validate checks the field values on CreateWorkingScheduleSnapshotResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateWorkingScheduleSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateWorkingScheduleSnapshotResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateWorkingScheduleSnapshotResponseMultiError, or nil if none found.
+func (m *CreateWorkingScheduleSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateWorkingScheduleSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateWorkingScheduleSnapshotResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateWorkingScheduleSnapshotResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateWorkingScheduleSnapshotResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateWorkingScheduleSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateWorkingScheduleSnapshotResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// CreateWorkingScheduleSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateWorkingScheduleSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateWorkingScheduleSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateWorkingScheduleSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// CreateWorkingScheduleSnapshotResponseValidationError is the validation error
+// returned by CreateWorkingScheduleSnapshotResponse.Validate if the
+// designated constraints aren't met.
+type CreateWorkingScheduleSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateWorkingScheduleSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateWorkingScheduleSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateWorkingScheduleSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateWorkingScheduleSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateWorkingScheduleSnapshotResponseValidationError) ErrorName() string {
+	return "CreateWorkingScheduleSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateWorkingScheduleSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateWorkingScheduleSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateWorkingScheduleSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateWorkingScheduleSnapshotResponseValidationError{}
+
+// Validate checks the field values on ReadWorkingScheduleSnapshotRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ReadWorkingScheduleSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadWorkingScheduleSnapshotRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ReadWorkingScheduleSnapshotRequestMultiError, or nil if none found.
+func (m *ReadWorkingScheduleSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadWorkingScheduleSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkingScheduleId
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return ReadWorkingScheduleSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadWorkingScheduleSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ReadWorkingScheduleSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReadWorkingScheduleSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadWorkingScheduleSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadWorkingScheduleSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// ReadWorkingScheduleSnapshotRequestValidationError is the validation error
+// returned by ReadWorkingScheduleSnapshotRequest.Validate if the designated
+// constraints aren't met.
+type ReadWorkingScheduleSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadWorkingScheduleSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadWorkingScheduleSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadWorkingScheduleSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadWorkingScheduleSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadWorkingScheduleSnapshotRequestValidationError) ErrorName() string {
+	return "ReadWorkingScheduleSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadWorkingScheduleSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadWorkingScheduleSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadWorkingScheduleSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadWorkingScheduleSnapshotRequestValidationError{}
+
+// Validate checks the field values on ReadWorkingScheduleSnapshotResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ReadWorkingScheduleSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadWorkingScheduleSnapshotResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ReadWorkingScheduleSnapshotResponseMultiError, or nil if none found.
+func (m *ReadWorkingScheduleSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadWorkingScheduleSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReadWorkingScheduleSnapshotResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReadWorkingScheduleSnapshotResponseValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReadWorkingScheduleSnapshotResponseValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetShifts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReadWorkingScheduleSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Shifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReadWorkingScheduleSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Shifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReadWorkingScheduleSnapshotResponseValidationError{
+					field:  fmt.Sprintf("Shifts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReadWorkingScheduleSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadWorkingScheduleSnapshotResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ReadWorkingScheduleSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReadWorkingScheduleSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadWorkingScheduleSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadWorkingScheduleSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// ReadWorkingScheduleSnapshotResponseValidationError is the validation error
+// returned by ReadWorkingScheduleSnapshotResponse.Validate if the designated
+// constraints aren't met.
+type ReadWorkingScheduleSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadWorkingScheduleSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadWorkingScheduleSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadWorkingScheduleSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadWorkingScheduleSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadWorkingScheduleSnapshotResponseValidationError) ErrorName() string {
+	return "ReadWorkingScheduleSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadWorkingScheduleSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadWorkingScheduleSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadWorkingScheduleSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadWorkingScheduleSnapshotResponseValidationError{}
+
+// Validate checks the field values on SearchWorkingScheduleSnapshotRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SearchWorkingScheduleSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchWorkingScheduleSnapshotRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SearchWorkingScheduleSnapshotRequestMultiError, or nil if none found.
+func (m *SearchWorkingScheduleSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchWorkingScheduleSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkingScheduleId
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.Size != nil {
+		// no validation rules for Size
+	}
+
+	if m.Sort != nil {
+		// no validation rules for Sort
+	}
+
+	if len(errors) > 0 {
+		return SearchWorkingScheduleSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchWorkingScheduleSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SearchWorkingScheduleSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SearchWorkingScheduleSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchWorkingScheduleSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchWorkingScheduleSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// SearchWorkingScheduleSnapshotRequestValidationError is the validation error
+// returned by SearchWorkingScheduleSnapshotRequest.Validate if the designated
+// constraints aren't met.
+type SearchWorkingScheduleSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchWorkingScheduleSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchWorkingScheduleSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchWorkingScheduleSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchWorkingScheduleSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchWorkingScheduleSnapshotRequestValidationError) ErrorName() string {
+	return "SearchWorkingScheduleSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchWorkingScheduleSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchWorkingScheduleSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchWorkingScheduleSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchWorkingScheduleSnapshotRequestValidationError{}
+
+// Validate checks the field values on SearchWorkingScheduleSnapshotResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *SearchWorkingScheduleSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SearchWorkingScheduleSnapshotResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SearchWorkingScheduleSnapshotResponseMultiError, or nil if none found.
+func (m *SearchWorkingScheduleSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SearchWorkingScheduleSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SearchWorkingScheduleSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SearchWorkingScheduleSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SearchWorkingScheduleSnapshotResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Next
+
+	if len(errors) > 0 {
+		return SearchWorkingScheduleSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SearchWorkingScheduleSnapshotResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// SearchWorkingScheduleSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SearchWorkingScheduleSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SearchWorkingScheduleSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SearchWorkingScheduleSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// SearchWorkingScheduleSnapshotResponseValidationError is the validation error
+// returned by SearchWorkingScheduleSnapshotResponse.Validate if the
+// designated constraints aren't met.
+type SearchWorkingScheduleSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchWorkingScheduleSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchWorkingScheduleSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchWorkingScheduleSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchWorkingScheduleSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchWorkingScheduleSnapshotResponseValidationError) ErrorName() string {
+	return "SearchWorkingScheduleSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchWorkingScheduleSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchWorkingScheduleSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchWorkingScheduleSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchWorkingScheduleSnapshotResponseValidationError{}
+
+// Validate checks the field values on DiffWorkingScheduleSnapshotRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DiffWorkingScheduleSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffWorkingScheduleSnapshotRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DiffWorkingScheduleSnapshotRequestMultiError, or nil if none found.
+func (m *DiffWorkingScheduleSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffWorkingScheduleSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WorkingScheduleId
+
+	// no validation rules for Id
+
+	if m.ToId != nil {
+		// no validation rules for ToId
+	}
+
+	if len(errors) > 0 {
+		return DiffWorkingScheduleSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffWorkingScheduleSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DiffWorkingScheduleSnapshotRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DiffWorkingScheduleSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffWorkingScheduleSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffWorkingScheduleSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// DiffWorkingScheduleSnapshotRequestValidationError is the validation error
+// returned by DiffWorkingScheduleSnapshotRequest.Validate if the designated
+// constraints aren't met.
+type DiffWorkingScheduleSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffWorkingScheduleSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffWorkingScheduleSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffWorkingScheduleSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffWorkingScheduleSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffWorkingScheduleSnapshotRequestValidationError) ErrorName() string {
+	return "DiffWorkingScheduleSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffWorkingScheduleSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffWorkingScheduleSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffWorkingScheduleSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffWorkingScheduleSnapshotRequestValidationError{}
+
+// Validate checks the field values on DiffWorkingScheduleSnapshotResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DiffWorkingScheduleSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffWorkingScheduleSnapshotResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DiffWorkingScheduleSnapshotResponseMultiError, or nil if none found.
+func (m *DiffWorkingScheduleSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffWorkingScheduleSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DiffWorkingScheduleSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DiffWorkingScheduleSnapshotResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DiffWorkingScheduleSnapshotResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DiffWorkingScheduleSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffWorkingScheduleSnapshotResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DiffWorkingScheduleSnapshotResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DiffWorkingScheduleSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffWorkingScheduleSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffWorkingScheduleSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// DiffWorkingScheduleSnapshotResponseValidationError is the validation error
+// returned by DiffWorkingScheduleSnapshotResponse.Validate if the designated
+// constraints aren't met.
+type DiffWorkingScheduleSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffWorkingScheduleSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffWorkingScheduleSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffWorkingScheduleSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffWorkingScheduleSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffWorkingScheduleSnapshotResponseValidationError) ErrorName() string {
+	return "DiffWorkingScheduleSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffWorkingScheduleSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffWorkingScheduleSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffWorkingScheduleSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffWorkingScheduleSnapshotResponseValidationError{}
+
+// Validate checks the field values on WorkingScheduleShiftDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkingScheduleShiftDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkingScheduleShiftDiff with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkingScheduleShiftDiffMultiError, or nil if none found.
+func (m *WorkingScheduleShiftDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkingScheduleShiftDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Change
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkingScheduleShiftDiffValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkingScheduleShiftDiffValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkingScheduleShiftDiffValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkingScheduleShiftDiffValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkingScheduleShiftDiffValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkingScheduleShiftDiffValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WorkingScheduleShiftDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkingScheduleShiftDiffMultiError is an error wrapping multiple validation
+// errors returned by WorkingScheduleShiftDiff.ValidateAll() if the designated
+// constraints aren't met.
+type WorkingScheduleShiftDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkingScheduleShiftDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkingScheduleShiftDiffMultiError) AllErrors() []error { return m }
+
+// WorkingScheduleShiftDiffValidationError is the validation error returned by
+// WorkingScheduleShiftDiff.Validate if the designated constraints aren't met.
+type WorkingScheduleShiftDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkingScheduleShiftDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkingScheduleShiftDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkingScheduleShiftDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkingScheduleShiftDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkingScheduleShiftDiffValidationError) ErrorName() string {
+	return "WorkingScheduleShiftDiffValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkingScheduleShiftDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkingScheduleShiftDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkingScheduleShiftDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkingScheduleShiftDiffValidationError{}
+
+// Validate checks the field values on WorkingScheduleAgentDiff with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkingScheduleAgentDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkingScheduleAgentDiff with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkingScheduleAgentDiffMultiError, or nil if none found.
+func (m *WorkingScheduleAgentDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkingScheduleAgentDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAgent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkingScheduleAgentDiffValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkingScheduleAgentDiffValidationError{
+					field:  "Agent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAgent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkingScheduleAgentDiffValidationError{
+				field:  "Agent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetShifts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WorkingScheduleAgentDiffValidationError{
+						field:  fmt.Sprintf("Shifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WorkingScheduleAgentDiffValidationError{
+						field:  fmt.Sprintf("Shifts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WorkingScheduleAgentDiffValidationError{
+					field:  fmt.Sprintf("Shifts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WorkingScheduleAgentDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkingScheduleAgentDiffMultiError is an error wrapping multiple validation
+// errors returned by WorkingScheduleAgentDiff.ValidateAll() if the designated
+// constraints aren't met.
+type WorkingScheduleAgentDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkingScheduleAgentDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkingScheduleAgentDiffMultiError) AllErrors() []error { return m }
+
+// WorkingScheduleAgentDiffValidationError is the validation error returned by
+// WorkingScheduleAgentDiff.Validate if the designated constraints aren't met.
+type WorkingScheduleAgentDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkingScheduleAgentDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkingScheduleAgentDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkingScheduleAgentDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkingScheduleAgentDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkingScheduleAgentDiffValidationError) ErrorName() string {
+	return "WorkingScheduleAgentDiffValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkingScheduleAgentDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkingScheduleAgentDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkingScheduleAgentDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkingScheduleAgentDiffValidationError{}
+
+// Validate checks the field values on WorkingScheduleSnapshot with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WorkingScheduleSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WorkingScheduleSnapshot with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WorkingScheduleSnapshotMultiError, or nil if none found.
+func (m *WorkingScheduleSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WorkingScheduleSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for DomainId
+
+	// no validation rules for CreatedAt
+
+	if all {
+		switch v := interface{}(m.GetCreatedBy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkingScheduleSnapshotValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkingScheduleSnapshotValidationError{
+					field:  "CreatedBy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedBy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkingScheduleSnapshotValidationError{
+				field:  "CreatedBy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWorkingSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WorkingScheduleSnapshotValidationError{
+					field:  "WorkingSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WorkingScheduleSnapshotValidationError{
+					field:  "WorkingSchedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWorkingSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WorkingScheduleSnapshotValidationError{
+				field:  "WorkingSchedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	// no validation rules for Shifts
+
+	if m.Note != nil {
+		// no validation rules for Note
+	}
+
+	if len(errors) > 0 {
+		return WorkingScheduleSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// WorkingScheduleSnapshotMultiError is an error wrapping multiple validation
+// errors returned by WorkingScheduleSnapshot.ValidateAll() if the designated
+// constraints aren't met.
+type WorkingScheduleSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WorkingScheduleSnapshotMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WorkingScheduleSnapshotMultiError) AllErrors() []error { return m }
+
+// WorkingScheduleSnapshotValidationError is the validation error returned by
+// WorkingScheduleSnapshot.Validate if the designated constraints aren't met.
+type WorkingScheduleSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WorkingScheduleSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WorkingScheduleSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WorkingScheduleSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WorkingScheduleSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WorkingScheduleSnapshotValidationError) ErrorName() string {
+	return "WorkingScheduleSnapshotValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WorkingScheduleSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWorkingScheduleSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WorkingScheduleSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WorkingScheduleSnapshotValidationError{}
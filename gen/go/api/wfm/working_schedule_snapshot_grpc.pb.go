@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: working_schedule_snapshot.proto
+
+package wfm
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	WorkingScheduleSnapshotService_CreateWorkingScheduleSnapshot_FullMethodName = "/wfm.WorkingScheduleSnapshotService/CreateWorkingScheduleSnapshot"
+	WorkingScheduleSnapshotService_ReadWorkingScheduleSnapshot_FullMethodName   = "/wfm.WorkingScheduleSnapshotService/ReadWorkingScheduleSnapshot"
+	WorkingScheduleSnapshotService_SearchWorkingScheduleSnapshot_FullMethodName = "/wfm.WorkingScheduleSnapshotService/SearchWorkingScheduleSnapshot"
+	WorkingScheduleSnapshotService_DiffWorkingScheduleSnapshot_FullMethodName   = "/wfm.WorkingScheduleSnapshotService/DiffWorkingScheduleSnapshot"
+)
+
+// WorkingScheduleSnapshotServiceClient is the client API for WorkingScheduleSnapshotService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkingScheduleSnapshotServiceClient interface {
+	// Publishes current shifts of an active working schedule as a new snapshot.
+	// The first snapshot is taken once the working schedule is approved.
+	CreateWorkingScheduleSnapshot(ctx context.Context, in *CreateWorkingScheduleSnapshotRequest, opts ...grpc.CallOption) (*CreateWorkingScheduleSnapshotResponse, error)
+	ReadWorkingScheduleSnapshot(ctx context.Context, in *ReadWorkingScheduleSnapshotRequest, opts ...grpc.CallOption) (*ReadWorkingScheduleSnapshotResponse, error)
+	SearchWorkingScheduleSnapshot(ctx context.Context, in *SearchWorkingScheduleSnapshotRequest, opts ...grpc.CallOption) (*SearchWorkingScheduleSnapshotResponse, error)
+	// Lists added, removed and changed shifts per agent between two snapshots
+	// or between a snapshot and the current shifts of the working schedule.
+	DiffWorkingScheduleSnapshot(ctx context.Context, in *DiffWorkingScheduleSnapshotRequest, opts ...grpc.CallOption) (*DiffWorkingScheduleSnapshotResponse, error)
+}
+
+type workingScheduleSnapshotServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkingScheduleSnapshotServiceClient(cc grpc.ClientConnInterface) WorkingScheduleSnapshotServiceClient {
+	return &workingScheduleSnapshotServiceClient{cc}
+}
+
+func (c *workingScheduleSnapshotServiceClient) CreateWorkingScheduleSnapshot(ctx context.Context, in *CreateWorkingScheduleSnapshotRequest, opts ...grpc.CallOption) (*CreateWorkingScheduleSnapshotResponse, error) {
+	out := new(CreateWorkingScheduleSnapshotResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleSnapshotService_CreateWorkingScheduleSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingScheduleSnapshotServiceClient) ReadWorkingScheduleSnapshot(ctx context.Context, in *ReadWorkingScheduleSnapshotRequest, opts ...grpc.CallOption) (*ReadWorkingScheduleSnapshotResponse, error) {
+	out := new(ReadWorkingScheduleSnapshotResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleSnapshotService_ReadWorkingScheduleSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingScheduleSnapshotServiceClient) SearchWorkingScheduleSnapshot(ctx context.Context, in *SearchWorkingScheduleSnapshotRequest, opts ...grpc.CallOption) (*SearchWorkingScheduleSnapshotResponse, error) {
+	out := new(SearchWorkingScheduleSnapshotResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleSnapshotService_SearchWorkingScheduleSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workingScheduleSnapshotServiceClient) DiffWorkingScheduleSnapshot(ctx context.Context, in *DiffWorkingScheduleSnapshotRequest, opts ...grpc.CallOption) (*DiffWorkingScheduleSnapshotResponse, error) {
+	out := new(DiffWorkingScheduleSnapshotResponse)
+	err := c.cc.Invoke(ctx, WorkingScheduleSnapshotService_DiffWorkingScheduleSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkingScheduleSnapshotServiceServer is the server API for WorkingScheduleSnapshotService service.
+// All implementations must embed UnimplementedWorkingScheduleSnapshotServiceServer
+// for forward compatibility
+type WorkingScheduleSnapshotServiceServer interface {
+	// Publishes current shifts of an active working schedule as a new snapshot.
+	// The first snapshot is taken once the working schedule is approved.
+	CreateWorkingScheduleSnapshot(context.Context, *CreateWorkingScheduleSnapshotRequest) (*CreateWorkingScheduleSnapshotResponse, error)
+	ReadWorkingScheduleSnapshot(context.Context, *ReadWorkingScheduleSnapshotRequest) (*ReadWorkingScheduleSnapshotResponse, error)
+	SearchWorkingScheduleSnapshot(context.Context, *SearchWorkingScheduleSnapshotRequest) (*SearchWorkingScheduleSnapshotResponse, error)
+	// Lists added, removed and changed shifts per agent between two snapshots
+	// or between a snapshot and the current shifts of the working schedule.
+	DiffWorkingScheduleSnapshot(context.Context, *DiffWorkingScheduleSnapshotRequest) (*DiffWorkingScheduleSnapshotResponse, error)
+	mustEmbedUnimplementedWorkingScheduleSnapshotServiceServer()
+}
+
+// UnimplementedWorkingScheduleSnapshotServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWorkingScheduleSnapshotServiceServer struct {
+}
+
+func (UnimplementedWorkingScheduleSnapshotServiceServer) CreateWorkingScheduleSnapshot(context.Context, *CreateWorkingScheduleSnapshotRequest) (*CreateWorkingScheduleSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkingScheduleSnapshot not implemented")
+}
+func (UnimplementedWorkingScheduleSnapshotServiceServer) ReadWorkingScheduleSnapshot(context.Context, *ReadWorkingScheduleSnapshotRequest) (*ReadWorkingScheduleSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadWorkingScheduleSnapshot not implemented")
+}
+func (UnimplementedWorkingScheduleSnapshotServiceServer) SearchWorkingScheduleSnapshot(context.Context, *SearchWorkingScheduleSnapshotRequest) (*SearchWorkingScheduleSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchWorkingScheduleSnapshot not implemented")
+}
+func (UnimplementedWorkingScheduleSnapshotServiceServer) DiffWorkingScheduleSnapshot(context.Context, *DiffWorkingScheduleSnapshotRequest) (*DiffWorkingScheduleSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffWorkingScheduleSnapshot not implemented")
+}
+func (UnimplementedWorkingScheduleSnapshotServiceServer) mustEmbedUnimplementedWorkingScheduleSnapshotServiceServer() {
+}
+
+// UnsafeWorkingScheduleSnapshotServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkingScheduleSnapshotServiceServer will
+// result in compilation errors.
+type UnsafeWorkingScheduleSnapshotServiceServer interface {
+	mustEmbedUnimplementedWorkingScheduleSnapshotServiceServer()
+}
+
+func RegisterWorkingScheduleSnapshotServiceServer(s grpc.ServiceRegistrar, srv WorkingScheduleSnapshotServiceServer) {
+	s.RegisterService(&WorkingScheduleSnapshotService_ServiceDesc, srv)
+}
+
+func _WorkingScheduleSnapshotService_CreateWorkingScheduleSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWorkingScheduleSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleSnapshotServiceServer).CreateWorkingScheduleSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleSnapshotService_CreateWorkingScheduleSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleSnapshotServiceServer).CreateWorkingScheduleSnapshot(ctx, req.(*CreateWorkingScheduleSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingScheduleSnapshotService_ReadWorkingScheduleSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadWorkingScheduleSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleSnapshotServiceServer).ReadWorkingScheduleSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleSnapshotService_ReadWorkingScheduleSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleSnapshotServiceServer).ReadWorkingScheduleSnapshot(ctx, req.(*ReadWorkingScheduleSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingScheduleSnapshotService_SearchWorkingScheduleSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchWorkingScheduleSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleSnapshotServiceServer).SearchWorkingScheduleSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleSnapshotService_SearchWorkingScheduleSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleSnapshotServiceServer).SearchWorkingScheduleSnapshot(ctx, req.(*SearchWorkingScheduleSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkingScheduleSnapshotService_DiffWorkingScheduleSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffWorkingScheduleSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkingScheduleSnapshotServiceServer).DiffWorkingScheduleSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkingScheduleSnapshotService_DiffWorkingScheduleSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkingScheduleSnapshotServiceServer).DiffWorkingScheduleSnapshot(ctx, req.(*DiffWorkingScheduleSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkingScheduleSnapshotService_ServiceDesc is the grpc.ServiceDesc for WorkingScheduleSnapshotService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkingScheduleSnapshotService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wfm.WorkingScheduleSnapshotService",
+	HandlerType: (*WorkingScheduleSnapshotServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWorkingScheduleSnapshot",
+			Handler:    _WorkingScheduleSnapshotService_CreateWorkingScheduleSnapshot_Handler,
+		},
+		{
+			MethodName: "ReadWorkingScheduleSnapshot",
+			Handler:    _WorkingScheduleSnapshotService_ReadWorkingScheduleSnapshot_Handler,
+		},
+		{
+			MethodName: "SearchWorkingScheduleSnapshot",
+			Handler:    _WorkingScheduleSnapshotService_SearchWorkingScheduleSnapshot_Handler,
+		},
+		{
+			MethodName: "DiffWorkingScheduleSnapshot",
+			Handler:    _WorkingScheduleSnapshotService_DiffWorkingScheduleSnapshot_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "working_schedule_snapshot.proto",
+}
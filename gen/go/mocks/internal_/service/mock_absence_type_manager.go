@@ -0,0 +1,341 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/webitel/webitel-wfm/internal/model"
+
+	options "github.com/webitel/webitel-wfm/internal/model/options"
+)
+
+// MockAbsenceTypeManager is an autogenerated mock type for the AbsenceTypeManager type
+type MockAbsenceTypeManager struct {
+	mock.Mock
+}
+
+type MockAbsenceTypeManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAbsenceTypeManager) EXPECT() *MockAbsenceTypeManager_Expecter {
+	return &MockAbsenceTypeManager_Expecter{mock: &_m.Mock}
+}
+
+// CreateAbsenceType provides a mock function with given fields: ctx, read, in
+func (_m *MockAbsenceTypeManager) CreateAbsenceType(ctx context.Context, read *options.Read, in *model.AbsenceType) (*model.AbsenceType, error) {
+	ret := _m.Called(ctx, read, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAbsenceType")
+	}
+
+	var r0 *model.AbsenceType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.AbsenceType) (*model.AbsenceType, error)); ok {
+		return rf(ctx, read, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.AbsenceType) *model.AbsenceType); ok {
+		r0 = rf(ctx, read, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AbsenceType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *model.AbsenceType) error); ok {
+		r1 = rf(ctx, read, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAbsenceTypeManager_CreateAbsenceType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAbsenceType'
+type MockAbsenceTypeManager_CreateAbsenceType_Call struct {
+	*mock.Call
+}
+
+// CreateAbsenceType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - in *model.AbsenceType
+func (_e *MockAbsenceTypeManager_Expecter) CreateAbsenceType(ctx interface{}, read interface{}, in interface{}) *MockAbsenceTypeManager_CreateAbsenceType_Call {
+	return &MockAbsenceTypeManager_CreateAbsenceType_Call{Call: _e.mock.On("CreateAbsenceType", ctx, read, in)}
+}
+
+func (_c *MockAbsenceTypeManager_CreateAbsenceType_Call) Run(run func(ctx context.Context, read *options.Read, in *model.AbsenceType)) *MockAbsenceTypeManager_CreateAbsenceType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*model.AbsenceType))
+	})
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_CreateAbsenceType_Call) Return(_a0 *model.AbsenceType, _a1 error) *MockAbsenceTypeManager_CreateAbsenceType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_CreateAbsenceType_Call) RunAndReturn(run func(context.Context, *options.Read, *model.AbsenceType) (*model.AbsenceType, error)) *MockAbsenceTypeManager_CreateAbsenceType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAbsenceType provides a mock function with given fields: ctx, read
+func (_m *MockAbsenceTypeManager) DeleteAbsenceType(ctx context.Context, read *options.Read) (int64, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAbsenceType")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (int64, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) int64); ok {
+		r0 = rf(ctx, read)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAbsenceTypeManager_DeleteAbsenceType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAbsenceType'
+type MockAbsenceTypeManager_DeleteAbsenceType_Call struct {
+	*mock.Call
+}
+
+// DeleteAbsenceType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockAbsenceTypeManager_Expecter) DeleteAbsenceType(ctx interface{}, read interface{}) *MockAbsenceTypeManager_DeleteAbsenceType_Call {
+	return &MockAbsenceTypeManager_DeleteAbsenceType_Call{Call: _e.mock.On("DeleteAbsenceType", ctx, read)}
+}
+
+func (_c *MockAbsenceTypeManager_DeleteAbsenceType_Call) Run(run func(ctx context.Context, read *options.Read)) *MockAbsenceTypeManager_DeleteAbsenceType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_DeleteAbsenceType_Call) Return(_a0 int64, _a1 error) *MockAbsenceTypeManager_DeleteAbsenceType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_DeleteAbsenceType_Call) RunAndReturn(run func(context.Context, *options.Read) (int64, error)) *MockAbsenceTypeManager_DeleteAbsenceType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadAbsenceType provides a mock function with given fields: ctx, read
+func (_m *MockAbsenceTypeManager) ReadAbsenceType(ctx context.Context, read *options.Read) (*model.AbsenceType, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadAbsenceType")
+	}
+
+	var r0 *model.AbsenceType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.AbsenceType, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.AbsenceType); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AbsenceType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAbsenceTypeManager_ReadAbsenceType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAbsenceType'
+type MockAbsenceTypeManager_ReadAbsenceType_Call struct {
+	*mock.Call
+}
+
+// ReadAbsenceType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockAbsenceTypeManager_Expecter) ReadAbsenceType(ctx interface{}, read interface{}) *MockAbsenceTypeManager_ReadAbsenceType_Call {
+	return &MockAbsenceTypeManager_ReadAbsenceType_Call{Call: _e.mock.On("ReadAbsenceType", ctx, read)}
+}
+
+func (_c *MockAbsenceTypeManager_ReadAbsenceType_Call) Run(run func(ctx context.Context, read *options.Read)) *MockAbsenceTypeManager_ReadAbsenceType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_ReadAbsenceType_Call) Return(_a0 *model.AbsenceType, _a1 error) *MockAbsenceTypeManager_ReadAbsenceType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_ReadAbsenceType_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.AbsenceType, error)) *MockAbsenceTypeManager_ReadAbsenceType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAbsenceType provides a mock function with given fields: ctx, search
+func (_m *MockAbsenceTypeManager) SearchAbsenceType(ctx context.Context, search *options.Search) ([]*model.AbsenceType, bool, error) {
+	ret := _m.Called(ctx, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAbsenceType")
+	}
+
+	var r0 []*model.AbsenceType
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search) ([]*model.AbsenceType, bool, error)); ok {
+		return rf(ctx, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search) []*model.AbsenceType); ok {
+		r0 = rf(ctx, search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AbsenceType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Search) bool); ok {
+		r1 = rf(ctx, search)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *options.Search) error); ok {
+		r2 = rf(ctx, search)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockAbsenceTypeManager_SearchAbsenceType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAbsenceType'
+type MockAbsenceTypeManager_SearchAbsenceType_Call struct {
+	*mock.Call
+}
+
+// SearchAbsenceType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *options.Search
+func (_e *MockAbsenceTypeManager_Expecter) SearchAbsenceType(ctx interface{}, search interface{}) *MockAbsenceTypeManager_SearchAbsenceType_Call {
+	return &MockAbsenceTypeManager_SearchAbsenceType_Call{Call: _e.mock.On("SearchAbsenceType", ctx, search)}
+}
+
+func (_c *MockAbsenceTypeManager_SearchAbsenceType_Call) Run(run func(ctx context.Context, search *options.Search)) *MockAbsenceTypeManager_SearchAbsenceType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Search))
+	})
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_SearchAbsenceType_Call) Return(_a0 []*model.AbsenceType, _a1 bool, _a2 error) *MockAbsenceTypeManager_SearchAbsenceType_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_SearchAbsenceType_Call) RunAndReturn(run func(context.Context, *options.Search) ([]*model.AbsenceType, bool, error)) *MockAbsenceTypeManager_SearchAbsenceType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAbsenceType provides a mock function with given fields: ctx, read, in
+func (_m *MockAbsenceTypeManager) UpdateAbsenceType(ctx context.Context, read *options.Read, in *model.AbsenceType) (*model.AbsenceType, error) {
+	ret := _m.Called(ctx, read, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAbsenceType")
+	}
+
+	var r0 *model.AbsenceType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.AbsenceType) (*model.AbsenceType, error)); ok {
+		return rf(ctx, read, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.AbsenceType) *model.AbsenceType); ok {
+		r0 = rf(ctx, read, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AbsenceType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *model.AbsenceType) error); ok {
+		r1 = rf(ctx, read, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAbsenceTypeManager_UpdateAbsenceType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAbsenceType'
+type MockAbsenceTypeManager_UpdateAbsenceType_Call struct {
+	*mock.Call
+}
+
+// UpdateAbsenceType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - in *model.AbsenceType
+func (_e *MockAbsenceTypeManager_Expecter) UpdateAbsenceType(ctx interface{}, read interface{}, in interface{}) *MockAbsenceTypeManager_UpdateAbsenceType_Call {
+	return &MockAbsenceTypeManager_UpdateAbsenceType_Call{Call: _e.mock.On("UpdateAbsenceType", ctx, read, in)}
+}
+
+func (_c *MockAbsenceTypeManager_UpdateAbsenceType_Call) Run(run func(ctx context.Context, read *options.Read, in *model.AbsenceType)) *MockAbsenceTypeManager_UpdateAbsenceType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*model.AbsenceType))
+	})
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_UpdateAbsenceType_Call) Return(_a0 *model.AbsenceType, _a1 error) *MockAbsenceTypeManager_UpdateAbsenceType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_UpdateAbsenceType_Call) RunAndReturn(run func(context.Context, *options.Read, *model.AbsenceType) (*model.AbsenceType, error)) *MockAbsenceTypeManager_UpdateAbsenceType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAbsenceTypeManager creates a new instance of MockAbsenceTypeManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAbsenceTypeManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAbsenceTypeManager {
+	mock := &MockAbsenceTypeManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
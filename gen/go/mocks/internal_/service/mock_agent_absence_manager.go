@@ -24,34 +24,41 @@ func (_m *MockAgentAbsenceManager) EXPECT() *MockAgentAbsenceManager_Expecter {
 	return &MockAgentAbsenceManager_Expecter{mock: &_m.Mock}
 }
 
-// CreateAgentAbsence provides a mock function with given fields: ctx, read, in
-func (_m *MockAgentAbsenceManager) CreateAgentAbsence(ctx context.Context, read *options.Read, in *model.Absence) (*model.Absence, error) {
-	ret := _m.Called(ctx, read, in)
+// CreateAgentAbsence provides a mock function with given fields: ctx, read, in, allowExceedBalance
+func (_m *MockAgentAbsenceManager) CreateAgentAbsence(ctx context.Context, read *options.Read, in *model.Absence, allowExceedBalance bool) (*model.Absence, bool, error) {
+	ret := _m.Called(ctx, read, in, allowExceedBalance)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateAgentAbsence")
 	}
 
 	var r0 *model.Absence
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.Absence) (*model.Absence, error)); ok {
-		return rf(ctx, read, in)
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.Absence, bool) (*model.Absence, bool, error)); ok {
+		return rf(ctx, read, in, allowExceedBalance)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.Absence) *model.Absence); ok {
-		r0 = rf(ctx, read, in)
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.Absence, bool) *model.Absence); ok {
+		r0 = rf(ctx, read, in, allowExceedBalance)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Absence)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *model.Absence) error); ok {
-		r1 = rf(ctx, read, in)
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *model.Absence, bool) bool); ok {
+		r1 = rf(ctx, read, in, allowExceedBalance)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(bool)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, *options.Read, *model.Absence, bool) error); ok {
+		r2 = rf(ctx, read, in, allowExceedBalance)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // MockAgentAbsenceManager_CreateAgentAbsence_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAgentAbsence'
@@ -63,23 +70,24 @@ type MockAgentAbsenceManager_CreateAgentAbsence_Call struct {
 //   - ctx context.Context
 //   - read *options.Read
 //   - in *model.Absence
-func (_e *MockAgentAbsenceManager_Expecter) CreateAgentAbsence(ctx interface{}, read interface{}, in interface{}) *MockAgentAbsenceManager_CreateAgentAbsence_Call {
-	return &MockAgentAbsenceManager_CreateAgentAbsence_Call{Call: _e.mock.On("CreateAgentAbsence", ctx, read, in)}
+//   - allowExceedBalance bool
+func (_e *MockAgentAbsenceManager_Expecter) CreateAgentAbsence(ctx interface{}, read interface{}, in interface{}, allowExceedBalance interface{}) *MockAgentAbsenceManager_CreateAgentAbsence_Call {
+	return &MockAgentAbsenceManager_CreateAgentAbsence_Call{Call: _e.mock.On("CreateAgentAbsence", ctx, read, in, allowExceedBalance)}
 }
 
-func (_c *MockAgentAbsenceManager_CreateAgentAbsence_Call) Run(run func(ctx context.Context, read *options.Read, in *model.Absence)) *MockAgentAbsenceManager_CreateAgentAbsence_Call {
+func (_c *MockAgentAbsenceManager_CreateAgentAbsence_Call) Run(run func(ctx context.Context, read *options.Read, in *model.Absence, allowExceedBalance bool)) *MockAgentAbsenceManager_CreateAgentAbsence_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*model.Absence))
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*model.Absence), args[3].(bool))
 	})
 	return _c
 }
 
-func (_c *MockAgentAbsenceManager_CreateAgentAbsence_Call) Return(_a0 *model.Absence, _a1 error) *MockAgentAbsenceManager_CreateAgentAbsence_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *MockAgentAbsenceManager_CreateAgentAbsence_Call) Return(_a0 *model.Absence, _a1 bool, _a2 error) *MockAgentAbsenceManager_CreateAgentAbsence_Call {
+	_c.Call.Return(_a0, _a1, _a2)
 	return _c
 }
 
-func (_c *MockAgentAbsenceManager_CreateAgentAbsence_Call) RunAndReturn(run func(context.Context, *options.Read, *model.Absence) (*model.Absence, error)) *MockAgentAbsenceManager_CreateAgentAbsence_Call {
+func (_c *MockAgentAbsenceManager_CreateAgentAbsence_Call) RunAndReturn(run func(context.Context, *options.Read, *model.Absence, bool) (*model.Absence, bool, error)) *MockAgentAbsenceManager_CreateAgentAbsence_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -250,6 +258,67 @@ func (_c *MockAgentAbsenceManager_ReadAgentAbsence_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// ReadAgentAbsenceBalance provides a mock function with given fields: ctx, read, year, prorate
+func (_m *MockAgentAbsenceManager) ReadAgentAbsenceBalance(ctx context.Context, read *options.Read, year int, prorate bool) ([]*model.AgentAbsenceBalance, error) {
+	ret := _m.Called(ctx, read, year, prorate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadAgentAbsenceBalance")
+	}
+
+	var r0 []*model.AgentAbsenceBalance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, int, bool) ([]*model.AgentAbsenceBalance, error)); ok {
+		return rf(ctx, read, year, prorate)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, int, bool) []*model.AgentAbsenceBalance); ok {
+		r0 = rf(ctx, read, year, prorate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AgentAbsenceBalance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, int, bool) error); ok {
+		r1 = rf(ctx, read, year, prorate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAgentAbsenceManager_ReadAgentAbsenceBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAgentAbsenceBalance'
+type MockAgentAbsenceManager_ReadAgentAbsenceBalance_Call struct {
+	*mock.Call
+}
+
+// ReadAgentAbsenceBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - year int
+//   - prorate bool
+func (_e *MockAgentAbsenceManager_Expecter) ReadAgentAbsenceBalance(ctx interface{}, read interface{}, year interface{}, prorate interface{}) *MockAgentAbsenceManager_ReadAgentAbsenceBalance_Call {
+	return &MockAgentAbsenceManager_ReadAgentAbsenceBalance_Call{Call: _e.mock.On("ReadAgentAbsenceBalance", ctx, read, year, prorate)}
+}
+
+func (_c *MockAgentAbsenceManager_ReadAgentAbsenceBalance_Call) Run(run func(ctx context.Context, read *options.Read, year int, prorate bool)) *MockAgentAbsenceManager_ReadAgentAbsenceBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(int), args[3].(bool))
+	})
+	return _c
+}
+
+func (_c *MockAgentAbsenceManager_ReadAgentAbsenceBalance_Call) Return(_a0 []*model.AgentAbsenceBalance, _a1 error) *MockAgentAbsenceManager_ReadAgentAbsenceBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAgentAbsenceManager_ReadAgentAbsenceBalance_Call) RunAndReturn(run func(context.Context, *options.Read, int, bool) ([]*model.AgentAbsenceBalance, error)) *MockAgentAbsenceManager_ReadAgentAbsenceBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchAgentAbsence provides a mock function with given fields: ctx, search
 func (_m *MockAgentAbsenceManager) SearchAgentAbsence(ctx context.Context, search *options.Search) ([]*model.Absence, error) {
 	ret := _m.Called(ctx, search)
@@ -0,0 +1,341 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/webitel/webitel-wfm/internal/model"
+
+	options "github.com/webitel/webitel-wfm/internal/model/options"
+)
+
+// MockRosterPatternManager is an autogenerated mock type for the RosterPatternManager type
+type MockRosterPatternManager struct {
+	mock.Mock
+}
+
+type MockRosterPatternManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRosterPatternManager) EXPECT() *MockRosterPatternManager_Expecter {
+	return &MockRosterPatternManager_Expecter{mock: &_m.Mock}
+}
+
+// CreateRosterPattern provides a mock function with given fields: ctx, read, in
+func (_m *MockRosterPatternManager) CreateRosterPattern(ctx context.Context, read *options.Read, in *model.RosterPattern) (*model.RosterPattern, error) {
+	ret := _m.Called(ctx, read, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRosterPattern")
+	}
+
+	var r0 *model.RosterPattern
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.RosterPattern) (*model.RosterPattern, error)); ok {
+		return rf(ctx, read, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.RosterPattern) *model.RosterPattern); ok {
+		r0 = rf(ctx, read, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RosterPattern)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *model.RosterPattern) error); ok {
+		r1 = rf(ctx, read, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRosterPatternManager_CreateRosterPattern_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRosterPattern'
+type MockRosterPatternManager_CreateRosterPattern_Call struct {
+	*mock.Call
+}
+
+// CreateRosterPattern is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - in *model.RosterPattern
+func (_e *MockRosterPatternManager_Expecter) CreateRosterPattern(ctx interface{}, read interface{}, in interface{}) *MockRosterPatternManager_CreateRosterPattern_Call {
+	return &MockRosterPatternManager_CreateRosterPattern_Call{Call: _e.mock.On("CreateRosterPattern", ctx, read, in)}
+}
+
+func (_c *MockRosterPatternManager_CreateRosterPattern_Call) Run(run func(ctx context.Context, read *options.Read, in *model.RosterPattern)) *MockRosterPatternManager_CreateRosterPattern_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*model.RosterPattern))
+	})
+	return _c
+}
+
+func (_c *MockRosterPatternManager_CreateRosterPattern_Call) Return(_a0 *model.RosterPattern, _a1 error) *MockRosterPatternManager_CreateRosterPattern_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRosterPatternManager_CreateRosterPattern_Call) RunAndReturn(run func(context.Context, *options.Read, *model.RosterPattern) (*model.RosterPattern, error)) *MockRosterPatternManager_CreateRosterPattern_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRosterPattern provides a mock function with given fields: ctx, read
+func (_m *MockRosterPatternManager) DeleteRosterPattern(ctx context.Context, read *options.Read) (int64, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRosterPattern")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (int64, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) int64); ok {
+		r0 = rf(ctx, read)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRosterPatternManager_DeleteRosterPattern_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRosterPattern'
+type MockRosterPatternManager_DeleteRosterPattern_Call struct {
+	*mock.Call
+}
+
+// DeleteRosterPattern is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockRosterPatternManager_Expecter) DeleteRosterPattern(ctx interface{}, read interface{}) *MockRosterPatternManager_DeleteRosterPattern_Call {
+	return &MockRosterPatternManager_DeleteRosterPattern_Call{Call: _e.mock.On("DeleteRosterPattern", ctx, read)}
+}
+
+func (_c *MockRosterPatternManager_DeleteRosterPattern_Call) Run(run func(ctx context.Context, read *options.Read)) *MockRosterPatternManager_DeleteRosterPattern_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockRosterPatternManager_DeleteRosterPattern_Call) Return(_a0 int64, _a1 error) *MockRosterPatternManager_DeleteRosterPattern_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRosterPatternManager_DeleteRosterPattern_Call) RunAndReturn(run func(context.Context, *options.Read) (int64, error)) *MockRosterPatternManager_DeleteRosterPattern_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadRosterPattern provides a mock function with given fields: ctx, read
+func (_m *MockRosterPatternManager) ReadRosterPattern(ctx context.Context, read *options.Read) (*model.RosterPattern, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadRosterPattern")
+	}
+
+	var r0 *model.RosterPattern
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.RosterPattern, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.RosterPattern); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RosterPattern)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRosterPatternManager_ReadRosterPattern_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadRosterPattern'
+type MockRosterPatternManager_ReadRosterPattern_Call struct {
+	*mock.Call
+}
+
+// ReadRosterPattern is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockRosterPatternManager_Expecter) ReadRosterPattern(ctx interface{}, read interface{}) *MockRosterPatternManager_ReadRosterPattern_Call {
+	return &MockRosterPatternManager_ReadRosterPattern_Call{Call: _e.mock.On("ReadRosterPattern", ctx, read)}
+}
+
+func (_c *MockRosterPatternManager_ReadRosterPattern_Call) Run(run func(ctx context.Context, read *options.Read)) *MockRosterPatternManager_ReadRosterPattern_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockRosterPatternManager_ReadRosterPattern_Call) Return(_a0 *model.RosterPattern, _a1 error) *MockRosterPatternManager_ReadRosterPattern_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRosterPatternManager_ReadRosterPattern_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.RosterPattern, error)) *MockRosterPatternManager_ReadRosterPattern_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchRosterPattern provides a mock function with given fields: ctx, search
+func (_m *MockRosterPatternManager) SearchRosterPattern(ctx context.Context, search *options.Search) ([]*model.RosterPattern, bool, error) {
+	ret := _m.Called(ctx, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchRosterPattern")
+	}
+
+	var r0 []*model.RosterPattern
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search) ([]*model.RosterPattern, bool, error)); ok {
+		return rf(ctx, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search) []*model.RosterPattern); ok {
+		r0 = rf(ctx, search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RosterPattern)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Search) bool); ok {
+		r1 = rf(ctx, search)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *options.Search) error); ok {
+		r2 = rf(ctx, search)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockRosterPatternManager_SearchRosterPattern_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchRosterPattern'
+type MockRosterPatternManager_SearchRosterPattern_Call struct {
+	*mock.Call
+}
+
+// SearchRosterPattern is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *options.Search
+func (_e *MockRosterPatternManager_Expecter) SearchRosterPattern(ctx interface{}, search interface{}) *MockRosterPatternManager_SearchRosterPattern_Call {
+	return &MockRosterPatternManager_SearchRosterPattern_Call{Call: _e.mock.On("SearchRosterPattern", ctx, search)}
+}
+
+func (_c *MockRosterPatternManager_SearchRosterPattern_Call) Run(run func(ctx context.Context, search *options.Search)) *MockRosterPatternManager_SearchRosterPattern_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Search))
+	})
+	return _c
+}
+
+func (_c *MockRosterPatternManager_SearchRosterPattern_Call) Return(_a0 []*model.RosterPattern, _a1 bool, _a2 error) *MockRosterPatternManager_SearchRosterPattern_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockRosterPatternManager_SearchRosterPattern_Call) RunAndReturn(run func(context.Context, *options.Search) ([]*model.RosterPattern, bool, error)) *MockRosterPatternManager_SearchRosterPattern_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateRosterPattern provides a mock function with given fields: ctx, read, in
+func (_m *MockRosterPatternManager) UpdateRosterPattern(ctx context.Context, read *options.Read, in *model.RosterPattern) (*model.RosterPattern, error) {
+	ret := _m.Called(ctx, read, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateRosterPattern")
+	}
+
+	var r0 *model.RosterPattern
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.RosterPattern) (*model.RosterPattern, error)); ok {
+		return rf(ctx, read, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.RosterPattern) *model.RosterPattern); ok {
+		r0 = rf(ctx, read, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RosterPattern)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *model.RosterPattern) error); ok {
+		r1 = rf(ctx, read, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRosterPatternManager_UpdateRosterPattern_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRosterPattern'
+type MockRosterPatternManager_UpdateRosterPattern_Call struct {
+	*mock.Call
+}
+
+// UpdateRosterPattern is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - in *model.RosterPattern
+func (_e *MockRosterPatternManager_Expecter) UpdateRosterPattern(ctx interface{}, read interface{}, in interface{}) *MockRosterPatternManager_UpdateRosterPattern_Call {
+	return &MockRosterPatternManager_UpdateRosterPattern_Call{Call: _e.mock.On("UpdateRosterPattern", ctx, read, in)}
+}
+
+func (_c *MockRosterPatternManager_UpdateRosterPattern_Call) Run(run func(ctx context.Context, read *options.Read, in *model.RosterPattern)) *MockRosterPatternManager_UpdateRosterPattern_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*model.RosterPattern))
+	})
+	return _c
+}
+
+func (_c *MockRosterPatternManager_UpdateRosterPattern_Call) Return(_a0 *model.RosterPattern, _a1 error) *MockRosterPatternManager_UpdateRosterPattern_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRosterPatternManager_UpdateRosterPattern_Call) RunAndReturn(run func(context.Context, *options.Read, *model.RosterPattern) (*model.RosterPattern, error)) *MockRosterPatternManager_UpdateRosterPattern_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRosterPatternManager creates a new instance of MockRosterPatternManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRosterPatternManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRosterPatternManager {
+	mock := &MockRosterPatternManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,523 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/webitel/webitel-wfm/internal/model"
+
+	options "github.com/webitel/webitel-wfm/internal/model/options"
+)
+
+// MockShiftSwapManager is an autogenerated mock type for the ShiftSwapManager type
+type MockShiftSwapManager struct {
+	mock.Mock
+}
+
+type MockShiftSwapManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShiftSwapManager) EXPECT() *MockShiftSwapManager_Expecter {
+	return &MockShiftSwapManager_Expecter{mock: &_m.Mock}
+}
+
+// AcceptShiftSwap provides a mock function with given fields: ctx, read, shiftId
+func (_m *MockShiftSwapManager) AcceptShiftSwap(ctx context.Context, read *options.Read, shiftId *int64) (*model.ShiftSwap, error) {
+	ret := _m.Called(ctx, read, shiftId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptShiftSwap")
+	}
+
+	var r0 *model.ShiftSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *int64) (*model.ShiftSwap, error)); ok {
+		return rf(ctx, read, shiftId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *int64) *model.ShiftSwap); ok {
+		r0 = rf(ctx, read, shiftId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShiftSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *int64) error); ok {
+		r1 = rf(ctx, read, shiftId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_AcceptShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptShiftSwap'
+type MockShiftSwapManager_AcceptShiftSwap_Call struct {
+	*mock.Call
+}
+
+// AcceptShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - shiftId *int64
+func (_e *MockShiftSwapManager_Expecter) AcceptShiftSwap(ctx interface{}, read interface{}, shiftId interface{}) *MockShiftSwapManager_AcceptShiftSwap_Call {
+	return &MockShiftSwapManager_AcceptShiftSwap_Call{Call: _e.mock.On("AcceptShiftSwap", ctx, read, shiftId)}
+}
+
+func (_c *MockShiftSwapManager_AcceptShiftSwap_Call) Run(run func(ctx context.Context, read *options.Read, shiftId *int64)) *MockShiftSwapManager_AcceptShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*int64))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_AcceptShiftSwap_Call) Return(_a0 *model.ShiftSwap, _a1 error) *MockShiftSwapManager_AcceptShiftSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_AcceptShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Read, *int64) (*model.ShiftSwap, error)) *MockShiftSwapManager_AcceptShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ApproveShiftSwap provides a mock function with given fields: ctx, read, comment
+func (_m *MockShiftSwapManager) ApproveShiftSwap(ctx context.Context, read *options.Read, comment *string) (*model.ShiftSwap, error) {
+	ret := _m.Called(ctx, read, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveShiftSwap")
+	}
+
+	var r0 *model.ShiftSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) (*model.ShiftSwap, error)); ok {
+		return rf(ctx, read, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) *model.ShiftSwap); ok {
+		r0 = rf(ctx, read, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShiftSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *string) error); ok {
+		r1 = rf(ctx, read, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_ApproveShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveShiftSwap'
+type MockShiftSwapManager_ApproveShiftSwap_Call struct {
+	*mock.Call
+}
+
+// ApproveShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - comment *string
+func (_e *MockShiftSwapManager_Expecter) ApproveShiftSwap(ctx interface{}, read interface{}, comment interface{}) *MockShiftSwapManager_ApproveShiftSwap_Call {
+	return &MockShiftSwapManager_ApproveShiftSwap_Call{Call: _e.mock.On("ApproveShiftSwap", ctx, read, comment)}
+}
+
+func (_c *MockShiftSwapManager_ApproveShiftSwap_Call) Run(run func(ctx context.Context, read *options.Read, comment *string)) *MockShiftSwapManager_ApproveShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_ApproveShiftSwap_Call) Return(_a0 *model.ShiftSwap, _a1 error) *MockShiftSwapManager_ApproveShiftSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_ApproveShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Read, *string) (*model.ShiftSwap, error)) *MockShiftSwapManager_ApproveShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelShiftSwap provides a mock function with given fields: ctx, read
+func (_m *MockShiftSwapManager) CancelShiftSwap(ctx context.Context, read *options.Read) (*model.ShiftSwap, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelShiftSwap")
+	}
+
+	var r0 *model.ShiftSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.ShiftSwap, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.ShiftSwap); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShiftSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_CancelShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelShiftSwap'
+type MockShiftSwapManager_CancelShiftSwap_Call struct {
+	*mock.Call
+}
+
+// CancelShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockShiftSwapManager_Expecter) CancelShiftSwap(ctx interface{}, read interface{}) *MockShiftSwapManager_CancelShiftSwap_Call {
+	return &MockShiftSwapManager_CancelShiftSwap_Call{Call: _e.mock.On("CancelShiftSwap", ctx, read)}
+}
+
+func (_c *MockShiftSwapManager_CancelShiftSwap_Call) Run(run func(ctx context.Context, read *options.Read)) *MockShiftSwapManager_CancelShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_CancelShiftSwap_Call) Return(_a0 *model.ShiftSwap, _a1 error) *MockShiftSwapManager_CancelShiftSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_CancelShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.ShiftSwap, error)) *MockShiftSwapManager_CancelShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateShiftSwap provides a mock function with given fields: ctx, read, in
+func (_m *MockShiftSwapManager) CreateShiftSwap(ctx context.Context, read *options.Read, in *model.ShiftSwap) (*model.ShiftSwap, error) {
+	ret := _m.Called(ctx, read, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShiftSwap")
+	}
+
+	var r0 *model.ShiftSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.ShiftSwap) (*model.ShiftSwap, error)); ok {
+		return rf(ctx, read, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.ShiftSwap) *model.ShiftSwap); ok {
+		r0 = rf(ctx, read, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShiftSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *model.ShiftSwap) error); ok {
+		r1 = rf(ctx, read, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_CreateShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShiftSwap'
+type MockShiftSwapManager_CreateShiftSwap_Call struct {
+	*mock.Call
+}
+
+// CreateShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - in *model.ShiftSwap
+func (_e *MockShiftSwapManager_Expecter) CreateShiftSwap(ctx interface{}, read interface{}, in interface{}) *MockShiftSwapManager_CreateShiftSwap_Call {
+	return &MockShiftSwapManager_CreateShiftSwap_Call{Call: _e.mock.On("CreateShiftSwap", ctx, read, in)}
+}
+
+func (_c *MockShiftSwapManager_CreateShiftSwap_Call) Run(run func(ctx context.Context, read *options.Read, in *model.ShiftSwap)) *MockShiftSwapManager_CreateShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*model.ShiftSwap))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_CreateShiftSwap_Call) Return(_a0 *model.ShiftSwap, _a1 error) *MockShiftSwapManager_CreateShiftSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_CreateShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Read, *model.ShiftSwap) (*model.ShiftSwap, error)) *MockShiftSwapManager_CreateShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadShiftSwap provides a mock function with given fields: ctx, read
+func (_m *MockShiftSwapManager) ReadShiftSwap(ctx context.Context, read *options.Read) (*model.ShiftSwap, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadShiftSwap")
+	}
+
+	var r0 *model.ShiftSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.ShiftSwap, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.ShiftSwap); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShiftSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_ReadShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadShiftSwap'
+type MockShiftSwapManager_ReadShiftSwap_Call struct {
+	*mock.Call
+}
+
+// ReadShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockShiftSwapManager_Expecter) ReadShiftSwap(ctx interface{}, read interface{}) *MockShiftSwapManager_ReadShiftSwap_Call {
+	return &MockShiftSwapManager_ReadShiftSwap_Call{Call: _e.mock.On("ReadShiftSwap", ctx, read)}
+}
+
+func (_c *MockShiftSwapManager_ReadShiftSwap_Call) Run(run func(ctx context.Context, read *options.Read)) *MockShiftSwapManager_ReadShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_ReadShiftSwap_Call) Return(_a0 *model.ShiftSwap, _a1 error) *MockShiftSwapManager_ReadShiftSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_ReadShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.ShiftSwap, error)) *MockShiftSwapManager_ReadShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectShiftSwap provides a mock function with given fields: ctx, read, comment
+func (_m *MockShiftSwapManager) RejectShiftSwap(ctx context.Context, read *options.Read, comment *string) (*model.ShiftSwap, error) {
+	ret := _m.Called(ctx, read, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectShiftSwap")
+	}
+
+	var r0 *model.ShiftSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) (*model.ShiftSwap, error)); ok {
+		return rf(ctx, read, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) *model.ShiftSwap); ok {
+		r0 = rf(ctx, read, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShiftSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *string) error); ok {
+		r1 = rf(ctx, read, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_RejectShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectShiftSwap'
+type MockShiftSwapManager_RejectShiftSwap_Call struct {
+	*mock.Call
+}
+
+// RejectShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - comment *string
+func (_e *MockShiftSwapManager_Expecter) RejectShiftSwap(ctx interface{}, read interface{}, comment interface{}) *MockShiftSwapManager_RejectShiftSwap_Call {
+	return &MockShiftSwapManager_RejectShiftSwap_Call{Call: _e.mock.On("RejectShiftSwap", ctx, read, comment)}
+}
+
+func (_c *MockShiftSwapManager_RejectShiftSwap_Call) Run(run func(ctx context.Context, read *options.Read, comment *string)) *MockShiftSwapManager_RejectShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_RejectShiftSwap_Call) Return(_a0 *model.ShiftSwap, _a1 error) *MockShiftSwapManager_RejectShiftSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_RejectShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Read, *string) (*model.ShiftSwap, error)) *MockShiftSwapManager_RejectShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchShiftSwap provides a mock function with given fields: ctx, search, filter
+func (_m *MockShiftSwapManager) SearchShiftSwap(ctx context.Context, search *options.Search, filter *model.ShiftSwapSearch) ([]*model.ShiftSwap, bool, error) {
+	ret := _m.Called(ctx, search, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchShiftSwap")
+	}
+
+	var r0 []*model.ShiftSwap
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search, *model.ShiftSwapSearch) ([]*model.ShiftSwap, bool, error)); ok {
+		return rf(ctx, search, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search, *model.ShiftSwapSearch) []*model.ShiftSwap); ok {
+		r0 = rf(ctx, search, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ShiftSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Search, *model.ShiftSwapSearch) bool); ok {
+		r1 = rf(ctx, search, filter)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *options.Search, *model.ShiftSwapSearch) error); ok {
+		r2 = rf(ctx, search, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockShiftSwapManager_SearchShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchShiftSwap'
+type MockShiftSwapManager_SearchShiftSwap_Call struct {
+	*mock.Call
+}
+
+// SearchShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *options.Search
+//   - filter *model.ShiftSwapSearch
+func (_e *MockShiftSwapManager_Expecter) SearchShiftSwap(ctx interface{}, search interface{}, filter interface{}) *MockShiftSwapManager_SearchShiftSwap_Call {
+	return &MockShiftSwapManager_SearchShiftSwap_Call{Call: _e.mock.On("SearchShiftSwap", ctx, search, filter)}
+}
+
+func (_c *MockShiftSwapManager_SearchShiftSwap_Call) Run(run func(ctx context.Context, search *options.Search, filter *model.ShiftSwapSearch)) *MockShiftSwapManager_SearchShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Search), args[2].(*model.ShiftSwapSearch))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_SearchShiftSwap_Call) Return(_a0 []*model.ShiftSwap, _a1 bool, _a2 error) *MockShiftSwapManager_SearchShiftSwap_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_SearchShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Search, *model.ShiftSwapSearch) ([]*model.ShiftSwap, bool, error)) *MockShiftSwapManager_SearchShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateShiftSwap provides a mock function with given fields: ctx, read
+func (_m *MockShiftSwapManager) ValidateShiftSwap(ctx context.Context, read *options.Read) ([]*model.WorkingScheduleViolation, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateShiftSwap")
+	}
+
+	var r0 []*model.WorkingScheduleViolation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) ([]*model.WorkingScheduleViolation, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) []*model.WorkingScheduleViolation); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WorkingScheduleViolation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_ValidateShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateShiftSwap'
+type MockShiftSwapManager_ValidateShiftSwap_Call struct {
+	*mock.Call
+}
+
+// ValidateShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockShiftSwapManager_Expecter) ValidateShiftSwap(ctx interface{}, read interface{}) *MockShiftSwapManager_ValidateShiftSwap_Call {
+	return &MockShiftSwapManager_ValidateShiftSwap_Call{Call: _e.mock.On("ValidateShiftSwap", ctx, read)}
+}
+
+func (_c *MockShiftSwapManager_ValidateShiftSwap_Call) Run(run func(ctx context.Context, read *options.Read)) *MockShiftSwapManager_ValidateShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_ValidateShiftSwap_Call) Return(_a0 []*model.WorkingScheduleViolation, _a1 error) *MockShiftSwapManager_ValidateShiftSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_ValidateShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Read) ([]*model.WorkingScheduleViolation, error)) *MockShiftSwapManager_ValidateShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShiftSwapManager creates a new instance of MockShiftSwapManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShiftSwapManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShiftSwapManager {
+	mock := &MockShiftSwapManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
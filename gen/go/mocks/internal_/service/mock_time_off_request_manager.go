@@ -0,0 +1,404 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/webitel/webitel-wfm/internal/model"
+
+	options "github.com/webitel/webitel-wfm/internal/model/options"
+)
+
+// MockTimeOffRequestManager is an autogenerated mock type for the TimeOffRequestManager type
+type MockTimeOffRequestManager struct {
+	mock.Mock
+}
+
+type MockTimeOffRequestManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTimeOffRequestManager) EXPECT() *MockTimeOffRequestManager_Expecter {
+	return &MockTimeOffRequestManager_Expecter{mock: &_m.Mock}
+}
+
+// ApproveTimeOffRequest provides a mock function with given fields: ctx, read, comment
+func (_m *MockTimeOffRequestManager) ApproveTimeOffRequest(ctx context.Context, read *options.Read, comment *string) (*model.TimeOffRequest, error) {
+	ret := _m.Called(ctx, read, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveTimeOffRequest")
+	}
+
+	var r0 *model.TimeOffRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) (*model.TimeOffRequest, error)); ok {
+		return rf(ctx, read, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) *model.TimeOffRequest); ok {
+		r0 = rf(ctx, read, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TimeOffRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *string) error); ok {
+		r1 = rf(ctx, read, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeOffRequestManager_ApproveTimeOffRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveTimeOffRequest'
+type MockTimeOffRequestManager_ApproveTimeOffRequest_Call struct {
+	*mock.Call
+}
+
+// ApproveTimeOffRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - comment *string
+func (_e *MockTimeOffRequestManager_Expecter) ApproveTimeOffRequest(ctx interface{}, read interface{}, comment interface{}) *MockTimeOffRequestManager_ApproveTimeOffRequest_Call {
+	return &MockTimeOffRequestManager_ApproveTimeOffRequest_Call{Call: _e.mock.On("ApproveTimeOffRequest", ctx, read, comment)}
+}
+
+func (_c *MockTimeOffRequestManager_ApproveTimeOffRequest_Call) Run(run func(ctx context.Context, read *options.Read, comment *string)) *MockTimeOffRequestManager_ApproveTimeOffRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_ApproveTimeOffRequest_Call) Return(_a0 *model.TimeOffRequest, _a1 error) *MockTimeOffRequestManager_ApproveTimeOffRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_ApproveTimeOffRequest_Call) RunAndReturn(run func(context.Context, *options.Read, *string) (*model.TimeOffRequest, error)) *MockTimeOffRequestManager_ApproveTimeOffRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelTimeOffRequest provides a mock function with given fields: ctx, read
+func (_m *MockTimeOffRequestManager) CancelTimeOffRequest(ctx context.Context, read *options.Read) (*model.TimeOffRequest, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelTimeOffRequest")
+	}
+
+	var r0 *model.TimeOffRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.TimeOffRequest, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.TimeOffRequest); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TimeOffRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeOffRequestManager_CancelTimeOffRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelTimeOffRequest'
+type MockTimeOffRequestManager_CancelTimeOffRequest_Call struct {
+	*mock.Call
+}
+
+// CancelTimeOffRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockTimeOffRequestManager_Expecter) CancelTimeOffRequest(ctx interface{}, read interface{}) *MockTimeOffRequestManager_CancelTimeOffRequest_Call {
+	return &MockTimeOffRequestManager_CancelTimeOffRequest_Call{Call: _e.mock.On("CancelTimeOffRequest", ctx, read)}
+}
+
+func (_c *MockTimeOffRequestManager_CancelTimeOffRequest_Call) Run(run func(ctx context.Context, read *options.Read)) *MockTimeOffRequestManager_CancelTimeOffRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_CancelTimeOffRequest_Call) Return(_a0 *model.TimeOffRequest, _a1 error) *MockTimeOffRequestManager_CancelTimeOffRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_CancelTimeOffRequest_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.TimeOffRequest, error)) *MockTimeOffRequestManager_CancelTimeOffRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTimeOffRequest provides a mock function with given fields: ctx, read, in
+func (_m *MockTimeOffRequestManager) CreateTimeOffRequest(ctx context.Context, read *options.Read, in *model.TimeOffRequest) (*model.TimeOffRequest, error) {
+	ret := _m.Called(ctx, read, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTimeOffRequest")
+	}
+
+	var r0 *model.TimeOffRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.TimeOffRequest) (*model.TimeOffRequest, error)); ok {
+		return rf(ctx, read, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.TimeOffRequest) *model.TimeOffRequest); ok {
+		r0 = rf(ctx, read, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TimeOffRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *model.TimeOffRequest) error); ok {
+		r1 = rf(ctx, read, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeOffRequestManager_CreateTimeOffRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTimeOffRequest'
+type MockTimeOffRequestManager_CreateTimeOffRequest_Call struct {
+	*mock.Call
+}
+
+// CreateTimeOffRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - in *model.TimeOffRequest
+func (_e *MockTimeOffRequestManager_Expecter) CreateTimeOffRequest(ctx interface{}, read interface{}, in interface{}) *MockTimeOffRequestManager_CreateTimeOffRequest_Call {
+	return &MockTimeOffRequestManager_CreateTimeOffRequest_Call{Call: _e.mock.On("CreateTimeOffRequest", ctx, read, in)}
+}
+
+func (_c *MockTimeOffRequestManager_CreateTimeOffRequest_Call) Run(run func(ctx context.Context, read *options.Read, in *model.TimeOffRequest)) *MockTimeOffRequestManager_CreateTimeOffRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*model.TimeOffRequest))
+	})
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_CreateTimeOffRequest_Call) Return(_a0 *model.TimeOffRequest, _a1 error) *MockTimeOffRequestManager_CreateTimeOffRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_CreateTimeOffRequest_Call) RunAndReturn(run func(context.Context, *options.Read, *model.TimeOffRequest) (*model.TimeOffRequest, error)) *MockTimeOffRequestManager_CreateTimeOffRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTimeOffRequest provides a mock function with given fields: ctx, read
+func (_m *MockTimeOffRequestManager) ReadTimeOffRequest(ctx context.Context, read *options.Read) (*model.TimeOffRequest, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTimeOffRequest")
+	}
+
+	var r0 *model.TimeOffRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.TimeOffRequest, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.TimeOffRequest); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TimeOffRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeOffRequestManager_ReadTimeOffRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTimeOffRequest'
+type MockTimeOffRequestManager_ReadTimeOffRequest_Call struct {
+	*mock.Call
+}
+
+// ReadTimeOffRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockTimeOffRequestManager_Expecter) ReadTimeOffRequest(ctx interface{}, read interface{}) *MockTimeOffRequestManager_ReadTimeOffRequest_Call {
+	return &MockTimeOffRequestManager_ReadTimeOffRequest_Call{Call: _e.mock.On("ReadTimeOffRequest", ctx, read)}
+}
+
+func (_c *MockTimeOffRequestManager_ReadTimeOffRequest_Call) Run(run func(ctx context.Context, read *options.Read)) *MockTimeOffRequestManager_ReadTimeOffRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_ReadTimeOffRequest_Call) Return(_a0 *model.TimeOffRequest, _a1 error) *MockTimeOffRequestManager_ReadTimeOffRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_ReadTimeOffRequest_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.TimeOffRequest, error)) *MockTimeOffRequestManager_ReadTimeOffRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RejectTimeOffRequest provides a mock function with given fields: ctx, read, comment
+func (_m *MockTimeOffRequestManager) RejectTimeOffRequest(ctx context.Context, read *options.Read, comment *string) (*model.TimeOffRequest, error) {
+	ret := _m.Called(ctx, read, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectTimeOffRequest")
+	}
+
+	var r0 *model.TimeOffRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) (*model.TimeOffRequest, error)); ok {
+		return rf(ctx, read, comment)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) *model.TimeOffRequest); ok {
+		r0 = rf(ctx, read, comment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TimeOffRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *string) error); ok {
+		r1 = rf(ctx, read, comment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeOffRequestManager_RejectTimeOffRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectTimeOffRequest'
+type MockTimeOffRequestManager_RejectTimeOffRequest_Call struct {
+	*mock.Call
+}
+
+// RejectTimeOffRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - comment *string
+func (_e *MockTimeOffRequestManager_Expecter) RejectTimeOffRequest(ctx interface{}, read interface{}, comment interface{}) *MockTimeOffRequestManager_RejectTimeOffRequest_Call {
+	return &MockTimeOffRequestManager_RejectTimeOffRequest_Call{Call: _e.mock.On("RejectTimeOffRequest", ctx, read, comment)}
+}
+
+func (_c *MockTimeOffRequestManager_RejectTimeOffRequest_Call) Run(run func(ctx context.Context, read *options.Read, comment *string)) *MockTimeOffRequestManager_RejectTimeOffRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_RejectTimeOffRequest_Call) Return(_a0 *model.TimeOffRequest, _a1 error) *MockTimeOffRequestManager_RejectTimeOffRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_RejectTimeOffRequest_Call) RunAndReturn(run func(context.Context, *options.Read, *string) (*model.TimeOffRequest, error)) *MockTimeOffRequestManager_RejectTimeOffRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTimeOffRequest provides a mock function with given fields: ctx, search, filter
+func (_m *MockTimeOffRequestManager) SearchTimeOffRequest(ctx context.Context, search *options.Search, filter *model.TimeOffRequestSearch) ([]*model.TimeOffRequest, bool, error) {
+	ret := _m.Called(ctx, search, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTimeOffRequest")
+	}
+
+	var r0 []*model.TimeOffRequest
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search, *model.TimeOffRequestSearch) ([]*model.TimeOffRequest, bool, error)); ok {
+		return rf(ctx, search, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search, *model.TimeOffRequestSearch) []*model.TimeOffRequest); ok {
+		r0 = rf(ctx, search, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TimeOffRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Search, *model.TimeOffRequestSearch) bool); ok {
+		r1 = rf(ctx, search, filter)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *options.Search, *model.TimeOffRequestSearch) error); ok {
+		r2 = rf(ctx, search, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockTimeOffRequestManager_SearchTimeOffRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTimeOffRequest'
+type MockTimeOffRequestManager_SearchTimeOffRequest_Call struct {
+	*mock.Call
+}
+
+// SearchTimeOffRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *options.Search
+//   - filter *model.TimeOffRequestSearch
+func (_e *MockTimeOffRequestManager_Expecter) SearchTimeOffRequest(ctx interface{}, search interface{}, filter interface{}) *MockTimeOffRequestManager_SearchTimeOffRequest_Call {
+	return &MockTimeOffRequestManager_SearchTimeOffRequest_Call{Call: _e.mock.On("SearchTimeOffRequest", ctx, search, filter)}
+}
+
+func (_c *MockTimeOffRequestManager_SearchTimeOffRequest_Call) Run(run func(ctx context.Context, search *options.Search, filter *model.TimeOffRequestSearch)) *MockTimeOffRequestManager_SearchTimeOffRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Search), args[2].(*model.TimeOffRequestSearch))
+	})
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_SearchTimeOffRequest_Call) Return(_a0 []*model.TimeOffRequest, _a1 bool, _a2 error) *MockTimeOffRequestManager_SearchTimeOffRequest_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_SearchTimeOffRequest_Call) RunAndReturn(run func(context.Context, *options.Search, *model.TimeOffRequestSearch) ([]*model.TimeOffRequest, bool, error)) *MockTimeOffRequestManager_SearchTimeOffRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTimeOffRequestManager creates a new instance of MockTimeOffRequestManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTimeOffRequestManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTimeOffRequestManager {
+	mock := &MockTimeOffRequestManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 	model "github.com/webitel/webitel-wfm/internal/model"
+
+	time "time"
 )
 
 // MockWorkingScheduleManager is an autogenerated mock type for the WorkingScheduleManager type
@@ -22,6 +24,186 @@ func (_m *MockWorkingScheduleManager) EXPECT() *MockWorkingScheduleManager_Expec
 	return &MockWorkingScheduleManager_Expecter{mock: &_m.Mock}
 }
 
+// ApproveWorkingSchedule provides a mock function with given fields: ctx, user, id
+func (_m *MockWorkingScheduleManager) ApproveWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error) {
+	ret := _m.Called(ctx, user, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveWorkingSchedule")
+	}
+
+	var r0 *model.WorkingSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64) (*model.WorkingSchedule, error)); ok {
+		return rf(ctx, user, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64) *model.WorkingSchedule); ok {
+		r0 = rf(ctx, user, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WorkingSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SignedInUser, int64) error); ok {
+		r1 = rf(ctx, user, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleManager_ApproveWorkingSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApproveWorkingSchedule'
+type MockWorkingScheduleManager_ApproveWorkingSchedule_Call struct {
+	*mock.Call
+}
+
+// ApproveWorkingSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - id int64
+func (_e *MockWorkingScheduleManager_Expecter) ApproveWorkingSchedule(ctx interface{}, user interface{}, id interface{}) *MockWorkingScheduleManager_ApproveWorkingSchedule_Call {
+	return &MockWorkingScheduleManager_ApproveWorkingSchedule_Call{Call: _e.mock.On("ApproveWorkingSchedule", ctx, user, id)}
+}
+
+func (_c *MockWorkingScheduleManager_ApproveWorkingSchedule_Call) Run(run func(ctx context.Context, user *model.SignedInUser, id int64)) *MockWorkingScheduleManager_ApproveWorkingSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_ApproveWorkingSchedule_Call) Return(_a0 *model.WorkingSchedule, _a1 error) *MockWorkingScheduleManager_ApproveWorkingSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_ApproveWorkingSchedule_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, int64) (*model.WorkingSchedule, error)) *MockWorkingScheduleManager_ApproveWorkingSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ArchiveWorkingSchedule provides a mock function with given fields: ctx, user, id
+func (_m *MockWorkingScheduleManager) ArchiveWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error) {
+	ret := _m.Called(ctx, user, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveWorkingSchedule")
+	}
+
+	var r0 *model.WorkingSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64) (*model.WorkingSchedule, error)); ok {
+		return rf(ctx, user, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64) *model.WorkingSchedule); ok {
+		r0 = rf(ctx, user, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WorkingSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SignedInUser, int64) error); ok {
+		r1 = rf(ctx, user, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleManager_ArchiveWorkingSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveWorkingSchedule'
+type MockWorkingScheduleManager_ArchiveWorkingSchedule_Call struct {
+	*mock.Call
+}
+
+// ArchiveWorkingSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - id int64
+func (_e *MockWorkingScheduleManager_Expecter) ArchiveWorkingSchedule(ctx interface{}, user interface{}, id interface{}) *MockWorkingScheduleManager_ArchiveWorkingSchedule_Call {
+	return &MockWorkingScheduleManager_ArchiveWorkingSchedule_Call{Call: _e.mock.On("ArchiveWorkingSchedule", ctx, user, id)}
+}
+
+func (_c *MockWorkingScheduleManager_ArchiveWorkingSchedule_Call) Run(run func(ctx context.Context, user *model.SignedInUser, id int64)) *MockWorkingScheduleManager_ArchiveWorkingSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_ArchiveWorkingSchedule_Call) Return(_a0 *model.WorkingSchedule, _a1 error) *MockWorkingScheduleManager_ArchiveWorkingSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_ArchiveWorkingSchedule_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, int64) (*model.WorkingSchedule, error)) *MockWorkingScheduleManager_ArchiveWorkingSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CloneWorkingSchedule provides a mock function with given fields: ctx, user, in
+func (_m *MockWorkingScheduleManager) CloneWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.CloneWorkingSchedule) (*model.WorkingSchedule, error) {
+	ret := _m.Called(ctx, user, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CloneWorkingSchedule")
+	}
+
+	var r0 *model.WorkingSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, *model.CloneWorkingSchedule) (*model.WorkingSchedule, error)); ok {
+		return rf(ctx, user, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, *model.CloneWorkingSchedule) *model.WorkingSchedule); ok {
+		r0 = rf(ctx, user, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WorkingSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SignedInUser, *model.CloneWorkingSchedule) error); ok {
+		r1 = rf(ctx, user, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleManager_CloneWorkingSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CloneWorkingSchedule'
+type MockWorkingScheduleManager_CloneWorkingSchedule_Call struct {
+	*mock.Call
+}
+
+// CloneWorkingSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - in *model.CloneWorkingSchedule
+func (_e *MockWorkingScheduleManager_Expecter) CloneWorkingSchedule(ctx interface{}, user interface{}, in interface{}) *MockWorkingScheduleManager_CloneWorkingSchedule_Call {
+	return &MockWorkingScheduleManager_CloneWorkingSchedule_Call{Call: _e.mock.On("CloneWorkingSchedule", ctx, user, in)}
+}
+
+func (_c *MockWorkingScheduleManager_CloneWorkingSchedule_Call) Run(run func(ctx context.Context, user *model.SignedInUser, in *model.CloneWorkingSchedule)) *MockWorkingScheduleManager_CloneWorkingSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(*model.CloneWorkingSchedule))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_CloneWorkingSchedule_Call) Return(_a0 *model.WorkingSchedule, _a1 error) *MockWorkingScheduleManager_CloneWorkingSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_CloneWorkingSchedule_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, *model.CloneWorkingSchedule) (*model.WorkingSchedule, error)) *MockWorkingScheduleManager_CloneWorkingSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateWorkingSchedule provides a mock function with given fields: ctx, user, in
 func (_m *MockWorkingScheduleManager) CreateWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.WorkingSchedule) (*model.WorkingSchedule, error) {
 	ret := _m.Called(ctx, user, in)
@@ -140,6 +322,150 @@ func (_c *MockWorkingScheduleManager_DeleteWorkingSchedule_Call) RunAndReturn(ru
 	return _c
 }
 
+// GenerateWorkingSchedule provides a mock function with given fields: ctx, user, id, date, shiftTemplateId
+func (_m *MockWorkingScheduleManager) GenerateWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, shiftTemplateId *int64) ([]*model.AgentWorkingSchedule, []*model.WorkingScheduleStaffing, error) {
+	ret := _m.Called(ctx, user, id, date, shiftTemplateId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateWorkingSchedule")
+	}
+
+	var r0 []*model.AgentWorkingSchedule
+	var r1 []*model.WorkingScheduleStaffing
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, *int64) ([]*model.AgentWorkingSchedule, []*model.WorkingScheduleStaffing, error)); ok {
+		return rf(ctx, user, id, date, shiftTemplateId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, *int64) []*model.AgentWorkingSchedule); ok {
+		r0 = rf(ctx, user, id, date, shiftTemplateId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AgentWorkingSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, *int64) []*model.WorkingScheduleStaffing); ok {
+		r1 = rf(ctx, user, id, date, shiftTemplateId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*model.WorkingScheduleStaffing)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, *int64) error); ok {
+		r2 = rf(ctx, user, id, date, shiftTemplateId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockWorkingScheduleManager_GenerateWorkingSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateWorkingSchedule'
+type MockWorkingScheduleManager_GenerateWorkingSchedule_Call struct {
+	*mock.Call
+}
+
+// GenerateWorkingSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - id int64
+//   - date *model.FilterBetween
+//   - shiftTemplateId *int64
+func (_e *MockWorkingScheduleManager_Expecter) GenerateWorkingSchedule(ctx interface{}, user interface{}, id interface{}, date interface{}, shiftTemplateId interface{}) *MockWorkingScheduleManager_GenerateWorkingSchedule_Call {
+	return &MockWorkingScheduleManager_GenerateWorkingSchedule_Call{Call: _e.mock.On("GenerateWorkingSchedule", ctx, user, id, date, shiftTemplateId)}
+}
+
+func (_c *MockWorkingScheduleManager_GenerateWorkingSchedule_Call) Run(run func(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, shiftTemplateId *int64)) *MockWorkingScheduleManager_GenerateWorkingSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(int64), args[3].(*model.FilterBetween), args[4].(*int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_GenerateWorkingSchedule_Call) Return(_a0 []*model.AgentWorkingSchedule, _a1 []*model.WorkingScheduleStaffing, _a2 error) *MockWorkingScheduleManager_GenerateWorkingSchedule_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_GenerateWorkingSchedule_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, *int64) ([]*model.AgentWorkingSchedule, []*model.WorkingScheduleStaffing, error)) *MockWorkingScheduleManager_GenerateWorkingSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceWorkingSchedulePauses provides a mock function with given fields: ctx, user, id, date, agentIds, placement, overwrite
+func (_m *MockWorkingScheduleManager) PlaceWorkingSchedulePauses(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, agentIds []int64, placement *model.PausePlacement, overwrite bool) ([]*model.AgentWorkingSchedule, []*model.WorkingScheduleStaffing, error) {
+	ret := _m.Called(ctx, user, id, date, agentIds, placement, overwrite)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceWorkingSchedulePauses")
+	}
+
+	var r0 []*model.AgentWorkingSchedule
+	var r1 []*model.WorkingScheduleStaffing
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, []int64, *model.PausePlacement, bool) ([]*model.AgentWorkingSchedule, []*model.WorkingScheduleStaffing, error)); ok {
+		return rf(ctx, user, id, date, agentIds, placement, overwrite)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, []int64, *model.PausePlacement, bool) []*model.AgentWorkingSchedule); ok {
+		r0 = rf(ctx, user, id, date, agentIds, placement, overwrite)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AgentWorkingSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, []int64, *model.PausePlacement, bool) []*model.WorkingScheduleStaffing); ok {
+		r1 = rf(ctx, user, id, date, agentIds, placement, overwrite)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*model.WorkingScheduleStaffing)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, []int64, *model.PausePlacement, bool) error); ok {
+		r2 = rf(ctx, user, id, date, agentIds, placement, overwrite)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockWorkingScheduleManager_PlaceWorkingSchedulePauses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceWorkingSchedulePauses'
+type MockWorkingScheduleManager_PlaceWorkingSchedulePauses_Call struct {
+	*mock.Call
+}
+
+// PlaceWorkingSchedulePauses is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - id int64
+//   - date *model.FilterBetween
+//   - agentIds []int64
+//   - placement *model.PausePlacement
+//   - overwrite bool
+func (_e *MockWorkingScheduleManager_Expecter) PlaceWorkingSchedulePauses(ctx interface{}, user interface{}, id interface{}, date interface{}, agentIds interface{}, placement interface{}, overwrite interface{}) *MockWorkingScheduleManager_PlaceWorkingSchedulePauses_Call {
+	return &MockWorkingScheduleManager_PlaceWorkingSchedulePauses_Call{Call: _e.mock.On("PlaceWorkingSchedulePauses", ctx, user, id, date, agentIds, placement, overwrite)}
+}
+
+func (_c *MockWorkingScheduleManager_PlaceWorkingSchedulePauses_Call) Run(run func(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, agentIds []int64, placement *model.PausePlacement, overwrite bool)) *MockWorkingScheduleManager_PlaceWorkingSchedulePauses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(int64), args[3].(*model.FilterBetween), args[4].([]int64), args[5].(*model.PausePlacement), args[6].(bool))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_PlaceWorkingSchedulePauses_Call) Return(_a0 []*model.AgentWorkingSchedule, _a1 []*model.WorkingScheduleStaffing, _a2 error) *MockWorkingScheduleManager_PlaceWorkingSchedulePauses_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_PlaceWorkingSchedulePauses_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, []int64, *model.PausePlacement, bool) ([]*model.AgentWorkingSchedule, []*model.WorkingScheduleStaffing, error)) *MockWorkingScheduleManager_PlaceWorkingSchedulePauses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadWorkingSchedule provides a mock function with given fields: ctx, user, search
 func (_m *MockWorkingScheduleManager) ReadWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.SearchItem) (*model.WorkingSchedule, error) {
 	ret := _m.Called(ctx, user, search)
@@ -200,6 +526,69 @@ func (_c *MockWorkingScheduleManager_ReadWorkingSchedule_Call) RunAndReturn(run
 	return _c
 }
 
+// ReadWorkingScheduleCoverage provides a mock function with given fields: ctx, user, id, date, granularity, skillIds
+func (_m *MockWorkingScheduleManager) ReadWorkingScheduleCoverage(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, granularity time.Duration, skillIds []int64) ([]*model.WorkingScheduleStaffing, error) {
+	ret := _m.Called(ctx, user, id, date, granularity, skillIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadWorkingScheduleCoverage")
+	}
+
+	var r0 []*model.WorkingScheduleStaffing
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, time.Duration, []int64) ([]*model.WorkingScheduleStaffing, error)); ok {
+		return rf(ctx, user, id, date, granularity, skillIds)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, time.Duration, []int64) []*model.WorkingScheduleStaffing); ok {
+		r0 = rf(ctx, user, id, date, granularity, skillIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WorkingScheduleStaffing)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, time.Duration, []int64) error); ok {
+		r1 = rf(ctx, user, id, date, granularity, skillIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleManager_ReadWorkingScheduleCoverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadWorkingScheduleCoverage'
+type MockWorkingScheduleManager_ReadWorkingScheduleCoverage_Call struct {
+	*mock.Call
+}
+
+// ReadWorkingScheduleCoverage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - id int64
+//   - date *model.FilterBetween
+//   - granularity time.Duration
+//   - skillIds []int64
+func (_e *MockWorkingScheduleManager_Expecter) ReadWorkingScheduleCoverage(ctx interface{}, user interface{}, id interface{}, date interface{}, granularity interface{}, skillIds interface{}) *MockWorkingScheduleManager_ReadWorkingScheduleCoverage_Call {
+	return &MockWorkingScheduleManager_ReadWorkingScheduleCoverage_Call{Call: _e.mock.On("ReadWorkingScheduleCoverage", ctx, user, id, date, granularity, skillIds)}
+}
+
+func (_c *MockWorkingScheduleManager_ReadWorkingScheduleCoverage_Call) Run(run func(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, granularity time.Duration, skillIds []int64)) *MockWorkingScheduleManager_ReadWorkingScheduleCoverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(int64), args[3].(*model.FilterBetween), args[4].(time.Duration), args[5].([]int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_ReadWorkingScheduleCoverage_Call) Return(_a0 []*model.WorkingScheduleStaffing, _a1 error) *MockWorkingScheduleManager_ReadWorkingScheduleCoverage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_ReadWorkingScheduleCoverage_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, int64, *model.FilterBetween, time.Duration, []int64) ([]*model.WorkingScheduleStaffing, error)) *MockWorkingScheduleManager_ReadWorkingScheduleCoverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReadWorkingScheduleForecast provides a mock function with given fields: ctx, user, id, date
 func (_m *MockWorkingScheduleManager) ReadWorkingScheduleForecast(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween) ([]*model.ForecastCalculationResult, error) {
 	ret := _m.Called(ctx, user, id, date)
@@ -261,6 +650,66 @@ func (_c *MockWorkingScheduleManager_ReadWorkingScheduleForecast_Call) RunAndRet
 	return _c
 }
 
+// RejectWorkingSchedule provides a mock function with given fields: ctx, user, id
+func (_m *MockWorkingScheduleManager) RejectWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error) {
+	ret := _m.Called(ctx, user, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectWorkingSchedule")
+	}
+
+	var r0 *model.WorkingSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64) (*model.WorkingSchedule, error)); ok {
+		return rf(ctx, user, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64) *model.WorkingSchedule); ok {
+		r0 = rf(ctx, user, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WorkingSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SignedInUser, int64) error); ok {
+		r1 = rf(ctx, user, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleManager_RejectWorkingSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RejectWorkingSchedule'
+type MockWorkingScheduleManager_RejectWorkingSchedule_Call struct {
+	*mock.Call
+}
+
+// RejectWorkingSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - id int64
+func (_e *MockWorkingScheduleManager_Expecter) RejectWorkingSchedule(ctx interface{}, user interface{}, id interface{}) *MockWorkingScheduleManager_RejectWorkingSchedule_Call {
+	return &MockWorkingScheduleManager_RejectWorkingSchedule_Call{Call: _e.mock.On("RejectWorkingSchedule", ctx, user, id)}
+}
+
+func (_c *MockWorkingScheduleManager_RejectWorkingSchedule_Call) Run(run func(ctx context.Context, user *model.SignedInUser, id int64)) *MockWorkingScheduleManager_RejectWorkingSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_RejectWorkingSchedule_Call) Return(_a0 *model.WorkingSchedule, _a1 error) *MockWorkingScheduleManager_RejectWorkingSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_RejectWorkingSchedule_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, int64) (*model.WorkingSchedule, error)) *MockWorkingScheduleManager_RejectWorkingSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchWorkingSchedule provides a mock function with given fields: ctx, user, search
 func (_m *MockWorkingScheduleManager) SearchWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.SearchItem) ([]*model.WorkingSchedule, bool, error) {
 	ret := _m.Called(ctx, user, search)
@@ -328,6 +777,66 @@ func (_c *MockWorkingScheduleManager_SearchWorkingSchedule_Call) RunAndReturn(ru
 	return _c
 }
 
+// SubmitWorkingSchedule provides a mock function with given fields: ctx, user, id
+func (_m *MockWorkingScheduleManager) SubmitWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error) {
+	ret := _m.Called(ctx, user, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitWorkingSchedule")
+	}
+
+	var r0 *model.WorkingSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64) (*model.WorkingSchedule, error)); ok {
+		return rf(ctx, user, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64) *model.WorkingSchedule); ok {
+		r0 = rf(ctx, user, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WorkingSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SignedInUser, int64) error); ok {
+		r1 = rf(ctx, user, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleManager_SubmitWorkingSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitWorkingSchedule'
+type MockWorkingScheduleManager_SubmitWorkingSchedule_Call struct {
+	*mock.Call
+}
+
+// SubmitWorkingSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - id int64
+func (_e *MockWorkingScheduleManager_Expecter) SubmitWorkingSchedule(ctx interface{}, user interface{}, id interface{}) *MockWorkingScheduleManager_SubmitWorkingSchedule_Call {
+	return &MockWorkingScheduleManager_SubmitWorkingSchedule_Call{Call: _e.mock.On("SubmitWorkingSchedule", ctx, user, id)}
+}
+
+func (_c *MockWorkingScheduleManager_SubmitWorkingSchedule_Call) Run(run func(ctx context.Context, user *model.SignedInUser, id int64)) *MockWorkingScheduleManager_SubmitWorkingSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_SubmitWorkingSchedule_Call) Return(_a0 *model.WorkingSchedule, _a1 error) *MockWorkingScheduleManager_SubmitWorkingSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_SubmitWorkingSchedule_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, int64) (*model.WorkingSchedule, error)) *MockWorkingScheduleManager_SubmitWorkingSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateWorkingSchedule provides a mock function with given fields: ctx, user, in
 func (_m *MockWorkingScheduleManager) UpdateWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.WorkingSchedule) (*model.WorkingSchedule, error) {
 	ret := _m.Called(ctx, user, in)
@@ -508,6 +1017,66 @@ func (_c *MockWorkingScheduleManager_UpdateWorkingScheduleRemoveAgent_Call) RunA
 	return _c
 }
 
+// ValidateWorkingSchedule provides a mock function with given fields: ctx, user, id
+func (_m *MockWorkingScheduleManager) ValidateWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) ([]*model.WorkingScheduleViolation, error) {
+	ret := _m.Called(ctx, user, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateWorkingSchedule")
+	}
+
+	var r0 []*model.WorkingScheduleViolation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64) ([]*model.WorkingScheduleViolation, error)); ok {
+		return rf(ctx, user, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, int64) []*model.WorkingScheduleViolation); ok {
+		r0 = rf(ctx, user, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WorkingScheduleViolation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SignedInUser, int64) error); ok {
+		r1 = rf(ctx, user, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleManager_ValidateWorkingSchedule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateWorkingSchedule'
+type MockWorkingScheduleManager_ValidateWorkingSchedule_Call struct {
+	*mock.Call
+}
+
+// ValidateWorkingSchedule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - id int64
+func (_e *MockWorkingScheduleManager_Expecter) ValidateWorkingSchedule(ctx interface{}, user interface{}, id interface{}) *MockWorkingScheduleManager_ValidateWorkingSchedule_Call {
+	return &MockWorkingScheduleManager_ValidateWorkingSchedule_Call{Call: _e.mock.On("ValidateWorkingSchedule", ctx, user, id)}
+}
+
+func (_c *MockWorkingScheduleManager_ValidateWorkingSchedule_Call) Run(run func(ctx context.Context, user *model.SignedInUser, id int64)) *MockWorkingScheduleManager_ValidateWorkingSchedule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_ValidateWorkingSchedule_Call) Return(_a0 []*model.WorkingScheduleViolation, _a1 error) *MockWorkingScheduleManager_ValidateWorkingSchedule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleManager_ValidateWorkingSchedule_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, int64) ([]*model.WorkingScheduleViolation, error)) *MockWorkingScheduleManager_ValidateWorkingSchedule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockWorkingScheduleManager creates a new instance of MockWorkingScheduleManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockWorkingScheduleManager(t interface {
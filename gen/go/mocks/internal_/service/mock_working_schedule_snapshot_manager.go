@@ -0,0 +1,293 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/webitel/webitel-wfm/internal/model"
+
+	options "github.com/webitel/webitel-wfm/internal/model/options"
+)
+
+// MockWorkingScheduleSnapshotManager is an autogenerated mock type for the WorkingScheduleSnapshotManager type
+type MockWorkingScheduleSnapshotManager struct {
+	mock.Mock
+}
+
+type MockWorkingScheduleSnapshotManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWorkingScheduleSnapshotManager) EXPECT() *MockWorkingScheduleSnapshotManager_Expecter {
+	return &MockWorkingScheduleSnapshotManager_Expecter{mock: &_m.Mock}
+}
+
+// CreateWorkingScheduleSnapshot provides a mock function with given fields: ctx, read, note
+func (_m *MockWorkingScheduleSnapshotManager) CreateWorkingScheduleSnapshot(ctx context.Context, read *options.Read, note *string) (*model.WorkingScheduleSnapshot, error) {
+	ret := _m.Called(ctx, read, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkingScheduleSnapshot")
+	}
+
+	var r0 *model.WorkingScheduleSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) (*model.WorkingScheduleSnapshot, error)); ok {
+		return rf(ctx, read, note)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) *model.WorkingScheduleSnapshot); ok {
+		r0 = rf(ctx, read, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WorkingScheduleSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *string) error); ok {
+		r1 = rf(ctx, read, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorkingScheduleSnapshot'
+type MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateWorkingScheduleSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - note *string
+func (_e *MockWorkingScheduleSnapshotManager_Expecter) CreateWorkingScheduleSnapshot(ctx interface{}, read interface{}, note interface{}) *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call {
+	return &MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call{Call: _e.mock.On("CreateWorkingScheduleSnapshot", ctx, read, note)}
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call) Run(run func(ctx context.Context, read *options.Read, note *string)) *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call) Return(_a0 *model.WorkingScheduleSnapshot, _a1 error) *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call) RunAndReturn(run func(context.Context, *options.Read, *string) (*model.WorkingScheduleSnapshot, error)) *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiffWorkingScheduleSnapshot provides a mock function with given fields: ctx, read, toId
+func (_m *MockWorkingScheduleSnapshotManager) DiffWorkingScheduleSnapshot(ctx context.Context, read *options.Read, toId *int64) ([]*model.WorkingScheduleAgentDiff, error) {
+	ret := _m.Called(ctx, read, toId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffWorkingScheduleSnapshot")
+	}
+
+	var r0 []*model.WorkingScheduleAgentDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *int64) ([]*model.WorkingScheduleAgentDiff, error)); ok {
+		return rf(ctx, read, toId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *int64) []*model.WorkingScheduleAgentDiff); ok {
+		r0 = rf(ctx, read, toId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WorkingScheduleAgentDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *int64) error); ok {
+		r1 = rf(ctx, read, toId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleSnapshotManager_DiffWorkingScheduleSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffWorkingScheduleSnapshot'
+type MockWorkingScheduleSnapshotManager_DiffWorkingScheduleSnapshot_Call struct {
+	*mock.Call
+}
+
+// DiffWorkingScheduleSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - toId *int64
+func (_e *MockWorkingScheduleSnapshotManager_Expecter) DiffWorkingScheduleSnapshot(ctx interface{}, read interface{}, toId interface{}) *MockWorkingScheduleSnapshotManager_DiffWorkingScheduleSnapshot_Call {
+	return &MockWorkingScheduleSnapshotManager_DiffWorkingScheduleSnapshot_Call{Call: _e.mock.On("DiffWorkingScheduleSnapshot", ctx, read, toId)}
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_DiffWorkingScheduleSnapshot_Call) Run(run func(ctx context.Context, read *options.Read, toId *int64)) *MockWorkingScheduleSnapshotManager_DiffWorkingScheduleSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*int64))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_DiffWorkingScheduleSnapshot_Call) Return(_a0 []*model.WorkingScheduleAgentDiff, _a1 error) *MockWorkingScheduleSnapshotManager_DiffWorkingScheduleSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_DiffWorkingScheduleSnapshot_Call) RunAndReturn(run func(context.Context, *options.Read, *int64) ([]*model.WorkingScheduleAgentDiff, error)) *MockWorkingScheduleSnapshotManager_DiffWorkingScheduleSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadWorkingScheduleSnapshot provides a mock function with given fields: ctx, read
+func (_m *MockWorkingScheduleSnapshotManager) ReadWorkingScheduleSnapshot(ctx context.Context, read *options.Read) (*model.WorkingScheduleSnapshot, []*model.AgentWorkingSchedule, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadWorkingScheduleSnapshot")
+	}
+
+	var r0 *model.WorkingScheduleSnapshot
+	var r1 []*model.AgentWorkingSchedule
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.WorkingScheduleSnapshot, []*model.AgentWorkingSchedule, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.WorkingScheduleSnapshot); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WorkingScheduleSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) []*model.AgentWorkingSchedule); ok {
+		r1 = rf(ctx, read)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*model.AgentWorkingSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *options.Read) error); ok {
+		r2 = rf(ctx, read)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadWorkingScheduleSnapshot'
+type MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call struct {
+	*mock.Call
+}
+
+// ReadWorkingScheduleSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockWorkingScheduleSnapshotManager_Expecter) ReadWorkingScheduleSnapshot(ctx interface{}, read interface{}) *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call {
+	return &MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call{Call: _e.mock.On("ReadWorkingScheduleSnapshot", ctx, read)}
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call) Run(run func(ctx context.Context, read *options.Read)) *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call) Return(_a0 *model.WorkingScheduleSnapshot, _a1 []*model.AgentWorkingSchedule, _a2 error) *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.WorkingScheduleSnapshot, []*model.AgentWorkingSchedule, error)) *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchWorkingScheduleSnapshot provides a mock function with given fields: ctx, search
+func (_m *MockWorkingScheduleSnapshotManager) SearchWorkingScheduleSnapshot(ctx context.Context, search *options.Search) ([]*model.WorkingScheduleSnapshot, bool, error) {
+	ret := _m.Called(ctx, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchWorkingScheduleSnapshot")
+	}
+
+	var r0 []*model.WorkingScheduleSnapshot
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search) ([]*model.WorkingScheduleSnapshot, bool, error)); ok {
+		return rf(ctx, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search) []*model.WorkingScheduleSnapshot); ok {
+		r0 = rf(ctx, search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WorkingScheduleSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Search) bool); ok {
+		r1 = rf(ctx, search)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *options.Search) error); ok {
+		r2 = rf(ctx, search)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchWorkingScheduleSnapshot'
+type MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call struct {
+	*mock.Call
+}
+
+// SearchWorkingScheduleSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *options.Search
+func (_e *MockWorkingScheduleSnapshotManager_Expecter) SearchWorkingScheduleSnapshot(ctx interface{}, search interface{}) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call {
+	return &MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call{Call: _e.mock.On("SearchWorkingScheduleSnapshot", ctx, search)}
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call) Run(run func(ctx context.Context, search *options.Search)) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Search))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call) Return(_a0 []*model.WorkingScheduleSnapshot, _a1 bool, _a2 error) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call) RunAndReturn(run func(context.Context, *options.Search) ([]*model.WorkingScheduleSnapshot, bool, error)) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWorkingScheduleSnapshotManager creates a new instance of MockWorkingScheduleSnapshotManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWorkingScheduleSnapshotManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWorkingScheduleSnapshotManager {
+	mock := &MockWorkingScheduleSnapshotManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
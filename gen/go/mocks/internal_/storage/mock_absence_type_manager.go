@@ -0,0 +1,320 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package storage
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/webitel/webitel-wfm/internal/model"
+
+	options "github.com/webitel/webitel-wfm/internal/model/options"
+)
+
+// MockAbsenceTypeManager is an autogenerated mock type for the AbsenceTypeManager type
+type MockAbsenceTypeManager struct {
+	mock.Mock
+}
+
+type MockAbsenceTypeManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAbsenceTypeManager) EXPECT() *MockAbsenceTypeManager_Expecter {
+	return &MockAbsenceTypeManager_Expecter{mock: &_m.Mock}
+}
+
+// CreateAbsenceType provides a mock function with given fields: ctx, user, in
+func (_m *MockAbsenceTypeManager) CreateAbsenceType(ctx context.Context, user *model.SignedInUser, in *model.AbsenceType) (int64, error) {
+	ret := _m.Called(ctx, user, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAbsenceType")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, *model.AbsenceType) (int64, error)); ok {
+		return rf(ctx, user, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, *model.AbsenceType) int64); ok {
+		r0 = rf(ctx, user, in)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SignedInUser, *model.AbsenceType) error); ok {
+		r1 = rf(ctx, user, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAbsenceTypeManager_CreateAbsenceType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAbsenceType'
+type MockAbsenceTypeManager_CreateAbsenceType_Call struct {
+	*mock.Call
+}
+
+// CreateAbsenceType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - in *model.AbsenceType
+func (_e *MockAbsenceTypeManager_Expecter) CreateAbsenceType(ctx interface{}, user interface{}, in interface{}) *MockAbsenceTypeManager_CreateAbsenceType_Call {
+	return &MockAbsenceTypeManager_CreateAbsenceType_Call{Call: _e.mock.On("CreateAbsenceType", ctx, user, in)}
+}
+
+func (_c *MockAbsenceTypeManager_CreateAbsenceType_Call) Run(run func(ctx context.Context, user *model.SignedInUser, in *model.AbsenceType)) *MockAbsenceTypeManager_CreateAbsenceType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(*model.AbsenceType))
+	})
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_CreateAbsenceType_Call) Return(_a0 int64, _a1 error) *MockAbsenceTypeManager_CreateAbsenceType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_CreateAbsenceType_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, *model.AbsenceType) (int64, error)) *MockAbsenceTypeManager_CreateAbsenceType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAbsenceType provides a mock function with given fields: ctx, read
+func (_m *MockAbsenceTypeManager) DeleteAbsenceType(ctx context.Context, read *options.Read) (int64, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAbsenceType")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (int64, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) int64); ok {
+		r0 = rf(ctx, read)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAbsenceTypeManager_DeleteAbsenceType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAbsenceType'
+type MockAbsenceTypeManager_DeleteAbsenceType_Call struct {
+	*mock.Call
+}
+
+// DeleteAbsenceType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockAbsenceTypeManager_Expecter) DeleteAbsenceType(ctx interface{}, read interface{}) *MockAbsenceTypeManager_DeleteAbsenceType_Call {
+	return &MockAbsenceTypeManager_DeleteAbsenceType_Call{Call: _e.mock.On("DeleteAbsenceType", ctx, read)}
+}
+
+func (_c *MockAbsenceTypeManager_DeleteAbsenceType_Call) Run(run func(ctx context.Context, read *options.Read)) *MockAbsenceTypeManager_DeleteAbsenceType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_DeleteAbsenceType_Call) Return(_a0 int64, _a1 error) *MockAbsenceTypeManager_DeleteAbsenceType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_DeleteAbsenceType_Call) RunAndReturn(run func(context.Context, *options.Read) (int64, error)) *MockAbsenceTypeManager_DeleteAbsenceType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadAbsenceType provides a mock function with given fields: ctx, read
+func (_m *MockAbsenceTypeManager) ReadAbsenceType(ctx context.Context, read *options.Read) (*model.AbsenceType, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadAbsenceType")
+	}
+
+	var r0 *model.AbsenceType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.AbsenceType, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.AbsenceType); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AbsenceType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAbsenceTypeManager_ReadAbsenceType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadAbsenceType'
+type MockAbsenceTypeManager_ReadAbsenceType_Call struct {
+	*mock.Call
+}
+
+// ReadAbsenceType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockAbsenceTypeManager_Expecter) ReadAbsenceType(ctx interface{}, read interface{}) *MockAbsenceTypeManager_ReadAbsenceType_Call {
+	return &MockAbsenceTypeManager_ReadAbsenceType_Call{Call: _e.mock.On("ReadAbsenceType", ctx, read)}
+}
+
+func (_c *MockAbsenceTypeManager_ReadAbsenceType_Call) Run(run func(ctx context.Context, read *options.Read)) *MockAbsenceTypeManager_ReadAbsenceType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_ReadAbsenceType_Call) Return(_a0 *model.AbsenceType, _a1 error) *MockAbsenceTypeManager_ReadAbsenceType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_ReadAbsenceType_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.AbsenceType, error)) *MockAbsenceTypeManager_ReadAbsenceType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAbsenceType provides a mock function with given fields: ctx, search
+func (_m *MockAbsenceTypeManager) SearchAbsenceType(ctx context.Context, search *options.Search) ([]*model.AbsenceType, error) {
+	ret := _m.Called(ctx, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAbsenceType")
+	}
+
+	var r0 []*model.AbsenceType
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search) ([]*model.AbsenceType, error)); ok {
+		return rf(ctx, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search) []*model.AbsenceType); ok {
+		r0 = rf(ctx, search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AbsenceType)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Search) error); ok {
+		r1 = rf(ctx, search)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAbsenceTypeManager_SearchAbsenceType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAbsenceType'
+type MockAbsenceTypeManager_SearchAbsenceType_Call struct {
+	*mock.Call
+}
+
+// SearchAbsenceType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *options.Search
+func (_e *MockAbsenceTypeManager_Expecter) SearchAbsenceType(ctx interface{}, search interface{}) *MockAbsenceTypeManager_SearchAbsenceType_Call {
+	return &MockAbsenceTypeManager_SearchAbsenceType_Call{Call: _e.mock.On("SearchAbsenceType", ctx, search)}
+}
+
+func (_c *MockAbsenceTypeManager_SearchAbsenceType_Call) Run(run func(ctx context.Context, search *options.Search)) *MockAbsenceTypeManager_SearchAbsenceType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Search))
+	})
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_SearchAbsenceType_Call) Return(_a0 []*model.AbsenceType, _a1 error) *MockAbsenceTypeManager_SearchAbsenceType_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_SearchAbsenceType_Call) RunAndReturn(run func(context.Context, *options.Search) ([]*model.AbsenceType, error)) *MockAbsenceTypeManager_SearchAbsenceType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAbsenceType provides a mock function with given fields: ctx, user, in
+func (_m *MockAbsenceTypeManager) UpdateAbsenceType(ctx context.Context, user *model.SignedInUser, in *model.AbsenceType) error {
+	ret := _m.Called(ctx, user, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAbsenceType")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SignedInUser, *model.AbsenceType) error); ok {
+		r0 = rf(ctx, user, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAbsenceTypeManager_UpdateAbsenceType_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAbsenceType'
+type MockAbsenceTypeManager_UpdateAbsenceType_Call struct {
+	*mock.Call
+}
+
+// UpdateAbsenceType is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *model.SignedInUser
+//   - in *model.AbsenceType
+func (_e *MockAbsenceTypeManager_Expecter) UpdateAbsenceType(ctx interface{}, user interface{}, in interface{}) *MockAbsenceTypeManager_UpdateAbsenceType_Call {
+	return &MockAbsenceTypeManager_UpdateAbsenceType_Call{Call: _e.mock.On("UpdateAbsenceType", ctx, user, in)}
+}
+
+func (_c *MockAbsenceTypeManager_UpdateAbsenceType_Call) Run(run func(ctx context.Context, user *model.SignedInUser, in *model.AbsenceType)) *MockAbsenceTypeManager_UpdateAbsenceType_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.SignedInUser), args[2].(*model.AbsenceType))
+	})
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_UpdateAbsenceType_Call) Return(_a0 error) *MockAbsenceTypeManager_UpdateAbsenceType_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAbsenceTypeManager_UpdateAbsenceType_Call) RunAndReturn(run func(context.Context, *model.SignedInUser, *model.AbsenceType) error) *MockAbsenceTypeManager_UpdateAbsenceType_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAbsenceTypeManager creates a new instance of MockAbsenceTypeManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAbsenceTypeManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAbsenceTypeManager {
+	mock := &MockAbsenceTypeManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -83,6 +83,65 @@ func (_c *MockAgentWorkingConditionsManager_ReadAgentWorkingConditions_Call) Run
 	return _c
 }
 
+// SearchAgentWorkingConditionsHistory provides a mock function with given fields: ctx, read
+func (_m *MockAgentWorkingConditionsManager) SearchAgentWorkingConditionsHistory(ctx context.Context, read *options.Read) ([]*model.AgentWorkingConditionsHistory, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAgentWorkingConditionsHistory")
+	}
+
+	var r0 []*model.AgentWorkingConditionsHistory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) ([]*model.AgentWorkingConditionsHistory, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) []*model.AgentWorkingConditionsHistory); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AgentWorkingConditionsHistory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAgentWorkingConditionsManager_SearchAgentWorkingConditionsHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAgentWorkingConditionsHistory'
+type MockAgentWorkingConditionsManager_SearchAgentWorkingConditionsHistory_Call struct {
+	*mock.Call
+}
+
+// SearchAgentWorkingConditionsHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockAgentWorkingConditionsManager_Expecter) SearchAgentWorkingConditionsHistory(ctx interface{}, read interface{}) *MockAgentWorkingConditionsManager_SearchAgentWorkingConditionsHistory_Call {
+	return &MockAgentWorkingConditionsManager_SearchAgentWorkingConditionsHistory_Call{Call: _e.mock.On("SearchAgentWorkingConditionsHistory", ctx, read)}
+}
+
+func (_c *MockAgentWorkingConditionsManager_SearchAgentWorkingConditionsHistory_Call) Run(run func(ctx context.Context, read *options.Read)) *MockAgentWorkingConditionsManager_SearchAgentWorkingConditionsHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockAgentWorkingConditionsManager_SearchAgentWorkingConditionsHistory_Call) Return(_a0 []*model.AgentWorkingConditionsHistory, _a1 error) *MockAgentWorkingConditionsManager_SearchAgentWorkingConditionsHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAgentWorkingConditionsManager_SearchAgentWorkingConditionsHistory_Call) RunAndReturn(run func(context.Context, *options.Read) ([]*model.AgentWorkingConditionsHistory, error)) *MockAgentWorkingConditionsManager_SearchAgentWorkingConditionsHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateAgentWorkingConditions provides a mock function with given fields: ctx, read, in
 func (_m *MockAgentWorkingConditionsManager) UpdateAgentWorkingConditions(ctx context.Context, read *options.Read, in *model.AgentWorkingConditions) error {
 	ret := _m.Called(ctx, read, in)
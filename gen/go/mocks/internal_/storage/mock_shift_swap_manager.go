@@ -0,0 +1,375 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package storage
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/webitel/webitel-wfm/internal/model"
+
+	options "github.com/webitel/webitel-wfm/internal/model/options"
+)
+
+// MockShiftSwapManager is an autogenerated mock type for the ShiftSwapManager type
+type MockShiftSwapManager struct {
+	mock.Mock
+}
+
+type MockShiftSwapManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockShiftSwapManager) EXPECT() *MockShiftSwapManager_Expecter {
+	return &MockShiftSwapManager_Expecter{mock: &_m.Mock}
+}
+
+// AcceptShiftSwap provides a mock function with given fields: ctx, read, agentId, shiftId
+func (_m *MockShiftSwapManager) AcceptShiftSwap(ctx context.Context, read *options.Read, agentId int64, shiftId *int64) error {
+	ret := _m.Called(ctx, read, agentId, shiftId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AcceptShiftSwap")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, int64, *int64) error); ok {
+		r0 = rf(ctx, read, agentId, shiftId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockShiftSwapManager_AcceptShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AcceptShiftSwap'
+type MockShiftSwapManager_AcceptShiftSwap_Call struct {
+	*mock.Call
+}
+
+// AcceptShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - agentId int64
+//   - shiftId *int64
+func (_e *MockShiftSwapManager_Expecter) AcceptShiftSwap(ctx interface{}, read interface{}, agentId interface{}, shiftId interface{}) *MockShiftSwapManager_AcceptShiftSwap_Call {
+	return &MockShiftSwapManager_AcceptShiftSwap_Call{Call: _e.mock.On("AcceptShiftSwap", ctx, read, agentId, shiftId)}
+}
+
+func (_c *MockShiftSwapManager_AcceptShiftSwap_Call) Run(run func(ctx context.Context, read *options.Read, agentId int64, shiftId *int64)) *MockShiftSwapManager_AcceptShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(int64), args[3].(*int64))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_AcceptShiftSwap_Call) Return(_a0 error) *MockShiftSwapManager_AcceptShiftSwap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_AcceptShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Read, int64, *int64) error) *MockShiftSwapManager_AcceptShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateShiftSwap provides a mock function with given fields: ctx, read, in
+func (_m *MockShiftSwapManager) CreateShiftSwap(ctx context.Context, read *options.Read, in *model.ShiftSwap) (int64, error) {
+	ret := _m.Called(ctx, read, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateShiftSwap")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.ShiftSwap) (int64, error)); ok {
+		return rf(ctx, read, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.ShiftSwap) int64); ok {
+		r0 = rf(ctx, read, in)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *model.ShiftSwap) error); ok {
+		r1 = rf(ctx, read, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_CreateShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateShiftSwap'
+type MockShiftSwapManager_CreateShiftSwap_Call struct {
+	*mock.Call
+}
+
+// CreateShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - in *model.ShiftSwap
+func (_e *MockShiftSwapManager_Expecter) CreateShiftSwap(ctx interface{}, read interface{}, in interface{}) *MockShiftSwapManager_CreateShiftSwap_Call {
+	return &MockShiftSwapManager_CreateShiftSwap_Call{Call: _e.mock.On("CreateShiftSwap", ctx, read, in)}
+}
+
+func (_c *MockShiftSwapManager_CreateShiftSwap_Call) Run(run func(ctx context.Context, read *options.Read, in *model.ShiftSwap)) *MockShiftSwapManager_CreateShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*model.ShiftSwap))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_CreateShiftSwap_Call) Return(_a0 int64, _a1 error) *MockShiftSwapManager_CreateShiftSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_CreateShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Read, *model.ShiftSwap) (int64, error)) *MockShiftSwapManager_CreateShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadShiftSwap provides a mock function with given fields: ctx, read
+func (_m *MockShiftSwapManager) ReadShiftSwap(ctx context.Context, read *options.Read) (*model.ShiftSwap, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadShiftSwap")
+	}
+
+	var r0 *model.ShiftSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.ShiftSwap, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.ShiftSwap); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShiftSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_ReadShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadShiftSwap'
+type MockShiftSwapManager_ReadShiftSwap_Call struct {
+	*mock.Call
+}
+
+// ReadShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockShiftSwapManager_Expecter) ReadShiftSwap(ctx interface{}, read interface{}) *MockShiftSwapManager_ReadShiftSwap_Call {
+	return &MockShiftSwapManager_ReadShiftSwap_Call{Call: _e.mock.On("ReadShiftSwap", ctx, read)}
+}
+
+func (_c *MockShiftSwapManager_ReadShiftSwap_Call) Run(run func(ctx context.Context, read *options.Read)) *MockShiftSwapManager_ReadShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_ReadShiftSwap_Call) Return(_a0 *model.ShiftSwap, _a1 error) *MockShiftSwapManager_ReadShiftSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_ReadShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.ShiftSwap, error)) *MockShiftSwapManager_ReadShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchShiftSwap provides a mock function with given fields: ctx, search, filter
+func (_m *MockShiftSwapManager) SearchShiftSwap(ctx context.Context, search *options.Search, filter *model.ShiftSwapSearch) ([]*model.ShiftSwap, error) {
+	ret := _m.Called(ctx, search, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchShiftSwap")
+	}
+
+	var r0 []*model.ShiftSwap
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search, *model.ShiftSwapSearch) ([]*model.ShiftSwap, error)); ok {
+		return rf(ctx, search, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search, *model.ShiftSwapSearch) []*model.ShiftSwap); ok {
+		r0 = rf(ctx, search, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ShiftSwap)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Search, *model.ShiftSwapSearch) error); ok {
+		r1 = rf(ctx, search, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_SearchShiftSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchShiftSwap'
+type MockShiftSwapManager_SearchShiftSwap_Call struct {
+	*mock.Call
+}
+
+// SearchShiftSwap is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *options.Search
+//   - filter *model.ShiftSwapSearch
+func (_e *MockShiftSwapManager_Expecter) SearchShiftSwap(ctx interface{}, search interface{}, filter interface{}) *MockShiftSwapManager_SearchShiftSwap_Call {
+	return &MockShiftSwapManager_SearchShiftSwap_Call{Call: _e.mock.On("SearchShiftSwap", ctx, search, filter)}
+}
+
+func (_c *MockShiftSwapManager_SearchShiftSwap_Call) Run(run func(ctx context.Context, search *options.Search, filter *model.ShiftSwapSearch)) *MockShiftSwapManager_SearchShiftSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Search), args[2].(*model.ShiftSwapSearch))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_SearchShiftSwap_Call) Return(_a0 []*model.ShiftSwap, _a1 error) *MockShiftSwapManager_SearchShiftSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_SearchShiftSwap_Call) RunAndReturn(run func(context.Context, *options.Search, *model.ShiftSwapSearch) ([]*model.ShiftSwap, error)) *MockShiftSwapManager_SearchShiftSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchShiftSwapMissingSkills provides a mock function with given fields: ctx, read, shiftId, agentId
+func (_m *MockShiftSwapManager) SearchShiftSwapMissingSkills(ctx context.Context, read *options.Read, shiftId int64, agentId int64) ([]*model.LookupItem, error) {
+	ret := _m.Called(ctx, read, shiftId, agentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchShiftSwapMissingSkills")
+	}
+
+	var r0 []*model.LookupItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, int64, int64) ([]*model.LookupItem, error)); ok {
+		return rf(ctx, read, shiftId, agentId)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, int64, int64) []*model.LookupItem); ok {
+		r0 = rf(ctx, read, shiftId, agentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LookupItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, int64, int64) error); ok {
+		r1 = rf(ctx, read, shiftId, agentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockShiftSwapManager_SearchShiftSwapMissingSkills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchShiftSwapMissingSkills'
+type MockShiftSwapManager_SearchShiftSwapMissingSkills_Call struct {
+	*mock.Call
+}
+
+// SearchShiftSwapMissingSkills is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - shiftId int64
+//   - agentId int64
+func (_e *MockShiftSwapManager_Expecter) SearchShiftSwapMissingSkills(ctx interface{}, read interface{}, shiftId interface{}, agentId interface{}) *MockShiftSwapManager_SearchShiftSwapMissingSkills_Call {
+	return &MockShiftSwapManager_SearchShiftSwapMissingSkills_Call{Call: _e.mock.On("SearchShiftSwapMissingSkills", ctx, read, shiftId, agentId)}
+}
+
+func (_c *MockShiftSwapManager_SearchShiftSwapMissingSkills_Call) Run(run func(ctx context.Context, read *options.Read, shiftId int64, agentId int64)) *MockShiftSwapManager_SearchShiftSwapMissingSkills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_SearchShiftSwapMissingSkills_Call) Return(_a0 []*model.LookupItem, _a1 error) *MockShiftSwapManager_SearchShiftSwapMissingSkills_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_SearchShiftSwapMissingSkills_Call) RunAndReturn(run func(context.Context, *options.Read, int64, int64) ([]*model.LookupItem, error)) *MockShiftSwapManager_SearchShiftSwapMissingSkills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateShiftSwapState provides a mock function with given fields: ctx, read, from, in
+func (_m *MockShiftSwapManager) UpdateShiftSwapState(ctx context.Context, read *options.Read, from model.ShiftSwapState, in *model.ShiftSwap) error {
+	ret := _m.Called(ctx, read, from, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateShiftSwapState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, model.ShiftSwapState, *model.ShiftSwap) error); ok {
+		r0 = rf(ctx, read, from, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockShiftSwapManager_UpdateShiftSwapState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateShiftSwapState'
+type MockShiftSwapManager_UpdateShiftSwapState_Call struct {
+	*mock.Call
+}
+
+// UpdateShiftSwapState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - from model.ShiftSwapState
+//   - in *model.ShiftSwap
+func (_e *MockShiftSwapManager_Expecter) UpdateShiftSwapState(ctx interface{}, read interface{}, from interface{}, in interface{}) *MockShiftSwapManager_UpdateShiftSwapState_Call {
+	return &MockShiftSwapManager_UpdateShiftSwapState_Call{Call: _e.mock.On("UpdateShiftSwapState", ctx, read, from, in)}
+}
+
+func (_c *MockShiftSwapManager_UpdateShiftSwapState_Call) Run(run func(ctx context.Context, read *options.Read, from model.ShiftSwapState, in *model.ShiftSwap)) *MockShiftSwapManager_UpdateShiftSwapState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(model.ShiftSwapState), args[3].(*model.ShiftSwap))
+	})
+	return _c
+}
+
+func (_c *MockShiftSwapManager_UpdateShiftSwapState_Call) Return(_a0 error) *MockShiftSwapManager_UpdateShiftSwapState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockShiftSwapManager_UpdateShiftSwapState_Call) RunAndReturn(run func(context.Context, *options.Read, model.ShiftSwapState, *model.ShiftSwap) error) *MockShiftSwapManager_UpdateShiftSwapState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockShiftSwapManager creates a new instance of MockShiftSwapManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockShiftSwapManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockShiftSwapManager {
+	mock := &MockShiftSwapManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
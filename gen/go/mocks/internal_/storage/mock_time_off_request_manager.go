@@ -0,0 +1,265 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package storage
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/webitel/webitel-wfm/internal/model"
+
+	options "github.com/webitel/webitel-wfm/internal/model/options"
+)
+
+// MockTimeOffRequestManager is an autogenerated mock type for the TimeOffRequestManager type
+type MockTimeOffRequestManager struct {
+	mock.Mock
+}
+
+type MockTimeOffRequestManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTimeOffRequestManager) EXPECT() *MockTimeOffRequestManager_Expecter {
+	return &MockTimeOffRequestManager_Expecter{mock: &_m.Mock}
+}
+
+// CreateTimeOffRequest provides a mock function with given fields: ctx, read, in
+func (_m *MockTimeOffRequestManager) CreateTimeOffRequest(ctx context.Context, read *options.Read, in *model.TimeOffRequest) (int64, error) {
+	ret := _m.Called(ctx, read, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTimeOffRequest")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.TimeOffRequest) (int64, error)); ok {
+		return rf(ctx, read, in)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *model.TimeOffRequest) int64); ok {
+		r0 = rf(ctx, read, in)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *model.TimeOffRequest) error); ok {
+		r1 = rf(ctx, read, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeOffRequestManager_CreateTimeOffRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTimeOffRequest'
+type MockTimeOffRequestManager_CreateTimeOffRequest_Call struct {
+	*mock.Call
+}
+
+// CreateTimeOffRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - in *model.TimeOffRequest
+func (_e *MockTimeOffRequestManager_Expecter) CreateTimeOffRequest(ctx interface{}, read interface{}, in interface{}) *MockTimeOffRequestManager_CreateTimeOffRequest_Call {
+	return &MockTimeOffRequestManager_CreateTimeOffRequest_Call{Call: _e.mock.On("CreateTimeOffRequest", ctx, read, in)}
+}
+
+func (_c *MockTimeOffRequestManager_CreateTimeOffRequest_Call) Run(run func(ctx context.Context, read *options.Read, in *model.TimeOffRequest)) *MockTimeOffRequestManager_CreateTimeOffRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*model.TimeOffRequest))
+	})
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_CreateTimeOffRequest_Call) Return(_a0 int64, _a1 error) *MockTimeOffRequestManager_CreateTimeOffRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_CreateTimeOffRequest_Call) RunAndReturn(run func(context.Context, *options.Read, *model.TimeOffRequest) (int64, error)) *MockTimeOffRequestManager_CreateTimeOffRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadTimeOffRequest provides a mock function with given fields: ctx, read
+func (_m *MockTimeOffRequestManager) ReadTimeOffRequest(ctx context.Context, read *options.Read) (*model.TimeOffRequest, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadTimeOffRequest")
+	}
+
+	var r0 *model.TimeOffRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.TimeOffRequest, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.TimeOffRequest); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TimeOffRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeOffRequestManager_ReadTimeOffRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadTimeOffRequest'
+type MockTimeOffRequestManager_ReadTimeOffRequest_Call struct {
+	*mock.Call
+}
+
+// ReadTimeOffRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockTimeOffRequestManager_Expecter) ReadTimeOffRequest(ctx interface{}, read interface{}) *MockTimeOffRequestManager_ReadTimeOffRequest_Call {
+	return &MockTimeOffRequestManager_ReadTimeOffRequest_Call{Call: _e.mock.On("ReadTimeOffRequest", ctx, read)}
+}
+
+func (_c *MockTimeOffRequestManager_ReadTimeOffRequest_Call) Run(run func(ctx context.Context, read *options.Read)) *MockTimeOffRequestManager_ReadTimeOffRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_ReadTimeOffRequest_Call) Return(_a0 *model.TimeOffRequest, _a1 error) *MockTimeOffRequestManager_ReadTimeOffRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_ReadTimeOffRequest_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.TimeOffRequest, error)) *MockTimeOffRequestManager_ReadTimeOffRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchTimeOffRequest provides a mock function with given fields: ctx, search, filter
+func (_m *MockTimeOffRequestManager) SearchTimeOffRequest(ctx context.Context, search *options.Search, filter *model.TimeOffRequestSearch) ([]*model.TimeOffRequest, error) {
+	ret := _m.Called(ctx, search, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchTimeOffRequest")
+	}
+
+	var r0 []*model.TimeOffRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search, *model.TimeOffRequestSearch) ([]*model.TimeOffRequest, error)); ok {
+		return rf(ctx, search, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search, *model.TimeOffRequestSearch) []*model.TimeOffRequest); ok {
+		r0 = rf(ctx, search, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TimeOffRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Search, *model.TimeOffRequestSearch) error); ok {
+		r1 = rf(ctx, search, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTimeOffRequestManager_SearchTimeOffRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchTimeOffRequest'
+type MockTimeOffRequestManager_SearchTimeOffRequest_Call struct {
+	*mock.Call
+}
+
+// SearchTimeOffRequest is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *options.Search
+//   - filter *model.TimeOffRequestSearch
+func (_e *MockTimeOffRequestManager_Expecter) SearchTimeOffRequest(ctx interface{}, search interface{}, filter interface{}) *MockTimeOffRequestManager_SearchTimeOffRequest_Call {
+	return &MockTimeOffRequestManager_SearchTimeOffRequest_Call{Call: _e.mock.On("SearchTimeOffRequest", ctx, search, filter)}
+}
+
+func (_c *MockTimeOffRequestManager_SearchTimeOffRequest_Call) Run(run func(ctx context.Context, search *options.Search, filter *model.TimeOffRequestSearch)) *MockTimeOffRequestManager_SearchTimeOffRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Search), args[2].(*model.TimeOffRequestSearch))
+	})
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_SearchTimeOffRequest_Call) Return(_a0 []*model.TimeOffRequest, _a1 error) *MockTimeOffRequestManager_SearchTimeOffRequest_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_SearchTimeOffRequest_Call) RunAndReturn(run func(context.Context, *options.Search, *model.TimeOffRequestSearch) ([]*model.TimeOffRequest, error)) *MockTimeOffRequestManager_SearchTimeOffRequest_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTimeOffRequestState provides a mock function with given fields: ctx, read, from, in
+func (_m *MockTimeOffRequestManager) UpdateTimeOffRequestState(ctx context.Context, read *options.Read, from model.TimeOffRequestState, in *model.TimeOffRequest) error {
+	ret := _m.Called(ctx, read, from, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTimeOffRequestState")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, model.TimeOffRequestState, *model.TimeOffRequest) error); ok {
+		r0 = rf(ctx, read, from, in)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTimeOffRequestManager_UpdateTimeOffRequestState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTimeOffRequestState'
+type MockTimeOffRequestManager_UpdateTimeOffRequestState_Call struct {
+	*mock.Call
+}
+
+// UpdateTimeOffRequestState is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - from model.TimeOffRequestState
+//   - in *model.TimeOffRequest
+func (_e *MockTimeOffRequestManager_Expecter) UpdateTimeOffRequestState(ctx interface{}, read interface{}, from interface{}, in interface{}) *MockTimeOffRequestManager_UpdateTimeOffRequestState_Call {
+	return &MockTimeOffRequestManager_UpdateTimeOffRequestState_Call{Call: _e.mock.On("UpdateTimeOffRequestState", ctx, read, from, in)}
+}
+
+func (_c *MockTimeOffRequestManager_UpdateTimeOffRequestState_Call) Run(run func(ctx context.Context, read *options.Read, from model.TimeOffRequestState, in *model.TimeOffRequest)) *MockTimeOffRequestManager_UpdateTimeOffRequestState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(model.TimeOffRequestState), args[3].(*model.TimeOffRequest))
+	})
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_UpdateTimeOffRequestState_Call) Return(_a0 error) *MockTimeOffRequestManager_UpdateTimeOffRequestState_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTimeOffRequestManager_UpdateTimeOffRequestState_Call) RunAndReturn(run func(context.Context, *options.Read, model.TimeOffRequestState, *model.TimeOffRequest) error) *MockTimeOffRequestManager_UpdateTimeOffRequestState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTimeOffRequestManager creates a new instance of MockTimeOffRequestManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTimeOffRequestManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTimeOffRequestManager {
+	mock := &MockTimeOffRequestManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
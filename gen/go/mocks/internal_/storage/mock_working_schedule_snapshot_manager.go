@@ -0,0 +1,274 @@
+// Code generated by mockery v2.50.0. DO NOT EDIT.
+
+package storage
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+	model "github.com/webitel/webitel-wfm/internal/model"
+
+	options "github.com/webitel/webitel-wfm/internal/model/options"
+)
+
+// MockWorkingScheduleSnapshotManager is an autogenerated mock type for the WorkingScheduleSnapshotManager type
+type MockWorkingScheduleSnapshotManager struct {
+	mock.Mock
+}
+
+type MockWorkingScheduleSnapshotManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockWorkingScheduleSnapshotManager) EXPECT() *MockWorkingScheduleSnapshotManager_Expecter {
+	return &MockWorkingScheduleSnapshotManager_Expecter{mock: &_m.Mock}
+}
+
+// CreateWorkingScheduleSnapshot provides a mock function with given fields: ctx, read, note
+func (_m *MockWorkingScheduleSnapshotManager) CreateWorkingScheduleSnapshot(ctx context.Context, read *options.Read, note *string) (int64, error) {
+	ret := _m.Called(ctx, read, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWorkingScheduleSnapshot")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) (int64, error)); ok {
+		return rf(ctx, read, note)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read, *string) int64); ok {
+		r0 = rf(ctx, read, note)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read, *string) error); ok {
+		r1 = rf(ctx, read, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorkingScheduleSnapshot'
+type MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call struct {
+	*mock.Call
+}
+
+// CreateWorkingScheduleSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+//   - note *string
+func (_e *MockWorkingScheduleSnapshotManager_Expecter) CreateWorkingScheduleSnapshot(ctx interface{}, read interface{}, note interface{}) *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call {
+	return &MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call{Call: _e.mock.On("CreateWorkingScheduleSnapshot", ctx, read, note)}
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call) Run(run func(ctx context.Context, read *options.Read, note *string)) *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read), args[2].(*string))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call) Return(_a0 int64, _a1 error) *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call) RunAndReturn(run func(context.Context, *options.Read, *string) (int64, error)) *MockWorkingScheduleSnapshotManager_CreateWorkingScheduleSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReadWorkingScheduleSnapshot provides a mock function with given fields: ctx, read
+func (_m *MockWorkingScheduleSnapshotManager) ReadWorkingScheduleSnapshot(ctx context.Context, read *options.Read) (*model.WorkingScheduleSnapshot, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReadWorkingScheduleSnapshot")
+	}
+
+	var r0 *model.WorkingScheduleSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) (*model.WorkingScheduleSnapshot, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) *model.WorkingScheduleSnapshot); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.WorkingScheduleSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReadWorkingScheduleSnapshot'
+type MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call struct {
+	*mock.Call
+}
+
+// ReadWorkingScheduleSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockWorkingScheduleSnapshotManager_Expecter) ReadWorkingScheduleSnapshot(ctx interface{}, read interface{}) *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call {
+	return &MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call{Call: _e.mock.On("ReadWorkingScheduleSnapshot", ctx, read)}
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call) Run(run func(ctx context.Context, read *options.Read)) *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call) Return(_a0 *model.WorkingScheduleSnapshot, _a1 error) *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call) RunAndReturn(run func(context.Context, *options.Read) (*model.WorkingScheduleSnapshot, error)) *MockWorkingScheduleSnapshotManager_ReadWorkingScheduleSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchWorkingScheduleSnapshot provides a mock function with given fields: ctx, search
+func (_m *MockWorkingScheduleSnapshotManager) SearchWorkingScheduleSnapshot(ctx context.Context, search *options.Search) ([]*model.WorkingScheduleSnapshot, error) {
+	ret := _m.Called(ctx, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchWorkingScheduleSnapshot")
+	}
+
+	var r0 []*model.WorkingScheduleSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search) ([]*model.WorkingScheduleSnapshot, error)); ok {
+		return rf(ctx, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Search) []*model.WorkingScheduleSnapshot); ok {
+		r0 = rf(ctx, search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WorkingScheduleSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Search) error); ok {
+		r1 = rf(ctx, search)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchWorkingScheduleSnapshot'
+type MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call struct {
+	*mock.Call
+}
+
+// SearchWorkingScheduleSnapshot is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *options.Search
+func (_e *MockWorkingScheduleSnapshotManager_Expecter) SearchWorkingScheduleSnapshot(ctx interface{}, search interface{}) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call {
+	return &MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call{Call: _e.mock.On("SearchWorkingScheduleSnapshot", ctx, search)}
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call) Run(run func(ctx context.Context, search *options.Search)) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Search))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call) Return(_a0 []*model.WorkingScheduleSnapshot, _a1 error) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call) RunAndReturn(run func(context.Context, *options.Search) ([]*model.WorkingScheduleSnapshot, error)) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshot_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchWorkingScheduleSnapshotShifts provides a mock function with given fields: ctx, read
+func (_m *MockWorkingScheduleSnapshotManager) SearchWorkingScheduleSnapshotShifts(ctx context.Context, read *options.Read) ([]*model.AgentWorkingSchedule, error) {
+	ret := _m.Called(ctx, read)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchWorkingScheduleSnapshotShifts")
+	}
+
+	var r0 []*model.AgentWorkingSchedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) ([]*model.AgentWorkingSchedule, error)); ok {
+		return rf(ctx, read)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *options.Read) []*model.AgentWorkingSchedule); ok {
+		r0 = rf(ctx, read)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AgentWorkingSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *options.Read) error); ok {
+		r1 = rf(ctx, read)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshotShifts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchWorkingScheduleSnapshotShifts'
+type MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshotShifts_Call struct {
+	*mock.Call
+}
+
+// SearchWorkingScheduleSnapshotShifts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - read *options.Read
+func (_e *MockWorkingScheduleSnapshotManager_Expecter) SearchWorkingScheduleSnapshotShifts(ctx interface{}, read interface{}) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshotShifts_Call {
+	return &MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshotShifts_Call{Call: _e.mock.On("SearchWorkingScheduleSnapshotShifts", ctx, read)}
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshotShifts_Call) Run(run func(ctx context.Context, read *options.Read)) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshotShifts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*options.Read))
+	})
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshotShifts_Call) Return(_a0 []*model.AgentWorkingSchedule, _a1 error) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshotShifts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshotShifts_Call) RunAndReturn(run func(context.Context, *options.Read) ([]*model.AgentWorkingSchedule, error)) *MockWorkingScheduleSnapshotManager_SearchWorkingScheduleSnapshotShifts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockWorkingScheduleSnapshotManager creates a new instance of MockWorkingScheduleSnapshotManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockWorkingScheduleSnapshotManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockWorkingScheduleSnapshotManager {
+	mock := &MockWorkingScheduleSnapshotManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -10,10 +10,16 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
 	"github.com/webitel/webitel-wfm/infra/server/interceptor"
 	"github.com/webitel/webitel-wfm/infra/shutdown"
 )
 
+// PublicMethods are called without a session, they authorize requests on their own.
+var PublicMethods = []string{
+	pb.AgentCalendarService_SubscribeAgentCalendarFeed_FullMethodName,
+}
+
 type Server struct {
 	*grpc.Server
 }
@@ -31,7 +37,7 @@ func New(log *wlog.Logger, authcli auth_manager.AuthManager) (*Server, error) {
 			interceptor.ErrUnaryServerInterceptor(),
 			interceptor.RecoveryUnaryServerInterceptor(log),
 			interceptor.LoggingUnaryServerInterceptor(log),
-			interceptor.AuthUnaryServerInterceptor(authcli),
+			interceptor.AuthUnaryServerInterceptor(authcli, PublicMethods...),
 			interceptor.ValidateUnaryServerInterceptor(val),
 		),
 	)
@@ -3,6 +3,7 @@ package interceptor
 import (
 	"context"
 	"regexp"
+	"slices"
 	"strings"
 
 	"google.golang.org/grpc"
@@ -28,8 +29,13 @@ var (
 )
 
 // AuthUnaryServerInterceptor returns a server interceptor function to authenticate && authorize unary RPC.
-func AuthUnaryServerInterceptor(authcli auth_manager.AuthManager) grpc.UnaryServerInterceptor {
+// Public methods are called without a session, they authorize requests on their own.
+func AuthUnaryServerInterceptor(authcli auth_manager.AuthManager, public ...string) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if slices.Contains(public, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
 		token, err := tokenFromContext(ctx)
 		if err != nil {
 			return nil, werror.Wrap(ErrInvalidToken, werror.WithCause(err))
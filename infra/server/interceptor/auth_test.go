@@ -142,3 +142,18 @@ func TestAuthUnaryServerInterceptor(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthUnaryServerInterceptorPublic(t *testing.T) {
+	handler := func(context.Context, any) (any, error) {
+		return "good", nil
+	}
+
+	am := authmock.NewMockManager(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/FakeService/FakePublicMethod"}
+	out, err := AuthUnaryServerInterceptor(am, info.FullMethod)(context.Background(), nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "good", out)
+
+	_, err = AuthUnaryServerInterceptor(am, info.FullMethod)(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/FakeService/FakeMethod"}, handler)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
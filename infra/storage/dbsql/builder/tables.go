@@ -7,15 +7,31 @@ var (
 var (
 	PauseCauseTable = Table{name: "call_center.cc_pause_cause", alias: "cpc"}
 	AgentTable      = Table{name: "call_center.cc_agent", alias: "ca"}
+	TeamTable       = Table{name: "call_center.cc_team", alias: "ctm"}
 )
 
 var (
-	PauseTemplateTable         = Table{name: "wfm.pause_template", alias: "pt"}
-	PauseTemplateCauseTable    = Table{name: "wfm.pause_template_cause", alias: "ptc"}
-	ShiftTemplateTable         = Table{name: "wfm.shift_template", alias: "st"}
-	WorkingConditionTable      = Table{name: "wfm.working_condition", alias: "wc"}
-	AgentWorkingConditionTable = Table{name: "wfm.agent_working_conditions", alias: "awc"}
-	AgentAbsenceTable          = Table{name: "wfm.agent_absence", alias: "aa"}
+	CalendarTimezoneTable = Table{name: "flow.calendar_timezones", alias: "ct"}
+)
+
+var (
+	PauseTemplateTable                = Table{name: "wfm.pause_template", alias: "pt"}
+	PauseTemplateCauseTable           = Table{name: "wfm.pause_template_cause", alias: "ptc"}
+	ShiftTemplateTable                = Table{name: "wfm.shift_template", alias: "st"}
+	WorkingConditionTable             = Table{name: "wfm.working_condition", alias: "wc"}
+	AgentWorkingConditionTable        = Table{name: "wfm.agent_working_conditions", alias: "awc"}
+	AgentWorkingConditionHistoryTable = Table{name: "wfm.agent_working_conditions_history", alias: "awch"}
+	AbsenceTypeTable                  = Table{name: "wfm.absence_type", alias: "at"}
+	AgentAbsenceTable                 = Table{name: "wfm.agent_absence", alias: "aa"}
+	AgentTimeOffRequestTable          = Table{name: "wfm.agent_time_off_request", alias: "atr"}
+	AgentCalendarFeedTable            = Table{name: "wfm.agent_calendar_feed", alias: "acf"}
+	WorkingScheduleTable              = Table{name: "wfm.working_schedule", alias: "ws"}
+	AgentWorkingScheduleTable         = Table{name: "wfm.agent_working_schedule", alias: "aws"}
+	AgentShiftSwapTable               = Table{name: "wfm.agent_shift_swap", alias: "asw"}
+	AgentShiftSwapStateHistoryTable   = Table{name: "wfm.agent_shift_swap_state_history", alias: "asws"}
+	WorkingScheduleSnapshotTable      = Table{name: "wfm.working_schedule_snapshot", alias: "wss"}
+	RosterPatternTable                = Table{name: "wfm.roster_pattern", alias: "rp"}
+	RosterPatternAgentTable           = Table{name: "wfm.roster_pattern_agent", alias: "rpa"}
 )
 
 type Table struct {
@@ -34,9 +34,38 @@ func (a *AgentService) Agent(ctx context.Context, id int64) (int64, error) {
 }
 
 func (a *AgentService) Agents(ctx context.Context, search *model.AgentSearch) ([]int64, error) {
+	agents, err := a.cli.SearchAgent(ctx, searchAgentRequest(search, "id"))
+	if err != nil {
+		return nil, webitel.ParseError(err)
+	}
+
+	ids := make([]int64, 0, len(agents.Items))
+	for _, id := range agents.Items {
+		ids = append(ids, id.Id)
+	}
+
+	return ids, nil
+}
+
+// AgentLookups returns ids and names of agents, that match the search.
+func (a *AgentService) AgentLookups(ctx context.Context, search *model.AgentSearch) ([]*model.LookupItem, error) {
+	agents, err := a.cli.SearchAgent(ctx, searchAgentRequest(search, "id", "name"))
+	if err != nil {
+		return nil, webitel.ParseError(err)
+	}
+
+	out := make([]*model.LookupItem, 0, len(agents.Items))
+	for _, agent := range agents.Items {
+		out = append(out, &model.LookupItem{Id: agent.Id, Name: &agent.Name})
+	}
+
+	return out, nil
+}
+
+func searchAgentRequest(search *model.AgentSearch, fields ...string) *pb.SearchAgentRequest {
 	req := &pb.SearchAgentRequest{
 		Size:   -1,
-		Fields: []string{"id"},
+		Fields: fields,
 	}
 
 	if len(search.Ids) > 0 {
@@ -75,15 +104,5 @@ func (a *AgentService) Agents(ctx context.Context, search *model.AgentSearch) ([
 		req.SkillId = ids
 	}
 
-	agents, err := a.cli.SearchAgent(ctx, req)
-	if err != nil {
-		return nil, webitel.ParseError(err)
-	}
-
-	ids := make([]int64, 0, len(agents.Items))
-	for _, id := range agents.Items {
-		ids = append(ids, id.Id)
-	}
-
-	return ids, nil
+	return req
 }
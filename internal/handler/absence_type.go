@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/service"
+)
+
+type AbsenceType struct {
+	pb.UnimplementedAbsenceTypeServiceServer
+
+	service service.AbsenceTypeManager
+}
+
+func NewAbsenceType(sr grpc.ServiceRegistrar, service service.AbsenceTypeManager) *AbsenceType {
+	s := &AbsenceType{
+		service: service,
+	}
+
+	pb.RegisterAbsenceTypeServiceServer(sr, s)
+
+	return s
+}
+
+func (a *AbsenceType) CreateAbsenceType(ctx context.Context, req *pb.CreateAbsenceTypeRequest) (*pb.CreateAbsenceTypeResponse, error) {
+	read, err := options.NewRead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := a.service.CreateAbsenceType(ctx, read, unmarshalAbsenceTypeProto(req.GetItem()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAbsenceTypeResponse{Item: out.MarshalProto()}, nil
+}
+
+func (a *AbsenceType) ReadAbsenceType(ctx context.Context, req *pb.ReadAbsenceTypeRequest) (*pb.ReadAbsenceTypeResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()), options.WithFields(req.GetFields()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := a.service.ReadAbsenceType(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAbsenceTypeResponse{Item: out.MarshalProto()}, nil
+}
+
+func (a *AbsenceType) SearchAbsenceType(ctx context.Context, req *pb.SearchAbsenceTypeRequest) (*pb.SearchAbsenceTypeResponse, error) {
+	opts := []options.Option{
+		options.WithPagination(req.GetPage(), req.GetSize()),
+		options.WithSearch(req.GetQ()),
+		options.WithFields(req.GetFields()),
+		options.WithOrder(req.GetSort()),
+	}
+
+	search, err := options.NewSearch(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	items, next, err := a.service.SearchAbsenceType(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchAbsenceTypeResponse{Items: marshalAbsenceTypeBulkProto(items), Next: next}, nil
+}
+
+func (a *AbsenceType) UpdateAbsenceType(ctx context.Context, req *pb.UpdateAbsenceTypeRequest) (*pb.UpdateAbsenceTypeResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.Item.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := a.service.UpdateAbsenceType(ctx, read, unmarshalAbsenceTypeProto(req.GetItem()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateAbsenceTypeResponse{Item: out.MarshalProto()}, nil
+}
+
+func (a *AbsenceType) DeleteAbsenceType(ctx context.Context, req *pb.DeleteAbsenceTypeRequest) (*pb.DeleteAbsenceTypeResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := a.service.DeleteAbsenceType(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAbsenceTypeResponse{Id: id}, nil
+}
+
+func marshalAbsenceTypeBulkProto(items []*model.AbsenceType) []*pb.AbsenceTypeItem {
+	out := make([]*pb.AbsenceTypeItem, 0, len(items))
+	for _, t := range items {
+		out = append(out, t.MarshalProto())
+	}
+
+	return out
+}
+
+func unmarshalAbsenceTypeProto(item *pb.AbsenceTypeItem) *model.AbsenceType {
+	out := &model.AbsenceType{
+		DomainRecord:         model.DomainRecord{Id: item.Id},
+		Name:                 item.GetName(),
+		Description:          item.Description,
+		Color:                item.GetColor(),
+		Paid:                 item.GetPaid(),
+		CountsAgainstBalance: item.GetCountsAgainstBalance(),
+		Allowance:            model.AbsenceAllowance(item.GetAllowance()),
+		RequiresApproval:     item.GetRequiresApproval(),
+	}
+
+	if out.Color == "" {
+		out.Color = model.DefaultAbsenceTypeColor
+	}
+
+	return out
+}
@@ -33,12 +33,12 @@ func (a *AgentAbsence) CreateAgentAbsence(ctx context.Context, req *pb.CreateAge
 		return nil, err
 	}
 
-	out, err := a.service.CreateAgentAbsence(ctx, read, unmarshalAbsenceProto(req.GetItem()))
+	out, exceeded, err := a.service.CreateAgentAbsence(ctx, read, unmarshalAbsenceProto(req.GetItem()), req.GetAllowExceedBalance())
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.CreateAgentAbsenceResponse{Item: out.MarshalProto()}, nil
+	return &pb.CreateAgentAbsenceResponse{Item: out.MarshalProto(), BalanceExceeded: exceeded}, nil
 }
 
 func (a *AgentAbsence) ReadAgentAbsence(ctx context.Context, req *pb.ReadAgentAbsenceRequest) (*pb.ReadAgentAbsenceResponse, error) {
@@ -77,6 +77,20 @@ func (a *AgentAbsence) SearchAgentAbsence(ctx context.Context, req *pb.SearchAge
 	return &pb.SearchAgentAbsenceResponse{Items: marshalAbsenceBulkProto(out)}, nil
 }
 
+func (a *AgentAbsence) ReadAgentAbsenceBalance(ctx context.Context, req *pb.ReadAgentAbsenceBalanceRequest) (*pb.ReadAgentAbsenceBalanceResponse, error) {
+	read, err := options.NewRead(ctx, options.WithDerivedID("agent", req.GetAgentId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := a.service.ReadAgentAbsenceBalance(ctx, read, int(req.GetYear()), req.GetProrate())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAgentAbsenceBalanceResponse{Items: marshalAgentAbsenceBalanceBulkProto(out)}, nil
+}
+
 func (a *AgentAbsence) UpdateAgentAbsence(ctx context.Context, req *pb.UpdateAgentAbsenceRequest) (*pb.UpdateAgentAbsenceResponse, error) {
 	read, err := options.NewRead(ctx, options.WithID(req.GetItem().GetId()), options.WithDerivedID("agent", req.GetAgentId()))
 	if err != nil {
@@ -146,7 +160,9 @@ func unmarshalAbsenceProto(in *pb.Absence) *model.Absence {
 			Id: in.Id,
 		},
 		AbsentAt:    model.NewDate(in.AbsentAt),
-		AbsenceType: model.AgentAbsenceType(in.TypeId),
+		AbsenceType: model.LookupItem{Id: in.GetType().GetId()},
+		Start:       in.Start,
+		End:         in.End,
 	}
 }
 
@@ -158,7 +174,9 @@ func unmarshalAgentsAbsencesBulk(agentIDs []int64, in []*pb.CreateAgentsAbsences
 		for d := start; !d.Time.After(end.Time); d.Time = d.Time.AddDate(0, 0, 1) {
 			item := &model.Absence{
 				AbsentAt:    d,
-				AbsenceType: model.AgentAbsenceType(absence.TypeId),
+				AbsenceType: model.LookupItem{Id: absence.TypeId},
+				Start:       absence.Start,
+				End:         absence.End,
 			}
 
 			absences = append(absences, item)
@@ -195,3 +213,12 @@ func marshalAbsenceBulkProto(in []*model.Absence) []*pb.Absence {
 
 	return out
 }
+
+func marshalAgentAbsenceBalanceBulkProto(in []*model.AgentAbsenceBalance) []*pb.AgentAbsenceBalance {
+	out := make([]*pb.AgentAbsenceBalance, 0, len(in))
+	for _, t := range in {
+		out = append(out, t.MarshalProto())
+	}
+
+	return out
+}
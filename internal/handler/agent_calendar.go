@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+	"github.com/webitel/webitel-wfm/infra/server/grpccontext"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/service"
+)
+
+type AgentCalendar struct {
+	pb.UnimplementedAgentCalendarServiceServer
+
+	service service.AgentCalendarManager
+}
+
+func NewAgentCalendar(sr grpc.ServiceRegistrar, service service.AgentCalendarManager) *AgentCalendar {
+	s := &AgentCalendar{
+		service: service,
+	}
+
+	pb.RegisterAgentCalendarServiceServer(sr, s)
+
+	return s
+}
+
+func (a *AgentCalendar) ReadAgentCalendar(ctx context.Context, req *pb.ReadAgentCalendarRequest) (*httpbody.HttpBody, error) {
+	s := grpccontext.FromContext(ctx)
+	search := &model.AgentCalendarSearch{AgentId: req.AgentId}
+	if req.Date != nil {
+		search.Date = model.FilterBetween{
+			From: model.NewTimestamp(req.Date.From),
+			To:   model.NewTimestamp(req.Date.To),
+		}
+	}
+
+	out, err := a.service.ReadAgentCalendar(ctx, s.SignedInUser, search)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpbody.HttpBody{ContentType: out.ContentType, Data: out.Data}, nil
+}
+
+func (a *AgentCalendar) CreateAgentCalendarFeed(ctx context.Context, req *pb.CreateAgentCalendarFeedRequest) (*pb.CreateAgentCalendarFeedResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	out, err := a.service.CreateAgentCalendarFeed(ctx, s.SignedInUser, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateAgentCalendarFeedResponse{Item: out.MarshalProto()}, nil
+}
+
+func (a *AgentCalendar) ReadAgentCalendarFeed(ctx context.Context, req *pb.ReadAgentCalendarFeedRequest) (*pb.ReadAgentCalendarFeedResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	out, err := a.service.ReadAgentCalendarFeed(ctx, s.SignedInUser, req.AgentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadAgentCalendarFeedResponse{Item: out.MarshalProto()}, nil
+}
+
+func (a *AgentCalendar) DeleteAgentCalendarFeed(ctx context.Context, req *pb.DeleteAgentCalendarFeedRequest) (*pb.DeleteAgentCalendarFeedResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	if err := a.service.DeleteAgentCalendarFeed(ctx, s.SignedInUser, req.AgentId); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAgentCalendarFeedResponse{}, nil
+}
+
+func (a *AgentCalendar) ReadMySchedule(ctx context.Context, req *pb.ReadMyScheduleRequest) (*pb.ReadMyScheduleResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	var date model.FilterBetween
+	if req.Date != nil {
+		date = model.FilterBetween{
+			From: model.NewTimestamp(req.Date.From),
+			To:   model.NewTimestamp(req.Date.To),
+		}
+	}
+
+	out, err := a.service.ReadMySchedule(ctx, s.SignedInUser, date)
+	if err != nil {
+		return nil, err
+	}
+
+	shifts := make([]*pb.AgentCalendarShift, 0, len(out.Shifts))
+	for _, shift := range out.Shifts {
+		shifts = append(shifts, shift.MarshalProto())
+	}
+
+	absences := make([]*pb.Absence, 0, len(out.Absences))
+	for _, absence := range out.Absences {
+		absences = append(absences, absence.MarshalProto())
+	}
+
+	changes := make([]*pb.AgentScheduleChange, 0, len(out.Changes))
+	for _, change := range out.Changes {
+		changes = append(changes, change.MarshalProto())
+	}
+
+	return &pb.ReadMyScheduleResponse{
+		Agent:    out.Agent.MarshalProto(),
+		Timezone: out.Location().String(),
+		Shifts:   shifts,
+		Absences: absences,
+		Holidays: marshalAgentWorkingScheduleHolidayBulkProto(out.Holidays),
+		Changes:  changes,
+	}, nil
+}
+
+// SubscribeAgentCalendarFeed is called by calendar clients without a session, see server.PublicMethods.
+func (a *AgentCalendar) SubscribeAgentCalendarFeed(ctx context.Context, req *pb.SubscribeAgentCalendarFeedRequest) (*httpbody.HttpBody, error) {
+	out, err := a.service.SubscribeAgentCalendarFeed(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httpbody.HttpBody{ContentType: out.ContentType, Data: out.Data}, nil
+}
@@ -69,5 +69,12 @@ func unmarshalAgentWorkingConditionsProto(item *pb.AgentWorkingConditions) *mode
 		}
 	}
 
+	// Omitted timezone keeps the current override, timezone with an empty id resets it.
+	if item.Timezone != nil {
+		out.Timezone = &model.LookupItem{
+			Id: item.Timezone.Id,
+		}
+	}
+
 	return out
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/webitel/webitel-wfm/infra/server/grpccontext"
 	"github.com/webitel/webitel-wfm/internal/model"
 	"github.com/webitel/webitel-wfm/internal/service"
+	"github.com/webitel/webitel-wfm/pkg/spreadsheet"
 )
 
 type AgentWorkingSchedule struct {
@@ -34,9 +35,15 @@ func (a *AgentWorkingSchedule) CreateAgentsWorkingScheduleShifts(ctx context.Con
 		agents = append(agents, &model.LookupItem{Id: agent.Id})
 	}
 
-	shifts := make(map[int64]*model.AgentScheduleShift, len(req.Items))
+	shifts := make(map[int64][]*model.AgentScheduleShift, len(req.Items)+len(req.Segments))
 	for k, item := range req.Items {
-		shifts[k] = unmarshalAgentScheduleShift(item)
+		shifts[k] = []*model.AgentScheduleShift{unmarshalAgentScheduleShift(item)}
+	}
+
+	for k, segments := range req.Segments {
+		for _, item := range segments.Items {
+			shifts[k] = append(shifts[k], unmarshalAgentScheduleShift(item))
+		}
 	}
 
 	opts := &model.CreateAgentsWorkingScheduleShifts{
@@ -47,6 +54,23 @@ func (a *AgentWorkingSchedule) CreateAgentsWorkingScheduleShifts(ctx context.Con
 		},
 		Agents: agents,
 		Shifts: shifts,
+		Mode:   model.ShiftConflictMode(req.Mode),
+	}
+
+	if v := req.PlacePauses; v != nil {
+		opts.PlacePauses = unmarshalPausePlacement(v)
+	}
+
+	if req.ShiftTemplateId != nil {
+		opts.ShiftTemplateId = req.ShiftTemplateId
+	}
+
+	if v := req.AnchorDate; v != nil {
+		opts.AnchorDate = model.NewDate(*v)
+	}
+
+	if req.RosterPatternId != nil {
+		opts.RosterPatternId = req.RosterPatternId
 	}
 
 	out, err := a.service.CreateAgentsWorkingScheduleShifts(ctx, s.SignedInUser, opts)
@@ -54,7 +78,69 @@ func (a *AgentWorkingSchedule) CreateAgentsWorkingScheduleShifts(ctx context.Con
 		return nil, err
 	}
 
-	return &pb.CreateAgentsWorkingScheduleShiftsResponse{Items: marshalAgentWorkingScheduleBulkProto(out)}, nil
+	return &pb.CreateAgentsWorkingScheduleShiftsResponse{
+		Items:    marshalAgentWorkingScheduleBulkProto(out.Items),
+		Created:  marshalAgentScheduleDatesBulkProto(out.Created),
+		Replaced: marshalAgentScheduleDatesBulkProto(out.Replaced),
+		Skipped:  marshalAgentScheduleDatesBulkProto(out.Skipped),
+	}, nil
+}
+
+func (a *AgentWorkingSchedule) ImportAgentsWorkingScheduleShifts(ctx context.Context, req *pb.ImportAgentsWorkingScheduleShiftsRequest) (*pb.ImportAgentsWorkingScheduleShiftsResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	opts := &model.ImportAgentsWorkingScheduleShifts{
+		WorkingScheduleID: req.WorkingScheduleId,
+		File:              req.File,
+		Format:            spreadsheet.Format(req.Format),
+		DryRun:            req.DryRun,
+		Mode:              model.ShiftConflictMode(req.Mode),
+	}
+
+	out, err := a.service.ImportAgentsWorkingScheduleShifts(ctx, s.SignedInUser, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make([]*pb.ImportRowError, 0, len(out.Errors))
+	for _, e := range out.Errors {
+		errs = append(errs, e.MarshalProto())
+	}
+
+	return &pb.ImportAgentsWorkingScheduleShiftsResponse{
+		Errors:   errs,
+		Rows:     out.Rows,
+		Items:    marshalAgentWorkingScheduleBulkProto(out.Items),
+		Created:  marshalAgentScheduleDatesBulkProto(out.Created),
+		Replaced: marshalAgentScheduleDatesBulkProto(out.Replaced),
+		Skipped:  marshalAgentScheduleDatesBulkProto(out.Skipped),
+	}, nil
+}
+
+func (a *AgentWorkingSchedule) ExportAgentsWorkingSchedule(ctx context.Context, req *pb.ExportAgentsWorkingScheduleRequest) (*pb.ExportAgentsWorkingScheduleResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	opts := &model.ExportAgentsWorkingSchedule{
+		WorkingScheduleID: req.WorkingScheduleId,
+		Format:            spreadsheet.Format(req.Format),
+		Layout:            model.ScheduleExportLayout(req.Layout),
+	}
+
+	if req.Date != nil {
+		opts.Date = &model.FilterBetween{
+			From: model.NewTimestamp(req.Date.From),
+			To:   model.NewTimestamp(req.Date.To),
+		}
+	}
+
+	out, err := a.service.ExportAgentsWorkingSchedule(ctx, s.SignedInUser, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ExportAgentsWorkingScheduleResponse{
+		File:        out.Data,
+		FileName:    out.Name,
+		ContentType: out.ContentType,
+	}, nil
 }
 
 func (a *AgentWorkingSchedule) SearchAgentsWorkingSchedule(ctx context.Context, req *pb.SearchAgentsWorkingScheduleRequest) (*pb.SearchAgentsWorkingScheduleResponse, error) {
@@ -85,11 +171,43 @@ func (a *AgentWorkingSchedule) SearchAgentsWorkingSchedule(ctx context.Context,
 	}, nil
 }
 
+func (a *AgentWorkingSchedule) UpdateAgentWorkingScheduleShift(ctx context.Context, req *pb.UpdateAgentWorkingScheduleShiftRequest) (*pb.UpdateAgentWorkingScheduleShiftResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	out, err := a.service.UpdateAgentWorkingScheduleShift(ctx, s.SignedInUser, req.WorkingScheduleId, unmarshalAgentScheduleShift(req.Item))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateAgentWorkingScheduleShiftResponse{Item: out.MarshalProto()}, nil
+}
+
+func (a *AgentWorkingSchedule) DeleteAgentsWorkingScheduleShifts(ctx context.Context, req *pb.DeleteAgentsWorkingScheduleShiftsRequest) (*pb.DeleteAgentsWorkingScheduleShiftsResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	opts := &model.DeleteAgentsWorkingScheduleShifts{
+		WorkingScheduleID: req.WorkingScheduleId,
+		AgentIds:          req.AgentId,
+	}
+
+	if req.Date != nil {
+		opts.Date = model.FilterBetween{
+			From: model.NewTimestamp(req.Date.From),
+			To:   model.NewTimestamp(req.Date.To),
+		}
+	}
+
+	out, err := a.service.DeleteAgentsWorkingScheduleShifts(ctx, s.SignedInUser, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAgentsWorkingScheduleShiftsResponse{Ids: out}, nil
+}
+
 func unmarshalAgentScheduleShift(in *pb.AgentScheduleShift) *model.AgentScheduleShift {
 	pauses := make([]*model.AgentScheduleShiftPause, 0, len(in.Pauses))
 	for _, pause := range in.Pauses {
 		p := &model.AgentScheduleShiftPause{
-			DomainRecord: model.DomainRecord{Id: in.Id},
+			DomainRecord: model.DomainRecord{Id: pause.Id},
 			Start:        pause.Start,
 			End:          pause.End,
 		}
@@ -136,3 +254,26 @@ func marshalAgentWorkingScheduleHolidayBulkProto(in []*model.Holiday) []*pb.Holi
 
 	return out
 }
+
+func marshalAgentScheduleDatesBulkProto(in []*model.AgentScheduleDates) []*pb.AgentScheduleDates {
+	out := make([]*pb.AgentScheduleDates, 0, len(in))
+	for _, i := range in {
+		out = append(out, i.MarshalProto())
+	}
+
+	return out
+}
+
+func unmarshalPausePlacement(in *pb.PausePlacement) *model.PausePlacement {
+	if in == nil {
+		return nil
+	}
+
+	return &model.PausePlacement{
+		PauseTemplateId: in.PauseTemplateId,
+		MinStartOffset:  in.MinStartOffset,
+		MaxStartOffset:  in.MaxStartOffset,
+		MinEndOffset:    in.MinEndOffset,
+		MaxEndOffset:    in.MaxEndOffset,
+	}
+}
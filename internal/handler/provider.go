@@ -3,17 +3,24 @@ package handler
 import "github.com/google/wire"
 
 var Set = wire.NewSet(NewPauseTemplate, NewShiftTemplate, NewWorkingCondition, NewAgentWorkingConditions,
-	NewAgentAbsence, NewForecastCalculation, NewWorkingSchedule, NewAgentWorkingSchedule,
+	NewAgentAbsence, NewForecastCalculation, NewWorkingSchedule, NewAgentWorkingSchedule, NewTimeOffRequest,
+	NewAbsenceType, NewAgentCalendar, NewShiftSwap, NewWorkingScheduleSnapshot, NewRosterPattern,
 )
 
 // Handlers needed for google/wire to build body of generated function.
 type Handlers struct {
-	PauseTemplate          *PauseTemplate
-	ShiftTemplate          *ShiftTemplate
-	WorkingCondition       *WorkingCondition
-	AgentWorkingConditions *AgentWorkingConditions
-	AgentAbsence           *AgentAbsence
-	ForecastCalculation    *ForecastCalculation
-	WorkingSchedule        *WorkingSchedule
-	AgentWorkingSchedule   *AgentWorkingSchedule
+	PauseTemplate           *PauseTemplate
+	ShiftTemplate           *ShiftTemplate
+	WorkingCondition        *WorkingCondition
+	AgentWorkingConditions  *AgentWorkingConditions
+	AgentAbsence            *AgentAbsence
+	ForecastCalculation     *ForecastCalculation
+	WorkingSchedule         *WorkingSchedule
+	AgentWorkingSchedule    *AgentWorkingSchedule
+	TimeOffRequest          *TimeOffRequest
+	AbsenceType             *AbsenceType
+	AgentCalendar           *AgentCalendar
+	ShiftSwap               *ShiftSwap
+	WorkingScheduleSnapshot *WorkingScheduleSnapshot
+	RosterPattern           *RosterPattern
 }
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/service"
+)
+
+type RosterPattern struct {
+	pb.UnimplementedRosterPatternServiceServer
+
+	service service.RosterPatternManager
+}
+
+func NewRosterPattern(sr grpc.ServiceRegistrar, service service.RosterPatternManager) *RosterPattern {
+	s := &RosterPattern{
+		service: service,
+	}
+
+	pb.RegisterRosterPatternServiceServer(sr, s)
+
+	return s
+}
+
+func (h *RosterPattern) CreateRosterPattern(ctx context.Context, req *pb.CreateRosterPatternRequest) (*pb.CreateRosterPatternResponse, error) {
+	read, err := options.NewRead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.CreateRosterPattern(ctx, read, unmarshalRosterPatternProto(req.GetItem()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateRosterPatternResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *RosterPattern) ReadRosterPattern(ctx context.Context, req *pb.ReadRosterPatternRequest) (*pb.ReadRosterPatternResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()), options.WithFields(req.GetFields()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.ReadRosterPattern(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadRosterPatternResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *RosterPattern) SearchRosterPattern(ctx context.Context, req *pb.SearchRosterPatternRequest) (*pb.SearchRosterPatternResponse, error) {
+	opts := []options.Option{
+		options.WithPagination(req.GetPage(), req.GetSize()),
+		options.WithSearch(req.GetQ()),
+		options.WithFields(req.GetFields()),
+		options.WithOrder(req.GetSort()),
+		options.WithDerivedID("team", req.GetTeamId()),
+		options.WithDerivedID("agent", req.GetAgentId()),
+	}
+
+	search, err := options.NewSearch(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	items, next, err := h.service.SearchRosterPattern(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchRosterPatternResponse{Items: marshalRosterPatternBulkProto(items), Next: next}, nil
+}
+
+func (h *RosterPattern) UpdateRosterPattern(ctx context.Context, req *pb.UpdateRosterPatternRequest) (*pb.UpdateRosterPatternResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.Item.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.UpdateRosterPattern(ctx, read, unmarshalRosterPatternProto(req.GetItem()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateRosterPatternResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *RosterPattern) DeleteRosterPattern(ctx context.Context, req *pb.DeleteRosterPatternRequest) (*pb.DeleteRosterPatternResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := h.service.DeleteRosterPattern(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteRosterPatternResponse{Id: id}, nil
+}
+
+func marshalRosterPatternBulkProto(patterns []*model.RosterPattern) []*pb.RosterPattern {
+	out := make([]*pb.RosterPattern, 0, len(patterns))
+	for _, p := range patterns {
+		out = append(out, p.MarshalProto())
+	}
+
+	return out
+}
+
+func unmarshalRosterPatternProto(in *pb.RosterPattern) *model.RosterPattern {
+	agents := make([]*model.LookupItem, 0, len(in.Agents))
+	for _, a := range in.Agents {
+		agents = append(agents, &model.LookupItem{Id: a.Id})
+	}
+
+	shifts := make(map[int64][]*model.RosterPatternShift, len(in.Shifts))
+	for day, segments := range in.Shifts {
+		for _, s := range segments.Items {
+			shifts[day] = append(shifts[day], unmarshalRosterPatternShiftProto(s))
+		}
+	}
+
+	out := &model.RosterPattern{
+		DomainRecord: model.DomainRecord{Id: in.Id},
+		Name:         in.GetName(),
+		Description:  in.Description,
+		Agents:       agents,
+		Shifts:       shifts,
+	}
+
+	if in.Team != nil {
+		out.Team = &model.LookupItem{Id: in.Team.Id}
+	}
+
+	return out
+}
+
+func unmarshalRosterPatternShiftProto(in *pb.AgentScheduleShift) *model.RosterPatternShift {
+	pauses := make([]*model.RosterPatternPause, 0, len(in.Pauses))
+	for _, pause := range in.Pauses {
+		p := &model.RosterPatternPause{
+			Start: pause.Start,
+			End:   pause.End,
+		}
+
+		if pause.Cause != nil {
+			p.Cause = &model.LookupItem{Id: pause.Cause.Id}
+		}
+
+		pauses = append(pauses, p)
+	}
+
+	skills := make([]*model.AgentScheduleShiftSkill, 0, len(in.Skills))
+	for _, skill := range in.Skills {
+		skills = append(skills, &model.AgentScheduleShiftSkill{
+			Skill:    model.LookupItem{Id: skill.Skill.Id},
+			Capacity: skill.Capacity,
+			Enabled:  skill.Enabled,
+		})
+	}
+
+	return &model.RosterPatternShift{
+		Start:  in.Start,
+		End:    in.End,
+		Pauses: pauses,
+		Skills: skills,
+	}
+}
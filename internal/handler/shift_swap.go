@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/service"
+)
+
+type ShiftSwap struct {
+	pb.UnimplementedShiftSwapServiceServer
+
+	service service.ShiftSwapManager
+}
+
+func NewShiftSwap(sr grpc.ServiceRegistrar, service service.ShiftSwapManager) *ShiftSwap {
+	s := &ShiftSwap{
+		service: service,
+	}
+
+	pb.RegisterShiftSwapServiceServer(sr, s)
+
+	return s
+}
+
+func (h *ShiftSwap) CreateShiftSwap(ctx context.Context, req *pb.CreateShiftSwapRequest) (*pb.CreateShiftSwapResponse, error) {
+	read, err := options.NewRead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.CreateShiftSwap(ctx, read, unmarshalShiftSwapProto(req.GetItem()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateShiftSwapResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *ShiftSwap) ReadShiftSwap(ctx context.Context, req *pb.ReadShiftSwapRequest) (*pb.ReadShiftSwapResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.ReadShiftSwap(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadShiftSwapResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *ShiftSwap) SearchShiftSwap(ctx context.Context, req *pb.SearchShiftSwapRequest) (*pb.SearchShiftSwapResponse, error) {
+	opts := []options.Option{
+		options.WithPagination(req.GetPage(), req.GetSize()),
+		options.WithSearch(req.GetQ()),
+		options.WithFields(req.GetFields()),
+		options.WithOrder(req.GetSort()),
+	}
+
+	search, err := options.NewSearch(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &model.ShiftSwapSearch{
+		WorkingScheduleIds: req.GetWorkingScheduleId(),
+		AgentIds:           req.GetAgentId(),
+	}
+
+	for _, state := range req.GetState() {
+		filter.States = append(filter.States, model.ShiftSwapState(state))
+	}
+
+	items, next, err := h.service.SearchShiftSwap(ctx, search, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchShiftSwapResponse{Items: marshalShiftSwapBulkProto(items), Next: next}, nil
+}
+
+func (h *ShiftSwap) AcceptShiftSwap(ctx context.Context, req *pb.AcceptShiftSwapRequest) (*pb.AcceptShiftSwapResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.AcceptShiftSwap(ctx, read, req.ShiftId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AcceptShiftSwapResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *ShiftSwap) ValidateShiftSwap(ctx context.Context, req *pb.ValidateShiftSwapRequest) (*pb.ValidateShiftSwapResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := h.service.ValidateShiftSwap(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	valid := true
+	for _, i := range items {
+		if i.Severity == model.ViolationSeverityError {
+			valid = false
+
+			break
+		}
+	}
+
+	return &pb.ValidateShiftSwapResponse{
+		Items: marshalWorkingScheduleViolationBulkProto(items),
+		Valid: valid,
+	}, nil
+}
+
+func (h *ShiftSwap) ApproveShiftSwap(ctx context.Context, req *pb.ApproveShiftSwapRequest) (*pb.ApproveShiftSwapResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.ApproveShiftSwap(ctx, read, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApproveShiftSwapResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *ShiftSwap) RejectShiftSwap(ctx context.Context, req *pb.RejectShiftSwapRequest) (*pb.RejectShiftSwapResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.RejectShiftSwap(ctx, read, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RejectShiftSwapResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *ShiftSwap) CancelShiftSwap(ctx context.Context, req *pb.CancelShiftSwapRequest) (*pb.CancelShiftSwapResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.CancelShiftSwap(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelShiftSwapResponse{Item: out.MarshalProto()}, nil
+}
+
+func unmarshalShiftSwapProto(in *pb.ShiftSwap) *model.ShiftSwap {
+	out := &model.ShiftSwap{
+		DomainRecord: model.DomainRecord{
+			Id: in.Id,
+		},
+		Shift: model.ShiftSwapShift{Id: in.GetShift().GetId()},
+		Note:  in.Note,
+	}
+
+	if in.TargetAgent != nil {
+		out.TargetAgent = &model.LookupItem{Id: in.TargetAgent.Id}
+	}
+
+	return out
+}
+
+func marshalShiftSwapBulkProto(in []*model.ShiftSwap) []*pb.ShiftSwap {
+	out := make([]*pb.ShiftSwap, 0, len(in))
+	for _, s := range in {
+		out = append(out, s.MarshalProto())
+	}
+
+	return out
+}
@@ -119,11 +119,23 @@ func unmarshalShiftTemplateProto(in *pb.ShiftTemplate) *model.ShiftTemplate {
 		times = append(times, unmarshalShiftTemplateTimeProto(t))
 	}
 
+	rotation := make([]model.ShiftTemplateRotationDay, 0, len(in.Rotation))
+	for _, r := range in.Rotation {
+		var day model.ShiftTemplateRotationDay
+		if r.Time != nil {
+			t := unmarshalShiftTemplateTimeProto(r.Time)
+			day.Time = &t
+		}
+
+		rotation = append(rotation, day)
+	}
+
 	return &model.ShiftTemplate{
 		DomainRecord: model.DomainRecord{Id: in.Id},
 		Name:         in.GetName(),
 		Description:  in.Description,
 		Times:        times,
+		Rotation:     rotation,
 	}
 }
 
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/service"
+)
+
+type TimeOffRequest struct {
+	pb.UnimplementedTimeOffRequestServiceServer
+
+	service service.TimeOffRequestManager
+}
+
+func NewTimeOffRequest(sr grpc.ServiceRegistrar, service service.TimeOffRequestManager) *TimeOffRequest {
+	s := &TimeOffRequest{
+		service: service,
+	}
+
+	pb.RegisterTimeOffRequestServiceServer(sr, s)
+
+	return s
+}
+
+func (h *TimeOffRequest) CreateTimeOffRequest(ctx context.Context, req *pb.CreateTimeOffRequestRequest) (*pb.CreateTimeOffRequestResponse, error) {
+	read, err := options.NewRead(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.CreateTimeOffRequest(ctx, read, unmarshalTimeOffRequestProto(req.GetItem()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateTimeOffRequestResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *TimeOffRequest) ReadTimeOffRequest(ctx context.Context, req *pb.ReadTimeOffRequestRequest) (*pb.ReadTimeOffRequestResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.ReadTimeOffRequest(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadTimeOffRequestResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *TimeOffRequest) SearchTimeOffRequest(ctx context.Context, req *pb.SearchTimeOffRequestRequest) (*pb.SearchTimeOffRequestResponse, error) {
+	opts := []options.Option{
+		options.WithPagination(req.GetPage(), req.GetSize()),
+		options.WithSearch(req.GetQ()),
+		options.WithFields(req.GetFields()),
+		options.WithOrder(req.GetSort()),
+	}
+
+	search, err := options.NewSearch(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &model.TimeOffRequestSearch{
+		AgentIds:      req.GetAgentId(),
+		TeamIds:       req.GetTeamId(),
+		SupervisorIds: req.GetSupervisorId(),
+	}
+
+	for _, state := range req.GetState() {
+		filter.States = append(filter.States, model.TimeOffRequestState(state))
+	}
+
+	items, next, err := h.service.SearchTimeOffRequest(ctx, search, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchTimeOffRequestResponse{Items: marshalTimeOffRequestBulkProto(items), Next: next}, nil
+}
+
+func (h *TimeOffRequest) ApproveTimeOffRequest(ctx context.Context, req *pb.ApproveTimeOffRequestRequest) (*pb.ApproveTimeOffRequestResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.ApproveTimeOffRequest(ctx, read, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApproveTimeOffRequestResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *TimeOffRequest) RejectTimeOffRequest(ctx context.Context, req *pb.RejectTimeOffRequestRequest) (*pb.RejectTimeOffRequestResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.RejectTimeOffRequest(ctx, read, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RejectTimeOffRequestResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *TimeOffRequest) CancelTimeOffRequest(ctx context.Context, req *pb.CancelTimeOffRequestRequest) (*pb.CancelTimeOffRequestResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.CancelTimeOffRequest(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelTimeOffRequestResponse{Item: out.MarshalProto()}, nil
+}
+
+func unmarshalTimeOffRequestProto(in *pb.TimeOffRequest) *model.TimeOffRequest {
+	return &model.TimeOffRequest{
+		DomainRecord: model.DomainRecord{
+			Id: in.Id,
+		},
+		AbsenceType: model.LookupItem{Id: in.GetType().GetId()},
+		DateFrom:    model.NewDate(in.DateFrom),
+		DateTo:      model.NewDate(in.DateTo),
+		Note:        in.Note,
+	}
+}
+
+func marshalTimeOffRequestBulkProto(in []*model.TimeOffRequest) []*pb.TimeOffRequest {
+	out := make([]*pb.TimeOffRequest, 0, len(in))
+	for _, t := range in {
+		out = append(out, t.MarshalProto())
+	}
+
+	return out
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -67,18 +68,46 @@ func (w *WorkingSchedule) ReadWorkingScheduleForecast(ctx context.Context, req *
 	for _, i := range items {
 		day := timeutils.Date(i.Timestamp.Time).Unix()
 		if _, ok := out[day]; !ok {
-			out[day].Forecast = make([]*pb.WorkingScheduleForecast_Forecast, 0)
+			out[day] = &pb.WorkingScheduleForecast{Forecast: make([]*pb.WorkingScheduleForecast_Forecast, 0)}
+		}
+
+		var agents int64
+		if i.Agents != nil {
+			agents = *i.Agents
 		}
 
 		out[day].Forecast = append(out[day].Forecast, &pb.WorkingScheduleForecast_Forecast{
 			Hour:   int64(i.Timestamp.Time.Hour()),
-			Agents: *i.Agents,
+			Agents: agents,
 		})
 	}
 
 	return &pb.ReadWorkingScheduleForecastResponse{Items: out}, nil
 }
 
+func (w *WorkingSchedule) ReadWorkingScheduleCoverage(ctx context.Context, req *pb.ReadWorkingScheduleCoverageRequest) (*pb.ReadWorkingScheduleCoverageResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	date := &model.FilterBetween{}
+	if v := req.Date; v != nil {
+		date = &model.FilterBetween{
+			From: model.NewTimestamp(v.From),
+			To:   model.NewTimestamp(v.To),
+		}
+	}
+
+	granularity := time.Hour
+	if req.Granularity != nil {
+		granularity = time.Duration(req.GetGranularity()) * time.Minute
+	}
+
+	items, err := w.service.ReadWorkingScheduleCoverage(ctx, s.SignedInUser, req.Id, date, granularity, req.SkillId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadWorkingScheduleCoverageResponse{Items: marshalWorkingScheduleStaffingBulkProto(items)}, nil
+}
+
 func (w *WorkingSchedule) SearchWorkingSchedule(ctx context.Context, req *pb.SearchWorkingScheduleRequest) (*pb.SearchWorkingScheduleResponse, error) {
 	s := grpccontext.FromContext(ctx)
 	search := &model.SearchItem{
@@ -147,6 +176,129 @@ func (w *WorkingSchedule) UpdateWorkingScheduleRemoveAgents(ctx context.Context,
 	return &pb.UpdateWorkingScheduleRemoveAgentResponse{Id: out}, nil
 }
 
+func (w *WorkingSchedule) SubmitWorkingSchedule(ctx context.Context, req *pb.SubmitWorkingScheduleRequest) (*pb.SubmitWorkingScheduleResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	out, err := w.service.SubmitWorkingSchedule(ctx, s.SignedInUser, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitWorkingScheduleResponse{Item: out.MarshalProto()}, nil
+}
+
+func (w *WorkingSchedule) ApproveWorkingSchedule(ctx context.Context, req *pb.ApproveWorkingScheduleRequest) (*pb.ApproveWorkingScheduleResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	out, err := w.service.ApproveWorkingSchedule(ctx, s.SignedInUser, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApproveWorkingScheduleResponse{Item: out.MarshalProto()}, nil
+}
+
+func (w *WorkingSchedule) RejectWorkingSchedule(ctx context.Context, req *pb.RejectWorkingScheduleRequest) (*pb.RejectWorkingScheduleResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	out, err := w.service.RejectWorkingSchedule(ctx, s.SignedInUser, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RejectWorkingScheduleResponse{Item: out.MarshalProto()}, nil
+}
+
+func (w *WorkingSchedule) ArchiveWorkingSchedule(ctx context.Context, req *pb.ArchiveWorkingScheduleRequest) (*pb.ArchiveWorkingScheduleResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	out, err := w.service.ArchiveWorkingSchedule(ctx, s.SignedInUser, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ArchiveWorkingScheduleResponse{Item: out.MarshalProto()}, nil
+}
+
+func (w *WorkingSchedule) GenerateWorkingSchedule(ctx context.Context, req *pb.GenerateWorkingScheduleRequest) (*pb.GenerateWorkingScheduleResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	date := &model.FilterBetween{}
+	if v := req.Date; v != nil {
+		date = &model.FilterBetween{
+			From: model.NewTimestamp(v.From),
+			To:   model.NewTimestamp(v.To),
+		}
+	}
+
+	items, staffing, err := w.service.GenerateWorkingSchedule(ctx, s.SignedInUser, req.Id, date, req.ShiftTemplateId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GenerateWorkingScheduleResponse{
+		Items:    marshalAgentWorkingScheduleBulkProto(items),
+		Staffing: marshalWorkingScheduleStaffingBulkProto(staffing),
+	}, nil
+}
+
+func (w *WorkingSchedule) CloneWorkingSchedule(ctx context.Context, req *pb.CloneWorkingScheduleRequest) (*pb.CloneWorkingScheduleResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	in := &model.CloneWorkingSchedule{
+		Id:          req.Id,
+		Name:        req.Name,
+		StartDateAt: model.NewDate(req.StartDateAt),
+		EndDateAt:   model.NewDate(req.EndDateAt),
+		Shifts:      model.WorkingScheduleCloneShifts(req.Shifts),
+		Weeks:       int(req.GetWeeks()),
+	}
+
+	out, err := w.service.CloneWorkingSchedule(ctx, s.SignedInUser, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CloneWorkingScheduleResponse{Item: out.MarshalProto()}, nil
+}
+
+func (w *WorkingSchedule) PlaceWorkingSchedulePauses(ctx context.Context, req *pb.PlaceWorkingSchedulePausesRequest) (*pb.PlaceWorkingSchedulePausesResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	date := &model.FilterBetween{}
+	if v := req.Date; v != nil {
+		date = &model.FilterBetween{
+			From: model.NewTimestamp(v.From),
+			To:   model.NewTimestamp(v.To),
+		}
+	}
+
+	items, staffing, err := w.service.PlaceWorkingSchedulePauses(ctx, s.SignedInUser, req.Id, date, req.AgentIds, unmarshalPausePlacement(req.Placement), req.Overwrite)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PlaceWorkingSchedulePausesResponse{
+		Items:    marshalAgentWorkingScheduleBulkProto(items),
+		Staffing: marshalWorkingScheduleStaffingBulkProto(staffing),
+	}, nil
+}
+
+func (w *WorkingSchedule) ValidateWorkingSchedule(ctx context.Context, req *pb.ValidateWorkingScheduleRequest) (*pb.ValidateWorkingScheduleResponse, error) {
+	s := grpccontext.FromContext(ctx)
+	items, err := w.service.ValidateWorkingSchedule(ctx, s.SignedInUser, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	valid := true
+	for _, i := range items {
+		if i.Severity == model.ViolationSeverityError {
+			valid = false
+
+			break
+		}
+	}
+
+	return &pb.ValidateWorkingScheduleResponse{
+		Items: marshalWorkingScheduleViolationBulkProto(items),
+		Valid: valid,
+	}, nil
+}
+
 func unmarshalWorkingScheduleProto(in *pb.WorkingSchedule) *model.WorkingSchedule {
 	skills := make([]*model.LookupItem, 0, len(in.ExtraSkills))
 	for _, skill := range in.ExtraSkills {
@@ -161,7 +313,7 @@ func unmarshalWorkingScheduleProto(in *pb.WorkingSchedule) *model.WorkingSchedul
 	return &model.WorkingSchedule{
 		DomainRecord:         model.DomainRecord{Id: in.Id},
 		Name:                 in.Name,
-		State:                model.WorkingScheduleState(in.State.Number()),
+		State:                model.UnmarshalWorkingScheduleStateProto(in.State),
 		Team:                 model.LookupItem{Id: in.Team.Id},
 		Calendar:             model.LookupItem{Id: in.Calendar.Id},
 		StartDateAt:          model.NewDate(in.StartDateAt),
@@ -182,3 +334,21 @@ func marshalWorkingScheduleBulkProto(in []*model.WorkingSchedule) []*pb.WorkingS
 
 	return out
 }
+
+func marshalWorkingScheduleStaffingBulkProto(in []*model.WorkingScheduleStaffing) []*pb.WorkingScheduleStaffing {
+	out := make([]*pb.WorkingScheduleStaffing, 0, len(in))
+	for _, i := range in {
+		out = append(out, i.MarshalProto())
+	}
+
+	return out
+}
+
+func marshalWorkingScheduleViolationBulkProto(in []*model.WorkingScheduleViolation) []*pb.WorkingScheduleViolation {
+	out := make([]*pb.WorkingScheduleViolation, 0, len(in))
+	for _, i := range in {
+		out = append(out, i.MarshalProto())
+	}
+
+	return out
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/service"
+)
+
+type WorkingScheduleSnapshot struct {
+	pb.UnimplementedWorkingScheduleSnapshotServiceServer
+
+	service service.WorkingScheduleSnapshotManager
+}
+
+func NewWorkingScheduleSnapshot(sr grpc.ServiceRegistrar, service service.WorkingScheduleSnapshotManager) *WorkingScheduleSnapshot {
+	s := &WorkingScheduleSnapshot{
+		service: service,
+	}
+
+	pb.RegisterWorkingScheduleSnapshotServiceServer(sr, s)
+
+	return s
+}
+
+func (h *WorkingScheduleSnapshot) CreateWorkingScheduleSnapshot(ctx context.Context, req *pb.CreateWorkingScheduleSnapshotRequest) (*pb.CreateWorkingScheduleSnapshotResponse, error) {
+	read, err := options.NewRead(ctx, options.WithDerivedID("working_schedule", req.GetWorkingScheduleId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.CreateWorkingScheduleSnapshot(ctx, read, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateWorkingScheduleSnapshotResponse{Item: out.MarshalProto()}, nil
+}
+
+func (h *WorkingScheduleSnapshot) ReadWorkingScheduleSnapshot(ctx context.Context, req *pb.ReadWorkingScheduleSnapshotRequest) (*pb.ReadWorkingScheduleSnapshotResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()), options.WithDerivedID("working_schedule", req.GetWorkingScheduleId()))
+	if err != nil {
+		return nil, err
+	}
+
+	out, shifts, err := h.service.ReadWorkingScheduleSnapshot(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReadWorkingScheduleSnapshotResponse{Item: out.MarshalProto(), Shifts: marshalAgentWorkingScheduleBulkProto(shifts)}, nil
+}
+
+func (h *WorkingScheduleSnapshot) SearchWorkingScheduleSnapshot(ctx context.Context, req *pb.SearchWorkingScheduleSnapshotRequest) (*pb.SearchWorkingScheduleSnapshotResponse, error) {
+	opts := []options.Option{
+		options.WithDerivedID("working_schedule", req.GetWorkingScheduleId()),
+		options.WithPagination(req.GetPage(), req.GetSize()),
+		options.WithFields(req.GetFields()),
+		options.WithOrder(req.GetSort()),
+	}
+
+	search, err := options.NewSearch(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	items, next, err := h.service.SearchWorkingScheduleSnapshot(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchWorkingScheduleSnapshotResponse{Items: marshalWorkingScheduleSnapshotBulkProto(items), Next: next}, nil
+}
+
+func (h *WorkingScheduleSnapshot) DiffWorkingScheduleSnapshot(ctx context.Context, req *pb.DiffWorkingScheduleSnapshotRequest) (*pb.DiffWorkingScheduleSnapshotResponse, error) {
+	read, err := options.NewRead(ctx, options.WithID(req.GetId()), options.WithDerivedID("working_schedule", req.GetWorkingScheduleId()))
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := h.service.DiffWorkingScheduleSnapshot(ctx, read, req.ToId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DiffWorkingScheduleSnapshotResponse{Items: marshalWorkingScheduleAgentDiffBulkProto(items)}, nil
+}
+
+func marshalWorkingScheduleSnapshotBulkProto(in []*model.WorkingScheduleSnapshot) []*pb.WorkingScheduleSnapshot {
+	out := make([]*pb.WorkingScheduleSnapshot, 0, len(in))
+	for _, s := range in {
+		out = append(out, s.MarshalProto())
+	}
+
+	return out
+}
+
+func marshalWorkingScheduleAgentDiffBulkProto(in []*model.WorkingScheduleAgentDiff) []*pb.WorkingScheduleAgentDiff {
+	out := make([]*pb.WorkingScheduleAgentDiff, 0, len(in))
+	for _, d := range in {
+		out = append(out, d.MarshalProto())
+	}
+
+	return out
+}
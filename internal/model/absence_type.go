@@ -0,0 +1,64 @@
+package model
+
+import pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+
+// DefaultAbsenceTypeColor is used for absence types created without a colour.
+const DefaultAbsenceTypeColor = "#9e9e9e"
+
+type AbsenceAllowance int32
+
+const (
+	AbsenceAllowanceUnspecified AbsenceAllowance = iota
+	AbsenceAllowanceVacation
+	AbsenceAllowanceSickLeaves
+)
+
+func (s AbsenceAllowance) String() string {
+	return []string{"unspecified", "vacation", "sick_leaves"}[s]
+}
+
+// AbsenceType is a domain-defined type of agent absence.
+type AbsenceType struct {
+	DomainRecord
+
+	Name                 string           `json:"name" db:"name"`
+	Description          *string          `json:"description" db:"description"`
+	Color                string           `json:"color" db:"color"`
+	Paid                 bool             `json:"paid" db:"paid"`
+	CountsAgainstBalance bool             `json:"counts_against_balance" db:"counts_against_balance"`
+	Allowance            AbsenceAllowance `json:"allowance" db:"allowance"`
+	RequiresApproval     bool             `json:"requires_approval" db:"requires_approval"`
+}
+
+func (a *AbsenceType) Lookup() *LookupItem {
+	return &LookupItem{
+		Id:   a.Id,
+		Name: &a.Name,
+	}
+}
+
+func (a *AbsenceType) MarshalProto() *pb.AbsenceTypeItem {
+	out := &pb.AbsenceTypeItem{
+		Id:                   a.Id,
+		DomainId:             a.DomainId,
+		CreatedBy:            a.CreatedBy.MarshalProto(),
+		UpdatedBy:            a.UpdatedBy.MarshalProto(),
+		Name:                 a.Name,
+		Description:          a.Description,
+		Color:                a.Color,
+		Paid:                 a.Paid,
+		CountsAgainstBalance: a.CountsAgainstBalance,
+		Allowance:            pb.AbsenceAllowance(a.Allowance),
+		RequiresApproval:     a.RequiresApproval,
+	}
+
+	if !a.CreatedAt.Time.IsZero() {
+		out.CreatedAt = a.CreatedAt.Time.UnixMilli()
+	}
+
+	if !a.UpdatedAt.Time.IsZero() {
+		out.UpdatedAt = a.UpdatedAt.Time.UnixMilli()
+	}
+
+	return out
+}
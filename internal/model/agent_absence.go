@@ -6,36 +6,38 @@ import (
 	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
 )
 
-type AgentAbsenceType int32
-
-const (
-	AgentAbsenceTypeUnspecified AgentAbsenceType = iota
-	AgentAbsenceTypeDayOff
-	AgentAbsenceTypeVacation
-	AgentAbsenceTypeSickDay
-)
+// Absence is a whole-day absence of the agent,
+// or a partial-day one, if start and end minutes are set.
+type Absence struct {
+	DomainRecord
 
-func (s AgentAbsenceType) String() string {
-	return []string{"unspecified", "dayoff", "vacation", "sickday"}[s]
+	AbsentAt    pgtype.Date `json:"absent_at" db:"absent_at,json"`
+	AbsenceType LookupItem  `json:"absence_type" db:"absence_type,json"`
+	Start       *int64      `json:"start" db:"start"`
+	End         *int64      `json:"end" db:"end"`
 }
 
-type Absence struct {
-	DomainRecord
+// Minutes returns absence duration within the day.
+func (a *Absence) Minutes() int64 {
+	if a.Start == nil || a.End == nil {
+		return MinutesPerDay
+	}
 
-	AbsentAt    pgtype.Date      `json:"absent_at" db:"absent_at,json"`
-	AbsenceType AgentAbsenceType `json:"absence_type_id" db:"absence_type_id"`
+	return *a.End - *a.Start
 }
 
 func (a *Absence) MarshalProto() *pb.Absence {
 	return &pb.Absence{
 		Id:        a.Id,
 		DomainId:  a.DomainId,
-		TypeId:    pb.AbsenceType(a.AbsenceType),
+		Type:      a.AbsenceType.MarshalProto(),
 		AbsentAt:  a.AbsentAt.Time.UnixMilli(),
 		CreatedAt: a.CreatedAt.Time.UnixMilli(),
 		CreatedBy: a.CreatedBy.MarshalProto(),
 		UpdatedAt: a.UpdatedAt.Time.UnixMilli(),
 		UpdatedBy: a.UpdatedBy.MarshalProto(),
+		Start:     a.Start,
+		End:       a.End,
 	}
 }
 
@@ -55,3 +57,26 @@ func (a *AgentAbsences) MarshalProto() *pb.AgentAbsences {
 		Absences: absences,
 	}
 }
+
+// AgentAbsenceBalance is a number of absence days of the specific type per agent within a year.
+// Partial-day absences make used and planned days fractional.
+type AgentAbsenceBalance struct {
+	AbsenceType LookupItem `json:"absence_type" db:"absence_type,json"`
+	Entitled    float64    `json:"entitled" db:"entitled"`
+	Used        float64    `json:"used" db:"used"`
+	Planned     float64    `json:"planned" db:"planned"`
+}
+
+func (a *AgentAbsenceBalance) Remaining() float64 {
+	return a.Entitled - a.Used - a.Planned
+}
+
+func (a *AgentAbsenceBalance) MarshalProto() *pb.AgentAbsenceBalance {
+	return &pb.AgentAbsenceBalance{
+		Type:      a.AbsenceType.MarshalProto(),
+		Entitled:  a.Entitled,
+		Used:      a.Used,
+		Planned:   a.Planned,
+		Remaining: a.Remaining(),
+	}
+}
@@ -0,0 +1,136 @@
+package model
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+)
+
+// AgentCalendar is the schedule of an agent across published working schedules.
+type AgentCalendar struct {
+	Agent LookupItem `json:"agent" db:"agent,json"`
+
+	// Timezone is the agent's own timezone, partial-day absences are placed within it.
+	Timezone *string `json:"timezone" db:"timezone"`
+
+	Shifts   []*AgentCalendarShift `json:"shifts" db:"shifts"`
+	Absences []*Absence            `json:"absences" db:"absences"`
+}
+
+// Location returns the agent's own timezone, UTC if it isn't set.
+func (a *AgentCalendar) Location() *time.Location {
+	if a.Timezone == nil {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(*a.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// AgentCalendarShift is a shift or a segment of a split shift within a working schedule.
+type AgentCalendarShift struct {
+	WorkingSchedule LookupItem          `json:"working_schedule" db:"working_schedule,json"`
+	Date            pgtype.Date         `json:"date" db:"date,json"`
+	Shift           *AgentScheduleShift `json:"shift" db:"shift,json"`
+}
+
+func (a *AgentCalendarShift) MarshalProto() *pb.AgentCalendarShift {
+	return &pb.AgentCalendarShift{
+		WorkingSchedule: a.WorkingSchedule.MarshalProto(),
+		Date:            a.Date.Time.Unix(),
+		Shift:           a.Shift.MarshalProto(),
+	}
+}
+
+type AgentCalendarSearch struct {
+	AgentId int64
+	Date    FilterBetween
+}
+
+// AgentCalendarFeed is a subscription of calendar clients to the agent's calendar.
+type AgentCalendarFeed struct {
+	DomainId  int64            `json:"domain_id" db:"domain_id"`
+	CreatedAt pgtype.Timestamp `json:"created_at" db:"created_at,json"`
+	CreatedBy *LookupItem      `json:"created_by" db:"created_by,json"`
+	Agent     LookupItem       `json:"agent" db:"agent,json"`
+
+	// Token is set only when the feed is created, since only its hash is stored.
+	Token string `json:"-" db:"-"`
+}
+
+// Path returns the subscribe path of the feed, or an empty string if the token isn't known.
+func (a *AgentCalendarFeed) Path() string {
+	if a.Token == "" {
+		return ""
+	}
+
+	return "/wfm/calendar_feeds/" + a.Token
+}
+
+func (a *AgentCalendarFeed) MarshalProto() *pb.AgentCalendarFeed {
+	return &pb.AgentCalendarFeed{
+		Agent:     a.Agent.MarshalProto(),
+		Token:     a.Token,
+		Path:      a.Path(),
+		CreatedAt: a.CreatedAt.Time.UnixMilli(),
+		CreatedBy: a.CreatedBy.MarshalProto(),
+	}
+}
+
+// MySchedule is the schedule of the agent linked to the signed-in user.
+type MySchedule struct {
+	AgentCalendar
+
+	Holidays []*Holiday
+	Changes  []*AgentScheduleChange
+}
+
+type AgentScheduleChangeAction int32
+
+const (
+	AgentScheduleChangeActionUnspecified AgentScheduleChangeAction = iota
+	AgentScheduleChangeActionCreated
+	AgentScheduleChangeActionUpdated
+	AgentScheduleChangeActionDeleted
+)
+
+func (a AgentScheduleChangeAction) String() string {
+	return []string{"unspecified", "created", "updated", "deleted"}[a]
+}
+
+// AgentScheduleChange is a change of an agent shift, it is recorded by the database on each shift change.
+type AgentScheduleChange struct {
+	Id              int64                     `json:"id" db:"id"`
+	CreatedAt       pgtype.Timestamp          `json:"created_at" db:"created_at,json"`
+	CreatedBy       *LookupItem               `json:"created_by" db:"created_by,json"`
+	WorkingSchedule LookupItem                `json:"working_schedule" db:"working_schedule,json"`
+	ShiftId         int64                     `json:"shift_id" db:"shift_id"`
+	Date            pgtype.Date               `json:"date" db:"date,json"`
+	Action          AgentScheduleChangeAction `json:"action" db:"action"`
+	Start           *int64                    `json:"start" db:"start"`
+	End             *int64                    `json:"end" db:"end"`
+	PrevStart       *int64                    `json:"prev_start" db:"prev_start"`
+	PrevEnd         *int64                    `json:"prev_end" db:"prev_end"`
+}
+
+func (a *AgentScheduleChange) MarshalProto() *pb.AgentScheduleChange {
+	return &pb.AgentScheduleChange{
+		Id:              a.Id,
+		CreatedAt:       a.CreatedAt.Time.UnixMilli(),
+		CreatedBy:       a.CreatedBy.MarshalProto(),
+		WorkingSchedule: a.WorkingSchedule.MarshalProto(),
+		ShiftId:         a.ShiftId,
+		Date:            a.Date.Time.Unix(),
+		Action:          pb.AgentScheduleChangeAction(a.Action),
+		Start:           a.Start,
+		End:             a.End,
+		PrevStart:       a.PrevStart,
+		PrevEnd:         a.PrevEnd,
+	}
+}
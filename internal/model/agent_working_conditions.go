@@ -1,10 +1,17 @@
 package model
 
-import pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+)
 
 type AgentWorkingConditions struct {
 	WorkingCondition LookupItem  `json:"working_condition" db:"working_condition,json"`
 	PauseTemplate    *LookupItem `json:"pause_template" db:"pause_template,json"`
+
+	// Timezone is the agent's own timezone, which overrides the calendar timezone of working schedules.
+	Timezone *LookupItem `json:"timezone" db:"timezone,json"`
 }
 
 func (a *AgentWorkingConditions) MarshalProto() *pb.AgentWorkingConditions {
@@ -13,5 +20,18 @@ func (a *AgentWorkingConditions) MarshalProto() *pb.AgentWorkingConditions {
 		PauseTemplate:    a.PauseTemplate.MarshalProto(),
 	}
 
+	if a.Timezone != nil && a.Timezone.Id != 0 {
+		out.Timezone = a.Timezone.MarshalProto()
+	}
+
 	return out
 }
+
+// AgentWorkingConditionsHistory is a working condition linked to the agent since a date.
+type AgentWorkingConditionsHistory struct {
+	StartedAt        pgtype.Date `json:"started_at" db:"started_at"`
+	WorkingCondition LookupItem  `json:"working_condition" db:"working_condition,json"`
+	WorkdayHours     *int32      `json:"workday_hours" db:"workday_hours"`
+	Vacation         *int32      `json:"vacation" db:"vacation"`
+	SickLeaves       *int32      `json:"sick_leaves" db:"sick_leaves"`
+}
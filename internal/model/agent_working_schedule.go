@@ -1,21 +1,34 @@
 package model
 
 import (
+	"time"
+
 	"github.com/jackc/pgx/v5/pgtype"
 
 	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+	"github.com/webitel/webitel-wfm/pkg/spreadsheet"
 )
 
+// MinutesPerDay is the number of minutes within a day, shift times are counted from the start of the shift date.
+const MinutesPerDay = 24 * 60
+
 type AgentScheduleShiftPause struct {
 	DomainRecord
 
 	Start int64       `json:"start" db:"start"`
 	End   int64       `json:"end" db:"end"`
 	Cause *LookupItem `json:"cause" db:"cause,json"`
+
+	// StartAt and EndAt are absolute instants of Start and End in the calendar timezone of the working schedule.
+	StartAt pgtype.Timestamp `json:"start_at" db:"start_at"`
+	EndAt   pgtype.Timestamp `json:"end_at" db:"end_at"`
+
+	// Local is set by Localize in the agent's own timezone.
+	Local *AgentScheduleLocalTime `json:"-" db:"-"`
 }
 
 func (a *AgentScheduleShiftPause) MarshalProto() *pb.AgentScheduleShiftPause {
-	return &pb.AgentScheduleShiftPause{
+	out := &pb.AgentScheduleShiftPause{
 		Id:        a.Id,
 		DomainId:  a.DomainId,
 		CreatedAt: a.CreatedAt.Time.UnixMilli(),
@@ -26,6 +39,61 @@ func (a *AgentScheduleShiftPause) MarshalProto() *pb.AgentScheduleShiftPause {
 		End:       a.End,
 		Cause:     a.Cause.MarshalProto(),
 	}
+
+	if a.StartAt.Valid {
+		out.StartAt = a.StartAt.Time.UnixMilli()
+	}
+
+	if a.EndAt.Valid {
+		out.EndAt = a.EndAt.Time.UnixMilli()
+	}
+
+	out.Local = a.Local.MarshalProto()
+
+	return out
+}
+
+// AgentScheduleLocalTime is a shift or a pause in the agent's own timezone.
+// Start and End are wall-clock minutes from the start of the local Date.
+type AgentScheduleLocalTime struct {
+	Date  pgtype.Date
+	Start int64
+	End   int64
+}
+
+func newAgentScheduleLocalTime(day time.Time, start, end time.Time) *AgentScheduleLocalTime {
+	return &AgentScheduleLocalTime{
+		Date:  pgtype.Date{Time: day, Valid: true},
+		Start: localMinutes(day, start),
+		End:   localMinutes(day, end),
+	}
+}
+
+func (a *AgentScheduleLocalTime) MarshalProto() *pb.AgentScheduleLocalTime {
+	if a == nil {
+		return nil
+	}
+
+	return &pb.AgentScheduleLocalTime{
+		Date:  a.Date.Time.Unix(),
+		Start: a.Start,
+		End:   a.End,
+	}
+}
+
+// localDay returns the wall-clock date of t as UTC midnight, like dates are stored.
+func localDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// localMinutes returns wall-clock minutes of t from the start of the day,
+// which go past a day for times of the following days.
+func localMinutes(day time.Time, t time.Time) int64 {
+	days := int64(localDay(t).Sub(day) / (24 * time.Hour))
+
+	return days*MinutesPerDay + int64(t.Hour()*60+t.Minute())
 }
 
 type AgentScheduleShiftSkill struct {
@@ -49,6 +117,38 @@ type AgentScheduleShift struct {
 	End    int64                      `json:"end" db:"end"`
 	Pauses []*AgentScheduleShiftPause `json:"pauses" db:"pauses"`
 	Skills []*AgentScheduleShiftSkill `json:"skills" db:"skills"`
+
+	// StartAt and EndAt are absolute instants of Start and End in the calendar timezone of the working schedule.
+	StartAt pgtype.Timestamp `json:"start_at" db:"start_at"`
+	EndAt   pgtype.Timestamp `json:"end_at" db:"end_at"`
+
+	// Local is set by Localize in the agent's own timezone.
+	Local *AgentScheduleLocalTime `json:"-" db:"-"`
+
+	// RosterPattern is set if the shift is created from the roster pattern.
+	RosterPattern *LookupItem `json:"roster_pattern" db:"roster_pattern"`
+}
+
+// Localize sets local times of the shift and its pauses in the timezone.
+// Pause times are relative to the local date of the shift start.
+func (a *AgentScheduleShift) Localize(loc *time.Location) {
+	if !a.StartAt.Valid || !a.EndAt.Valid {
+		return
+	}
+
+	start := a.StartAt.Time.In(loc)
+	day := localDay(start)
+	a.Local = newAgentScheduleLocalTime(day, start, a.EndAt.Time.In(loc))
+	for _, pause := range a.Pauses {
+		if pause.StartAt.Valid && pause.EndAt.Valid {
+			pause.Local = newAgentScheduleLocalTime(day, pause.StartAt.Time.In(loc), pause.EndAt.Time.In(loc))
+		}
+	}
+}
+
+// Overnight reports whether the shift crosses midnight and ends on the next day.
+func (a *AgentScheduleShift) Overnight() bool {
+	return a.End > MinutesPerDay
 }
 
 func (a *AgentScheduleShift) MarshalProto() *pb.AgentScheduleShift {
@@ -62,7 +162,7 @@ func (a *AgentScheduleShift) MarshalProto() *pb.AgentScheduleShift {
 		skills = append(skills, skill.MarshalProto())
 	}
 
-	return &pb.AgentScheduleShift{
+	out := &pb.AgentScheduleShift{
 		Id:        a.Id,
 		DomainId:  a.DomainId,
 		CreatedAt: a.CreatedAt.Time.UnixMilli(),
@@ -74,6 +174,19 @@ func (a *AgentScheduleShift) MarshalProto() *pb.AgentScheduleShift {
 		Pauses:    pauses,
 		Skills:    skills,
 	}
+
+	if a.StartAt.Valid {
+		out.StartAt = a.StartAt.Time.UnixMilli()
+	}
+
+	if a.EndAt.Valid {
+		out.EndAt = a.EndAt.Time.UnixMilli()
+	}
+
+	out.Local = a.Local.MarshalProto()
+	out.RosterPattern = a.RosterPattern.MarshalProto()
+
+	return out
 }
 
 type AgentScheduleType int32
@@ -90,10 +203,26 @@ func (s AgentScheduleType) String() string {
 }
 
 type AgentSchedule struct {
-	Date    pgtype.Date         `json:"date" db:"date,json"`
-	Locked  bool                `json:"locked" db:"locked,json"`
-	Absence *AgentAbsenceType   `json:"absence" db:"absence"`
-	Shift   *AgentScheduleShift `json:"shift" db:"shift,json"`
+	Date         pgtype.Date         `json:"date" db:"date,json"`
+	Locked       bool                `json:"locked" db:"locked,json"`
+	Absence      *LookupItem         `json:"absence" db:"absence,json"`
+	AbsenceStart *int64              `json:"absence_start" db:"absence_start"`
+	AbsenceEnd   *int64              `json:"absence_end" db:"absence_end"`
+	Shift        *AgentScheduleShift `json:"shift" db:"shift,json"`
+
+	// Carryover is set for the next day of the overnight shift, the shift belongs to the previous date.
+	Carryover bool `json:"-" db:"-"`
+}
+
+// PartialAbsence reports whether the agent is absent only a part of the day,
+// so the shift of the same day is still in effect.
+func (a *AgentSchedule) PartialAbsence() bool {
+	return a.Absence != nil && a.AbsenceStart != nil && a.AbsenceEnd != nil
+}
+
+// FullAbsence reports whether the agent is absent the whole day.
+func (a *AgentSchedule) FullAbsence() bool {
+	return a.Absence != nil && !a.PartialAbsence()
 }
 
 func (a *AgentSchedule) MarshalProto() *pb.AgentSchedule {
@@ -108,12 +237,16 @@ func (a *AgentSchedule) MarshalProto() *pb.AgentSchedule {
 
 	if a.Absence != nil {
 		schedule.Type = &pb.AgentSchedule_Absence{
-			Absence: pb.AbsenceType(*a.Absence),
+			Absence: a.Absence.MarshalProto(),
 		}
 
+		schedule.AbsenceStart = a.AbsenceStart
+		schedule.AbsenceEnd = a.AbsenceEnd
+
 		return schedule
 	}
 
+	schedule.Carryover = a.Carryover
 	schedule.Type = &pb.AgentSchedule_Shift{
 		Shift: a.Shift.MarshalProto(),
 	}
@@ -124,6 +257,9 @@ func (a *AgentSchedule) MarshalProto() *pb.AgentSchedule {
 type AgentWorkingSchedule struct {
 	Agent    LookupItem       `json:"agent" db:"agent,json"`
 	Schedule []*AgentSchedule `json:"schedule,omitempty" db:"schedule,json"`
+
+	// Timezone is the IANA name of the agent's own timezone, nil if the agent uses the calendar one.
+	Timezone *string `json:"timezone,omitempty" db:"timezone"`
 }
 
 func (a *AgentWorkingSchedule) MarshalProto() *pb.AgentWorkingSchedule {
@@ -132,17 +268,27 @@ func (a *AgentWorkingSchedule) MarshalProto() *pb.AgentWorkingSchedule {
 		schedules = append(schedules, schedule.MarshalProto())
 	}
 
-	return &pb.AgentWorkingSchedule{
+	out := &pb.AgentWorkingSchedule{
 		Agent:    a.Agent.MarshalProto(),
 		Schedule: schedules,
 	}
+
+	if a.Timezone != nil {
+		out.Timezone = *a.Timezone
+	}
+
+	return out
 }
 
 type AgentWorkingScheduleSearch struct {
 	SearchItem SearchItem
 
+	// WorkingScheduleId limits shifts to the working schedule, if it is zero, shifts of all working schedules are searched.
 	WorkingScheduleId int64
 
+	// WorkingScheduleStates limits shifts to working schedules in any of the states.
+	WorkingScheduleStates []WorkingScheduleState
+
 	Ids           []int64
 	AgentIds      []int64
 	SupervisorIds []int64
@@ -150,9 +296,132 @@ type AgentWorkingScheduleSearch struct {
 	SkillIds      []int64
 }
 
+type ShiftConflictMode int32
+
+const (
+	ShiftConflictModeUnspecified ShiftConflictMode = iota
+	ShiftConflictModeFail
+	ShiftConflictModeSkipExisting
+	ShiftConflictModeOverwrite
+	ShiftConflictModeAppend
+)
+
+func (s ShiftConflictMode) String() string {
+	return []string{"unspecified", "fail", "skip_existing", "overwrite", "append"}[s]
+}
+
 type CreateAgentsWorkingScheduleShifts struct {
 	WorkingScheduleID int64
-	Date              FilterBetween                 `json:"date" db:"date,json"`
-	Agents            []*LookupItem                 `json:"agents" db:"agents,json"`
-	Shifts            map[int64]*AgentScheduleShift `json:"shifts" db:"shifts,json"`
+	Date              FilterBetween                   `json:"date" db:"date,json"`
+	Agents            []*LookupItem                   `json:"agents" db:"agents,json"`
+	Shifts            map[int64][]*AgentScheduleShift `json:"shifts" db:"shifts,json"`
+	Mode              ShiftConflictMode               `json:"mode" db:"mode"`
+	PlacePauses       *PausePlacement
+
+	// ShiftTemplateId creates shifts by the rotation pattern of the shift template,
+	// which starts at AnchorDate. Only one of Shifts, ShiftTemplateId and RosterPatternId is set.
+	ShiftTemplateId *int64
+	AnchorDate      pgtype.Date
+
+	// RosterPatternId creates shifts of the roster pattern,
+	// Agents default to the agents, that are assigned to the pattern.
+	RosterPatternId *int64
+}
+
+// PausePlacement defines how pauses of the pause template are placed into shifts.
+// Offsets are in minutes, zero maximum offsets mean unlimited.
+type PausePlacement struct {
+	PauseTemplateId *int64
+	MinStartOffset  int64
+	MaxStartOffset  int64
+	MinEndOffset    int64
+	MaxEndOffset    int64
+}
+
+type AgentScheduleDates struct {
+	Agent LookupItem    `json:"agent" db:"agent,json"`
+	Dates []pgtype.Date `json:"dates" db:"dates,json"`
+}
+
+func (a *AgentScheduleDates) MarshalProto() *pb.AgentScheduleDates {
+	dates := make([]int64, 0, len(a.Dates))
+	for _, date := range a.Dates {
+		dates = append(dates, date.Time.Unix())
+	}
+
+	return &pb.AgentScheduleDates{
+		Agent: a.Agent.MarshalProto(),
+		Dates: dates,
+	}
+}
+
+// CreateAgentsWorkingScheduleShiftsResult holds created shifts
+// and reports which agent days were created, replaced or skipped.
+type CreateAgentsWorkingScheduleShiftsResult struct {
+	Items    []*AgentWorkingSchedule
+	Created  []*AgentScheduleDates
+	Replaced []*AgentScheduleDates
+	Skipped  []*AgentScheduleDates
+}
+
+// ImportAgentsWorkingScheduleShifts is a roster file of agent shifts to import into the working schedule.
+type ImportAgentsWorkingScheduleShifts struct {
+	WorkingScheduleID int64
+	File              []byte
+	Format            spreadsheet.Format
+	DryRun            bool
+	Mode              ShiftConflictMode
+}
+
+// ImportAgentsWorkingScheduleShiftsResult reports rows with errors,
+// or shifts written (to be written on a dry run) if there are none.
+type ImportAgentsWorkingScheduleShiftsResult struct {
+	CreateAgentsWorkingScheduleShiftsResult
+
+	Rows   int64
+	Errors []*ImportRowError
+}
+
+// ImportRowError is an error of a roster row, rows are numbered from 1 including the header.
+type ImportRowError struct {
+	Row     int64
+	Column  string
+	Message string
+}
+
+func (i *ImportRowError) MarshalProto() *pb.ImportRowError {
+	return &pb.ImportRowError{
+		Row:     i.Row,
+		Column:  i.Column,
+		Message: i.Message,
+	}
+}
+
+type ScheduleExportLayout int32
+
+const (
+	ScheduleExportLayoutUnspecified ScheduleExportLayout = iota
+	ScheduleExportLayoutGrid
+	ScheduleExportLayoutFlat
+)
+
+// ExportAgentsWorkingSchedule defines a file export of agent shifts, the date defaults to the working schedule period.
+type ExportAgentsWorkingSchedule struct {
+	WorkingScheduleID int64
+	Date              *FilterBetween
+	Format            spreadsheet.Format
+	Layout            ScheduleExportLayout
+}
+
+// ExportFile is a file produced by an export.
+type ExportFile struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+type DeleteAgentsWorkingScheduleShifts struct {
+	WorkingScheduleID int64
+	Date              FilterBetween `json:"date" db:"date,json"`
+	AgentIds          []int64       `json:"agent_ids" db:"agent_ids"`
 }
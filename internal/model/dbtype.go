@@ -12,7 +12,7 @@ func NewDate(timestamp int64) pgtype.Date {
 	}
 
 	return pgtype.Date{
-		Time:  time.Unix(timestamp, 0),
+		Time:  time.Unix(timestamp, 0).UTC(),
 		Valid: true,
 	}
 }
@@ -23,7 +23,7 @@ func NewTimestamp(timestamp int64) pgtype.Timestamp {
 	}
 
 	return pgtype.Timestamp{
-		Time:  time.Unix(timestamp, 0),
+		Time:  time.Unix(timestamp, 0).UTC(),
 		Valid: true,
 	}
 }
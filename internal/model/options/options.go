@@ -30,6 +30,15 @@ func WithID(id int64) Option {
 
 func WithDerivedID(name string, id int64) Option {
 	return func(options any) error {
+		v, ok := options.(DerivedOptions)
+		if !ok {
+			return werror.Wrap(ErrInsufficientRequestCapabilities, werror.WithValue("option", "derived_id"))
+		}
+
+		derived := v.DerivedByName(name)
+		derived.WithID(id)
+		v.WithDerived(name, derived)
+
 		return nil
 	}
 }
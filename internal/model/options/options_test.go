@@ -136,6 +136,61 @@ func TestWithIDs(t *testing.T) {
 	}
 }
 
+func TestWithDerivedID(t *testing.T) {
+	tests := map[string]struct {
+		name     string
+		id       []int64
+		expected int64
+	}{
+		"single id": {
+			name:     "agent",
+			id:       []int64{1},
+			expected: 1,
+		},
+		"overridden id": {
+			name:     "agent",
+			id:       []int64{1, 2},
+			expected: 2,
+		},
+	}
+
+	options := []struct {
+		options any
+		err     error
+	}{
+		{
+			options: &Read{},
+			err:     nil,
+		},
+		{
+			options: &Search{},
+			err:     nil,
+		},
+	}
+
+	for _, o := range options {
+		t.Run(fmt.Sprintf("%T", o.options), func(t *testing.T) {
+			for scenario, tt := range tests {
+				opts := DeepCopy(o.options)
+				t.Run(scenario, func(t *testing.T) {
+					for _, id := range tt.id {
+						err := WithDerivedID(tt.name, id)(opts)
+						if o.err != nil {
+							require.ErrorIs(t, err, o.err)
+						} else {
+							require.NoError(t, err)
+						}
+					}
+
+					if v, ok := opts.(DerivedOptions); ok {
+						assert.Equal(t, tt.expected, v.DerivedByName(tt.name).ID())
+					}
+				})
+			}
+		})
+	}
+}
+
 func TestWithFields(t *testing.T) {
 	type expected struct {
 		fields  fields
@@ -153,6 +153,11 @@ func (s *Search) Page() int {
 }
 
 func (s *Search) Offset() int {
+	// Unlimited search has no pages.
+	if s.Page() == 0 {
+		return 0
+	}
+
 	return (s.Size() - 1) * (s.Page() - 1)
 }
 
@@ -0,0 +1,104 @@
+package model
+
+import pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+
+// RosterPattern is a named weekly set of shifts, that can be applied to any working schedule.
+type RosterPattern struct {
+	DomainRecord
+
+	Name        string        `json:"name" db:"name"`
+	Description *string       `json:"description" db:"description"`
+	Team        *LookupItem   `json:"team" db:"team,json"`
+	Agents      []*LookupItem `json:"agents" db:"agents,json"`
+
+	// Shifts are segments of the day by day of week: 0 - Sunday, ..., 6 - Saturday.
+	Shifts map[int64][]*RosterPatternShift `json:"shifts" db:"shifts,json"`
+}
+
+func (r *RosterPattern) MarshalProto() *pb.RosterPattern {
+	agents := make([]*pb.LookupEntity, 0, len(r.Agents))
+	for _, a := range r.Agents {
+		agents = append(agents, a.MarshalProto())
+	}
+
+	shifts := make(map[int64]*pb.AgentScheduleShifts, len(r.Shifts))
+	for day, segments := range r.Shifts {
+		items := make([]*pb.AgentScheduleShift, 0, len(segments))
+		for _, s := range segments {
+			items = append(items, s.MarshalProto())
+		}
+
+		shifts[day] = &pb.AgentScheduleShifts{Items: items}
+	}
+
+	out := &pb.RosterPattern{
+		Id:          r.Id,
+		DomainId:    r.DomainId,
+		Name:        r.Name,
+		Description: r.Description,
+		Team:        r.Team.MarshalProto(),
+		Agents:      agents,
+		Shifts:      shifts,
+		CreatedBy:   r.CreatedBy.MarshalProto(),
+		UpdatedBy:   r.UpdatedBy.MarshalProto(),
+	}
+
+	if !r.CreatedAt.Time.IsZero() {
+		out.CreatedAt = r.CreatedAt.Time.UnixMilli()
+	}
+
+	if !r.UpdatedAt.Time.IsZero() {
+		out.UpdatedAt = r.UpdatedAt.Time.UnixMilli()
+	}
+
+	return out
+}
+
+// RosterPatternShift is a shift segment of the roster pattern day.
+type RosterPatternShift struct {
+	Start  int64                      `json:"start"`
+	End    int64                      `json:"end"`
+	Pauses []*RosterPatternPause      `json:"pauses"`
+	Skills []*AgentScheduleShiftSkill `json:"skills"`
+}
+
+// Shift returns a new agent shift, that references the roster pattern.
+func (r *RosterPatternShift) Shift(pattern *LookupItem) *AgentScheduleShift {
+	pauses := make([]*AgentScheduleShiftPause, 0, len(r.Pauses))
+	for _, p := range r.Pauses {
+		pauses = append(pauses, &AgentScheduleShiftPause{Start: p.Start, End: p.End, Cause: p.Cause})
+	}
+
+	return &AgentScheduleShift{
+		Start:         r.Start,
+		End:           r.End,
+		Pauses:        pauses,
+		Skills:        r.Skills,
+		RosterPattern: pattern,
+	}
+}
+
+func (r *RosterPatternShift) MarshalProto() *pb.AgentScheduleShift {
+	pauses := make([]*pb.AgentScheduleShiftPause, 0, len(r.Pauses))
+	for _, p := range r.Pauses {
+		pauses = append(pauses, &pb.AgentScheduleShiftPause{Start: p.Start, End: p.End, Cause: p.Cause.MarshalProto()})
+	}
+
+	skills := make([]*pb.AgentScheduleShiftSkill, 0, len(r.Skills))
+	for _, s := range r.Skills {
+		skills = append(skills, s.MarshalProto())
+	}
+
+	return &pb.AgentScheduleShift{
+		Start:  r.Start,
+		End:    r.End,
+		Pauses: pauses,
+		Skills: skills,
+	}
+}
+
+type RosterPatternPause struct {
+	Start int64       `json:"start"`
+	End   int64       `json:"end"`
+	Cause *LookupItem `json:"cause"`
+}
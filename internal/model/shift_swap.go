@@ -0,0 +1,127 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+)
+
+type ShiftSwapState int32
+
+const (
+	ShiftSwapStateUnspecified ShiftSwapState = iota
+	ShiftSwapStateOpen
+	ShiftSwapStateAccepted
+	ShiftSwapStateApproved
+	ShiftSwapStateRejected
+	ShiftSwapStateCancelled
+)
+
+func (s ShiftSwapState) String() string {
+	return []string{"unspecified", "open", "accepted", "approved", "rejected", "cancelled"}[s]
+}
+
+// ShiftSwapShift is a shift offered or proposed in exchange.
+type ShiftSwapShift struct {
+	Id    int64       `json:"id" db:"id"`
+	Date  pgtype.Date `json:"date" db:"date"`
+	Start int64       `json:"start" db:"start"`
+	End   int64       `json:"end" db:"end"`
+}
+
+func (s *ShiftSwapShift) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	}
+
+	return nil
+}
+
+func (s *ShiftSwapShift) MarshalProto() *pb.ShiftSwapShift {
+	if s == nil {
+		return nil
+	}
+
+	return &pb.ShiftSwapShift{
+		Id:    s.Id,
+		Date:  s.Date.Time.Unix(),
+		Start: s.Start,
+		End:   s.End,
+	}
+}
+
+// ShiftSwap is an offer of an agent to give away a shift. Another agent accepts the offer,
+// optionally proposing own shift in exchange, and approved swap reassigns the shifts between the agents.
+type ShiftSwap struct {
+	DomainRecord
+
+	WorkingSchedule LookupItem       `json:"working_schedule" db:"working_schedule,json"`
+	Agent           LookupItem       `json:"agent" db:"agent,json"`
+	Shift           ShiftSwapShift   `json:"shift" db:"shift,json"`
+	TargetAgent     *LookupItem      `json:"target_agent" db:"target_agent,json"`
+	CounterAgent    *LookupItem      `json:"counter_agent" db:"counter_agent,json"`
+	CounterShift    *ShiftSwapShift  `json:"counter_shift" db:"counter_shift,json"`
+	State           ShiftSwapState   `json:"state" db:"state"`
+	Note            *string          `json:"note" db:"note"`
+	Comment         *string          `json:"comment" db:"comment"`
+	AcceptedAt      pgtype.Timestamp `json:"accepted_at" db:"accepted_at,json"`
+	DecidedAt       pgtype.Timestamp `json:"decided_at" db:"decided_at,json"`
+	DecidedBy       *LookupItem      `json:"decided_by" db:"decided_by,json"`
+}
+
+func (s *ShiftSwap) MarshalProto() *pb.ShiftSwap {
+	out := &pb.ShiftSwap{
+		Id:              s.Id,
+		DomainId:        s.DomainId,
+		CreatedAt:       s.CreatedAt.Time.UnixMilli(),
+		CreatedBy:       s.CreatedBy.MarshalProto(),
+		UpdatedAt:       s.UpdatedAt.Time.UnixMilli(),
+		UpdatedBy:       s.UpdatedBy.MarshalProto(),
+		WorkingSchedule: s.WorkingSchedule.MarshalProto(),
+		Agent:           s.Agent.MarshalProto(),
+		Shift:           s.Shift.MarshalProto(),
+		TargetAgent:     s.TargetAgent.MarshalProto(),
+		CounterAgent:    s.CounterAgent.MarshalProto(),
+		CounterShift:    s.CounterShift.MarshalProto(),
+		State:           pb.ShiftSwapState(s.State),
+		Note:            s.Note,
+		Comment:         s.Comment,
+		DecidedBy:       s.DecidedBy.MarshalProto(),
+	}
+
+	if s.AcceptedAt.Valid {
+		out.AcceptedAt = s.AcceptedAt.Time.UnixMilli()
+	}
+
+	if s.DecidedAt.Valid {
+		out.DecidedAt = s.DecidedAt.Time.UnixMilli()
+	}
+
+	return out
+}
+
+// InProgress reports whether the swap is neither approved nor closed.
+func (s *ShiftSwap) InProgress() bool {
+	return s.State == ShiftSwapStateOpen || s.State == ShiftSwapStateAccepted
+}
+
+// Agents returns the offering agent and the counter agent, if the swap is accepted.
+func (s *ShiftSwap) Agents() []int64 {
+	out := []int64{s.Agent.Id}
+	if s.CounterAgent != nil {
+		out = append(out, s.CounterAgent.Id)
+	}
+
+	return out
+}
+
+type ShiftSwapSearch struct {
+	WorkingScheduleIds []int64
+	AgentIds           []int64
+	States             []ShiftSwapState
+}
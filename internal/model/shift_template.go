@@ -8,6 +8,9 @@ type ShiftTemplate struct {
 	Name        string              `json:"name" db:"name"`
 	Description *string             `json:"description" db:"description"`
 	Times       []ShiftTemplateTime `json:"times" db:"times,json"`
+
+	// Rotation is an N-day cycle, that is repeated from an anchor date.
+	Rotation []ShiftTemplateRotationDay `json:"rotation" db:"rotation,json"`
 }
 
 func (s *ShiftTemplate) MarshalProto() *pb.ShiftTemplate {
@@ -16,12 +19,18 @@ func (s *ShiftTemplate) MarshalProto() *pb.ShiftTemplate {
 		times = append(times, t.MarshalProto())
 	}
 
+	rotation := make([]*pb.ShiftTemplateRotationDay, 0, len(s.Rotation))
+	for _, r := range s.Rotation {
+		rotation = append(rotation, r.MarshalProto())
+	}
+
 	out := &pb.ShiftTemplate{
 		Id:          s.Id,
 		DomainId:    s.DomainId,
 		Name:        s.Name,
 		Description: s.Description,
 		Times:       times,
+		Rotation:    rotation,
 		CreatedBy:   s.CreatedBy.MarshalProto(),
 		UpdatedBy:   s.UpdatedBy.MarshalProto(),
 	}
@@ -48,3 +57,17 @@ func (s *ShiftTemplateTime) MarshalProto() *pb.ShiftTemplateTime {
 		End:   s.End,
 	}
 }
+
+// ShiftTemplateRotationDay is a day of the rotation cycle, the day is off if Time is nil.
+type ShiftTemplateRotationDay struct {
+	Time *ShiftTemplateTime `json:"time"`
+}
+
+func (s *ShiftTemplateRotationDay) MarshalProto() *pb.ShiftTemplateRotationDay {
+	out := &pb.ShiftTemplateRotationDay{}
+	if s.Time != nil {
+		out.Time = s.Time.MarshalProto()
+	}
+
+	return out
+}
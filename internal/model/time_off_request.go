@@ -0,0 +1,69 @@
+package model
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+)
+
+type TimeOffRequestState int32
+
+const (
+	TimeOffRequestStateUnspecified TimeOffRequestState = iota
+	TimeOffRequestStatePending
+	TimeOffRequestStateApproved
+	TimeOffRequestStateRejected
+	TimeOffRequestStateCancelled
+)
+
+func (s TimeOffRequestState) String() string {
+	return []string{"unspecified", "pending", "approved", "rejected", "cancelled"}[s]
+}
+
+// TimeOffRequest is a request of an agent to be absent within a period of days.
+// Approved request materializes agent absences.
+type TimeOffRequest struct {
+	DomainRecord
+
+	Agent       LookupItem          `json:"agent" db:"agent,json"`
+	AbsenceType LookupItem          `json:"absence_type" db:"absence_type,json"`
+	DateFrom    pgtype.Date         `json:"date_from" db:"date_from,json"`
+	DateTo      pgtype.Date         `json:"date_to" db:"date_to,json"`
+	State       TimeOffRequestState `json:"state" db:"state"`
+	Note        *string             `json:"note" db:"note"`
+	Comment     *string             `json:"comment" db:"comment"`
+	DecidedAt   pgtype.Timestamp    `json:"decided_at" db:"decided_at,json"`
+	DecidedBy   *LookupItem         `json:"decided_by" db:"decided_by,json"`
+}
+
+func (t *TimeOffRequest) MarshalProto() *pb.TimeOffRequest {
+	out := &pb.TimeOffRequest{
+		Id:        t.Id,
+		DomainId:  t.DomainId,
+		CreatedAt: t.CreatedAt.Time.UnixMilli(),
+		CreatedBy: t.CreatedBy.MarshalProto(),
+		UpdatedAt: t.UpdatedAt.Time.UnixMilli(),
+		UpdatedBy: t.UpdatedBy.MarshalProto(),
+		Agent:     t.Agent.MarshalProto(),
+		Type:      t.AbsenceType.MarshalProto(),
+		DateFrom:  t.DateFrom.Time.Unix(),
+		DateTo:    t.DateTo.Time.Unix(),
+		State:     pb.TimeOffRequestState(t.State),
+		Note:      t.Note,
+		Comment:   t.Comment,
+		DecidedBy: t.DecidedBy.MarshalProto(),
+	}
+
+	if t.DecidedAt.Valid {
+		out.DecidedAt = t.DecidedAt.Time.UnixMilli()
+	}
+
+	return out
+}
+
+type TimeOffRequestSearch struct {
+	AgentIds      []int64
+	TeamIds       []int64
+	SupervisorIds []int64
+	States        []TimeOffRequestState
+}
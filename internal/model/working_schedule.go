@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"github.com/jackc/pgx/v5/pgtype"
 
 	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
@@ -20,6 +22,38 @@ func (s WorkingScheduleState) String() string {
 	return []string{"unspecified", "draft", "pending", "active", "archived"}[s]
 }
 
+// MarshalProto maps the stored state onto the API enum,
+// which uses a different numbering.
+func (s WorkingScheduleState) MarshalProto() pb.WorkingScheduleState {
+	switch s {
+	case WorkingScheduleStateDraft:
+		return pb.WorkingScheduleState_WORKING_SCHEDULE_STATE_DRAFT
+	case WorkingScheduleStatePending:
+		return pb.WorkingScheduleState_WORKING_SCHEDULE_STATE_PENDING
+	case WorkingScheduleStateActive:
+		return pb.WorkingScheduleState_WORKING_SCHEDULE_STATE_ACTIVE
+	case WorkingScheduleStateArchived:
+		return pb.WorkingScheduleState_WORKING_SCHEDULE_STATE_ARCHIVED
+	}
+
+	return pb.WorkingScheduleState_WORKING_SCHEDULE_STATE_UNSPECIFIED
+}
+
+func UnmarshalWorkingScheduleStateProto(in pb.WorkingScheduleState) WorkingScheduleState {
+	switch in {
+	case pb.WorkingScheduleState_WORKING_SCHEDULE_STATE_DRAFT:
+		return WorkingScheduleStateDraft
+	case pb.WorkingScheduleState_WORKING_SCHEDULE_STATE_PENDING:
+		return WorkingScheduleStatePending
+	case pb.WorkingScheduleState_WORKING_SCHEDULE_STATE_ACTIVE:
+		return WorkingScheduleStateActive
+	case pb.WorkingScheduleState_WORKING_SCHEDULE_STATE_ARCHIVED:
+		return WorkingScheduleStateArchived
+	}
+
+	return WorkingScheduleStateUnspecified
+}
+
 type WorkingSchedule struct {
 	DomainRecord
 
@@ -35,6 +69,26 @@ type WorkingSchedule struct {
 	ExtraSkills          []*LookupItem `db:"extra_skills,json"`
 	BlockOutsideActivity bool          `db:"block_outside_activity"`
 	Agents               []*LookupItem `db:"agents,json"`
+
+	StateChangedAt pgtype.Timestamp `db:"state_changed_at,json"`
+	StateChangedBy *LookupItem      `db:"state_changed_by,json"`
+
+	// Timezone is the IANA name of the calendar timezone, shift minutes are local to it.
+	Timezone string `db:"timezone"`
+}
+
+// Location returns the calendar timezone of the working schedule, UTC if it is unknown.
+func (w *WorkingSchedule) Location() *time.Location {
+	if w.Timezone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
 }
 
 func (w *WorkingSchedule) MarshalProto() *pb.WorkingSchedule {
@@ -54,7 +108,7 @@ func (w *WorkingSchedule) MarshalProto() *pb.WorkingSchedule {
 		CreatedBy:            w.CreatedBy.MarshalProto(),
 		UpdatedBy:            w.UpdatedBy.MarshalProto(),
 		Name:                 w.Name,
-		State:                pb.WorkingScheduleState(w.State),
+		State:                w.State.MarshalProto(),
 		Team:                 w.Team.MarshalProto(),
 		Calendar:             w.Calendar.MarshalProto(),
 		StartDateAt:          w.StartDateAt.Time.Unix(),
@@ -65,6 +119,8 @@ func (w *WorkingSchedule) MarshalProto() *pb.WorkingSchedule {
 		BlockOutsideActivity: w.BlockOutsideActivity,
 		Agents:               agents,
 		TotalAgents:          int64(len(agents)),
+		StateChangedBy:       w.StateChangedBy.MarshalProto(),
+		Timezone:             w.Timezone,
 	}
 
 	if !w.CreatedAt.Time.IsZero() {
@@ -75,5 +131,59 @@ func (w *WorkingSchedule) MarshalProto() *pb.WorkingSchedule {
 		out.UpdatedAt = w.UpdatedAt.Time.UnixMilli()
 	}
 
+	if !w.StateChangedAt.Time.IsZero() {
+		out.StateChangedAt = w.StateChangedAt.Time.UnixMilli()
+	}
+
 	return out
 }
+
+// WorkingScheduleStaffing compares required (forecasted) and scheduled agents
+// within a single interval, that starts at Timestamp.
+type WorkingScheduleStaffing struct {
+	Timestamp pgtype.Timestamp `json:"timestamp" db:"timestamp"`
+	Required  int64            `json:"required" db:"required"`
+	Scheduled int64            `json:"scheduled" db:"scheduled"`
+	OnPause   int64            `json:"on_pause" db:"on_pause"`
+}
+
+// Delta returns the difference between available (scheduled and not on pause) and required agents.
+func (w *WorkingScheduleStaffing) Delta() int64 {
+	return w.Scheduled - w.OnPause - w.Required
+}
+
+func (w *WorkingScheduleStaffing) MarshalProto() *pb.WorkingScheduleStaffing {
+	return &pb.WorkingScheduleStaffing{
+		Timestamp: w.Timestamp.Time.UnixMilli(),
+		Required:  w.Required,
+		Scheduled: w.Scheduled,
+		Delta:     w.Delta(),
+		OnPause:   w.OnPause,
+	}
+}
+
+type WorkingScheduleCloneShifts int32
+
+const (
+	WorkingScheduleCloneShiftsUnspecified WorkingScheduleCloneShifts = iota
+	WorkingScheduleCloneShiftsNone
+	WorkingScheduleCloneShiftsWeekday
+	WorkingScheduleCloneShiftsRolling
+)
+
+func (s WorkingScheduleCloneShifts) String() string {
+	return []string{"unspecified", "none", "weekday", "rolling"}[s]
+}
+
+// CloneWorkingSchedule defines a new period and name of the working schedule copy
+// and how shifts of the source working schedule are copied into it.
+type CloneWorkingSchedule struct {
+	Id          int64
+	Name        *string
+	StartDateAt pgtype.Date
+	EndDateAt   pgtype.Date
+	Shifts      WorkingScheduleCloneShifts
+
+	// Weeks is the number of the first source weeks repeated by the rolling pattern.
+	Weeks int
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+)
+
+// WorkingScheduleSnapshot is an immutable copy of all agent shifts of the working schedule at the time of publishing.
+type WorkingScheduleSnapshot struct {
+	Id              int64            `json:"id" db:"id"`
+	DomainId        int64            `json:"domain_id" db:"domain_id"`
+	CreatedAt       pgtype.Timestamp `json:"created_at" db:"created_at,json"`
+	CreatedBy       LookupItem       `json:"created_by" db:"created_by,json"`
+	WorkingSchedule LookupItem       `json:"working_schedule" db:"working_schedule,json"`
+	Version         int32            `json:"version" db:"version"`
+	Note            *string          `json:"note" db:"note"`
+	Shifts          int64            `json:"shifts" db:"shifts"`
+}
+
+func (w *WorkingScheduleSnapshot) MarshalProto() *pb.WorkingScheduleSnapshot {
+	return &pb.WorkingScheduleSnapshot{
+		Id:              w.Id,
+		DomainId:        w.DomainId,
+		CreatedAt:       w.CreatedAt.Time.UnixMilli(),
+		CreatedBy:       w.CreatedBy.MarshalProto(),
+		WorkingSchedule: w.WorkingSchedule.MarshalProto(),
+		Version:         w.Version,
+		Note:            w.Note,
+		Shifts:          w.Shifts,
+	}
+}
+
+type ShiftDiffChange int32
+
+const (
+	ShiftDiffChangeUnspecified ShiftDiffChange = iota
+	ShiftDiffChangeAdded
+	ShiftDiffChangeRemoved
+	ShiftDiffChangeChanged
+)
+
+func (s ShiftDiffChange) String() string {
+	return []string{"unspecified", "added", "removed", "changed"}[s]
+}
+
+// WorkingScheduleShiftDiff is a change of an agent shift between two versions of the working schedule.
+// Before is set unless the shift is added, After is set unless the shift is removed.
+type WorkingScheduleShiftDiff struct {
+	Date   pgtype.Date
+	Change ShiftDiffChange
+	Before *AgentScheduleShift
+	After  *AgentScheduleShift
+}
+
+func (w *WorkingScheduleShiftDiff) MarshalProto() *pb.WorkingScheduleShiftDiff {
+	out := &pb.WorkingScheduleShiftDiff{
+		Date:   w.Date.Time.Unix(),
+		Change: pb.ShiftDiffChange(w.Change),
+	}
+
+	if w.Before != nil {
+		out.Before = w.Before.MarshalProto()
+	}
+
+	if w.After != nil {
+		out.After = w.After.MarshalProto()
+	}
+
+	return out
+}
+
+type WorkingScheduleAgentDiff struct {
+	Agent  LookupItem
+	Shifts []*WorkingScheduleShiftDiff
+}
+
+func (w *WorkingScheduleAgentDiff) MarshalProto() *pb.WorkingScheduleAgentDiff {
+	shifts := make([]*pb.WorkingScheduleShiftDiff, 0, len(w.Shifts))
+	for _, s := range w.Shifts {
+		shifts = append(shifts, s.MarshalProto())
+	}
+
+	return &pb.WorkingScheduleAgentDiff{
+		Agent:  w.Agent.MarshalProto(),
+		Shifts: shifts,
+	}
+}
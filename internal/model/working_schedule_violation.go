@@ -0,0 +1,38 @@
+package model
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+
+	pb "github.com/webitel/webitel-wfm/gen/go/api/wfm"
+)
+
+type ViolationSeverity int32
+
+const (
+	ViolationSeverityUnspecified ViolationSeverity = iota
+	ViolationSeverityWarning
+	ViolationSeverityError
+)
+
+func (s ViolationSeverity) String() string {
+	return []string{"unspecified", "warning", "error"}[s]
+}
+
+// WorkingScheduleViolation describes a rule, that agent schedule breaks on a specific date.
+type WorkingScheduleViolation struct {
+	Agent    LookupItem        `json:"agent" db:"agent,json"`
+	Date     pgtype.Date       `json:"date" db:"date,json"`
+	Rule     string            `json:"rule" db:"rule"`
+	Severity ViolationSeverity `json:"severity" db:"severity"`
+	Message  string            `json:"message" db:"message"`
+}
+
+func (w *WorkingScheduleViolation) MarshalProto() *pb.WorkingScheduleViolation {
+	return &pb.WorkingScheduleViolation{
+		Agent:    w.Agent.MarshalProto(),
+		Date:     w.Date.Time.Unix(),
+		Rule:     w.Rule,
+		Severity: pb.ViolationSeverity(w.Severity),
+		Message:  w.Message,
+	}
+}
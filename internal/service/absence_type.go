@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/storage"
+)
+
+type AbsenceTypeManager interface {
+	CreateAbsenceType(ctx context.Context, read *options.Read, in *model.AbsenceType) (*model.AbsenceType, error)
+	ReadAbsenceType(ctx context.Context, read *options.Read) (*model.AbsenceType, error)
+	SearchAbsenceType(ctx context.Context, search *options.Search) ([]*model.AbsenceType, bool, error)
+	UpdateAbsenceType(ctx context.Context, read *options.Read, in *model.AbsenceType) (*model.AbsenceType, error)
+	DeleteAbsenceType(ctx context.Context, read *options.Read) (int64, error)
+}
+
+type AbsenceType struct {
+	storage storage.AbsenceTypeManager
+}
+
+func NewAbsenceType(storage storage.AbsenceTypeManager) *AbsenceType {
+	return &AbsenceType{
+		storage: storage,
+	}
+}
+
+func (a *AbsenceType) CreateAbsenceType(ctx context.Context, read *options.Read, in *model.AbsenceType) (*model.AbsenceType, error) {
+	id, err := a.storage.CreateAbsenceType(ctx, read.User(), in)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.ReadAbsenceType(ctx, read.WithID(id))
+}
+
+func (a *AbsenceType) ReadAbsenceType(ctx context.Context, read *options.Read) (*model.AbsenceType, error) {
+	item, err := a.storage.ReadAbsenceType(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (a *AbsenceType) SearchAbsenceType(ctx context.Context, search *options.Search) ([]*model.AbsenceType, bool, error) {
+	out, err := a.storage.SearchAbsenceType(ctx, search)
+	if err != nil {
+		return nil, false, err
+	}
+
+	next, out := model.ListResult(int32(search.Size()), out)
+
+	return out, next, nil
+}
+
+func (a *AbsenceType) UpdateAbsenceType(ctx context.Context, read *options.Read, in *model.AbsenceType) (*model.AbsenceType, error) {
+	if err := a.storage.UpdateAbsenceType(ctx, read.User(), in); err != nil {
+		return nil, err
+	}
+
+	return a.ReadAbsenceType(ctx, read)
+}
+
+func (a *AbsenceType) DeleteAbsenceType(ctx context.Context, read *options.Read) (int64, error) {
+	out, err := a.storage.DeleteAbsenceType(ctx, read)
+	if err != nil {
+		return 0, err
+	}
+
+	return out, nil
+}
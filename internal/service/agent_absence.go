@@ -8,42 +8,66 @@ import (
 	"github.com/webitel/webitel-wfm/internal/model"
 	"github.com/webitel/webitel-wfm/internal/model/options"
 	"github.com/webitel/webitel-wfm/internal/storage"
+	"github.com/webitel/webitel-wfm/pkg/werror"
 )
 
 type AgentAbsenceManager interface {
-	CreateAgentAbsence(ctx context.Context, read *options.Read, in *model.Absence) (*model.Absence, error)
+	CreateAgentAbsence(ctx context.Context, read *options.Read, in *model.Absence, allowExceedBalance bool) (*model.Absence, bool, error)
 	ReadAgentAbsence(ctx context.Context, read *options.Read) (*model.Absence, error)
 	SearchAgentAbsence(ctx context.Context, search *options.Search) ([]*model.Absence, error)
 	UpdateAgentAbsence(ctx context.Context, read *options.Read, in *model.Absence) (*model.Absence, error)
 	DeleteAgentAbsence(ctx context.Context, read *options.Read) error
 
+	ReadAgentAbsenceBalance(ctx context.Context, read *options.Read, year int, prorate bool) ([]*model.AgentAbsenceBalance, error)
+
 	CreateAgentsAbsences(ctx context.Context, search *options.Search, in []*model.AgentAbsences) ([]*model.AgentAbsences, error)
 	SearchAgentsAbsences(ctx context.Context, search *options.Search) ([]*model.AgentAbsences, bool, error)
 }
 
 type AgentAbsence struct {
-	storage storage.AgentAbsenceManager
-	audit   *logger.Audit
-	engine  *engine.Client
+	storage         storage.AgentAbsenceManager
+	absenceType     storage.AbsenceTypeManager
+	agentConditions storage.AgentWorkingConditionsManager
+	audit           *logger.Audit
+	engine          *engine.Client
 }
 
-func NewAgentAbsence(storage storage.AgentAbsenceManager, audit *logger.Audit, engine *engine.Client) *AgentAbsence {
+func NewAgentAbsence(storage storage.AgentAbsenceManager, absenceType storage.AbsenceTypeManager, agentConditions storage.AgentWorkingConditionsManager, audit *logger.Audit, engine *engine.Client) *AgentAbsence {
 	return &AgentAbsence{
-		storage: storage,
-		audit:   audit,
-		engine:  engine,
+		storage:         storage,
+		absenceType:     absenceType,
+		agentConditions: agentConditions,
+		audit:           audit,
+		engine:          engine,
 	}
 }
 
-func (a *AgentAbsence) CreateAgentAbsence(ctx context.Context, read *options.Read, in *model.Absence) (*model.Absence, error) {
+// CreateAgentAbsence creates absence and reports whether it exceeds the remaining agent balance.
+// Absence, that exceeds the balance, is rejected unless allowExceedBalance is set.
+func (a *AgentAbsence) CreateAgentAbsence(ctx context.Context, read *options.Read, in *model.Absence, allowExceedBalance bool) (*model.Absence, bool, error) {
+	exceeded, err := a.checkAgentAbsenceBalance(ctx, read, nil, in)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if exceeded && !allowExceedBalance {
+		return nil, false, werror.Wrap(ErrAgentAbsenceBalanceExceeded, werror.WithValue("type", in.AbsenceType.Id),
+			werror.WithValue("year", in.AbsentAt.Time.Year()),
+		)
+	}
+
 	id, err := a.storage.CreateAgentAbsence(ctx, read, in)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
 	read.WithID(id)
+	out, err := a.ReadAgentAbsence(ctx, read)
+	if err != nil {
+		return nil, false, err
+	}
 
-	return a.ReadAgentAbsence(ctx, read)
+	return out, exceeded, nil
 }
 
 func (a *AgentAbsence) ReadAgentAbsence(ctx context.Context, read *options.Read) (*model.Absence, error) {
@@ -54,7 +78,25 @@ func (a *AgentAbsence) SearchAgentAbsence(ctx context.Context, search *options.S
 	return a.storage.SearchAgentAbsence(ctx, search)
 }
 
+// UpdateAgentAbsence updates absence, that doesn't exceed the remaining agent balance
+// with the previous absence given back.
 func (a *AgentAbsence) UpdateAgentAbsence(ctx context.Context, read *options.Read, in *model.Absence) (*model.Absence, error) {
+	current, err := a.storage.ReadAgentAbsence(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	exceeded, err := a.checkAgentAbsenceBalance(ctx, read, current, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if exceeded {
+		return nil, werror.Wrap(ErrAgentAbsenceBalanceExceeded, werror.WithValue("type", in.AbsenceType.Id),
+			werror.WithValue("year", in.AbsentAt.Time.Year()),
+		)
+	}
+
 	if err := a.storage.UpdateAgentAbsence(ctx, read, in); err != nil {
 		return nil, err
 	}
@@ -70,7 +112,24 @@ func (a *AgentAbsence) DeleteAgentAbsence(ctx context.Context, read *options.Rea
 	return nil
 }
 
+// CreateAgentsAbsences creates absences of the agents, if none of them exceeds the remaining agent balance.
 func (a *AgentAbsence) CreateAgentsAbsences(ctx context.Context, search *options.Search, in []*model.AgentAbsences) ([]*model.AgentAbsences, error) {
+	for _, agent := range in {
+		read, err := options.NewRead(ctx, options.WithDerivedID("agent", agent.Agent.Id))
+		if err != nil {
+			return nil, err
+		}
+
+		exceeded, err := a.checkAgentAbsenceBalance(ctx, read, nil, agent.Absence...)
+		if err != nil {
+			return nil, err
+		}
+
+		if exceeded {
+			return nil, werror.Wrap(ErrAgentAbsenceBalanceExceeded, werror.WithValue("agent", agent.Agent.Id))
+		}
+	}
+
 	ids, err := a.storage.CreateAgentsAbsences(ctx, search, in)
 	if err != nil {
 		return nil, err
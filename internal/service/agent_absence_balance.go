@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/pkg"
+	"github.com/webitel/webitel-wfm/pkg/timeutils"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var ErrAgentAbsenceBalanceExceeded = werror.InvalidArgument("invalid input: absence exceeds the remaining agent balance", werror.WithID("service.agent_absence.balance"))
+
+// defaultWorkdayHours is used to count partial-day absences,
+// when the agent working condition doesn't limit workday hours.
+const defaultWorkdayHours = 8
+
+// entitlement returns days per year granted by the working condition allowance of the absence type.
+func entitlement(h *model.AgentWorkingConditionsHistory, absenceType *model.AbsenceType) float64 {
+	switch absenceType.Allowance {
+	case model.AbsenceAllowanceVacation:
+		return float64(pkg.FromPTR(h.Vacation))
+	case model.AbsenceAllowanceSickLeaves:
+		return float64(pkg.FromPTR(h.SickLeaves))
+	}
+
+	return 0
+}
+
+// absenceDays returns the share of the workday, that is covered by the absence.
+// Whole-day absence is always counted as one day, partial-day one is counted by hours
+// of the working condition in effect on the absence date.
+func absenceDays(history []*model.AgentWorkingConditionsHistory, absence *model.Absence) float64 {
+	if absence.Start == nil || absence.End == nil {
+		return 1
+	}
+
+	hours := int32(defaultWorkdayHours)
+	for _, h := range history {
+		if h.StartedAt.Time.After(absence.AbsentAt.Time) {
+			break
+		}
+
+		if h.WorkdayHours != nil && *h.WorkdayHours > 0 {
+			hours = *h.WorkdayHours
+		}
+	}
+
+	return min(float64(absence.Minutes())/float64(hours*60), 1)
+}
+
+// ReadAgentAbsenceBalance computes entitled, used and planned absence days of the agent within a year
+// for each absence type, that counts against the balance.
+// Partial-day absences are counted by hours, so used and planned days may be fractional.
+// Without pro-rating the agent is entitled to the days of the working condition in effect at the end of the year,
+// otherwise days of each working condition are counted proportionally to the period it was in effect.
+// Working condition, that the agent had before the first known change, is considered to be in effect since ever.
+func (a *AgentAbsence) ReadAgentAbsenceBalance(ctx context.Context, read *options.Read, year int, prorate bool) ([]*model.AgentAbsenceBalance, error) {
+	var (
+		agent = read.DerivedByName("agent").ID()
+		from  = time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		to    = from.AddDate(1, 0, 0)
+		today = timeutils.Date(time.Now().UTC())
+	)
+
+	history, err := a.agentConditionsHistory(ctx, agent)
+	if err != nil {
+		return nil, err
+	}
+
+	search, err := options.NewSearch(ctx, options.WithDerivedID("agent", agent), options.WithPagination(1, -1))
+	if err != nil {
+		return nil, err
+	}
+
+	absences, err := a.storage.SearchAgentAbsence(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	absenceTypes, err := a.balanceAbsenceTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.AgentAbsenceBalance, 0, len(absenceTypes))
+	for _, absenceType := range absenceTypes {
+		balance := &model.AgentAbsenceBalance{AbsenceType: *absenceType.Lookup()}
+		for i, h := range history {
+			start, end := h.StartedAt.Time, to
+			if i == 0 || start.Before(from) {
+				start = from
+			}
+
+			if i+1 < len(history) && history[i+1].StartedAt.Time.Before(to) {
+				end = history[i+1].StartedAt.Time
+			}
+
+			if !start.Before(end) {
+				continue
+			}
+
+			if !prorate {
+				balance.Entitled = entitlement(h, absenceType)
+
+				continue
+			}
+
+			balance.Entitled += entitlement(h, absenceType) * end.Sub(start).Hours() / to.Sub(from).Hours()
+		}
+
+		balance.Entitled = math.Round(balance.Entitled*100) / 100
+		for _, absence := range absences {
+			if absence.AbsenceType.Id != absenceType.Id || absence.AbsentAt.Time.Before(from) || !absence.AbsentAt.Time.Before(to) {
+				continue
+			}
+
+			if absence.AbsentAt.Time.After(today) {
+				balance.Planned += absenceDays(history, absence)
+			} else {
+				balance.Used += absenceDays(history, absence)
+			}
+		}
+
+		balance.Used = math.Round(balance.Used*100) / 100
+		balance.Planned = math.Round(balance.Planned*100) / 100
+		out = append(out, balance)
+	}
+
+	return out, nil
+}
+
+// checkAgentAbsenceBalance reports whether absences exceed the remaining agent balance,
+// each absence is counted against the balance of the year it falls into.
+// Replaced absence, if set, is already counted in the balance and is given back before the check.
+func (a *AgentAbsence) checkAgentAbsenceBalance(ctx context.Context, read *options.Read, replaced *model.Absence, in ...*model.Absence) (bool, error) {
+	history, err := a.agentConditionsHistory(ctx, read.DerivedByName("agent").ID())
+	if err != nil {
+		return false, err
+	}
+
+	days := make(map[int]map[int64]float64)
+	count := func(absence *model.Absence, sign float64) {
+		year := absence.AbsentAt.Time.Year()
+		if days[year] == nil {
+			days[year] = make(map[int64]float64)
+		}
+
+		days[year][absence.AbsenceType.Id] += sign * absenceDays(history, absence)
+	}
+
+	for _, absence := range in {
+		count(absence, 1)
+	}
+
+	if replaced != nil {
+		count(replaced, -1)
+	}
+
+	for year, types := range days {
+		balances, err := a.ReadAgentAbsenceBalance(ctx, read, year, true)
+		if err != nil {
+			return false, err
+		}
+
+		for _, balance := range balances {
+			if d, ok := types[balance.AbsenceType.Id]; ok && d > 0 && balance.Remaining() < d {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+// balanceAbsenceTypes returns absence types of the domain, that count against the agent balance.
+func (a *AgentAbsence) balanceAbsenceTypes(ctx context.Context) ([]*model.AbsenceType, error) {
+	search, err := options.NewSearch(ctx, options.WithPagination(1, -1))
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := a.absenceType.SearchAbsenceType(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*model.AbsenceType, 0, len(items))
+	for _, item := range items {
+		if item.CountsAgainstBalance {
+			out = append(out, item)
+		}
+	}
+
+	return out, nil
+}
+
+func (a *AgentAbsence) agentConditionsHistory(ctx context.Context, agent int64) ([]*model.AgentWorkingConditionsHistory, error) {
+	read, err := options.NewRead(ctx, options.WithID(agent))
+	if err != nil {
+		return nil, err
+	}
+
+	return a.agentConditions.SearchAgentWorkingConditionsHistory(ctx, read)
+}
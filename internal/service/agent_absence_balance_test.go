@@ -0,0 +1,96 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/pkg"
+)
+
+func TestEntitlement(t *testing.T) {
+	h := &model.AgentWorkingConditionsHistory{Vacation: pkg.ToPTR(int32(24)), SickLeaves: pkg.ToPTR(int32(10))}
+	tests := map[string]struct {
+		history   *model.AgentWorkingConditionsHistory
+		allowance model.AbsenceAllowance
+		expected  float64
+	}{
+		"vacation": {
+			history:   h,
+			allowance: model.AbsenceAllowanceVacation,
+			expected:  24,
+		},
+		"sick leaves": {
+			history:   h,
+			allowance: model.AbsenceAllowanceSickLeaves,
+			expected:  10,
+		},
+		"unspecified allowance": {
+			history:   h,
+			allowance: model.AbsenceAllowanceUnspecified,
+			expected:  0,
+		},
+		"working condition without allowance": {
+			history:   &model.AgentWorkingConditionsHistory{},
+			allowance: model.AbsenceAllowanceVacation,
+			expected:  0,
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			assert.Equal(t, tt.expected, entitlement(tt.history, &model.AbsenceType{Allowance: tt.allowance}))
+		})
+	}
+}
+
+func TestAbsenceDays(t *testing.T) {
+	history := []*model.AgentWorkingConditionsHistory{
+		{StartedAt: model.NewDate(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC).Unix())},
+		{StartedAt: model.NewDate(time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC).Unix()), WorkdayHours: pkg.ToPTR(int32(6))},
+		{StartedAt: model.NewDate(time.Date(2026, time.June, 1, 0, 0, 0, 0, time.UTC).Unix()), WorkdayHours: pkg.ToPTR(int32(4))},
+	}
+
+	absence := func(month time.Month, start, end *int64) *model.Absence {
+		return &model.Absence{AbsentAt: model.NewDate(time.Date(2026, month, 10, 0, 0, 0, 0, time.UTC).Unix()), Start: start, End: end}
+	}
+
+	tests := map[string]struct {
+		history  []*model.AgentWorkingConditionsHistory
+		absence  *model.Absence
+		expected float64
+	}{
+		"whole-day absence": {
+			history:  history,
+			absence:  absence(time.April, nil, nil),
+			expected: 1,
+		},
+		"default workday hours": {
+			history:  history,
+			absence:  absence(time.February, pkg.ToPTR(int64(540)), pkg.ToPTR(int64(780))),
+			expected: 0.5,
+		},
+		"workday hours of the condition in effect": {
+			history:  history,
+			absence:  absence(time.April, pkg.ToPTR(int64(540)), pkg.ToPTR(int64(720))),
+			expected: 0.5,
+		},
+		"partial absence longer than the workday": {
+			history:  history,
+			absence:  absence(time.July, pkg.ToPTR(int64(540)), pkg.ToPTR(int64(900))),
+			expected: 1,
+		},
+		"no working condition history": {
+			absence:  absence(time.July, pkg.ToPTR(int64(540)), pkg.ToPTR(int64(660))),
+			expected: 0.25,
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, absenceDays(tt.history, tt.absence), 1e-9)
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
+	"github.com/webitel/webitel-wfm/infra/webitel/engine"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/storage"
+	"github.com/webitel/webitel-wfm/pkg/ical"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var ErrAgentCalendarAgent = werror.Forbidden("signed-in user is not an agent", werror.WithID("service.agent_calendar.agent"))
+
+const (
+	agentCalendarProdID = "-//Webitel//WFM//EN"
+
+	// agentCalendarUIDHost makes event UIDs globally unique, UIDs are stable across renders of the calendar,
+	// so calendar clients update events instead of duplicating them.
+	agentCalendarUIDHost = "wfm.webitel"
+
+	// The default calendar period in days before and after the current date.
+	agentCalendarPastDays  = 30
+	agentCalendarAheadDays = 90
+
+	// agentCalendarTokenSize is the number of random bytes of the subscribe token.
+	agentCalendarTokenSize = 32
+)
+
+type AgentCalendarManager interface {
+	ReadAgentCalendar(ctx context.Context, user *model.SignedInUser, search *model.AgentCalendarSearch) (*model.ExportFile, error)
+
+	CreateAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64) (*model.AgentCalendarFeed, error)
+	ReadAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64) (*model.AgentCalendarFeed, error)
+	DeleteAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64) error
+	SubscribeAgentCalendarFeed(ctx context.Context, token string) (*model.ExportFile, error)
+
+	ReadMySchedule(ctx context.Context, user *model.SignedInUser, date model.FilterBetween) (*model.MySchedule, error)
+}
+
+type AgentCalendar struct {
+	storage storage.AgentCalendarManager
+	agent   storage.AgentManager
+	engine  *engine.Client
+}
+
+func NewAgentCalendar(storage storage.AgentCalendarManager, agent storage.AgentManager, engine *engine.Client) *AgentCalendar {
+	return &AgentCalendar{
+		storage: storage,
+		agent:   agent,
+		engine:  engine,
+	}
+}
+
+// ReadAgentCalendar renders shifts, pauses and absences of the agent as an iCalendar file.
+// The period defaults to agentCalendarPastDays before and agentCalendarAheadDays after the current date.
+// Calendar is available to the agent itself and its supervisors.
+func (a *AgentCalendar) ReadAgentCalendar(ctx context.Context, user *model.SignedInUser, search *model.AgentCalendarSearch) (*model.ExportFile, error) {
+	if err := checkAgentOrSupervisor(ctx, a.agent, a.engine, user, search.AgentId); err != nil {
+		return nil, err
+	}
+
+	return a.readAgentCalendar(ctx, user, search)
+}
+
+func (a *AgentCalendar) readAgentCalendar(ctx context.Context, user *model.SignedInUser, search *model.AgentCalendarSearch) (*model.ExportFile, error) {
+	search.Date = agentCalendarPeriod(search.Date)
+	calendar, err := a.storage.ReadAgentCalendar(ctx, user, search)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ExportFile{
+		Name:        fmt.Sprintf("agent_%d.ics", calendar.Agent.Id),
+		ContentType: ical.ContentType,
+		Data:        agentCalendarICS(calendar).Bytes(),
+	}, nil
+}
+
+// CreateAgentCalendarFeed issues a new subscribe token of the agent's calendar,
+// the previous token stops working. Only the hash of the token is stored,
+// so the token is returned only once. Feeds are managed by the agent itself and its supervisors.
+func (a *AgentCalendar) CreateAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64) (*model.AgentCalendarFeed, error) {
+	if err := checkAgentOrSupervisor(ctx, a.agent, a.engine, user, agentId); err != nil {
+		return nil, err
+	}
+
+	token := make([]byte, agentCalendarTokenSize)
+	if _, err := rand.Read(token); err != nil {
+		return nil, err
+	}
+
+	raw := hex.EncodeToString(token)
+	if err := a.storage.CreateAgentCalendarFeed(ctx, user, agentId, agentCalendarTokenHash(raw)); err != nil {
+		return nil, err
+	}
+
+	out, err := a.storage.ReadAgentCalendarFeed(ctx, user, agentId)
+	if err != nil {
+		return nil, err
+	}
+
+	out.Token = raw
+
+	return out, nil
+}
+
+func (a *AgentCalendar) ReadAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64) (*model.AgentCalendarFeed, error) {
+	if err := checkAgentOrSupervisor(ctx, a.agent, a.engine, user, agentId); err != nil {
+		return nil, err
+	}
+
+	return a.storage.ReadAgentCalendarFeed(ctx, user, agentId)
+}
+
+func (a *AgentCalendar) DeleteAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64) error {
+	if err := checkAgentOrSupervisor(ctx, a.agent, a.engine, user, agentId); err != nil {
+		return err
+	}
+
+	return a.storage.DeleteAgentCalendarFeed(ctx, user, agentId)
+}
+
+// SubscribeAgentCalendarFeed renders the agent's calendar by the subscribe token within the default period.
+// The token is the only credential, so the calendar is read within the domain of the feed.
+func (a *AgentCalendar) SubscribeAgentCalendarFeed(ctx context.Context, token string) (*model.ExportFile, error) {
+	feed, err := a.storage.ReadAgentCalendarFeedByToken(ctx, agentCalendarTokenHash(token))
+	if err != nil {
+		return nil, err
+	}
+
+	user := &model.SignedInUser{DomainId: feed.DomainId}
+
+	return a.readAgentCalendar(ctx, user, &model.AgentCalendarSearch{AgentId: feed.Agent.Id})
+}
+
+// ReadMySchedule returns shifts, absences, holidays and shift changes of the agent linked to the signed-in user
+// within the date period, whatever working schedule they belong to. Shifts are localized in the agent's own timezone.
+// The period defaults to the one of ReadAgentCalendar.
+func (a *AgentCalendar) ReadMySchedule(ctx context.Context, user *model.SignedInUser, date model.FilterBetween) (*model.MySchedule, error) {
+	agent, err := a.agent.ReadUserAgent(ctx, user)
+	if err != nil {
+		if werror.Is(err, dbsql.ErrNoRows) {
+			return nil, werror.Wrap(ErrAgentCalendarAgent, werror.WithValue("user", user.Id))
+		}
+
+		return nil, err
+	}
+
+	search := &model.AgentCalendarSearch{AgentId: agent.Id, Date: agentCalendarPeriod(date)}
+	calendar, err := a.storage.ReadAgentCalendar(ctx, user, search)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays, err := a.storage.SearchAgentHolidays(ctx, user, search)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := a.storage.SearchAgentScheduleChanges(ctx, user, search)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := calendar.Location()
+	for _, s := range calendar.Shifts {
+		s.Shift.Localize(loc)
+	}
+
+	return &model.MySchedule{
+		AgentCalendar: *calendar,
+		Holidays:      holidays,
+		Changes:       changes,
+	}, nil
+}
+
+// agentCalendarICS converts the agent's calendar into iCalendar events.
+// Shifts and their pauses are identified by their ids, whole-day absences are all-day events,
+// partial-day absences are placed within the agent's own timezone.
+func agentCalendarICS(calendar *model.AgentCalendar) *ical.Calendar {
+	out := &ical.Calendar{
+		ProdID: agentCalendarProdID,
+		Name:   lookupName(&calendar.Agent),
+	}
+
+	for _, s := range calendar.Shifts {
+		shift := s.Shift
+		if shift == nil || !shift.StartAt.Valid || !shift.EndAt.Valid {
+			continue
+		}
+
+		skills := make([]string, 0, len(shift.Skills))
+		for _, skill := range shift.Skills {
+			if skill.Enabled {
+				skills = append(skills, lookupName(&skill.Skill))
+			}
+		}
+
+		description := "Working schedule: " + lookupName(&s.WorkingSchedule)
+		if len(skills) > 0 {
+			description += "\nSkills: " + strings.Join(skills, ", ")
+		}
+
+		out.Events = append(out.Events, &ical.Event{
+			UID:         fmt.Sprintf("agent-working-schedule-%d@%s", shift.Id, agentCalendarUIDHost),
+			Stamp:       agentCalendarStamp(&shift.DomainRecord),
+			Start:       shift.StartAt.Time,
+			End:         shift.EndAt.Time,
+			Summary:     "Shift",
+			Description: description,
+		})
+
+		for _, pause := range shift.Pauses {
+			if !pause.StartAt.Valid || !pause.EndAt.Valid {
+				continue
+			}
+
+			summary := "Pause"
+			if name := lookupName(pause.Cause); name != "" {
+				summary += ": " + name
+			}
+
+			out.Events = append(out.Events, &ical.Event{
+				UID:     fmt.Sprintf("agent-working-schedule-pause-%d@%s", pause.Id, agentCalendarUIDHost),
+				Stamp:   agentCalendarStamp(&pause.DomainRecord),
+				Start:   pause.StartAt.Time,
+				End:     pause.EndAt.Time,
+				Summary: summary,
+			})
+		}
+	}
+
+	loc := calendar.Location()
+	for _, absence := range calendar.Absences {
+		event := &ical.Event{
+			UID:     fmt.Sprintf("agent-absence-%d@%s", absence.Id, agentCalendarUIDHost),
+			Stamp:   agentCalendarStamp(&absence.DomainRecord),
+			Summary: lookupName(&absence.AbsenceType),
+		}
+
+		if absence.Start != nil && absence.End != nil {
+			event.Start, event.End = shiftInstants(loc, absence.AbsentAt.Time, *absence.Start, *absence.End)
+		} else {
+			event.AllDay = true
+			event.Start, event.End = absence.AbsentAt.Time, absence.AbsentAt.Time.AddDate(0, 0, 1)
+		}
+
+		out.Events = append(out.Events, event)
+	}
+
+	return out
+}
+
+// agentCalendarStamp returns the last modification time of the record,
+// so calendar clients see unchanged events as the same revision.
+func agentCalendarStamp(r *model.DomainRecord) time.Time {
+	if r.UpdatedAt.Valid {
+		return r.UpdatedAt.Time
+	}
+
+	return r.CreatedAt.Time
+}
+
+// agentCalendarPeriod returns the date period, unless it is incomplete,
+// otherwise agentCalendarPastDays before and agentCalendarAheadDays after the current date.
+func agentCalendarPeriod(date model.FilterBetween) model.FilterBetween {
+	if date.From.Valid && date.To.Valid {
+		return date
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	return model.FilterBetween{
+		From: model.NewTimestamp(today.AddDate(0, 0, -agentCalendarPastDays).Unix()),
+		To:   model.NewTimestamp(today.AddDate(0, 0, agentCalendarAheadDays).Unix()),
+	}
+}
+
+// agentCalendarTokenHash returns the hex encoded SHA-256 hash of the subscribe token, that is stored instead of it.
+func agentCalendarTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
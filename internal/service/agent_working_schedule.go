@@ -2,11 +2,16 @@ package service
 
 import (
 	"context"
+	"math"
+	"slices"
+	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/webitel/webitel-wfm/infra/webitel/engine"
 	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
 	"github.com/webitel/webitel-wfm/internal/storage"
 	"github.com/webitel/webitel-wfm/pkg/timeutils"
 	"github.com/webitel/webitel-wfm/pkg/werror"
@@ -15,28 +20,72 @@ import (
 var (
 	ErrAgentWorkingScheduleDateFilter   = werror.InvalidArgument("invalid input: date should be within working schedule period", werror.WithID("service.agent_working_schedule.date"))
 	ErrAgentWorkingScheduleDateShiftMap = werror.InvalidArgument("invalid input: required at least one shift day within date period", werror.WithID("service.agent_working_schedule.shift"))
+	ErrAgentWorkingScheduleDeleteAgents = werror.InvalidArgument("invalid input: required at least one agent", werror.WithID("service.agent_working_schedule.delete_agents"))
+	ErrAgentWorkingScheduleRosterAgents = werror.InvalidArgument("invalid input: no agents of the working schedule are assigned to the roster pattern", werror.WithID("service.agent_working_schedule.roster_agents"))
+	ErrAgentWorkingScheduleRotation     = werror.InvalidArgument("invalid input: shift template has no rotation pattern", werror.WithID("service.agent_working_schedule.rotation"))
+	ErrAgentWorkingScheduleAnchorDate   = werror.InvalidArgument("invalid input: anchor date is required for the shift template rotation", werror.WithID("service.agent_working_schedule.anchor_date"))
+	ErrAgentWorkingScheduleShiftSource  = werror.InvalidArgument("invalid input: exactly one of shifts, shift template or roster pattern should be set", werror.WithID("service.agent_working_schedule.shift_source"))
+	ErrAgentWorkingScheduleShiftTime    = werror.InvalidArgument("invalid input: shift should start within a day, end after its start and last no longer than a day", werror.WithID("service.agent_working_schedule.shift_time"))
+	ErrAgentWorkingSchedulePauseTime    = werror.InvalidArgument("invalid input: pause should end after its start and be within the shift", werror.WithID("service.agent_working_schedule.pause_time"))
+	ErrAgentWorkingScheduleShiftExists  = werror.Aborted("invalid input: agent already has a shift on a desired date", werror.WithID("service.agent_working_schedule.shift_exists"))
 )
 
 type AgentWorkingScheduleManager interface {
-	CreateAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, in *model.CreateAgentsWorkingScheduleShifts) ([]*model.AgentWorkingSchedule, error)
+	CreateAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, in *model.CreateAgentsWorkingScheduleShifts) (*model.CreateAgentsWorkingScheduleShiftsResult, error)
+	ImportAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, in *model.ImportAgentsWorkingScheduleShifts) (*model.ImportAgentsWorkingScheduleShiftsResult, error)
+	UpdateAgentWorkingScheduleShift(ctx context.Context, user *model.SignedInUser, workingScheduleID int64, in *model.AgentScheduleShift) (*model.AgentWorkingSchedule, error)
+	DeleteAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, in *model.DeleteAgentsWorkingScheduleShifts) ([]int64, error)
 	SearchAgentsWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.AgentWorkingScheduleSearch) ([]*model.AgentWorkingSchedule, []*model.Holiday, error)
+	ExportAgentsWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.ExportAgentsWorkingSchedule) (*model.ExportFile, error)
 }
+
 type AgentWorkingSchedule struct {
 	storage                storage.AgentWorkingScheduleManager
 	workingScheduleStorage storage.WorkingScheduleManager
+	agentConditions        storage.AgentWorkingConditionsManager
+	workingCondition       storage.WorkingConditionManager
+	pauseTemplate          storage.PauseTemplateManager
+	shiftTemplate          storage.ShiftTemplateManager
+	rosterPattern          storage.RosterPatternManager
+	workingSchedule        WorkingScheduleManager
 	engine                 *engine.Client
 }
 
-func NewAgentWorkingSchedule(storage storage.AgentWorkingScheduleManager, workingScheduleStorage storage.WorkingScheduleManager, engine *engine.Client) *AgentWorkingSchedule {
+func NewAgentWorkingSchedule(storage storage.AgentWorkingScheduleManager, workingScheduleStorage storage.WorkingScheduleManager, agentConditions storage.AgentWorkingConditionsManager, workingCondition storage.WorkingConditionManager, pauseTemplate storage.PauseTemplateManager, shiftTemplate storage.ShiftTemplateManager, rosterPattern storage.RosterPatternManager, workingSchedule WorkingScheduleManager, engine *engine.Client) *AgentWorkingSchedule {
 	return &AgentWorkingSchedule{
 		storage:                storage,
 		workingScheduleStorage: workingScheduleStorage,
+		agentConditions:        agentConditions,
+		workingCondition:       workingCondition,
+		pauseTemplate:          pauseTemplate,
+		shiftTemplate:          shiftTemplate,
+		rosterPattern:          rosterPattern,
+		workingSchedule:        workingSchedule,
 		engine:                 engine,
 	}
 }
 
-func (a *AgentWorkingSchedule) CreateAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, in *model.CreateAgentsWorkingScheduleShifts) ([]*model.AgentWorkingSchedule, error) {
-	ws, err := a.workingScheduleStorage.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: in.WorkingScheduleID})
+func (a *AgentWorkingSchedule) CreateAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, in *model.CreateAgentsWorkingScheduleShifts) (*model.CreateAgentsWorkingScheduleShiftsResult, error) {
+	var sources int
+	for _, set := range []bool{len(in.Shifts) > 0, in.ShiftTemplateId != nil, in.RosterPatternId != nil} {
+		if set {
+			sources++
+		}
+	}
+
+	if sources != 1 {
+		return nil, werror.Wrap(ErrAgentWorkingScheduleShiftSource, werror.WithValue("sources", sources))
+	}
+
+	for day, segments := range in.Shifts {
+		for _, segment := range segments {
+			if err := validateAgentScheduleShift(segment); err != nil {
+				return nil, werror.Wrap(err, werror.WithValue("day", day))
+			}
+		}
+	}
+
+	ws, err := a.draftWorkingSchedule(ctx, user, in.WorkingScheduleID)
 	if err != nil {
 		return nil, err
 	}
@@ -46,30 +95,353 @@ func (a *AgentWorkingSchedule) CreateAgentsWorkingScheduleShifts(ctx context.Con
 		return nil, ErrAgentWorkingScheduleDateFilter
 	}
 
+	segmentsOf := func(day time.Time) []*model.AgentScheduleShift {
+		return in.Shifts[int64(day.Weekday())]
+	}
+
+	if in.ShiftTemplateId != nil {
+		if !in.AnchorDate.Valid {
+			return nil, werror.Wrap(ErrAgentWorkingScheduleAnchorDate, werror.WithValue("shift_template_id", *in.ShiftTemplateId))
+		}
+
+		if segmentsOf, err = a.rotationShifts(ctx, *in.ShiftTemplateId, in.AnchorDate); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.RosterPatternId != nil {
+		if segmentsOf, err = a.rosterPatternShifts(ctx, *in.RosterPatternId); err != nil {
+			return nil, err
+		}
+
+		if len(in.Agents) == 0 {
+			if in.Agents, err = a.rosterPattern.SearchRosterPatternAgents(ctx, user, *in.RosterPatternId, ws.Id); err != nil {
+				return nil, err
+			}
+
+			if len(in.Agents) == 0 {
+				return nil, werror.Wrap(ErrAgentWorkingScheduleRosterAgents, werror.WithValue("roster_pattern_id", *in.RosterPatternId))
+			}
+		}
+	}
+
+	// Each segment of a split shift is a separate schedule item of the same date.
 	series := period.GenerateSeries(0, 0, 1)
-	schedules := make([]*model.AgentSchedule, 0, len(in.Shifts))
-	for _, time := range series {
-		if v, ok := in.Shifts[int64(time.Weekday())]; ok {
-			schedules = append(schedules, &model.AgentSchedule{
-				Date:  model.NewDate(time.Unix()),
+	days := make([][]*model.AgentSchedule, 0, len(series))
+	for _, day := range series {
+		segments := segmentsOf(day)
+		if len(segments) == 0 {
+			continue
+		}
+
+		schedule := make([]*model.AgentSchedule, 0, len(segments))
+		for _, v := range segments {
+			schedule = append(schedule, &model.AgentSchedule{
+				Date:  model.NewDate(day.Unix()),
 				Shift: v,
 			})
 		}
+
+		days = append(days, schedule)
 	}
 
-	if len(schedules) == 0 {
+	if len(days) == 0 {
 		return nil, ErrAgentWorkingScheduleDateShiftMap
 	}
 
-	agents := make([]*model.AgentWorkingSchedule, 0, len(in.Agents))
+	// Every agent gets the same days.
+	desired := make([]*model.AgentWorkingSchedule, 0, len(in.Agents))
 	for _, agent := range in.Agents {
-		agents = append(agents, &model.AgentWorkingSchedule{
-			Agent:    *agent,
-			Schedule: schedules,
-		})
+		schedule := make([]*model.AgentSchedule, 0, len(days))
+		for _, day := range days {
+			schedule = append(schedule, day...)
+		}
+
+		desired = append(desired, &model.AgentWorkingSchedule{Agent: *agent, Schedule: schedule})
+	}
+
+	return a.createShifts(ctx, user, ws, in.Date, desired, in.Mode, in.PlacePauses, false)
+}
+
+// rotationShifts returns shifts of a day by the rotation pattern of the shift template,
+// the cycle starts at the anchor date and is repeated in both directions.
+func (a *AgentWorkingSchedule) rotationShifts(ctx context.Context, templateId int64, anchor pgtype.Date) (func(day time.Time) []*model.AgentScheduleShift, error) {
+	read, err := options.NewRead(ctx, options.WithID(templateId))
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := a.shiftTemplate.ReadShiftTemplate(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	cycle := len(template.Rotation)
+	if cycle == 0 {
+		return nil, werror.Wrap(ErrAgentWorkingScheduleRotation, werror.WithValue("shift_template_id", templateId))
+	}
+
+	return func(day time.Time) []*model.AgentScheduleShift {
+		offset := int(math.Floor(day.Sub(anchor.Time).Hours()/24)) % cycle
+		if offset < 0 {
+			offset += cycle
+		}
+
+		t := template.Rotation[offset].Time
+		if t == nil {
+			return nil
+		}
+
+		return []*model.AgentScheduleShift{{Start: int64(t.Start), End: int64(t.End)}}
+	}, nil
+}
+
+// rosterPatternShifts returns shifts of a day by the day of week of the roster pattern,
+// shifts keep a reference to the pattern.
+func (a *AgentWorkingSchedule) rosterPatternShifts(ctx context.Context, patternId int64) (func(day time.Time) []*model.AgentScheduleShift, error) {
+	read, err := options.NewRead(ctx, options.WithID(patternId))
+	if err != nil {
+		return nil, err
+	}
+
+	pattern, err := a.rosterPattern.ReadRosterPattern(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	lookup := &model.LookupItem{Id: pattern.Id, Name: &pattern.Name}
+	shifts := make(map[int64][]*model.AgentScheduleShift, len(pattern.Shifts))
+	for day, segments := range pattern.Shifts {
+		for _, s := range segments {
+			shifts[day] = append(shifts[day], s.Shift(lookup))
+		}
+	}
+
+	return func(day time.Time) []*model.AgentScheduleShift {
+		return shifts[int64(day.Weekday())]
+	}, nil
+}
+
+// createShifts writes desired shifts of agents within the date filter,
+// agent days, that already have a shift, are handled according to the mode.
+// Segments of split shifts with the same date are a single agent day, agent schedules should be sorted by date.
+// On a dry run shifts are checked and returned as they would be written.
+func (a *AgentWorkingSchedule) createShifts(ctx context.Context, user *model.SignedInUser, ws *model.WorkingSchedule, date model.FilterBetween, desired []*model.AgentWorkingSchedule, mode model.ShiftConflictMode, placement *model.PausePlacement, dryRun bool) (*model.CreateAgentsWorkingScheduleShiftsResult, error) {
+	lookups := make([]*model.LookupItem, 0, len(desired))
+	for _, agent := range desired {
+		lookups = append(lookups, &agent.Agent)
+	}
+
+	existing, err := a.existingShifts(ctx, user, ws.Id, lookups, date)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make(map[int64]map[string]bool, len(existing))
+	for agentId, schedule := range existing {
+		dates[agentId] = shiftDates(schedule)
+	}
+
+	replace := mode == model.ShiftConflictModeOverwrite
+	out := &model.CreateAgentsWorkingScheduleShiftsResult{}
+	agents := make([]*model.AgentWorkingSchedule, 0, len(desired))
+	for _, d := range desired {
+		var (
+			agent    = d.Agent
+			days     = shiftDays(d.Schedule)
+			created  = &model.AgentScheduleDates{Agent: agent}
+			replaced = &model.AgentScheduleDates{Agent: agent}
+			skipped  = &model.AgentScheduleDates{Agent: agent}
+		)
+
+		agentSchedules := make([]*model.AgentSchedule, 0, len(d.Schedule))
+		for _, day := range days {
+			date := day[0].Date
+			if !dates[agent.Id][date.Time.Format(time.DateOnly)] {
+				created.Dates = append(created.Dates, date)
+				agentSchedules = append(agentSchedules, day...)
+
+				continue
+			}
+
+			switch mode {
+			case model.ShiftConflictModeSkipExisting:
+				skipped.Dates = append(skipped.Dates, date)
+			case model.ShiftConflictModeOverwrite:
+				replaced.Dates = append(replaced.Dates, date)
+				agentSchedules = append(agentSchedules, day...)
+			case model.ShiftConflictModeAppend:
+				created.Dates = append(created.Dates, date)
+				agentSchedules = append(agentSchedules, day...)
+			default:
+				return nil, werror.Wrap(ErrAgentWorkingScheduleShiftExists, werror.WithValue("agent", agent.Id),
+					werror.WithValue("date", date.Time.Format(time.DateOnly)),
+				)
+			}
+		}
+
+		if err := checkShiftOverlap(ws.Location(), agent.Id, existing[agent.Id], agentSchedules, replace); err != nil {
+			return nil, err
+		}
+
+		out.Created = appendAgentScheduleDates(out.Created, created)
+		out.Replaced = appendAgentScheduleDates(out.Replaced, replaced)
+		out.Skipped = appendAgentScheduleDates(out.Skipped, skipped)
+		if len(agentSchedules) > 0 {
+			agents = append(agents, &model.AgentWorkingSchedule{
+				Agent:    agent,
+				Schedule: agentSchedules,
+			})
+		}
+	}
+
+	if len(agents) == 0 {
+		return out, nil
+	}
+
+	if placement != nil {
+		if err := a.placePauses(ctx, user, ws, date, agents, placement, replace); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := a.checkConditionLimits(ctx, user, ws, agents, replace); err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		out.Items = agents
+
+		return out, nil
+	}
+
+	out.Items, err = a.storage.CreateAgentsWorkingScheduleShifts(ctx, user, ws.Id, agents, replace)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// placePauses replaces pauses of the agents shifts with pauses placed from pause templates.
+// Shifts of the working schedule, that aren't replaced, are counted in the staffing to stagger pauses with them.
+// Existing segments of the desired days are replaced only if replace is set.
+func (a *AgentWorkingSchedule) placePauses(ctx context.Context, user *model.SignedInUser, ws *model.WorkingSchedule, date model.FilterBetween, agents []*model.AgentWorkingSchedule, placement *model.PausePlacement, replace bool) error {
+	forecast, err := pausePlacementForecast(ctx, a.workingSchedule, user, ws.Id, &date)
+	if err != nil {
+		return err
+	}
+
+	existing, err := a.storage.SearchAgentWorkingSchedule(ctx, user, &model.AgentWorkingScheduleSearch{
+		SearchItem:        model.SearchItem{Date: withAdjacentDays(&date, 1, 0)},
+		WorkingScheduleId: ws.Id,
+	})
+	if err != nil {
+		return err
+	}
+
+	replaced := make(map[int64]map[string]bool, len(agents))
+	for _, agent := range agents {
+		replaced[agent.Agent.Id] = make(map[string]bool, len(agent.Schedule))
+		for _, s := range agent.Schedule {
+			replaced[agent.Agent.Id][s.Date.Time.Format(time.DateOnly)] = replace
+		}
+	}
+
+	placer := newPausePlacer(a.pauseTemplate, newAgentConditions(a.agentConditions, a.workingCondition), placement, ws.Location(), &date, forecast)
+	for _, e := range existing {
+		for _, s := range e.Schedule {
+			if s.Shift != nil && !replaced[e.Agent.Id][s.Date.Time.Format(time.DateOnly)] {
+				placer.add(s.Date.Time, s.Shift)
+			}
+		}
+	}
+
+	for _, agent := range agents {
+		// Shifts are shared between agents, so each agent gets its own copy with placed pauses.
+		for i, s := range agent.Schedule {
+			shift := *s.Shift
+			shift.Pauses = nil
+			agent.Schedule[i] = &model.AgentSchedule{Date: s.Date, Shift: &shift}
+			placer.add(s.Date.Time, &shift)
+		}
+	}
+
+	for _, agent := range agents {
+		if err := placer.place(ctx, agent.Agent.Id, agent.Schedule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *AgentWorkingSchedule) UpdateAgentWorkingScheduleShift(ctx context.Context, user *model.SignedInUser, workingScheduleID int64, in *model.AgentScheduleShift) (*model.AgentWorkingSchedule, error) {
+	if err := validateAgentScheduleShift(in); err != nil {
+		return nil, err
+	}
+
+	ws, err := a.draftWorkingSchedule(ctx, user, workingScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := a.storage.SearchAgentWorkingSchedule(ctx, user, &model.AgentWorkingScheduleSearch{WorkingScheduleId: ws.Id, Ids: []int64{in.Id}})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		agents := make([]*model.AgentWorkingSchedule, 0, 1)
+		for _, schedule := range item.Schedule {
+			agents = append(agents, &model.AgentWorkingSchedule{
+				Agent:    item.Agent,
+				Schedule: []*model.AgentSchedule{{Date: schedule.Date, Shift: in}},
+			})
+
+			// Other segments of the same day are kept, only the shift with the same id is replaced.
+			existing, err := a.existingShifts(ctx, user, ws.Id, []*model.LookupItem{&item.Agent}, model.FilterBetween{
+				From: model.NewTimestamp(schedule.Date.Time.Unix()),
+				To:   model.NewTimestamp(schedule.Date.Time.Unix()),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if err := checkShiftOverlap(ws.Location(), item.Agent.Id, existing[item.Agent.Id], agents[len(agents)-1].Schedule, false); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := a.checkConditionLimits(ctx, user, ws, agents, false); err != nil {
+			return nil, err
+		}
+	}
+
+	out, err := a.storage.UpdateAgentWorkingScheduleShift(ctx, user, workingScheduleID, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (a *AgentWorkingSchedule) DeleteAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, in *model.DeleteAgentsWorkingScheduleShifts) ([]int64, error) {
+	if len(in.AgentIds) == 0 {
+		return nil, ErrAgentWorkingScheduleDeleteAgents
+	}
+
+	ws, err := a.draftWorkingSchedule(ctx, user, in.WorkingScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	period := timeutils.NewPeriod(in.Date.From.Time, in.Date.To.Time, timeutils.IncludeAll)
+	if !timeutils.NewPeriod(ws.StartDateAt.Time, ws.EndDateAt.Time, timeutils.IncludeAll).Contains(period) {
+		return nil, ErrAgentWorkingScheduleDateFilter
 	}
 
-	out, err := a.storage.CreateAgentsWorkingScheduleShifts(ctx, user, ws.Id, agents)
+	out, err := a.storage.DeleteAgentsWorkingScheduleShifts(ctx, user, in)
 	if err != nil {
 		return nil, err
 	}
@@ -95,13 +467,20 @@ func (a *AgentWorkingSchedule) SearchAgentsWorkingSchedule(ctx context.Context,
 		}
 	}
 
+	// Overnight shifts of the previous day are shown on the first day of the period as well.
+	shifts := *search
+	shifts.SearchItem.Date = withAdjacentDays(search.SearchItem.Date, 1, 0)
+
 	var items []*model.AgentWorkingSchedule
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.Go(func() error {
-		if items, err = a.storage.SearchAgentWorkingSchedule(ctx, user, search); err != nil {
+		if items, err = a.storage.SearchAgentWorkingSchedule(ctx, user, &shifts); err != nil {
 			return err
 		}
 
+		withCarryover(items, search.SearchItem.Date)
+		withLocalTime(items)
+
 		return nil
 	})
 
@@ -120,3 +499,207 @@ func (a *AgentWorkingSchedule) SearchAgentsWorkingSchedule(ctx context.Context,
 
 	return items, holidays, nil
 }
+
+// withLocalTime sets local times of shifts for agents, that have their own timezone.
+// Shift minutes stay in the calendar timezone of the working schedule as the reference time.
+func withLocalTime(items []*model.AgentWorkingSchedule) {
+	for _, item := range items {
+		if item.Timezone == nil {
+			continue
+		}
+
+		loc, err := time.LoadLocation(*item.Timezone)
+		if err != nil {
+			continue
+		}
+
+		for _, s := range item.Schedule {
+			if s.Shift != nil {
+				s.Shift.Localize(loc)
+			}
+		}
+	}
+}
+
+// draftWorkingSchedule reads working schedule and checks if its shifts can be changed,
+// the same rule as for the working schedule itself.
+func (a *AgentWorkingSchedule) draftWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error) {
+	ws, err := a.workingScheduleStorage.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if ws.State != model.WorkingScheduleStateDraft {
+		return nil, werror.Wrap(ErrWorkingScheduleUpdateDraft, werror.WithValue("state", ws.State.String()))
+	}
+
+	return ws, nil
+}
+
+// existingShifts returns already existing shifts by agent.
+// Shifts of the adjacent days are included to check overlaps with overnight shifts.
+func (a *AgentWorkingSchedule) existingShifts(ctx context.Context, user *model.SignedInUser, workingScheduleID int64, agents []*model.LookupItem, date model.FilterBetween) (map[int64][]*model.AgentSchedule, error) {
+	agentIds := make([]int64, 0, len(agents))
+	for _, agent := range agents {
+		agentIds = append(agentIds, agent.Id)
+	}
+
+	search := &model.AgentWorkingScheduleSearch{
+		SearchItem:        model.SearchItem{Date: withAdjacentDays(&date, 1, 1)},
+		WorkingScheduleId: workingScheduleID,
+		AgentIds:          agentIds,
+	}
+
+	items, err := a.storage.SearchAgentWorkingSchedule(ctx, user, search)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[int64][]*model.AgentSchedule, len(items))
+	for _, item := range items {
+		for _, schedule := range item.Schedule {
+			if schedule.Shift != nil {
+				out[item.Agent.Id] = append(out[item.Agent.Id], schedule)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// shiftDates returns dates (in time.DateOnly format) of the agent shifts.
+func shiftDates(schedule []*model.AgentSchedule) map[string]bool {
+	out := make(map[string]bool, len(schedule))
+	for _, s := range schedule {
+		out[s.Date.Time.Format(time.DateOnly)] = true
+	}
+
+	return out
+}
+
+// validateAgentScheduleShift checks, that the shift starts within a day, ends after its start
+// and lasts no longer than a day, so overnight shifts end no later than 2880, pauses should be within the shift.
+func validateAgentScheduleShift(in *model.AgentScheduleShift) error {
+	if in.Start < 0 || in.Start >= model.MinutesPerDay || in.Start >= in.End || in.End-in.Start > model.MinutesPerDay {
+		return werror.Wrap(ErrAgentWorkingScheduleShiftTime, werror.WithValue("start", in.Start), werror.WithValue("end", in.End))
+	}
+
+	for _, p := range in.Pauses {
+		if p.Start >= p.End || p.Start < in.Start || p.End > in.End {
+			return werror.Wrap(ErrAgentWorkingSchedulePauseTime, werror.WithValue("start", p.Start), werror.WithValue("end", p.End))
+		}
+	}
+
+	return nil
+}
+
+// checkShiftOverlap rejects desired shifts of the agent, that overlap each other or existing shifts.
+func checkShiftOverlap(loc *time.Location, agentId int64, existing, desired []*model.AgentSchedule, replace bool) error {
+	changed := make(map[*model.AgentSchedule]bool, len(desired))
+	for _, s := range desired {
+		changed[s] = true
+	}
+
+	s, ok := overlappingShift(loc, mergeShifts(existing, desired, replace), func(s *model.AgentSchedule) bool { return changed[s] })
+	if ok {
+		return werror.Wrap(ErrAgentWorkingScheduleShiftOverlap, werror.WithValue("agent", agentId),
+			werror.WithValue("date", s.Date.Time.Format(time.DateOnly)),
+		)
+	}
+
+	return nil
+}
+
+// checkConditionLimits merges desired shifts with the existing ones of all working schedules within the same months
+// and rejects them if any agent exceeds limits of its working condition.
+// Existing segments of the desired dates are replaced only if replace is set.
+func (a *AgentWorkingSchedule) checkConditionLimits(ctx context.Context, user *model.SignedInUser, ws *model.WorkingSchedule, agents []*model.AgentWorkingSchedule, replace bool) error {
+	conditions := newAgentConditions(a.agentConditions, a.workingCondition)
+	for _, agent := range agents {
+		condition, err := conditions.read(ctx, agent.Agent.Id)
+		if err != nil {
+			return err
+		}
+
+		if condition == nil || len(agent.Schedule) == 0 {
+			continue
+		}
+
+		// Limits are monthly, so existing shifts are taken for whole months of desired ones.
+		from, to := agent.Schedule[0].Date.Time, agent.Schedule[0].Date.Time
+		for _, schedule := range agent.Schedule {
+			if schedule.Date.Time.Before(from) {
+				from = schedule.Date.Time
+			}
+
+			if schedule.Date.Time.After(to) {
+				to = schedule.Date.Time
+			}
+		}
+
+		// Shifts of other pending and active working schedules count against the same limits, but aren't replaced.
+		search := &model.AgentWorkingScheduleSearch{
+			SearchItem: model.SearchItem{
+				Date: &model.FilterBetween{
+					From: model.NewTimestamp(time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, from.Location()).Unix()),
+					To:   model.NewTimestamp(time.Date(to.Year(), to.Month()+1, 0, 0, 0, 0, 0, to.Location()).Unix()),
+				},
+			},
+			WorkingScheduleStates: []model.WorkingScheduleState{model.WorkingScheduleStatePending, model.WorkingScheduleStateActive},
+			AgentIds:              []int64{agent.Agent.Id},
+		}
+
+		all, err := a.storage.SearchAgentWorkingSchedule(ctx, user, search)
+		if err != nil {
+			return err
+		}
+
+		search.WorkingScheduleId, search.WorkingScheduleStates = ws.Id, nil
+		existing, err := a.storage.SearchAgentWorkingSchedule(ctx, user, search)
+		if err != nil {
+			return err
+		}
+
+		var schedule []*model.AgentSchedule
+		current := make(map[int64]bool)
+		for _, e := range existing {
+			for _, s := range e.Schedule {
+				if s.Shift != nil {
+					schedule = append(schedule, s)
+					current[s.Shift.Id] = true
+				}
+			}
+		}
+
+		var others []*model.AgentSchedule
+		for _, e := range all {
+			for _, s := range e.Schedule {
+				if s.Shift != nil && !current[s.Shift.Id] {
+					others = append(others, s)
+				}
+			}
+		}
+
+		merged := &model.AgentWorkingSchedule{Agent: agent.Agent, Schedule: append(mergeShifts(schedule, agent.Schedule, replace), others...)}
+		slices.SortStableFunc(merged.Schedule, func(a, b *model.AgentSchedule) int {
+			return a.Date.Time.Compare(b.Date.Time)
+		})
+
+		if v := changedViolation(checkConditionLimits(merged, condition, ws.Location()), agent.Schedule); v != nil {
+			return werror.Wrap(ErrAgentWorkingScheduleConditionLimit, werror.WithValue("agent", agent.Agent.Id),
+				werror.WithValue("date", v.Date.Time.Format(time.DateOnly)),
+				werror.WithValue("rule", v.Rule),
+			)
+		}
+	}
+
+	return nil
+}
+
+func appendAgentScheduleDates(in []*model.AgentScheduleDates, dates *model.AgentScheduleDates) []*model.AgentScheduleDates {
+	if len(dates.Dates) == 0 {
+		return in
+	}
+
+	return append(in, dates)
+}
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/pkg/spreadsheet"
+	"github.com/webitel/webitel-wfm/pkg/timeutils"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var ErrAgentWorkingScheduleExportFile = werror.Internal("unable to write the export file", werror.WithID("service.agent_working_schedule.export.file"))
+
+// ExportAgentsWorkingSchedule exports agent shifts of the working schedule into a spreadsheet file,
+// either as a grid of agents and dates or as a roster with a row per shift.
+func (a *AgentWorkingSchedule) ExportAgentsWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.ExportAgentsWorkingSchedule) (*model.ExportFile, error) {
+	ws, err := a.workingScheduleStorage.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: in.WorkingScheduleID})
+	if err != nil {
+		return nil, err
+	}
+
+	// The date filter is bounded by the working schedule period.
+	date := &model.FilterBetween{
+		From: model.NewTimestamp(ws.StartDateAt.Time.Unix()),
+		To:   model.NewTimestamp(ws.EndDateAt.Time.Unix()),
+	}
+
+	if in.Date != nil {
+		if in.Date.From.Valid && in.Date.From.Time.After(date.From.Time) {
+			date.From = in.Date.From
+		}
+
+		if in.Date.To.Valid && in.Date.To.Time.Before(date.To.Time) {
+			date.To = in.Date.To
+		}
+
+		if date.From.Time.After(date.To.Time) {
+			return nil, ErrAgentWorkingScheduleDateFilter
+		}
+	}
+
+	items, holidays, err := a.SearchAgentsWorkingSchedule(ctx, user, &model.AgentWorkingScheduleSearch{
+		SearchItem:        model.SearchItem{Date: date},
+		WorkingScheduleId: ws.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(items, func(a, b *model.AgentWorkingSchedule) int {
+		return cmp.Or(strings.Compare(lookupName(&a.Agent), lookupName(&b.Agent)), cmp.Compare(a.Agent.Id, b.Agent.Id))
+	})
+
+	var rows [][]string
+	switch in.Layout {
+	case model.ScheduleExportLayoutFlat:
+		rows = exportRoster(items)
+	default:
+		rows = exportGrid(date, items, holidays)
+	}
+
+	for _, row := range rows {
+		for i, v := range row {
+			row[i] = exportCell(v)
+		}
+	}
+
+	format := in.Format
+	if format == spreadsheet.FormatUnspecified {
+		format = spreadsheet.FormatCSV
+	}
+
+	data, err := spreadsheet.Write(rows, format)
+	if err != nil {
+		return nil, werror.Wrap(ErrAgentWorkingScheduleExportFile, werror.WithCause(err))
+	}
+
+	return &model.ExportFile{
+		Name:        fmt.Sprintf("%s_%s_%s.%s", exportFileName(ws.Name), date.From.Time.Format(time.DateOnly), date.To.Time.Format(time.DateOnly), format),
+		ContentType: format.ContentType(),
+		Data:        data,
+	}, nil
+}
+
+// exportGrid returns rows of agents and columns of dates. Holidays are listed in the row after the header.
+// Cells hold shift times, absence type or "locked", overnight shifts are shown on their start date.
+func exportGrid(date *model.FilterBetween, items []*model.AgentWorkingSchedule, holidays []*model.Holiday) [][]string {
+	dates := timeutils.NewPeriod(date.From.Time, date.To.Time, timeutils.IncludeAll).GenerateSeries(0, 0, 1)
+	columns := make(map[string]int, len(dates))
+	header := append(make([]string, 0, len(dates)+1), "agent")
+	for i, d := range dates {
+		columns[d.Format(time.DateOnly)] = i + 1
+		header = append(header, d.Format(time.DateOnly))
+	}
+
+	rows := [][]string{header}
+	if len(holidays) > 0 {
+		row := make([]string, len(header))
+		row[0] = "holiday"
+		for _, h := range holidays {
+			if i, ok := columns[h.Date.Time.Format(time.DateOnly)]; ok {
+				row[i] = h.Name
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	for _, item := range items {
+		days := make([][]*model.AgentSchedule, len(header))
+		for _, s := range item.Schedule {
+			if i, ok := columns[s.Date.Time.Format(time.DateOnly)]; ok && !s.Carryover {
+				days[i] = append(days[i], s)
+			}
+		}
+
+		row := make([]string, len(header))
+		row[0] = lookupName(&item.Agent)
+		for i, day := range days[1:] {
+			row[i+1] = exportGridCell(day)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// exportGridCell lists absences first and then shifts in their order within the day.
+func exportGridCell(day []*model.AgentSchedule) string {
+	slices.SortStableFunc(day, func(a, b *model.AgentSchedule) int {
+		if a.Shift == nil || b.Shift == nil {
+			return cmp.Compare(exportGridOrder(a), exportGridOrder(b))
+		}
+
+		return cmp.Compare(a.Shift.Start, b.Shift.Start)
+	})
+
+	parts := make([]string, 0, len(day))
+	for _, s := range day {
+		switch {
+		case s.Locked:
+			parts = append(parts, "locked")
+		case s.PartialAbsence():
+			parts = append(parts, fmt.Sprintf("%s %s-%s", lookupName(s.Absence), spreadsheet.FormatClock(*s.AbsenceStart), spreadsheet.FormatClock(*s.AbsenceEnd)))
+		case s.Absence != nil:
+			parts = append(parts, lookupName(s.Absence))
+		case s.Shift != nil:
+			parts = append(parts, fmt.Sprintf("%s-%s", spreadsheet.FormatClock(s.Shift.Start), spreadsheet.FormatClock(s.Shift.End)))
+		}
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func exportGridOrder(s *model.AgentSchedule) int {
+	switch {
+	case s.Locked:
+		return 0
+	case s.Absence != nil:
+		return 1
+	}
+
+	return 2
+}
+
+// exportRoster returns a row per shift in the roster format of the import,
+// agents are referenced by their ids and the agent name is informational.
+func exportRoster(items []*model.AgentWorkingSchedule) [][]string {
+	rows := [][]string{{rosterColumnAgent, "agent_name", rosterColumnDate, rosterColumnStart, rosterColumnEnd, rosterColumnPauses, rosterColumnSkills}}
+	for _, item := range items {
+		for _, day := range shiftDays(item.Schedule) {
+			slices.SortFunc(day, func(a, b *model.AgentSchedule) int {
+				return cmp.Compare(a.Shift.Start, b.Shift.Start)
+			})
+
+			for _, s := range day {
+				if s.Carryover {
+					continue
+				}
+
+				pauses := make([]string, 0, len(s.Shift.Pauses))
+				for _, p := range s.Shift.Pauses {
+					pause := fmt.Sprintf("%s-%s", spreadsheet.FormatClock(p.Start), spreadsheet.FormatClock(p.End))
+					if p.Cause != nil && p.Cause.Id != 0 {
+						pause += "/" + strconv.FormatInt(p.Cause.Id, 10)
+					}
+
+					pauses = append(pauses, pause)
+				}
+
+				skills := make([]string, 0, len(s.Shift.Skills))
+				for _, sk := range s.Shift.Skills {
+					skill := fmt.Sprintf("%d:%d", sk.Skill.Id, sk.Capacity)
+					if !sk.Enabled {
+						skill += ":" + rosterSkillDisabled
+					}
+
+					skills = append(skills, skill)
+				}
+
+				rows = append(rows, []string{
+					strconv.FormatInt(item.Agent.Id, 10),
+					lookupName(&item.Agent),
+					s.Date.Time.Format(time.DateOnly),
+					spreadsheet.FormatClock(s.Shift.Start),
+					spreadsheet.FormatClock(s.Shift.End),
+					strings.Join(pauses, "; "),
+					strings.Join(skills, "; "),
+				})
+			}
+		}
+	}
+
+	return rows
+}
+
+// exportCell prefixes values, that spreadsheet applications would evaluate as formulas, with a single quote.
+func exportCell(v string) string {
+	if v != "" && strings.ContainsRune("=+-@", rune(v[0])) {
+		return "'" + v
+	}
+
+	return v
+}
+
+func lookupName(l *model.LookupItem) string {
+	if l == nil || l.Name == nil {
+		return ""
+	}
+
+	return *l.Name
+}
+
+// exportFileName replaces characters, that aren't safe within file names.
+func exportFileName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>| `, r) {
+			return '_'
+		}
+
+		return r
+	}, name)
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportCell(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		expected string
+	}{
+		"empty":         {},
+		"shift times":   {value: "09:00-18:00", expected: "09:00-18:00"},
+		"agent name":    {value: "John Doe", expected: "John Doe"},
+		"formula":       {value: "=HYPERLINK(\"x\")", expected: "'=HYPERLINK(\"x\")"},
+		"plus sign":     {value: "+1", expected: "'+1"},
+		"minus sign":    {value: "-1+2", expected: "'-1+2"},
+		"at sign":       {value: "@SUM(A1)", expected: "'@SUM(A1)"},
+		"inner formula": {value: "a=b", expected: "a=b"},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			assert.Equal(t, tt.expected, exportCell(tt.value))
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/pkg/spreadsheet"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var (
+	ErrAgentWorkingScheduleImportFile   = werror.InvalidArgument("invalid input: unable to read the roster file", werror.WithID("service.agent_working_schedule.import.file"))
+	ErrAgentWorkingScheduleImportHeader = werror.InvalidArgument("invalid input: roster header should have agent, date, start and end columns", werror.WithID("service.agent_working_schedule.import.header"))
+)
+
+// Roster columns, optional columns may be omitted from the header.
+const (
+	rosterColumnAgent  = "agent"
+	rosterColumnDate   = "date"
+	rosterColumnStart  = "start"
+	rosterColumnEnd    = "end"
+	rosterColumnPauses = "pauses"
+	rosterColumnSkills = "skills"
+)
+
+// rosterSkillDisabled marks a disabled skill of the shift within the skills column.
+const rosterSkillDisabled = "disabled"
+
+// ImportAgentsWorkingScheduleShifts imports agent shifts from a roster file.
+// Each row is a shift or a segment of a split shift, agents are resolved by their ids or names
+// among agents of the working schedule. Nothing is written if any row has errors.
+func (a *AgentWorkingSchedule) ImportAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, in *model.ImportAgentsWorkingScheduleShifts) (*model.ImportAgentsWorkingScheduleShiftsResult, error) {
+	ws, err := a.draftWorkingSchedule(ctx, user, in.WorkingScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := spreadsheet.Read(in.File, in.Format)
+	if err != nil {
+		return nil, werror.Wrap(ErrAgentWorkingScheduleImportFile, werror.WithCause(err))
+	}
+
+	columns, err := rosterColumns(rows[0])
+	if err != nil {
+		return nil, err
+	}
+
+	agents, err := a.rosterAgents(ctx, ws)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		out      = &model.ImportAgentsWorkingScheduleShiftsResult{Rows: int64(len(rows) - 1)}
+		desired  = make(map[int64]*model.AgentWorkingSchedule)
+		order    []int64
+		from, to time.Time
+	)
+
+	for i, cells := range rows[1:] {
+		if slices.IndexFunc(cells, func(c string) bool { return strings.TrimSpace(c) != "" }) < 0 {
+			continue
+		}
+
+		row := &rosterRow{num: int64(i + 2), cells: cells, columns: columns}
+		agent, date, shift := row.agent(agents), row.date(ws), row.shift()
+		if len(row.errors) > 0 {
+			out.Errors = append(out.Errors, row.errors...)
+
+			continue
+		}
+
+		if _, ok := desired[agent.Id]; !ok {
+			desired[agent.Id] = &model.AgentWorkingSchedule{Agent: *agent}
+			order = append(order, agent.Id)
+		}
+
+		desired[agent.Id].Schedule = append(desired[agent.Id].Schedule, &model.AgentSchedule{
+			Date:  model.NewDate(date.Unix()),
+			Shift: shift,
+		})
+
+		if from.IsZero() || date.Before(from) {
+			from = date
+		}
+
+		if date.After(to) {
+			to = date
+		}
+	}
+
+	if len(out.Errors) > 0 || len(desired) == 0 {
+		return out, nil
+	}
+
+	items := make([]*model.AgentWorkingSchedule, 0, len(order))
+	for _, agentId := range order {
+		item := desired[agentId]
+		slices.SortStableFunc(item.Schedule, func(a, b *model.AgentSchedule) int {
+			if c := a.Date.Time.Compare(b.Date.Time); c != 0 {
+				return c
+			}
+
+			return int(a.Shift.Start - b.Shift.Start)
+		})
+
+		items = append(items, item)
+	}
+
+	date := model.FilterBetween{From: model.NewTimestamp(from.Unix()), To: model.NewTimestamp(to.Unix())}
+	res, err := a.createShifts(ctx, user, ws, date, items, in.Mode, nil, in.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	out.CreateAgentsWorkingScheduleShiftsResult = *res
+
+	return out, nil
+}
+
+// rosterColumns returns indexes of roster columns by the header row.
+func rosterColumns(header []string) (map[string]int, error) {
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	for _, required := range []string{rosterColumnAgent, rosterColumnDate, rosterColumnStart, rosterColumnEnd} {
+		if _, ok := columns[required]; !ok {
+			return nil, werror.Wrap(ErrAgentWorkingScheduleImportHeader, werror.WithValue("column", required))
+		}
+	}
+
+	return columns, nil
+}
+
+// rosterAgentLookups holds agents of the working schedule by their ids and lowercase names.
+type rosterAgentLookups struct {
+	ids   map[int64]*model.LookupItem
+	names map[string][]*model.LookupItem
+}
+
+// rosterAgents resolves agents of the working schedule through the engine.
+func (a *AgentWorkingSchedule) rosterAgents(ctx context.Context, ws *model.WorkingSchedule) (*rosterAgentLookups, error) {
+	out := &rosterAgentLookups{
+		ids:   make(map[int64]*model.LookupItem, len(ws.Agents)),
+		names: make(map[string][]*model.LookupItem, len(ws.Agents)),
+	}
+
+	if len(ws.Agents) == 0 {
+		return out, nil
+	}
+
+	ids := make([]int64, 0, len(ws.Agents))
+	for _, agent := range ws.Agents {
+		ids = append(ids, agent.Id)
+	}
+
+	agents, err := a.engine.AgentService().AgentLookups(ctx, &model.AgentSearch{Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, agent := range agents {
+		out.ids[agent.Id] = agent
+		if agent.Name != nil {
+			name := strings.ToLower(strings.TrimSpace(*agent.Name))
+			out.names[name] = append(out.names[name], agent)
+		}
+	}
+
+	return out, nil
+}
+
+// rosterRow parses cells of a roster row and collects their errors.
+type rosterRow struct {
+	num     int64
+	cells   []string
+	columns map[string]int
+	errors  []*model.ImportRowError
+}
+
+func (r *rosterRow) cell(column string) string {
+	i, ok := r.columns[column]
+	if !ok || i >= len(r.cells) {
+		return ""
+	}
+
+	return strings.TrimSpace(r.cells[i])
+}
+
+func (r *rosterRow) fail(column string, format string, args ...any) {
+	r.errors = append(r.errors, &model.ImportRowError{
+		Row:     r.num,
+		Column:  column,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// agent resolves the agent by its id or name, names should be unique within the working schedule.
+func (r *rosterRow) agent(agents *rosterAgentLookups) *model.LookupItem {
+	v := r.cell(rosterColumnAgent)
+	if v == "" {
+		r.fail(rosterColumnAgent, "agent is required")
+
+		return nil
+	}
+
+	if id, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if agent, ok := agents.ids[id]; ok {
+			return agent
+		}
+	}
+
+	switch found := agents.names[strings.ToLower(v)]; len(found) {
+	case 0:
+		r.fail(rosterColumnAgent, "agent %q is not in the working schedule", v)
+	case 1:
+		return found[0]
+	default:
+		r.fail(rosterColumnAgent, "agent name %q is ambiguous, use the agent id", v)
+	}
+
+	return nil
+}
+
+// date parses the shift date, which should be within the working schedule period.
+func (r *rosterRow) date(ws *model.WorkingSchedule) time.Time {
+	date, err := spreadsheet.ParseDate(r.cell(rosterColumnDate))
+	if err != nil {
+		r.fail(rosterColumnDate, "%s", err)
+
+		return time.Time{}
+	}
+
+	if date.Before(ws.StartDateAt.Time) || date.After(ws.EndDateAt.Time) {
+		r.fail(rosterColumnDate, "date %s is out of the working schedule period", date.Format(time.DateOnly))
+	}
+
+	return date
+}
+
+func (r *rosterRow) clock(column string) (int64, bool) {
+	minutes, err := spreadsheet.ParseClock(r.cell(column))
+	if err != nil {
+		r.fail(column, "%s", err)
+
+		return 0, false
+	}
+
+	return minutes, true
+}
+
+// shift parses the shift with its pauses and skills.
+// The shift is overnight if it doesn't end after the start, pauses are within the shift.
+func (r *rosterRow) shift() *model.AgentScheduleShift {
+	start, startOk := r.clock(rosterColumnStart)
+	end, endOk := r.clock(rosterColumnEnd)
+	if !startOk || !endOk {
+		return nil
+	}
+
+	if start >= model.MinutesPerDay {
+		r.fail(rosterColumnStart, "shift should start before 24:00")
+
+		return nil
+	}
+
+	if end <= start {
+		end += model.MinutesPerDay
+	}
+
+	shift := &model.AgentScheduleShift{Start: start, End: end}
+	for _, v := range rosterList(r.cell(rosterColumnPauses)) {
+		if pause := r.pause(shift, v); pause != nil {
+			shift.Pauses = append(shift.Pauses, pause)
+		}
+	}
+
+	for _, v := range rosterList(r.cell(rosterColumnSkills)) {
+		if skill := r.skill(v); skill != nil {
+			shift.Skills = append(shift.Skills, skill)
+		}
+	}
+
+	return shift
+}
+
+// pause parses a pause in HH:MM-HH:MM[/cause id] format.
+func (r *rosterRow) pause(shift *model.AgentScheduleShift, v string) *model.AgentScheduleShiftPause {
+	bounds, cause, _ := strings.Cut(v, "/")
+	s, e, ok := strings.Cut(bounds, "-")
+	if !ok {
+		r.fail(rosterColumnPauses, "invalid pause %q, expected HH:MM-HH:MM", v)
+
+		return nil
+	}
+
+	start, serr := spreadsheet.ParseClock(s)
+	end, eerr := spreadsheet.ParseClock(e)
+	if serr != nil || eerr != nil {
+		r.fail(rosterColumnPauses, "invalid pause %q, expected HH:MM-HH:MM", v)
+
+		return nil
+	}
+
+	// Pauses of overnight shifts after midnight belong to the next day.
+	if start < shift.Start {
+		start += model.MinutesPerDay
+	}
+
+	if end <= start {
+		end += model.MinutesPerDay
+	}
+
+	if start < shift.Start || end > shift.End {
+		r.fail(rosterColumnPauses, "pause %q is out of the shift", v)
+
+		return nil
+	}
+
+	pause := &model.AgentScheduleShiftPause{Start: start, End: end}
+	if cause = strings.TrimSpace(cause); cause != "" {
+		id, err := strconv.ParseInt(cause, 10, 64)
+		if err != nil || id <= 0 {
+			r.fail(rosterColumnPauses, "invalid pause cause %q, expected an id", cause)
+
+			return nil
+		}
+
+		pause.Cause = &model.LookupItem{Id: id}
+	}
+
+	return pause
+}
+
+// skill parses a skill in "id:capacity[:disabled]" format, skills are enabled unless marked as disabled.
+func (r *rosterRow) skill(v string) *model.AgentScheduleShiftSkill {
+	s, c, _ := strings.Cut(v, ":")
+	c, state, _ := strings.Cut(c, ":")
+	id, ierr := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	capacity, cerr := strconv.ParseInt(strings.TrimSpace(c), 10, 64)
+	state = strings.TrimSpace(state)
+	if ierr != nil || cerr != nil || id <= 0 || capacity <= 0 || (state != "" && state != rosterSkillDisabled) {
+		r.fail(rosterColumnSkills, "invalid skill %q, expected id:capacity[:disabled]", v)
+
+		return nil
+	}
+
+	return &model.AgentScheduleShiftSkill{
+		Skill:    model.LookupItem{Id: id},
+		Capacity: capacity,
+		Enabled:  state != rosterSkillDisabled,
+	}
+}
+
+// rosterList splits a cell with a list of values separated by semicolons or commas.
+func rosterList(v string) []string {
+	var out []string
+	for _, item := range strings.FieldsFunc(v, func(r rune) bool { return r == ';' || r == ',' }) {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/webitel/webitel-wfm/gen/go/mocks/internal_/storage"
+	"github.com/webitel/webitel-wfm/infra/server/grpccontext"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+func readWithID(id int64) any {
+	return mock.MatchedBy(func(read *options.Read) bool {
+		return read.ID() == id
+	})
+}
+
+func TestRotationShifts(t *testing.T) {
+	ctx := grpccontext.SetUser(context.Background(), &model.SignedInUser{Id: 1, DomainId: 1})
+	anchor := model.NewDate(time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC).Unix())
+
+	// Two days on, one day off.
+	rotation := []model.ShiftTemplateRotationDay{
+		{Time: &model.ShiftTemplateTime{Start: 540, End: 1080}},
+		{Time: &model.ShiftTemplateTime{Start: 1320, End: 1860}},
+		{},
+	}
+
+	tests := map[string]struct {
+		rotation []model.ShiftTemplateRotationDay
+		days     map[string][]int64
+		err      error
+	}{
+		"empty rotation": {
+			err: ErrAgentWorkingScheduleRotation,
+		},
+		"rotation cycle": {
+			rotation: rotation,
+			days: map[string][]int64{
+				"2026-03-02": {540, 1080},
+				"2026-03-03": {1320, 1860},
+				"2026-03-04": nil,
+				"2026-03-05": {540, 1080},
+				"2026-03-30": {1320, 1860},
+			},
+		},
+		"days before the anchor date": {
+			rotation: rotation,
+			days: map[string][]int64{
+				"2026-03-01": nil,
+				"2026-02-28": {1320, 1860},
+				"2026-02-27": {540, 1080},
+				"2026-02-26": nil,
+			},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			shiftTemplate := mocks.NewMockShiftTemplateManager(t)
+			shiftTemplate.EXPECT().ReadShiftTemplate(mock.Anything, readWithID(1)).Return(&model.ShiftTemplate{Rotation: tt.rotation}, nil)
+
+			a := &AgentWorkingSchedule{shiftTemplate: shiftTemplate}
+			shifts, err := a.rotationShifts(ctx, 1, anchor)
+			if tt.err != nil {
+				require.True(t, werror.Is(err, tt.err))
+
+				return
+			}
+
+			require.NoError(t, err)
+			for day, expected := range tt.days {
+				date, err := time.Parse(time.DateOnly, day)
+				require.NoError(t, err)
+
+				out := shifts(date)
+				if expected == nil {
+					assert.Empty(t, out, day)
+
+					continue
+				}
+
+				require.Len(t, out, 1, day)
+				assert.Equal(t, expected, []int64{out[0].Start, out[0].End}, day)
+			}
+		})
+	}
+}
+
+func TestRosterPatternShifts(t *testing.T) {
+	ctx := grpccontext.SetUser(context.Background(), &model.SignedInUser{Id: 1, DomainId: 1})
+	pattern := &model.RosterPattern{
+		DomainRecord: model.DomainRecord{Id: 1},
+		Name:         "pattern",
+		Shifts: map[int64][]*model.RosterPatternShift{
+			int64(time.Monday): {
+				{Start: 480, End: 720, Pauses: []*model.RosterPatternPause{{Start: 600, End: 615}}},
+				{Start: 780, End: 1020},
+			},
+			int64(time.Saturday): {{Start: 1320, End: 1860}},
+		},
+	}
+
+	// Expected shifts are start, end and the number of pauses.
+	tests := map[string]struct {
+		day      time.Time
+		expected [][]int64
+	}{
+		"split shift on monday": {
+			day:      time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC),
+			expected: [][]int64{{480, 720, 1}, {780, 1020, 0}},
+		},
+		"overnight shift on saturday": {
+			day:      time.Date(2026, time.March, 7, 0, 0, 0, 0, time.UTC),
+			expected: [][]int64{{1320, 1860, 0}},
+		},
+		"day off on sunday": {
+			day: time.Date(2026, time.March, 8, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	rosterPattern := mocks.NewMockRosterPatternManager(t)
+	rosterPattern.EXPECT().ReadRosterPattern(mock.Anything, readWithID(1)).Return(pattern, nil)
+
+	a := &AgentWorkingSchedule{rosterPattern: rosterPattern}
+	shifts, err := a.rosterPatternShifts(ctx, 1)
+	require.NoError(t, err)
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			var out [][]int64
+			for _, s := range shifts(tt.day) {
+				require.NotNil(t, s.RosterPattern)
+				assert.Equal(t, pattern.Id, s.RosterPattern.Id)
+				out = append(out, []int64{s.Start, s.End, int64(len(s.Pauses))})
+			}
+
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+}
+
+func TestValidateAgentScheduleShift(t *testing.T) {
+	tests := map[string]struct {
+		shift *model.AgentScheduleShift
+		err   error
+	}{
+		"day shift with a pause": {
+			shift: &model.AgentScheduleShift{Start: 540, End: 1080, Pauses: []*model.AgentScheduleShiftPause{{Start: 720, End: 780}}},
+		},
+		"overnight shift with a pause after midnight": {
+			shift: &model.AgentScheduleShift{Start: 1320, End: 1860, Pauses: []*model.AgentScheduleShiftPause{{Start: 1500, End: 1530}}},
+		},
+		"ends before the start": {
+			shift: &model.AgentScheduleShift{Start: 1080, End: 540},
+			err:   ErrAgentWorkingScheduleShiftTime,
+		},
+		"starts on the next day": {
+			shift: &model.AgentScheduleShift{Start: 1440, End: 1500},
+			err:   ErrAgentWorkingScheduleShiftTime,
+		},
+		"longer than a day": {
+			shift: &model.AgentScheduleShift{Start: 540, End: 2000},
+			err:   ErrAgentWorkingScheduleShiftTime,
+		},
+		"pause out of the shift": {
+			shift: &model.AgentScheduleShift{Start: 540, End: 1080, Pauses: []*model.AgentScheduleShiftPause{{Start: 1050, End: 1110}}},
+			err:   ErrAgentWorkingSchedulePauseTime,
+		},
+		"pause ends before the start": {
+			shift: &model.AgentScheduleShift{Start: 540, End: 1080, Pauses: []*model.AgentScheduleShiftPause{{Start: 780, End: 720}}},
+			err:   ErrAgentWorkingSchedulePauseTime,
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			err := validateAgentScheduleShift(tt.shift)
+			if tt.err == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.True(t, werror.Is(err, tt.err))
+		})
+	}
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/storage"
+	"github.com/webitel/webitel-wfm/pkg/timeutils"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var ErrWorkingSchedulePausePlacement = werror.InvalidArgument("invalid input: pause placement is required", werror.WithID("service.working_schedule.pause_placement"))
+
+// pausePlacementStep is the granularity of pause start times in minutes.
+const pausePlacementStep = 5
+
+// pausePlacer places pauses of pause templates into agent shifts.
+// Each pause is placed where it drops coverage of the forecast the least,
+// pauses of different agents are staggered, if drops are equal.
+type pausePlacer struct {
+	pauseTemplate storage.PauseTemplateManager
+	conditions    *agentConditions
+	placement     *model.PausePlacement
+	loc           *time.Location
+	staff         *staffing
+
+	templates map[int64]*model.PauseTemplate
+}
+
+// newPausePlacer counts staffing within the date filter and the next day, where overnight shifts of the last day end.
+// Shift times are local to the timezone, staffing is counted in UTC.
+func newPausePlacer(pauseTemplate storage.PauseTemplateManager, conditions *agentConditions, placement *model.PausePlacement, loc *time.Location, date *model.FilterBetween, forecast []*model.ForecastCalculationResult) *pausePlacer {
+	return &pausePlacer{
+		pauseTemplate: pauseTemplate,
+		conditions:    conditions,
+		placement:     placement,
+		loc:           loc,
+		staff:         newStaffingIntervals(dayStart(loc, date.From.Time), dayStart(loc, date.To.Time.AddDate(0, 0, 2)), pausePlacementStep*time.Minute, forecast),
+		templates:     make(map[int64]*model.PauseTemplate),
+	}
+}
+
+// add counts the shift and its pauses in the staffing.
+func (p *pausePlacer) add(date time.Time, shift *model.AgentScheduleShift) {
+	p.staff.add(shiftInstants(p.loc, date, shift.Start, shift.End))
+	for _, pause := range shift.Pauses {
+		p.staff.pause(shiftInstants(p.loc, date, pause.Start, pause.End))
+	}
+}
+
+// place replaces pauses of the agent shifts with pauses of the agent's pause template.
+// Pauses of the template are split between segments of split shifts within a day.
+// Shifts should be already counted in the staffing.
+func (p *pausePlacer) place(ctx context.Context, agentId int64, schedule []*model.AgentSchedule) error {
+	causes, err := p.causes(ctx, agentId)
+	if err != nil {
+		return err
+	}
+
+	var (
+		dates []string
+		days  = make(map[string][]*model.AgentSchedule)
+	)
+
+	for _, s := range schedule {
+		if s.Shift == nil {
+			continue
+		}
+
+		date := s.Date.Time.Format(time.DateOnly)
+		if _, ok := days[date]; !ok {
+			dates = append(dates, date)
+		}
+
+		days[date] = append(days[date], s)
+	}
+
+	for _, date := range dates {
+		segments := days[date]
+		slices.SortFunc(segments, func(a, b *model.AgentSchedule) int {
+			return int(a.Shift.Start - b.Shift.Start)
+		})
+
+		for i, c := range splitCauses(causes, segments) {
+			segments[i].Shift.Pauses = p.pauses(segments[i].Date.Time, segments[i].Shift, c)
+		}
+	}
+
+	return nil
+}
+
+// splitCauses distributes causes between segments of a split shift in their order,
+// proportionally to the segments length. Segments should be sorted by their start.
+func splitCauses(causes []model.PauseTemplateCause, segments []*model.AgentSchedule) [][]model.PauseTemplateCause {
+	out := make([][]model.PauseTemplateCause, len(segments))
+	if len(segments) == 1 {
+		out[0] = causes
+
+		return out
+	}
+
+	var total, length int64
+	for _, c := range causes {
+		total += c.Duration
+	}
+
+	for _, s := range segments {
+		length += s.Shift.End - s.Shift.Start
+	}
+
+	var (
+		i        int
+		assigned int64
+		share    = total * (segments[0].Shift.End - segments[0].Shift.Start) / length
+	)
+
+	for _, c := range causes {
+		// Moves to the next segment once the cause mostly exceeds the share of the current one.
+		for i < len(segments)-1 && assigned+c.Duration/2 > share {
+			i++
+			share += total * (segments[i].Shift.End - segments[i].Shift.Start) / length
+		}
+
+		out[i] = append(out[i], c)
+		assigned += c.Duration
+	}
+
+	return out
+}
+
+// causes returns pause template causes of the agent, that fit into the pause duration limit of the agent working condition.
+// The placement pause template overrides the agent's one, the agent's one overrides the working condition's one.
+func (p *pausePlacer) causes(ctx context.Context, agentId int64) ([]model.PauseTemplateCause, error) {
+	awc, err := p.conditions.agent(ctx, agentId)
+	if err != nil {
+		return nil, err
+	}
+
+	condition, err := p.conditions.read(ctx, agentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var id int64
+	switch {
+	case p.placement.PauseTemplateId != nil:
+		id = *p.placement.PauseTemplateId
+	case awc != nil && awc.PauseTemplate != nil && awc.PauseTemplate.Id != 0:
+		id = awc.PauseTemplate.Id
+	case condition != nil:
+		id = condition.PauseTemplate.Id
+	}
+
+	if id == 0 {
+		return nil, nil
+	}
+
+	template, ok := p.templates[id]
+	if !ok {
+		read, err := options.NewRead(ctx, options.WithID(id))
+		if err != nil {
+			return nil, err
+		}
+
+		if template, err = p.pauseTemplate.ReadPauseTemplate(ctx, read); err != nil {
+			return nil, err
+		}
+
+		p.templates[id] = template
+	}
+
+	var (
+		limit int64
+		total int64
+		out   = make([]model.PauseTemplateCause, 0, len(template.Causes))
+	)
+
+	if condition != nil && condition.PauseDuration != nil {
+		limit = int64(*condition.PauseDuration)
+	}
+
+	for _, c := range template.Causes {
+		if c.Duration <= 0 || (limit > 0 && total+c.Duration > limit) {
+			continue
+		}
+
+		total += c.Duration
+		out = append(out, c)
+	}
+
+	return out, nil
+}
+
+// pauses places causes into the shift in their order and counts them in the staffing.
+// Causes, that don't fit into the shift within placement offsets, are skipped.
+func (p *pausePlacer) pauses(date time.Time, shift *model.AgentScheduleShift, causes []model.PauseTemplateCause) []*model.AgentScheduleShiftPause {
+	var (
+		cursor = shift.Start + p.placement.MinStartOffset
+		end    = shift.End - p.placement.MinEndOffset
+		rest   int64
+		out    = make([]*model.AgentScheduleShiftPause, 0, len(causes))
+	)
+
+	for _, c := range causes {
+		rest += c.Duration
+	}
+
+	for i, c := range causes {
+		rest -= c.Duration
+
+		// Leave enough room for the remaining pauses.
+		earliest, latest := cursor, end-c.Duration-rest
+		if i == 0 && p.placement.MaxStartOffset > 0 {
+			latest = min(latest, shift.Start+p.placement.MaxStartOffset)
+		}
+
+		if i == len(causes)-1 && p.placement.MaxEndOffset > 0 {
+			earliest = max(earliest, shift.End-p.placement.MaxEndOffset-c.Duration)
+		}
+
+		if earliest > latest {
+			continue
+		}
+
+		var (
+			best       = earliest
+			bestLoss   = -1
+			bestPaused int64
+		)
+
+		for start := earliest; start <= latest; start += pausePlacementStep {
+			loss, paused := p.staff.loss(shiftInstants(p.loc, date, start, start+c.Duration))
+			if bestLoss < 0 || loss < bestLoss || (loss == bestLoss && paused < bestPaused) {
+				best, bestLoss, bestPaused = start, loss, paused
+			}
+		}
+
+		p.staff.pause(shiftInstants(p.loc, date, best, best+c.Duration))
+		out = append(out, &model.AgentScheduleShiftPause{
+			Start: best,
+			End:   best + c.Duration,
+			Cause: c.Cause,
+		})
+
+		cursor = best + c.Duration
+	}
+
+	return out
+}
+
+// pausePlacementForecast returns forecast of the working schedule for pause placement.
+// Pauses are only staggered across agents, if the team has no forecast calculation.
+func pausePlacementForecast(ctx context.Context, ws WorkingScheduleManager, user *model.SignedInUser, id int64, date *model.FilterBetween) ([]*model.ForecastCalculationResult, error) {
+	forecast, err := ws.ReadWorkingScheduleForecast(ctx, user, id, date)
+	if err != nil {
+		if werror.Is(err, ErrEmptyForecastCalculation) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return forecast, nil
+}
+
+// PlaceWorkingSchedulePauses places pauses into existing shifts of the working schedule agents.
+// Days, that already have pauses, are skipped unless overwrite is set.
+// Returns updated shifts and staffing per forecast interval after the placement.
+func (w *WorkingSchedule) PlaceWorkingSchedulePauses(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, agentIds []int64, placement *model.PausePlacement, overwrite bool) ([]*model.AgentWorkingSchedule, []*model.WorkingScheduleStaffing, error) {
+	if placement == nil {
+		return nil, nil, ErrWorkingSchedulePausePlacement
+	}
+
+	ws, err := w.storage.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: id})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if ws.State != model.WorkingScheduleStateDraft {
+		return nil, nil, werror.Wrap(ErrWorkingScheduleUpdateDraft, werror.WithValue("state", ws.State.String()))
+	}
+
+	if !date.From.Valid {
+		date.From = model.NewTimestamp(ws.StartDateAt.Time.Unix())
+	}
+
+	if !date.To.Valid {
+		date.To = model.NewTimestamp(ws.EndDateAt.Time.Unix())
+	}
+
+	forecast, err := pausePlacementForecast(ctx, w, user, id, date)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Overnight shifts of the previous day are only counted in the staffing.
+	existing, err := w.agentSchedule.SearchAgentWorkingSchedule(ctx, user, &model.AgentWorkingScheduleSearch{
+		SearchItem:        model.SearchItem{Date: withAdjacentDays(date, 1, 0)},
+		WorkingScheduleId: ws.Id,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targets := make(map[int64]bool, len(agentIds))
+	for _, agentId := range agentIds {
+		targets[agentId] = true
+	}
+
+	from, to := timeutils.Date(date.From.Time), timeutils.Date(date.To.Time)
+	placer := newPausePlacer(w.pauseTemplate, newAgentConditions(w.agentConditions, w.workingCondition), placement, ws.Location(), date, forecast)
+	in := make([]*model.AgentWorkingSchedule, 0, len(existing))
+	for _, e := range existing {
+		// Days are placed as a whole, so segments of split shifts share pauses of the template.
+		paused := make(map[string]bool)
+		for _, s := range e.Schedule {
+			if s.Shift != nil && len(s.Shift.Pauses) > 0 {
+				paused[s.Date.Time.Format(time.DateOnly)] = true
+			}
+		}
+
+		agent := &model.AgentWorkingSchedule{Agent: e.Agent}
+		for _, s := range e.Schedule {
+			if s.Shift == nil {
+				continue
+			}
+
+			within := !s.Date.Time.Before(from) && !s.Date.Time.After(to)
+			if within && (len(targets) == 0 || targets[e.Agent.Id]) && (!paused[s.Date.Time.Format(time.DateOnly)] || overwrite) {
+				s.Shift.Pauses = nil
+				agent.Schedule = append(agent.Schedule, &model.AgentSchedule{Date: s.Date, Shift: s.Shift})
+			}
+
+			placer.add(s.Date.Time, s.Shift)
+		}
+
+		if len(agent.Schedule) > 0 {
+			in = append(in, agent)
+		}
+	}
+
+	for _, agent := range in {
+		if err := placer.place(ctx, agent.Agent.Id, agent.Schedule); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	staff := newStaffing(forecast)
+	for _, e := range existing {
+		for _, s := range e.Schedule {
+			if s.Shift == nil {
+				continue
+			}
+
+			staff.add(shiftInstants(placer.loc, s.Date.Time, s.Shift.Start, s.Shift.End))
+			for _, pause := range s.Shift.Pauses {
+				staff.pause(shiftInstants(placer.loc, s.Date.Time, pause.Start, pause.End))
+			}
+		}
+	}
+
+	if len(in) == 0 {
+		return nil, staff.result(), nil
+	}
+
+	out, err := w.agentSchedule.CreateAgentsWorkingScheduleShifts(ctx, user, ws.Id, in, true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return out, staff.result(), nil
+}
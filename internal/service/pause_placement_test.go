@@ -0,0 +1,120 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+)
+
+func TestSplitCauses(t *testing.T) {
+	day := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	causes := []model.PauseTemplateCause{{Duration: 15}, {Duration: 30}, {Duration: 15}}
+
+	tests := map[string]struct {
+		segments []*model.AgentSchedule
+		expected [][]int64
+	}{
+		"single shift": {
+			segments: []*model.AgentSchedule{scheduleShift(day, 1, 540, 1080)},
+			expected: [][]int64{{15, 30, 15}},
+		},
+		"split shift": {
+			segments: []*model.AgentSchedule{scheduleShift(day, 1, 480, 720), scheduleShift(day, 2, 780, 1080)},
+			expected: [][]int64{{15}, {30, 15}},
+		},
+		"short segment gets nothing": {
+			segments: []*model.AgentSchedule{scheduleShift(day, 1, 480, 500), scheduleShift(day, 2, 780, 1080)},
+			expected: [][]int64{nil, {15, 30, 15}},
+		},
+		"equal segments": {
+			segments: []*model.AgentSchedule{scheduleShift(day, 1, 480, 720), scheduleShift(day, 2, 780, 1020)},
+			expected: [][]int64{{15, 30}, {15}},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			out := splitCauses(causes, tt.segments)
+			durations := make([][]int64, len(out))
+			for i, segment := range out {
+				for _, c := range segment {
+					durations[i] = append(durations[i], c.Duration)
+				}
+			}
+
+			assert.Equal(t, tt.expected, durations)
+		})
+	}
+}
+
+func TestPausePlacerPauses(t *testing.T) {
+	var (
+		day    = time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+		causes = []model.PauseTemplateCause{{Duration: 15}, {Duration: 30}}
+	)
+
+	// Two agents are required from 10:00 till 12:00, so pauses are placed outside of it.
+	forecast := []*model.ForecastCalculationResult{
+		forecastAt(day.Add(10*time.Hour), 2),
+		forecastAt(day.Add(12*time.Hour), 0),
+	}
+
+	tests := map[string]struct {
+		step      time.Duration
+		placement *model.PausePlacement
+		shift     *model.AgentScheduleShift
+		expected  [][2]int64
+	}{
+		"zero granularity places pauses at the earliest": {
+			placement: &model.PausePlacement{MinStartOffset: 60, MinEndOffset: 60},
+			shift:     &model.AgentScheduleShift{Start: 540, End: 1080},
+			expected:  [][2]int64{{600, 615}, {615, 645}},
+		},
+		"max end offset": {
+			placement: &model.PausePlacement{MinStartOffset: 60, MinEndOffset: 60, MaxEndOffset: 90},
+			shift:     &model.AgentScheduleShift{Start: 540, End: 1080},
+			expected:  [][2]int64{{600, 615}, {960, 990}},
+		},
+		"pause doesn't fit into the shift": {
+			placement: &model.PausePlacement{MinStartOffset: 30, MinEndOffset: 30},
+			shift:     &model.AgentScheduleShift{Start: 540, End: 600},
+			expected:  [][2]int64{},
+		},
+		"pauses avoid understaffed intervals": {
+			step:      pausePlacementStep * time.Minute,
+			placement: &model.PausePlacement{MinStartOffset: 60, MinEndOffset: 60},
+			shift:     &model.AgentScheduleShift{Start: 540, End: 1080},
+			expected:  [][2]int64{{720, 735}, {735, 765}},
+		},
+		"max start offset within understaffed intervals": {
+			step:      pausePlacementStep * time.Minute,
+			placement: &model.PausePlacement{MinStartOffset: 60, MaxStartOffset: 120, MinEndOffset: 60},
+			shift:     &model.AgentScheduleShift{Start: 540, End: 1080},
+			expected:  [][2]int64{{600, 615}, {720, 750}},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			p := &pausePlacer{
+				placement: tt.placement,
+				loc:       time.UTC,
+				staff:     newStaffingIntervals(day, day.AddDate(0, 0, 1), tt.step, forecast),
+			}
+
+			// Both agents work the whole shift.
+			p.add(day, tt.shift)
+			p.add(day, tt.shift)
+
+			out := make([][2]int64, 0)
+			for _, pause := range p.pauses(day, tt.shift, causes) {
+				out = append(out, [2]int64{pause.Start, pause.End})
+			}
+
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+}
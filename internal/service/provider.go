@@ -12,4 +12,10 @@ var Set = wire.NewSet(NewPauseTemplate, wire.Bind(new(PauseTemplateManager), new
 	NewForecastCalculation, wire.Bind(new(ForecastCalculationManager), new(*ForecastCalculation)),
 	NewWorkingSchedule, wire.Bind(new(WorkingScheduleManager), new(*WorkingSchedule)),
 	NewAgentWorkingSchedule, wire.Bind(new(AgentWorkingScheduleManager), new(*AgentWorkingSchedule)),
+	NewTimeOffRequest, wire.Bind(new(TimeOffRequestManager), new(*TimeOffRequest)),
+	NewAbsenceType, wire.Bind(new(AbsenceTypeManager), new(*AbsenceType)),
+	NewAgentCalendar, wire.Bind(new(AgentCalendarManager), new(*AgentCalendar)),
+	NewShiftSwap, wire.Bind(new(ShiftSwapManager), new(*ShiftSwap)),
+	NewWorkingScheduleSnapshot, wire.Bind(new(WorkingScheduleSnapshotManager), new(*WorkingScheduleSnapshot)),
+	NewRosterPattern, wire.Bind(new(RosterPatternManager), new(*RosterPattern)),
 )
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/storage"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var (
+	ErrRosterPatternName  = werror.InvalidArgument("invalid input: roster pattern name is required", werror.WithID("service.roster_pattern.name"))
+	ErrRosterPatternDay   = werror.InvalidArgument("invalid input: roster pattern day should be a day of week from 0 to 6", werror.WithID("service.roster_pattern.day"))
+	ErrRosterPatternShift = werror.InvalidArgument("invalid input: roster pattern shift should start within a day, end after its start and last no longer than a day", werror.WithID("service.roster_pattern.shift"))
+	ErrRosterPatternPause = werror.InvalidArgument("invalid input: roster pattern pause should end after its start and be within its shift segment", werror.WithID("service.roster_pattern.pause"))
+)
+
+type RosterPatternManager interface {
+	CreateRosterPattern(ctx context.Context, read *options.Read, in *model.RosterPattern) (*model.RosterPattern, error)
+	ReadRosterPattern(ctx context.Context, read *options.Read) (*model.RosterPattern, error)
+	SearchRosterPattern(ctx context.Context, search *options.Search) ([]*model.RosterPattern, bool, error)
+	UpdateRosterPattern(ctx context.Context, read *options.Read, in *model.RosterPattern) (*model.RosterPattern, error)
+	DeleteRosterPattern(ctx context.Context, read *options.Read) (int64, error)
+}
+
+type RosterPattern struct {
+	storage storage.RosterPatternManager
+}
+
+func NewRosterPattern(storage storage.RosterPatternManager) *RosterPattern {
+	return &RosterPattern{
+		storage: storage,
+	}
+}
+
+func (r *RosterPattern) CreateRosterPattern(ctx context.Context, read *options.Read, in *model.RosterPattern) (*model.RosterPattern, error) {
+	if err := validateRosterPattern(in); err != nil {
+		return nil, err
+	}
+
+	id, err := r.storage.CreateRosterPattern(ctx, read.User(), in)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.ReadRosterPattern(ctx, read.WithID(id))
+}
+
+func (r *RosterPattern) ReadRosterPattern(ctx context.Context, read *options.Read) (*model.RosterPattern, error) {
+	out, err := r.storage.ReadRosterPattern(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (r *RosterPattern) SearchRosterPattern(ctx context.Context, search *options.Search) ([]*model.RosterPattern, bool, error) {
+	out, err := r.storage.SearchRosterPattern(ctx, search)
+	if err != nil {
+		return nil, false, err
+	}
+
+	next, out := model.ListResult(int32(search.Size()), out)
+
+	return out, next, nil
+}
+
+func (r *RosterPattern) UpdateRosterPattern(ctx context.Context, read *options.Read, in *model.RosterPattern) (*model.RosterPattern, error) {
+	if err := validateRosterPattern(in); err != nil {
+		return nil, err
+	}
+
+	if err := r.storage.UpdateRosterPattern(ctx, read.User(), in); err != nil {
+		return nil, err
+	}
+
+	return r.ReadRosterPattern(ctx, read)
+}
+
+func (r *RosterPattern) DeleteRosterPattern(ctx context.Context, read *options.Read) (int64, error) {
+	out, err := r.storage.DeleteRosterPattern(ctx, read)
+	if err != nil {
+		return 0, err
+	}
+
+	return out, nil
+}
+
+// validateRosterPattern checks shift segments against limits of agent shifts:
+// a segment starts within the day and lasts no longer than a day, pauses are within their segment.
+func validateRosterPattern(in *model.RosterPattern) error {
+	if in.Name == "" {
+		return ErrRosterPatternName
+	}
+
+	for day, segments := range in.Shifts {
+		if day < 0 || day > 6 {
+			return werror.Wrap(ErrRosterPatternDay, werror.WithValue("day", day))
+		}
+
+		for _, s := range segments {
+			if s.Start < 0 || s.Start >= model.MinutesPerDay || s.Start >= s.End || s.End-s.Start > model.MinutesPerDay {
+				return werror.Wrap(ErrRosterPatternShift, werror.WithValue("day", day), werror.WithValue("start", s.Start), werror.WithValue("end", s.End))
+			}
+
+			for _, p := range s.Pauses {
+				if p.Start >= p.End || p.Start < s.Start || p.End > s.End {
+					return werror.Wrap(ErrRosterPatternPause, werror.WithValue("day", day), werror.WithValue("start", p.Start), werror.WithValue("end", p.End))
+				}
+			}
+		}
+	}
+
+	return nil
+}
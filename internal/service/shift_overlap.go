@@ -0,0 +1,125 @@
+package service
+
+import (
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var ErrAgentWorkingScheduleShiftOverlap = werror.Aborted("invalid input: agent shift overlaps another shift of the agent", werror.WithID("service.agent_working_schedule.shift_overlap"))
+
+// withAdjacentDays returns a copy of the date filter extended by a number of days before and after it,
+// so overnight shifts of the previous day, that continue within the filter, are found as well.
+func withAdjacentDays(date *model.FilterBetween, before, after int) *model.FilterBetween {
+	out := &model.FilterBetween{From: date.From, To: date.To}
+	if date.From.Valid {
+		out.From = model.NewTimestamp(date.From.Time.AddDate(0, 0, -before).Unix())
+	}
+
+	if date.To.Valid {
+		out.To = model.NewTimestamp(date.To.Time.AddDate(0, 0, after).Unix())
+	}
+
+	return out
+}
+
+// withCarryover limits agent schedules to the date filter
+// and lists overnight shifts on their next day as well, marked as a carryover.
+func withCarryover(items []*model.AgentWorkingSchedule, date *model.FilterBetween) {
+	within := func(d time.Time) bool {
+		day := d.Format(time.DateOnly)
+
+		return (!date.From.Valid || day >= date.From.Time.Format(time.DateOnly)) && (!date.To.Valid || day <= date.To.Time.Format(time.DateOnly))
+	}
+
+	for _, item := range items {
+		schedule := make([]*model.AgentSchedule, 0, len(item.Schedule))
+		for _, s := range item.Schedule {
+			if within(s.Date.Time) {
+				schedule = append(schedule, s)
+			}
+
+			if s.Shift == nil || !s.Shift.Overnight() {
+				continue
+			}
+
+			if next := s.Date.Time.AddDate(0, 0, 1); within(next) {
+				schedule = append(schedule, &model.AgentSchedule{
+					Date:      pgtype.Date{Time: next, Valid: true},
+					Shift:     s.Shift,
+					Carryover: true,
+				})
+			}
+		}
+
+		item.Schedule = schedule
+	}
+}
+
+// mergeShifts returns desired shifts along with existing ones, that are kept.
+// Existing shifts are replaced by desired shifts with the same id,
+// and all existing segments of the desired dates are replaced if replace is set.
+func mergeShifts(existing, desired []*model.AgentSchedule, replace bool) []*model.AgentSchedule {
+	var (
+		ids   = make(map[int64]bool, len(desired))
+		dates = make(map[string]bool, len(desired))
+		out   = slices.Clone(desired)
+	)
+
+	for _, s := range desired {
+		if s.Shift.Id != 0 {
+			ids[s.Shift.Id] = true
+		}
+
+		dates[s.Date.Time.Format(time.DateOnly)] = true
+	}
+
+	for _, s := range existing {
+		if s.Shift == nil || ids[s.Shift.Id] || (replace && dates[s.Date.Time.Format(time.DateOnly)]) {
+			continue
+		}
+
+		out = append(out, s)
+	}
+
+	return out
+}
+
+// overlappingShift returns the shift, that overlaps another shift of the agent,
+// including segments of split shifts and overnight shifts of the previous day.
+// Only overlaps with any of the changed shifts are reported.
+// Shift minutes are wall-clock minutes of the location, so shifts on DST transition days are compared by their instants.
+func overlappingShift(loc *time.Location, schedule []*model.AgentSchedule, changed func(s *model.AgentSchedule) bool) (*model.AgentSchedule, bool) {
+	type period struct {
+		schedule   *model.AgentSchedule
+		start, end time.Time
+	}
+
+	periods := make([]period, 0, len(schedule))
+	for _, s := range schedule {
+		if s.Shift == nil {
+			continue
+		}
+
+		start, end := shiftInstants(loc, s.Date.Time, s.Shift.Start, s.Shift.End)
+		periods = append(periods, period{schedule: s, start: start, end: end})
+	}
+
+	slices.SortFunc(periods, func(a, b period) int {
+		return a.start.Compare(b.start)
+	})
+
+	for i, p := range periods {
+		for _, prev := range periods[:i] {
+			if p.start.Before(prev.end) && (changed(p.schedule) || changed(prev.schedule)) {
+				return p.schedule, true
+			}
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+)
+
+func TestOverlappingShift(t *testing.T) {
+	kyiv, err := time.LoadLocation("Europe/Kyiv")
+	require.NoError(t, err)
+
+	var (
+		day  = time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+		next = day.AddDate(0, 0, 1)
+		dst  = time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := map[string]struct {
+		loc      *time.Location
+		schedule []*model.AgentSchedule
+		changed  int64
+		expected int64
+	}{
+		"adjacent shifts": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 540, 780), scheduleShift(day, 2, 780, 1080)},
+			changed:  2,
+		},
+		"split shift segments overlap": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 540, 780), scheduleShift(day, 2, 720, 1080)},
+			changed:  1,
+			expected: 2,
+		},
+		"segment nested into a longer one": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 480, 1080), scheduleShift(day, 2, 540, 600), scheduleShift(day, 3, 900, 960)},
+			changed:  3,
+			expected: 3,
+		},
+		"overnight shift overlaps the next day": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 1320, 1860), scheduleShift(next, 2, 360, 720)},
+			changed:  2,
+			expected: 2,
+		},
+		"overnight shift ends before the next day": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 1320, 1860), scheduleShift(next, 2, 420, 720)},
+			changed:  2,
+		},
+		"overlap of unchanged shifts": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 540, 780), scheduleShift(day, 2, 720, 1080), scheduleShift(next, 3, 540, 1080)},
+			changed:  3,
+		},
+		"overnight shift into DST day": {
+			loc:      kyiv,
+			schedule: []*model.AgentSchedule{scheduleShift(dst.AddDate(0, 0, -1), 1, 1320, 1860), scheduleShift(dst, 2, 360, 720)},
+			changed:  2,
+			expected: 2,
+		},
+		"overnight shift ends before DST day shift": {
+			loc:      kyiv,
+			schedule: []*model.AgentSchedule{scheduleShift(dst.AddDate(0, 0, -1), 1, 1320, 1860), scheduleShift(dst, 2, 420, 720)},
+			changed:  2,
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			out, ok := overlappingShift(tt.loc, tt.schedule, func(s *model.AgentSchedule) bool {
+				return s.Shift.Id == tt.changed
+			})
+
+			if tt.expected == 0 {
+				assert.False(t, ok)
+
+				return
+			}
+
+			require.True(t, ok)
+			assert.Equal(t, tt.expected, out.Shift.Id)
+		})
+	}
+}
+
+func TestMergeShifts(t *testing.T) {
+	var (
+		day  = time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+		next = day.AddDate(0, 0, 1)
+	)
+
+	existing := []*model.AgentSchedule{
+		scheduleShift(day, 1, 540, 780),
+		scheduleShift(day, 2, 840, 1080),
+		scheduleShift(next, 3, 540, 1080),
+		{Date: model.NewDate(next.Unix())},
+	}
+
+	tests := map[string]struct {
+		desired  []*model.AgentSchedule
+		replace  bool
+		expected []int64
+	}{
+		"new shift is added": {
+			desired:  []*model.AgentSchedule{scheduleShift(day, 0, 1200, 1260)},
+			expected: []int64{0, 1, 2, 3},
+		},
+		"shift with the same id is replaced": {
+			desired:  []*model.AgentSchedule{scheduleShift(day, 2, 900, 1080)},
+			expected: []int64{2, 1, 3},
+		},
+		"all segments of the date are replaced": {
+			desired:  []*model.AgentSchedule{scheduleShift(day, 0, 600, 1020)},
+			replace:  true,
+			expected: []int64{0, 3},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			out := mergeShifts(existing, tt.desired, tt.replace)
+			ids := make([]int64, 0, len(out))
+			for _, s := range out {
+				ids = append(ids, s.Shift.Id)
+			}
+
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestWithCarryover(t *testing.T) {
+	var (
+		day  = time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+		next = day.AddDate(0, 0, 1)
+	)
+
+	tests := map[string]struct {
+		date      *model.FilterBetween
+		expected  []string
+		carryover []bool
+	}{
+		"overnight shift continues on the next day": {
+			date:      &model.FilterBetween{From: model.NewTimestamp(day.Unix()), To: model.NewTimestamp(next.Unix())},
+			expected:  []string{"2026-03-02", "2026-03-03", "2026-03-03"},
+			carryover: []bool{false, true, false},
+		},
+		"previous day is out of the filter": {
+			date:      &model.FilterBetween{From: model.NewTimestamp(next.Unix()), To: model.NewTimestamp(next.Unix())},
+			expected:  []string{"2026-03-03", "2026-03-03"},
+			carryover: []bool{true, false},
+		},
+		"next day is out of the filter": {
+			date:      &model.FilterBetween{From: model.NewTimestamp(day.Unix()), To: model.NewTimestamp(day.Unix())},
+			expected:  []string{"2026-03-02"},
+			carryover: []bool{false},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			items := []*model.AgentWorkingSchedule{{
+				Schedule: []*model.AgentSchedule{scheduleShift(day, 1, 1320, 1860), scheduleShift(next, 2, 540, 1080)},
+			}}
+
+			withCarryover(items, tt.date)
+			require.Len(t, items[0].Schedule, len(tt.expected))
+			for i, s := range items[0].Schedule {
+				assert.Equal(t, tt.expected[i], s.Date.Time.Format(time.DateOnly))
+				assert.Equal(t, tt.carryover[i], s.Carryover)
+			}
+		})
+	}
+}
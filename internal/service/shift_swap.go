@@ -0,0 +1,466 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
+	"github.com/webitel/webitel-wfm/infra/webitel/engine"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/storage"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+const (
+	ruleSwapSkill   = "swap.skill"
+	ruleSwapLocked  = "swap.locked"
+	ruleSwapOverlap = "swap.overlap"
+)
+
+var (
+	ErrShiftSwapAgent           = werror.Forbidden("signed-in user is not an agent", werror.WithID("service.shift_swap.agent"))
+	ErrShiftSwapOwner           = werror.Forbidden("shift swap is offered by another agent", werror.WithID("service.shift_swap.owner"))
+	ErrShiftSwapTarget          = werror.Forbidden("shift swap is addressed to another agent", werror.WithID("service.shift_swap.target"))
+	ErrShiftSwapSelf            = werror.InvalidArgument("invalid input: shift swap can't be accepted by the offering agent", werror.WithID("service.shift_swap.self"))
+	ErrShiftSwapShift           = werror.InvalidArgument("invalid input: shift doesn't belong to the agent within an active working schedule", werror.WithID("service.shift_swap.shift"))
+	ErrShiftSwapInProgress      = werror.InvalidArgument("invalid input: shift already has a swap in progress", werror.WithID("service.shift_swap.in_progress"))
+	ErrShiftSwapAccept          = werror.InvalidArgument("invalid input: agent or exchange shift doesn't belong to the working schedule of the shift swap", werror.WithID("service.shift_swap.accept"))
+	ErrShiftSwapState           = werror.InvalidArgument("invalid input: shift swap state transition isn't allowed", werror.WithID("service.shift_swap.state"))
+	ErrShiftSwapWorkingSchedule = werror.InvalidArgument("invalid input: working schedule of the shift swap isn't active", werror.WithID("service.shift_swap.working_schedule"))
+	ErrShiftSwapViolations      = werror.InvalidArgument("shift swap has rule violations, check them for details", werror.WithID("service.shift_swap.violations"))
+)
+
+// shiftSwapRules lists rules evaluated for both agents of the swap, pauses move together with their shifts,
+// so they aren't evaluated.
+var shiftSwapRules = []scheduleRule{
+	checkShiftAbsence,
+	checkShiftMinRest,
+	checkConditionLimits,
+}
+
+type ShiftSwapManager interface {
+	CreateShiftSwap(ctx context.Context, read *options.Read, in *model.ShiftSwap) (*model.ShiftSwap, error)
+	ReadShiftSwap(ctx context.Context, read *options.Read) (*model.ShiftSwap, error)
+	SearchShiftSwap(ctx context.Context, search *options.Search, filter *model.ShiftSwapSearch) ([]*model.ShiftSwap, bool, error)
+
+	AcceptShiftSwap(ctx context.Context, read *options.Read, shiftId *int64) (*model.ShiftSwap, error)
+	ValidateShiftSwap(ctx context.Context, read *options.Read) ([]*model.WorkingScheduleViolation, error)
+	ApproveShiftSwap(ctx context.Context, read *options.Read, comment *string) (*model.ShiftSwap, error)
+	RejectShiftSwap(ctx context.Context, read *options.Read, comment *string) (*model.ShiftSwap, error)
+	CancelShiftSwap(ctx context.Context, read *options.Read) (*model.ShiftSwap, error)
+}
+
+type ShiftSwap struct {
+	storage          storage.ShiftSwapManager
+	agent            storage.AgentManager
+	workingSchedule  storage.WorkingScheduleManager
+	agentSchedule    storage.AgentWorkingScheduleManager
+	agentConditions  storage.AgentWorkingConditionsManager
+	workingCondition storage.WorkingConditionManager
+	engine           *engine.Client
+}
+
+func NewShiftSwap(storage storage.ShiftSwapManager, agent storage.AgentManager, workingSchedule storage.WorkingScheduleManager,
+	agentSchedule storage.AgentWorkingScheduleManager, agentConditions storage.AgentWorkingConditionsManager, workingCondition storage.WorkingConditionManager,
+	engine *engine.Client,
+) *ShiftSwap {
+	return &ShiftSwap{
+		storage:          storage,
+		agent:            agent,
+		workingSchedule:  workingSchedule,
+		agentSchedule:    agentSchedule,
+		agentConditions:  agentConditions,
+		workingCondition: workingCondition,
+		engine:           engine,
+	}
+}
+
+// CreateShiftSwap offers the shift on behalf of the agent linked to the signed-in user.
+func (s *ShiftSwap) CreateShiftSwap(ctx context.Context, read *options.Read, in *model.ShiftSwap) (*model.ShiftSwap, error) {
+	agent, err := s.signedInAgent(ctx, read.User())
+	if err != nil {
+		return nil, err
+	}
+
+	in.Agent = *agent
+	id, err := s.storage.CreateShiftSwap(ctx, read, in)
+	if err != nil {
+		switch {
+		case werror.Is(err, dbsql.ErrNoRows):
+			return nil, werror.Wrap(ErrShiftSwapShift, werror.WithValue("shift", in.Shift.Id))
+		case werror.Is(err, dbsql.ErrUniqueViolation):
+			return nil, werror.Wrap(ErrShiftSwapInProgress, werror.WithValue("shift", in.Shift.Id))
+		}
+
+		return nil, err
+	}
+
+	return s.ReadShiftSwap(ctx, read.WithID(id))
+}
+
+func (s *ShiftSwap) ReadShiftSwap(ctx context.Context, read *options.Read) (*model.ShiftSwap, error) {
+	out, err := s.storage.ReadShiftSwap(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (s *ShiftSwap) SearchShiftSwap(ctx context.Context, search *options.Search, filter *model.ShiftSwapSearch) ([]*model.ShiftSwap, bool, error) {
+	out, err := s.storage.SearchShiftSwap(ctx, search, filter)
+	if err != nil {
+		return nil, false, err
+	}
+
+	next, out := model.ListResult(int32(search.Size()), out)
+
+	return out, next, nil
+}
+
+// AcceptShiftSwap accepts the open swap on behalf of the agent linked to the signed-in user.
+// If shiftId is set, the agent proposes own shift in exchange.
+func (s *ShiftSwap) AcceptShiftSwap(ctx context.Context, read *options.Read, shiftId *int64) (*model.ShiftSwap, error) {
+	agent, err := s.signedInAgent(ctx, read.User())
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.ReadShiftSwap(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case item.State != model.ShiftSwapStateOpen:
+		return nil, shiftSwapStateError(item.State, model.ShiftSwapStateAccepted)
+	case item.Agent.Id == agent.Id:
+		return nil, ErrShiftSwapSelf
+	case item.TargetAgent != nil && item.TargetAgent.Id != agent.Id:
+		return nil, ErrShiftSwapTarget
+	}
+
+	if err := s.storage.AcceptShiftSwap(ctx, read, agent.Id, shiftId); err != nil {
+		if werror.Is(err, dbsql.ErrNoRows) {
+			return nil, werror.Wrap(ErrShiftSwapAccept, werror.WithValue("agent", agent.Id))
+		}
+
+		return nil, err
+	}
+
+	return s.ReadShiftSwap(ctx, read)
+}
+
+// ValidateShiftSwap evaluates schedules of both agents of the accepted swap as if the swap is approved.
+func (s *ShiftSwap) ValidateShiftSwap(ctx context.Context, read *options.Read) ([]*model.WorkingScheduleViolation, error) {
+	item, err := s.ReadShiftSwap(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.validate(ctx, read, item)
+}
+
+// ApproveShiftSwap approves the accepted swap and reassigns its shifts between the agents,
+// only a supervisor of both agents can approve it. Swap with violations of an error severity isn't approved.
+func (s *ShiftSwap) ApproveShiftSwap(ctx context.Context, read *options.Read, comment *string) (*model.ShiftSwap, error) {
+	item, err := s.ReadShiftSwap(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSupervisor(ctx, read, item); err != nil {
+		return nil, err
+	}
+
+	violations, err := s.validate(ctx, read, item)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs int
+	for _, v := range violations {
+		if v.Severity == model.ViolationSeverityError {
+			errs++
+		}
+	}
+
+	if errs > 0 {
+		return nil, werror.Wrap(ErrShiftSwapViolations, werror.WithValue("errors", errs))
+	}
+
+	return s.decide(ctx, read, item.State, model.ShiftSwapStateApproved, comment)
+}
+
+// RejectShiftSwap rejects the open or accepted swap, only a supervisor of its agents can reject it.
+func (s *ShiftSwap) RejectShiftSwap(ctx context.Context, read *options.Read, comment *string) (*model.ShiftSwap, error) {
+	item, err := s.ReadShiftSwap(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSupervisor(ctx, read, item); err != nil {
+		return nil, err
+	}
+
+	if !item.InProgress() {
+		return nil, shiftSwapStateError(item.State, model.ShiftSwapStateRejected)
+	}
+
+	return s.decide(ctx, read, item.State, model.ShiftSwapStateRejected, comment)
+}
+
+// CancelShiftSwap cancels the open or accepted swap, only the agent, who offered the shift, can cancel it.
+func (s *ShiftSwap) CancelShiftSwap(ctx context.Context, read *options.Read) (*model.ShiftSwap, error) {
+	agent, err := s.signedInAgent(ctx, read.User())
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.ReadShiftSwap(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.Agent.Id != agent.Id {
+		return nil, ErrShiftSwapOwner
+	}
+
+	if !item.InProgress() {
+		return nil, shiftSwapStateError(item.State, model.ShiftSwapStateCancelled)
+	}
+
+	return s.transition(ctx, read, item.State, &model.ShiftSwap{State: model.ShiftSwapStateCancelled})
+}
+
+// checkSupervisor checks the offering agent and, once the swap is accepted, the counter agent.
+func (s *ShiftSwap) checkSupervisor(ctx context.Context, read *options.Read, item *model.ShiftSwap) error {
+	agentIds := []int64{item.Agent.Id}
+	if item.CounterAgent != nil {
+		agentIds = append(agentIds, item.CounterAgent.Id)
+	}
+
+	return checkSupervisor(ctx, s.agent, s.engine, read.User(), agentIds...)
+}
+
+func (s *ShiftSwap) decide(ctx context.Context, read *options.Read, from, to model.ShiftSwapState, comment *string) (*model.ShiftSwap, error) {
+	in := &model.ShiftSwap{
+		State:     to,
+		Comment:   comment,
+		DecidedBy: &model.LookupItem{Id: read.User().Id},
+	}
+
+	return s.transition(ctx, read, from, in)
+}
+
+func (s *ShiftSwap) transition(ctx context.Context, read *options.Read, from model.ShiftSwapState, in *model.ShiftSwap) (*model.ShiftSwap, error) {
+	if err := s.storage.UpdateShiftSwapState(ctx, read, from, in); err != nil {
+		if werror.Is(err, dbsql.ErrNoRows) {
+			return nil, shiftSwapStateError(from, in.State)
+		}
+
+		return nil, err
+	}
+
+	return s.ReadShiftSwap(ctx, read)
+}
+
+// validate evaluates shiftSwapRules for schedules of both agents within the working schedule
+// before and after the swap and reports only violations, that the swap introduces.
+// Agents, who receive a shift, should have its skills and shouldn't work in another working schedule that day.
+func (s *ShiftSwap) validate(ctx context.Context, read *options.Read, item *model.ShiftSwap) ([]*model.WorkingScheduleViolation, error) {
+	if item.State != model.ShiftSwapStateAccepted || item.CounterAgent == nil {
+		return nil, shiftSwapStateError(item.State, model.ShiftSwapStateApproved)
+	}
+
+	ws, err := s.workingSchedule.ReadWorkingSchedule(ctx, read.User(), &model.SearchItem{Id: item.WorkingSchedule.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	if ws.State != model.WorkingScheduleStateActive {
+		return nil, werror.Wrap(ErrShiftSwapWorkingSchedule, werror.WithValue("state", ws.State.String()))
+	}
+
+	search := &model.AgentWorkingScheduleSearch{
+		SearchItem: model.SearchItem{
+			Date: &model.FilterBetween{
+				From: model.NewTimestamp(ws.StartDateAt.Time.Unix()),
+				To:   model.NewTimestamp(ws.EndDateAt.Time.Unix()),
+			},
+		},
+		WorkingScheduleId: ws.Id,
+		AgentIds:          item.Agents(),
+	}
+
+	items, err := s.agentSchedule.SearchAgentWorkingSchedule(ctx, read.User(), search)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions, err := newAgentConditions(s.agentConditions, s.workingCondition).readAll(ctx, item.Agents())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		agent   = shiftSwapAgent(items, item.Agent)
+		counter = shiftSwapAgent(items, *item.CounterAgent)
+		before  = make(map[string]bool)
+		out     = make([]*model.WorkingScheduleViolation, 0)
+	)
+
+	for _, v := range evaluateShiftSwap(ws.Location(), conditions, agent, counter) {
+		before[violationKey(v)] = true
+	}
+
+	given := swapShifts(agent, counter, item.Shift.Id)
+	var taken []*model.AgentSchedule
+	if item.CounterShift != nil {
+		taken = swapShifts(counter, agent, item.CounterShift.Id)
+	}
+
+	for _, v := range evaluateShiftSwap(ws.Location(), conditions, agent, counter) {
+		if !before[violationKey(v)] {
+			out = append(out, v)
+		}
+	}
+
+	for _, shifts := range []struct {
+		to     *model.AgentWorkingSchedule
+		shifts []*model.AgentSchedule
+	}{{counter, given}, {agent, taken}} {
+		for _, shift := range shifts.shifts {
+			missing, err := s.storage.SearchShiftSwapMissingSkills(ctx, read, shift.Shift.Id, shifts.to.Agent.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			out = append(out, checkShiftSwapSkills(shifts.to, shift, missing)...)
+			out = append(out, checkShiftSwapLocked(shifts.to, shift)...)
+		}
+
+		out = append(out, checkShiftSwapOverlap(ws.Location(), shifts.to, shifts.shifts)...)
+	}
+
+	return out, nil
+}
+
+func (s *ShiftSwap) signedInAgent(ctx context.Context, user *model.SignedInUser) (*model.LookupItem, error) {
+	agent, err := s.agent.ReadUserAgent(ctx, user)
+	if err != nil {
+		if werror.Is(err, dbsql.ErrNoRows) {
+			return nil, werror.Wrap(ErrShiftSwapAgent, werror.WithValue("user", user.Id))
+		}
+
+		return nil, err
+	}
+
+	return agent, nil
+}
+
+func shiftSwapStateError(from, to model.ShiftSwapState) error {
+	return werror.Wrap(ErrShiftSwapState, werror.WithValue("from", from.String()), werror.WithValue("to", to.String()))
+}
+
+// shiftSwapAgent returns the agent schedule within the working schedule,
+// agent without any schedule days has an empty one.
+func shiftSwapAgent(items []*model.AgentWorkingSchedule, agent model.LookupItem) *model.AgentWorkingSchedule {
+	for _, item := range items {
+		if item.Agent.Id == agent.Id {
+			return item
+		}
+	}
+
+	return &model.AgentWorkingSchedule{Agent: agent}
+}
+
+func evaluateShiftSwap(loc *time.Location, conditions map[int64]*model.WorkingCondition, agents ...*model.AgentWorkingSchedule) []*model.WorkingScheduleViolation {
+	var out []*model.WorkingScheduleViolation
+	for _, agent := range agents {
+		slices.SortStableFunc(agent.Schedule, func(a, b *model.AgentSchedule) int {
+			return a.Date.Time.Compare(b.Date.Time)
+		})
+
+		for _, rule := range shiftSwapRules {
+			out = append(out, rule(agent, conditions[agent.Agent.Id], loc)...)
+		}
+	}
+
+	return out
+}
+
+// swapShifts moves segments of the shift from one agent schedule to another and returns them.
+func swapShifts(from, to *model.AgentWorkingSchedule, shiftId int64) []*model.AgentSchedule {
+	var moved []*model.AgentSchedule
+	from.Schedule = slices.DeleteFunc(from.Schedule, func(s *model.AgentSchedule) bool {
+		if s.Shift != nil && s.Shift.Id == shiftId {
+			moved = append(moved, s)
+
+			return true
+		}
+
+		return false
+	})
+
+	to.Schedule = append(to.Schedule, moved...)
+
+	return moved
+}
+
+func violationKey(v *model.WorkingScheduleViolation) string {
+	return fmt.Sprintf("%d/%s/%s", v.Agent.Id, v.Date.Time.Format(time.DateOnly), v.Rule)
+}
+
+// checkShiftSwapSkills reports skills of the received shift, that the agent doesn't have.
+func checkShiftSwapSkills(agent *model.AgentWorkingSchedule, shift *model.AgentSchedule, missing []*model.LookupItem) []*model.WorkingScheduleViolation {
+	if len(missing) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(missing))
+	for _, skill := range missing {
+		names = append(names, lookupName(skill))
+	}
+
+	return []*model.WorkingScheduleViolation{
+		newViolation(agent, shift, ruleSwapSkill, model.ViolationSeverityError,
+			fmt.Sprintf("agent doesn't have skills of the shift: %s", strings.Join(names, ", "))),
+	}
+}
+
+// checkShiftSwapOverlap reports received segments, that overlap another shift of the agent,
+// including overnight shifts of the adjacent days.
+func checkShiftSwapOverlap(loc *time.Location, agent *model.AgentWorkingSchedule, received []*model.AgentSchedule) []*model.WorkingScheduleViolation {
+	if len(received) == 0 {
+		return nil
+	}
+
+	shift, ok := overlappingShift(loc, agent.Schedule, func(s *model.AgentSchedule) bool {
+		return slices.Contains(received, s)
+	})
+	if !ok {
+		return nil
+	}
+
+	return []*model.WorkingScheduleViolation{
+		newViolation(agent, shift, ruleSwapOverlap, model.ViolationSeverityError, "received shift overlaps another shift of the agent"),
+	}
+}
+
+// checkShiftSwapLocked reports the received shift on a day, that the agent works within another working schedule.
+func checkShiftSwapLocked(agent *model.AgentWorkingSchedule, shift *model.AgentSchedule) []*model.WorkingScheduleViolation {
+	for _, s := range agent.Schedule {
+		if s.Locked && s.Date.Time.Equal(shift.Date.Time) {
+			return []*model.WorkingScheduleViolation{
+				newViolation(agent, shift, ruleSwapLocked, model.ViolationSeverityError, "agent has a shift of another working schedule that day"),
+			}
+		}
+	}
+
+	return nil
+}
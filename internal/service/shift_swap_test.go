@@ -0,0 +1,50 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+)
+
+func TestCheckShiftSwapOverlap(t *testing.T) {
+	day := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		existing []*model.AgentSchedule
+		received *model.AgentSchedule
+		expected []string
+	}{
+		"free day": {
+			existing: []*model.AgentSchedule{scheduleShift(day.AddDate(0, 0, 1), 1, 540, 1080)},
+			received: scheduleShift(day, 2, 540, 1080),
+			expected: []string{},
+		},
+		"same start": {
+			existing: []*model.AgentSchedule{scheduleShift(day, 1, 540, 720)},
+			received: scheduleShift(day, 2, 540, 1080),
+			expected: []string{ruleSwapOverlap},
+		},
+		"overnight shift of the previous day": {
+			existing: []*model.AgentSchedule{scheduleShift(day.AddDate(0, 0, -1), 1, 1320, 1860)},
+			received: scheduleShift(day, 2, 360, 720),
+			expected: []string{ruleSwapOverlap},
+		},
+		"another segment of the day": {
+			existing: []*model.AgentSchedule{scheduleShift(day, 1, 480, 720)},
+			received: scheduleShift(day, 2, 780, 1080),
+			expected: []string{},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			agent := &model.AgentWorkingSchedule{Schedule: tt.existing}
+			received := swapShifts(&model.AgentWorkingSchedule{Schedule: []*model.AgentSchedule{tt.received}}, agent, tt.received.Shift.Id)
+
+			out := checkShiftSwapOverlap(time.UTC, agent, received)
+			assert.Equal(t, tt.expected, violationRules(out))
+		})
+	}
+}
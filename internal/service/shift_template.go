@@ -6,6 +6,13 @@ import (
 	"github.com/webitel/webitel-wfm/internal/model"
 	"github.com/webitel/webitel-wfm/internal/model/options"
 	"github.com/webitel/webitel-wfm/internal/storage"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var (
+	ErrShiftTemplateTimes    = werror.InvalidArgument("invalid input: either times or rotation should be set", werror.WithID("service.shift_template.times"))
+	ErrShiftTemplateRotation = werror.InvalidArgument("invalid input: rotation should have at least one working day", werror.WithID("service.shift_template.rotation"))
+	ErrShiftTemplateTime     = werror.InvalidArgument("invalid input: shift should start within a day, end after its start and last no longer than a day", werror.WithID("service.shift_template.time"))
 )
 
 type ShiftTemplateManager interface {
@@ -27,6 +34,10 @@ func NewShiftTemplate(storage storage.ShiftTemplateManager) *ShiftTemplate {
 }
 
 func (s *ShiftTemplate) CreateShiftTemplate(ctx context.Context, read *options.Read, in *model.ShiftTemplate) (*model.ShiftTemplate, error) {
+	if err := validateShiftTemplate(in); err != nil {
+		return nil, err
+	}
+
 	id, err := s.storage.CreateShiftTemplate(ctx, read.User(), in)
 	if err != nil {
 		return nil, err
@@ -56,6 +67,10 @@ func (s *ShiftTemplate) SearchShiftTemplate(ctx context.Context, search *options
 }
 
 func (s *ShiftTemplate) UpdateShiftTemplate(ctx context.Context, read *options.Read, in *model.ShiftTemplate) (*model.ShiftTemplate, error) {
+	if err := validateShiftTemplate(in); err != nil {
+		return nil, err
+	}
+
 	if err := s.storage.UpdateShiftTemplate(ctx, read.User(), in); err != nil {
 		return nil, err
 	}
@@ -71,3 +86,42 @@ func (s *ShiftTemplate) DeleteShiftTemplate(ctx context.Context, read *options.R
 
 	return out, nil
 }
+
+// validateShiftTemplate checks times and rotation days against limits of agent shifts,
+// rotation, if set, should have at least one working day.
+func validateShiftTemplate(in *model.ShiftTemplate) error {
+	if len(in.Times) == 0 && len(in.Rotation) == 0 {
+		return ErrShiftTemplateTimes
+	}
+
+	for _, t := range in.Times {
+		if !validShiftTemplateTime(&t) {
+			return werror.Wrap(ErrShiftTemplateTime, werror.WithValue("start", t.Start), werror.WithValue("end", t.End))
+		}
+	}
+
+	var working int
+	for i, d := range in.Rotation {
+		if d.Time == nil {
+			continue
+		}
+
+		if !validShiftTemplateTime(d.Time) {
+			return werror.Wrap(ErrShiftTemplateTime, werror.WithValue("day", i), werror.WithValue("start", d.Time.Start), werror.WithValue("end", d.Time.End))
+		}
+
+		working++
+	}
+
+	if len(in.Rotation) > 0 && working == 0 {
+		return ErrShiftTemplateRotation
+	}
+
+	return nil
+}
+
+// validShiftTemplateTime reports whether the shift starts within a day, ends after its start
+// and lasts no longer than a day, so overnight shifts end no later than 2880.
+func validShiftTemplateTime(t *model.ShiftTemplateTime) bool {
+	return t.Start >= 0 && t.Start < model.MinutesPerDay && t.Start < t.End && t.End-t.Start <= model.MinutesPerDay
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+)
+
+// staffing accumulates scheduled agents against forecast per interval.
+// Intervals are sorted by their start time.
+type staffing struct {
+	intervals []*model.WorkingScheduleStaffing
+}
+
+func newStaffing(forecast []*model.ForecastCalculationResult) *staffing {
+	intervals := make([]*model.WorkingScheduleStaffing, 0, len(forecast))
+	for _, f := range forecast {
+		item := &model.WorkingScheduleStaffing{Timestamp: f.Timestamp}
+		if f.Agents != nil {
+			item.Required = *f.Agents
+		}
+
+		intervals = append(intervals, item)
+	}
+
+	slices.SortFunc(intervals, func(a, b *model.WorkingScheduleStaffing) int {
+		return a.Timestamp.Time.Compare(b.Timestamp.Time)
+	})
+
+	return &staffing{
+		intervals: intervals,
+	}
+}
+
+// newStaffingIntervals splits [from, to) into intervals of a step length.
+// Each interval requires the peak of forecast values within it,
+// or the last preceding forecast value if there are none.
+// A non-positive step or an empty period gives no intervals.
+func newStaffingIntervals(from, to time.Time, step time.Duration, forecast []*model.ForecastCalculationResult) *staffing {
+	if step <= 0 || !from.Before(to) {
+		return &staffing{}
+	}
+
+	points := newStaffing(forecast)
+	intervals := make([]*model.WorkingScheduleStaffing, 0, int(to.Sub(from)/step))
+	for t := from; t.Before(to); t = t.Add(step) {
+		item := &model.WorkingScheduleStaffing{Timestamp: pgtype.Timestamp{Time: t, Valid: true}}
+		if within := points.between(t, t.Add(step)); len(within) > 0 {
+			for _, p := range within {
+				item.Required = max(item.Required, p.Required)
+			}
+		} else if before := points.between(time.Time{}, t); len(before) > 0 {
+			item.Required = before[len(before)-1].Required
+		}
+
+		intervals = append(intervals, item)
+	}
+
+	return &staffing{
+		intervals: intervals,
+	}
+}
+
+// between returns intervals, that start within [from, to).
+func (s *staffing) between(from, to time.Time) []*model.WorkingScheduleStaffing {
+	lo := sort.Search(len(s.intervals), func(i int) bool {
+		return !s.intervals[i].Timestamp.Time.Before(from)
+	})
+
+	hi := sort.Search(len(s.intervals), func(i int) bool {
+		return !s.intervals[i].Timestamp.Time.Before(to)
+	})
+
+	return s.intervals[lo:hi]
+}
+
+// add counts an agent as scheduled within [from, to).
+func (s *staffing) add(from, to time.Time) {
+	for _, i := range s.between(from, to) {
+		i.Scheduled++
+	}
+}
+
+// absent discounts a scheduled agent within [from, to).
+func (s *staffing) absent(from, to time.Time) {
+	for _, i := range s.between(from, to) {
+		i.Scheduled--
+	}
+}
+
+// pause counts an agent as on pause within [from, to).
+func (s *staffing) pause(from, to time.Time) {
+	for _, i := range s.between(from, to) {
+		i.OnPause++
+	}
+}
+
+// gain returns the number of understaffed intervals within [from, to).
+func (s *staffing) gain(from, to time.Time) int {
+	var gain int
+	for _, i := range s.between(from, to) {
+		if i.Scheduled < i.Required {
+			gain++
+		}
+	}
+
+	return gain
+}
+
+// loss returns the number of intervals within [from, to), that become understaffed
+// if one more agent takes a pause there, and the number of agents already on pause within it.
+func (s *staffing) loss(from, to time.Time) (int, int64) {
+	var (
+		loss   int
+		paused int64
+	)
+
+	for _, i := range s.between(from, to) {
+		if i.Scheduled-i.OnPause <= i.Required {
+			loss++
+		}
+
+		paused += i.OnPause
+	}
+
+	return loss, paused
+}
+
+func (s *staffing) result() []*model.WorkingScheduleStaffing {
+	return s.intervals
+}
+
+// absenceBounds returns absence minutes within the day, whole-day absence lasts the entire day.
+func absenceBounds(s *model.AgentSchedule) (int64, int64) {
+	if s.PartialAbsence() {
+		return *s.AbsenceStart, *s.AbsenceEnd
+	}
+
+	return 0, model.MinutesPerDay
+}
+
+// shiftInstants returns UTC bounds of a shift, that starts on a given date,
+// minutes are wall-clock minutes of the timezone, so DST transition days are taken into account.
+// Staffing against the forecast is always counted in UTC.
+func shiftInstants(loc *time.Location, date time.Time, start, end int64) (time.Time, time.Time) {
+	y, m, d := date.Date()
+
+	return time.Date(y, m, d, 0, int(start), 0, 0, loc).UTC(), time.Date(y, m, d, 0, int(end), 0, 0, loc).UTC()
+}
+
+// dayStart returns the UTC instant of the date's midnight in the timezone.
+func dayStart(loc *time.Location, date time.Time) time.Time {
+	start, _ := shiftInstants(loc, date, 0, 0)
+
+	return start
+}
@@ -0,0 +1,165 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+)
+
+func forecastAt(t time.Time, agents int64) *model.ForecastCalculationResult {
+	return &model.ForecastCalculationResult{Timestamp: model.NewTimestamp(t.Unix()), Agents: &agents}
+}
+
+func TestNewStaffingIntervals(t *testing.T) {
+	from := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	forecast := []*model.ForecastCalculationResult{
+		forecastAt(from.Add(-30*time.Minute), 1),
+		forecastAt(from.Add(20*time.Minute), 3),
+		forecastAt(from.Add(5*time.Minute), 2),
+	}
+
+	tests := map[string]struct {
+		from, to time.Time
+		step     time.Duration
+		expected []int64
+	}{
+		"zero granularity": {
+			from: from,
+			to:   from.Add(time.Hour),
+			step: 0,
+		},
+		"negative granularity": {
+			from: from,
+			to:   from.Add(time.Hour),
+			step: -15 * time.Minute,
+		},
+		"empty period": {
+			from: from,
+			to:   from,
+			step: 15 * time.Minute,
+		},
+		"peak within interval and last preceding value": {
+			from:     from,
+			to:       from.Add(time.Hour),
+			step:     15 * time.Minute,
+			expected: []int64{2, 3, 3, 3},
+		},
+		"no preceding value": {
+			from:     from.Add(-2 * time.Hour),
+			to:       from.Add(-time.Hour),
+			step:     30 * time.Minute,
+			expected: []int64{0, 0},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			out := newStaffingIntervals(tt.from, tt.to, tt.step, forecast).result()
+			require.Len(t, out, len(tt.expected))
+			for i, item := range out {
+				assert.Equal(t, tt.from.Add(time.Duration(i)*tt.step), item.Timestamp.Time)
+				assert.Equal(t, tt.expected[i], item.Required)
+			}
+		})
+	}
+}
+
+func TestStaffingLoss(t *testing.T) {
+	from := time.Date(2026, time.March, 2, 9, 0, 0, 0, time.UTC)
+	forecast := []*model.ForecastCalculationResult{forecastAt(from, 1)}
+
+	tests := map[string]struct {
+		agents int
+		paused int
+		loss   int
+		onHold int64
+	}{
+		"understaffed": {
+			agents: 1,
+			loss:   2,
+		},
+		"one spare agent": {
+			agents: 2,
+			loss:   0,
+		},
+		"spare agent already on pause": {
+			agents: 2,
+			paused: 1,
+			loss:   2,
+			onHold: 2,
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			s := newStaffingIntervals(from, from.Add(30*time.Minute), 15*time.Minute, forecast)
+			for range tt.agents {
+				s.add(from, from.Add(30*time.Minute))
+			}
+
+			for range tt.paused {
+				s.pause(from, from.Add(30*time.Minute))
+			}
+
+			loss, paused := s.loss(from, from.Add(30*time.Minute))
+			assert.Equal(t, tt.loss, loss)
+			assert.Equal(t, tt.onHold, paused)
+		})
+	}
+}
+
+func TestShiftInstants(t *testing.T) {
+	kyiv, err := time.LoadLocation("Europe/Kyiv")
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		loc        *time.Location
+		date       time.Time
+		start, end int64
+		expected   [2]time.Time
+	}{
+		"regular day": {
+			loc:      kyiv,
+			date:     time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC),
+			start:    540,
+			end:      1080,
+			expected: [2]time.Time{time.Date(2026, time.March, 2, 7, 0, 0, 0, time.UTC), time.Date(2026, time.March, 2, 16, 0, 0, 0, time.UTC)},
+		},
+		"overnight shift": {
+			loc:      time.UTC,
+			date:     time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC),
+			start:    1320,
+			end:      1860,
+			expected: [2]time.Time{time.Date(2026, time.March, 2, 22, 0, 0, 0, time.UTC), time.Date(2026, time.March, 3, 7, 0, 0, 0, time.UTC)},
+		},
+		"DST day is an hour shorter": {
+			loc:      kyiv,
+			date:     time.Date(2026, time.March, 29, 0, 0, 0, 0, time.UTC),
+			start:    0,
+			end:      model.MinutesPerDay,
+			expected: [2]time.Time{time.Date(2026, time.March, 28, 22, 0, 0, 0, time.UTC), time.Date(2026, time.March, 29, 21, 0, 0, 0, time.UTC)},
+		},
+		"DST day is an hour longer": {
+			loc:      kyiv,
+			date:     time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC),
+			start:    0,
+			end:      model.MinutesPerDay,
+			expected: [2]time.Time{time.Date(2026, time.October, 24, 21, 0, 0, 0, time.UTC), time.Date(2026, time.October, 25, 22, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			start, end := shiftInstants(tt.loc, tt.date, tt.start, tt.end)
+			assert.Equal(t, tt.expected[0], start)
+			assert.Equal(t, tt.expected[1], end)
+			if tt.start == 0 {
+				assert.Equal(t, tt.expected[0], dayStart(tt.loc, tt.date))
+			}
+		})
+	}
+}
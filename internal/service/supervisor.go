@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
+	"github.com/webitel/webitel-wfm/infra/webitel/engine"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/storage"
+	"github.com/webitel/webitel-wfm/pkg/compare"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var (
+	ErrSupervisorAgent     = werror.Forbidden("signed-in user is not a supervisor agent", werror.WithID("service.supervisor.agent"))
+	ErrSupervisorSelf      = werror.Forbidden("agent can't decide on own request", werror.WithID("service.supervisor.self"))
+	ErrSupervisorForbidden = werror.Forbidden("signed-in user doesn't supervise the agents of the request", werror.WithID("service.supervisor.forbidden"))
+)
+
+// checkSupervisor checks, that the signed-in user supervises all agents and isn't one of them.
+func checkSupervisor(ctx context.Context, agent storage.AgentManager, engine *engine.Client, user *model.SignedInUser, agentIds ...int64) error {
+	supervisor, err := agent.ReadUserAgent(ctx, user)
+	if err != nil {
+		if werror.Is(err, dbsql.ErrNoRows) {
+			return werror.Wrap(ErrSupervisorAgent, werror.WithValue("user", user.Id))
+		}
+
+		return err
+	}
+
+	if slices.Contains(agentIds, supervisor.Id) {
+		return werror.Wrap(ErrSupervisorSelf, werror.WithValue("agent", supervisor.Id))
+	}
+
+	agentIds = slices.Compact(slices.Sorted(slices.Values(agentIds)))
+	supervised, err := engine.AgentService().Agents(ctx, &model.AgentSearch{Ids: agentIds, SupervisorIds: []int64{supervisor.Id}})
+	if err != nil {
+		return err
+	}
+
+	if !compare.ElementsMatch(supervised, agentIds) {
+		return werror.Wrap(ErrSupervisorForbidden, werror.WithValue("supervisor", supervisor.Id))
+	}
+
+	return nil
+}
+
+// checkAgentOrSupervisor checks, that the signed-in user is the agent itself or supervises it.
+func checkAgentOrSupervisor(ctx context.Context, agent storage.AgentManager, engine *engine.Client, user *model.SignedInUser, agentId int64) error {
+	self, err := agent.ReadUserAgent(ctx, user)
+	if err != nil && !werror.Is(err, dbsql.ErrNoRows) {
+		return err
+	}
+
+	if err == nil && self.Id == agentId {
+		return nil
+	}
+
+	return checkSupervisor(ctx, agent, engine, user, agentId)
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
+	"github.com/webitel/webitel-wfm/infra/webitel/engine"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/storage"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var (
+	ErrTimeOffRequestAgent = werror.Forbidden("signed-in user is not an agent", werror.WithID("service.time_off_request.agent"))
+	ErrTimeOffRequestOwner = werror.Forbidden("time-off request belongs to another agent", werror.WithID("service.time_off_request.owner"))
+	ErrTimeOffRequestState = werror.InvalidArgument("invalid input: time-off request is not pending", werror.WithID("service.time_off_request.state"))
+)
+
+type TimeOffRequestManager interface {
+	CreateTimeOffRequest(ctx context.Context, read *options.Read, in *model.TimeOffRequest) (*model.TimeOffRequest, error)
+	ReadTimeOffRequest(ctx context.Context, read *options.Read) (*model.TimeOffRequest, error)
+	SearchTimeOffRequest(ctx context.Context, search *options.Search, filter *model.TimeOffRequestSearch) ([]*model.TimeOffRequest, bool, error)
+
+	ApproveTimeOffRequest(ctx context.Context, read *options.Read, comment *string) (*model.TimeOffRequest, error)
+	RejectTimeOffRequest(ctx context.Context, read *options.Read, comment *string) (*model.TimeOffRequest, error)
+	CancelTimeOffRequest(ctx context.Context, read *options.Read) (*model.TimeOffRequest, error)
+}
+
+type TimeOffRequest struct {
+	storage     storage.TimeOffRequestManager
+	agent       storage.AgentManager
+	absenceType storage.AbsenceTypeManager
+	absence     *AgentAbsence
+	engine      *engine.Client
+}
+
+func NewTimeOffRequest(storage storage.TimeOffRequestManager, agent storage.AgentManager, absenceType storage.AbsenceTypeManager, absence *AgentAbsence, engine *engine.Client) *TimeOffRequest {
+	return &TimeOffRequest{
+		storage:     storage,
+		agent:       agent,
+		absenceType: absenceType,
+		absence:     absence,
+		engine:      engine,
+	}
+}
+
+// CreateTimeOffRequest submits a pending request on behalf of the agent linked to the signed-in user.
+// Request of the absence type, that doesn't require approval, is approved immediately.
+func (t *TimeOffRequest) CreateTimeOffRequest(ctx context.Context, read *options.Read, in *model.TimeOffRequest) (*model.TimeOffRequest, error) {
+	agent, err := t.signedInAgent(ctx, read.User())
+	if err != nil {
+		return nil, err
+	}
+
+	absenceTypeRead, err := options.NewRead(ctx, options.WithID(in.AbsenceType.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	absenceType, err := t.absenceType.ReadAbsenceType(ctx, absenceTypeRead)
+	if err != nil {
+		return nil, err
+	}
+
+	in.Agent = *agent
+	id, err := t.storage.CreateTimeOffRequest(ctx, read, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if !absenceType.RequiresApproval {
+		return t.decide(ctx, read.WithID(id), model.TimeOffRequestStateApproved, nil)
+	}
+
+	return t.ReadTimeOffRequest(ctx, read.WithID(id))
+}
+
+func (t *TimeOffRequest) ReadTimeOffRequest(ctx context.Context, read *options.Read) (*model.TimeOffRequest, error) {
+	out, err := t.storage.ReadTimeOffRequest(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// SearchTimeOffRequest searches requests of the desired agents, teams and supervisors.
+// Teams and supervisors are resolved to the agents they consist of.
+func (t *TimeOffRequest) SearchTimeOffRequest(ctx context.Context, search *options.Search, filter *model.TimeOffRequestSearch) ([]*model.TimeOffRequest, bool, error) {
+	if len(filter.SupervisorIds) > 0 || len(filter.TeamIds) > 0 {
+		agentIds, err := t.engine.AgentService().Agents(ctx, &model.AgentSearch{Ids: filter.AgentIds, SupervisorIds: filter.SupervisorIds, TeamIds: filter.TeamIds})
+		if err != nil {
+			return nil, false, err
+		}
+
+		if len(agentIds) == 0 {
+			return nil, false, nil
+		}
+
+		filter.AgentIds = agentIds
+	}
+
+	out, err := t.storage.SearchTimeOffRequest(ctx, search, filter)
+	if err != nil {
+		return nil, false, err
+	}
+
+	next, out := model.ListResult(int32(search.Size()), out)
+
+	return out, next, nil
+}
+
+// ApproveTimeOffRequest approves pending request and creates agent absences for its period,
+// only a supervisor of the request's agent can approve it.
+func (t *TimeOffRequest) ApproveTimeOffRequest(ctx context.Context, read *options.Read, comment *string) (*model.TimeOffRequest, error) {
+	if err := t.checkSupervisor(ctx, read); err != nil {
+		return nil, err
+	}
+
+	return t.decide(ctx, read, model.TimeOffRequestStateApproved, comment)
+}
+
+// RejectTimeOffRequest rejects pending request, only a supervisor of the request's agent can reject it.
+func (t *TimeOffRequest) RejectTimeOffRequest(ctx context.Context, read *options.Read, comment *string) (*model.TimeOffRequest, error) {
+	if err := t.checkSupervisor(ctx, read); err != nil {
+		return nil, err
+	}
+
+	return t.decide(ctx, read, model.TimeOffRequestStateRejected, comment)
+}
+
+// CancelTimeOffRequest cancels pending request, only the agent, who submitted the request, can cancel it.
+func (t *TimeOffRequest) CancelTimeOffRequest(ctx context.Context, read *options.Read) (*model.TimeOffRequest, error) {
+	agent, err := t.signedInAgent(ctx, read.User())
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := t.ReadTimeOffRequest(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.Agent.Id != agent.Id {
+		return nil, ErrTimeOffRequestOwner
+	}
+
+	return t.transition(ctx, read, &model.TimeOffRequest{State: model.TimeOffRequestStateCancelled})
+}
+
+func (t *TimeOffRequest) checkSupervisor(ctx context.Context, read *options.Read) error {
+	item, err := t.ReadTimeOffRequest(ctx, read)
+	if err != nil {
+		return err
+	}
+
+	return checkSupervisor(ctx, t.agent, t.engine, read.User(), item.Agent.Id)
+}
+
+func (t *TimeOffRequest) decide(ctx context.Context, read *options.Read, to model.TimeOffRequestState, comment *string) (*model.TimeOffRequest, error) {
+	if to == model.TimeOffRequestStateApproved {
+		if err := t.checkBalance(ctx, read); err != nil {
+			return nil, err
+		}
+	}
+
+	in := &model.TimeOffRequest{
+		State:     to,
+		Comment:   comment,
+		DecidedBy: &model.LookupItem{Id: read.User().Id},
+	}
+
+	return t.transition(ctx, read, in)
+}
+
+// checkBalance checks, that whole-day absences of the request period don't exceed the remaining agent balance.
+func (t *TimeOffRequest) checkBalance(ctx context.Context, read *options.Read) error {
+	item, err := t.ReadTimeOffRequest(ctx, read)
+	if err != nil {
+		return err
+	}
+
+	var absences []*model.Absence
+	for d := item.DateFrom.Time; !d.After(item.DateTo.Time); d = d.AddDate(0, 0, 1) {
+		absences = append(absences, &model.Absence{AbsentAt: pgtype.Date{Time: d, Valid: true}, AbsenceType: item.AbsenceType})
+	}
+
+	agentRead, err := options.NewRead(ctx, options.WithDerivedID("agent", item.Agent.Id))
+	if err != nil {
+		return err
+	}
+
+	exceeded, err := t.absence.checkAgentAbsenceBalance(ctx, agentRead, nil, absences...)
+	if err != nil {
+		return err
+	}
+
+	if exceeded {
+		return werror.Wrap(ErrAgentAbsenceBalanceExceeded, werror.WithValue("type", item.AbsenceType.Id),
+			werror.WithValue("year", item.DateFrom.Time.Year()),
+		)
+	}
+
+	return nil
+}
+
+func (t *TimeOffRequest) transition(ctx context.Context, read *options.Read, in *model.TimeOffRequest) (*model.TimeOffRequest, error) {
+	if err := t.storage.UpdateTimeOffRequestState(ctx, read, model.TimeOffRequestStatePending, in); err != nil {
+		if werror.Is(err, dbsql.ErrNoRows) {
+			return nil, werror.Wrap(ErrTimeOffRequestState, werror.WithValue("to", in.State.String()))
+		}
+
+		return nil, err
+	}
+
+	return t.ReadTimeOffRequest(ctx, read)
+}
+
+func (t *TimeOffRequest) signedInAgent(ctx context.Context, user *model.SignedInUser) (*model.LookupItem, error) {
+	agent, err := t.agent.ReadUserAgent(ctx, user)
+	if err != nil {
+		if werror.Is(err, dbsql.ErrNoRows) {
+			return nil, werror.Wrap(ErrTimeOffRequestAgent, werror.WithValue("user", user.Id))
+		}
+
+		return nil, err
+	}
+
+	return agent, nil
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/storage"
+	"github.com/webitel/webitel-wfm/pkg"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+const (
+	ruleConditionWorkdayHours     = "condition.workday_hours"
+	ruleConditionMonthHours       = "condition.month_hours"
+	ruleConditionWorkdaysPerMonth = "condition.workdays_per_month"
+	ruleConditionPauseDuration    = "condition.pause_duration"
+)
+
+var ErrAgentWorkingScheduleConditionLimit = werror.InvalidArgument("invalid input: agent shifts exceed limits of the agent working condition", werror.WithID("service.agent_working_schedule.condition_limit"))
+
+// agentConditions reads working conditions linked to agents.
+// Working conditions are cached by id, so the shared condition is read only once.
+type agentConditions struct {
+	agentConditions  storage.AgentWorkingConditionsManager
+	workingCondition storage.WorkingConditionManager
+
+	agents     map[int64]*model.AgentWorkingConditions
+	conditions map[int64]*model.WorkingCondition
+}
+
+func newAgentConditions(agentConditionsStorage storage.AgentWorkingConditionsManager, workingConditionStorage storage.WorkingConditionManager) *agentConditions {
+	return &agentConditions{
+		agentConditions:  agentConditionsStorage,
+		workingCondition: workingConditionStorage,
+		agents:           make(map[int64]*model.AgentWorkingConditions),
+		conditions:       make(map[int64]*model.WorkingCondition),
+	}
+}
+
+// agent returns working conditions linked to the agent, or nil if agent has none.
+func (a *agentConditions) agent(ctx context.Context, agentId int64) (*model.AgentWorkingConditions, error) {
+	if awc, ok := a.agents[agentId]; ok {
+		return awc, nil
+	}
+
+	read, err := options.NewRead(ctx, options.WithID(agentId))
+	if err != nil {
+		return nil, err
+	}
+
+	awc, err := a.agentConditions.ReadAgentWorkingConditions(ctx, read)
+	if err != nil {
+		if !werror.Is(err, dbsql.ErrNoRows) {
+			return nil, err
+		}
+
+		awc = nil
+	}
+
+	a.agents[agentId] = awc
+
+	return awc, nil
+}
+
+// read returns working condition of the agent, or nil if agent has none.
+func (a *agentConditions) read(ctx context.Context, agentId int64) (*model.WorkingCondition, error) {
+	awc, err := a.agent(ctx, agentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if awc == nil || awc.WorkingCondition.Id == 0 {
+		return nil, nil
+	}
+
+	if condition, ok := a.conditions[awc.WorkingCondition.Id]; ok {
+		return condition, nil
+	}
+
+	read, err := options.NewRead(ctx, options.WithID(awc.WorkingCondition.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	condition, err := a.workingCondition.ReadWorkingCondition(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	a.conditions[awc.WorkingCondition.Id] = condition
+
+	return condition, nil
+}
+
+// readAll returns working conditions by agent id, agents without any working condition are omitted.
+func (a *agentConditions) readAll(ctx context.Context, agentIds []int64) (map[int64]*model.WorkingCondition, error) {
+	out := make(map[int64]*model.WorkingCondition, len(agentIds))
+	for _, id := range agentIds {
+		condition, err := a.read(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if condition != nil {
+			out[id] = condition
+		}
+	}
+
+	return out, nil
+}
+
+// shiftWorkedMinutes returns shift duration excluding its pauses.
+func shiftWorkedMinutes(shift *model.AgentScheduleShift) int64 {
+	return shift.End - shift.Start - shiftPauseMinutes(shift)
+}
+
+func shiftPauseMinutes(shift *model.AgentScheduleShift) int64 {
+	var out int64
+	for _, p := range shift.Pauses {
+		out += p.End - p.Start
+	}
+
+	return out
+}
+
+// checkConditionLimits reports agent shifts, that exceed limits of the working condition:
+// worked hours per day and per month, workdays per month and pause minutes per day.
+// Daily limits are computed over all segments of split shifts within a day.
+// Monthly worked hours limit is a product of workday hours and workdays per month.
+// Violations of monthly limits are reported on the date of the first exceeding shift.
+// Agent schedule should be sorted by date.
+func checkConditionLimits(agent *model.AgentWorkingSchedule, condition *model.WorkingCondition, _ *time.Location) []*model.WorkingScheduleViolation {
+	if condition == nil {
+		return nil
+	}
+
+	var (
+		out      []*model.WorkingScheduleViolation
+		workdays = make(map[string]int32)
+		minutes  = make(map[string]int64)
+		reported = make(map[string]bool)
+	)
+
+	workdayHours := pkg.FromPTR(condition.WorkdayHours)
+	workdaysPerMonth := pkg.FromPTR(condition.WorkdaysPerMonth)
+	pauseDuration := pkg.FromPTR(condition.PauseDuration)
+	for _, day := range shiftDays(agent.Schedule) {
+		var worked, paused int64
+		for _, s := range day {
+			worked += shiftWorkedMinutes(s.Shift)
+			paused += shiftPauseMinutes(s.Shift)
+		}
+
+		s := day[0]
+		if workdayHours > 0 && worked > int64(workdayHours)*60 {
+			out = append(out, newViolation(agent, s, ruleConditionWorkdayHours, model.ViolationSeverityError,
+				fmt.Sprintf("shift has %s of work, working condition allows %dh per day", time.Duration(worked)*time.Minute, workdayHours)),
+			)
+		}
+
+		if pauseDuration > 0 && paused > int64(pauseDuration) {
+			out = append(out, newViolation(agent, s, ruleConditionPauseDuration, model.ViolationSeverityError,
+				fmt.Sprintf("shift has %d pause minutes, working condition allows %d per day", paused, pauseDuration)),
+			)
+		}
+
+		month := s.Date.Time.Format("2006-01")
+		workdays[month]++
+		minutes[month] += worked
+		if workdaysPerMonth > 0 && workdays[month] > workdaysPerMonth && !reported[ruleConditionWorkdaysPerMonth+month] {
+			reported[ruleConditionWorkdaysPerMonth+month] = true
+			out = append(out, newViolation(agent, s, ruleConditionWorkdaysPerMonth, model.ViolationSeverityError,
+				fmt.Sprintf("agent has more than %d workdays in %s", workdaysPerMonth, month)),
+			)
+		}
+
+		if limit := int64(workdayHours) * int64(workdaysPerMonth) * 60; limit > 0 && minutes[month] > limit && !reported[ruleConditionMonthHours+month] {
+			reported[ruleConditionMonthHours+month] = true
+			out = append(out, newViolation(agent, s, ruleConditionMonthHours, model.ViolationSeverityError,
+				fmt.Sprintf("agent has more than %dh of work in %s", limit/60, month)),
+			)
+		}
+	}
+
+	return out
+}
+
+// changedViolation returns the first violation on a date of the changed shifts,
+// violations of monthly limits are returned, if the month has any of the changed shifts.
+// Limits exceeded on other dates don't block the change.
+func changedViolation(violations []*model.WorkingScheduleViolation, changed []*model.AgentSchedule) *model.WorkingScheduleViolation {
+	var (
+		dates  = make(map[string]bool, len(changed))
+		months = make(map[string]bool, len(changed))
+	)
+
+	for _, s := range changed {
+		dates[s.Date.Time.Format(time.DateOnly)] = true
+		months[s.Date.Time.Format("2006-01")] = true
+	}
+
+	for _, v := range violations {
+		switch v.Rule {
+		case ruleConditionMonthHours, ruleConditionWorkdaysPerMonth:
+			if months[v.Date.Time.Format("2006-01")] {
+				return v
+			}
+		default:
+			if dates[v.Date.Time.Format(time.DateOnly)] {
+				return v
+			}
+		}
+	}
+
+	return nil
+}
+
+// shiftDays groups shifts of the agent schedule by date, segments of split shifts are grouped together.
+// Agent schedule should be sorted by date.
+func shiftDays(schedule []*model.AgentSchedule) [][]*model.AgentSchedule {
+	var out [][]*model.AgentSchedule
+	for _, s := range schedule {
+		if s.Shift == nil {
+			continue
+		}
+
+		if l := len(out); l > 0 && out[l-1][0].Date.Time.Equal(s.Date.Time) {
+			out[l-1] = append(out[l-1], s)
+
+			continue
+		}
+
+		out = append(out, []*model.AgentSchedule{s})
+	}
+
+	return out
+}
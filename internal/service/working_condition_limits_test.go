@@ -0,0 +1,166 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/pkg"
+)
+
+func TestCheckConditionLimits(t *testing.T) {
+	day := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	paused := func(s *model.AgentSchedule, pauses ...*model.AgentScheduleShiftPause) *model.AgentSchedule {
+		s.Shift.Pauses = pauses
+
+		return s
+	}
+
+	tests := map[string]struct {
+		condition *model.WorkingCondition
+		schedule  []*model.AgentSchedule
+		expected  []string
+	}{
+		"no working condition": {
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 0, 1440)},
+			expected: []string{},
+		},
+		"unlimited working condition": {
+			condition: &model.WorkingCondition{},
+			schedule:  []*model.AgentSchedule{scheduleShift(day, 1, 0, 1440)},
+			expected:  []string{},
+		},
+		"workday hours exceeded": {
+			condition: &model.WorkingCondition{WorkdayHours: pkg.ToPTR(int32(8))},
+			schedule:  []*model.AgentSchedule{scheduleShift(day, 1, 480, 1020)},
+			expected:  []string{ruleConditionWorkdayHours},
+		},
+		"pauses are not worked": {
+			condition: &model.WorkingCondition{WorkdayHours: pkg.ToPTR(int32(8))},
+			schedule:  []*model.AgentSchedule{paused(scheduleShift(day, 1, 480, 1020), &model.AgentScheduleShiftPause{Start: 720, End: 780})},
+			expected:  []string{},
+		},
+		"split shift segments are a single workday": {
+			condition: &model.WorkingCondition{WorkdayHours: pkg.ToPTR(int32(8)), WorkdaysPerMonth: pkg.ToPTR(int32(1))},
+			schedule:  []*model.AgentSchedule{scheduleShift(day, 1, 480, 720), scheduleShift(day, 2, 780, 1080)},
+			expected:  []string{ruleConditionWorkdayHours, ruleConditionMonthHours},
+		},
+		"pause duration exceeded over segments": {
+			condition: &model.WorkingCondition{PauseDuration: pkg.ToPTR(int32(30))},
+			schedule: []*model.AgentSchedule{
+				paused(scheduleShift(day, 1, 480, 720), &model.AgentScheduleShiftPause{Start: 600, End: 620}),
+				paused(scheduleShift(day, 2, 780, 1020), &model.AgentScheduleShiftPause{Start: 900, End: 920}),
+			},
+			expected: []string{ruleConditionPauseDuration},
+		},
+		"workdays per month are reported once": {
+			condition: &model.WorkingCondition{WorkdaysPerMonth: pkg.ToPTR(int32(1))},
+			schedule: []*model.AgentSchedule{
+				scheduleShift(day, 1, 480, 960),
+				scheduleShift(day.AddDate(0, 0, 1), 2, 480, 960),
+				scheduleShift(day.AddDate(0, 0, 2), 3, 480, 960),
+			},
+			expected: []string{ruleConditionWorkdaysPerMonth},
+		},
+		"workdays are counted per month": {
+			condition: &model.WorkingCondition{WorkdaysPerMonth: pkg.ToPTR(int32(1))},
+			schedule: []*model.AgentSchedule{
+				scheduleShift(time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC), 1, 480, 960),
+				scheduleShift(time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC), 2, 480, 960),
+			},
+			expected: []string{},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			out := checkConditionLimits(&model.AgentWorkingSchedule{Schedule: tt.schedule}, tt.condition, time.UTC)
+			assert.Equal(t, tt.expected, violationRules(out))
+		})
+	}
+}
+
+func TestShiftDays(t *testing.T) {
+	var (
+		day  = time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+		next = day.AddDate(0, 0, 1)
+	)
+
+	tests := map[string]struct {
+		schedule []*model.AgentSchedule
+		expected [][]int64
+	}{
+		"empty schedule": {
+			expected: nil,
+		},
+		"days without shifts are skipped": {
+			schedule: []*model.AgentSchedule{{Date: model.NewDate(day.Unix())}, scheduleShift(next, 1, 540, 1080)},
+			expected: [][]int64{{1}},
+		},
+		"split shift segments are grouped": {
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 480, 720), scheduleShift(day, 2, 780, 1020), scheduleShift(next, 3, 540, 1080)},
+			expected: [][]int64{{1, 2}, {3}},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			var ids [][]int64
+			for _, d := range shiftDays(tt.schedule) {
+				var day []int64
+				for _, s := range d {
+					day = append(day, s.Shift.Id)
+				}
+
+				ids = append(ids, day)
+			}
+
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestChangedViolation(t *testing.T) {
+	day := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	violation := func(date time.Time, rule string) *model.WorkingScheduleViolation {
+		return &model.WorkingScheduleViolation{Date: model.NewDate(date.Unix()), Rule: rule}
+	}
+
+	changed := []*model.AgentSchedule{scheduleShift(day, 1, 540, 1080)}
+	tests := map[string]struct {
+		violations []*model.WorkingScheduleViolation
+		expected   string
+	}{
+		"daily limit on the changed date": {
+			violations: []*model.WorkingScheduleViolation{violation(day, ruleConditionWorkdayHours)},
+			expected:   ruleConditionWorkdayHours,
+		},
+		"daily limit on another date": {
+			violations: []*model.WorkingScheduleViolation{violation(day.AddDate(0, 0, 1), ruleConditionPauseDuration)},
+		},
+		"monthly limit within the changed month": {
+			violations: []*model.WorkingScheduleViolation{violation(day.AddDate(0, 0, 10), ruleConditionWorkdaysPerMonth)},
+			expected:   ruleConditionWorkdaysPerMonth,
+		},
+		"monthly limit of another month": {
+			violations: []*model.WorkingScheduleViolation{violation(day.AddDate(0, -1, 0), ruleConditionMonthHours)},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			v := changedViolation(tt.violations, changed)
+			if tt.expected == "" {
+				assert.Nil(t, v)
+
+				return
+			}
+
+			if assert.NotNil(t, v) {
+				assert.Equal(t, tt.expected, v.Rule)
+			}
+		})
+	}
+}
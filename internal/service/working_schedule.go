@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/webitel/webitel-wfm/infra/webitel/engine"
 	"github.com/webitel/webitel-wfm/internal/model"
@@ -15,13 +17,29 @@ var (
 	ErrWorkingScheduleUpdateDraft = werror.InvalidArgument("working schedule can only be updated in a draft state", werror.WithID("service.working_schedule.state"))
 	ErrAgentNotAllowed            = werror.Forbidden("you haven't read access to a desired set of agents")
 	ErrEmptyForecastCalculation   = werror.InvalidArgument("attached team doesn't have configured forecast calculation procedure", werror.WithID("service.working_schedule.empty_forecast_calculation"))
+
+	ErrWorkingScheduleStateTransition = werror.InvalidArgument("working schedule state transition isn't allowed", werror.WithID("service.working_schedule.state_transition"))
+	ErrWorkingScheduleAgentsOverlap   = werror.InvalidArgument("working schedule agents overlap with another active working schedule", werror.WithID("service.working_schedule.agents_overlap"))
+	ErrWorkingScheduleArchiveEndDate  = werror.InvalidArgument("working schedule can't be archived before its end date", werror.WithID("service.working_schedule.archive_end_date"))
+	ErrWorkingScheduleGranularity     = werror.InvalidArgument("invalid input: granularity should be one of 15, 30 or 60 minutes", werror.WithID("service.working_schedule.granularity"))
 )
 
+// coverageGranularities lists supported interval lengths of the working schedule coverage.
+var coverageGranularities = []time.Duration{15 * time.Minute, 30 * time.Minute, time.Hour}
+
+// workingScheduleTransitions lists target states allowed for each working schedule state.
+var workingScheduleTransitions = map[model.WorkingScheduleState][]model.WorkingScheduleState{
+	model.WorkingScheduleStateDraft:   {model.WorkingScheduleStatePending},
+	model.WorkingScheduleStatePending: {model.WorkingScheduleStateActive, model.WorkingScheduleStateDraft},
+	model.WorkingScheduleStateActive:  {model.WorkingScheduleStateArchived},
+}
+
 type WorkingScheduleManager interface {
 	CreateWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.WorkingSchedule) (*model.WorkingSchedule, error)
 	ReadWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.SearchItem) (*model.WorkingSchedule, error)
 
 	ReadWorkingScheduleForecast(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween) ([]*model.ForecastCalculationResult, error)
+	ReadWorkingScheduleCoverage(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, granularity time.Duration, skillIds []int64) ([]*model.WorkingScheduleStaffing, error)
 
 	SearchWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.SearchItem) ([]*model.WorkingSchedule, bool, error)
 	UpdateWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.WorkingSchedule) (*model.WorkingSchedule, error)
@@ -29,20 +47,52 @@ type WorkingScheduleManager interface {
 
 	UpdateWorkingScheduleAddAgents(ctx context.Context, user *model.SignedInUser, id int64, agentIds []int64) ([]*model.LookupItem, error)
 	UpdateWorkingScheduleRemoveAgent(ctx context.Context, user *model.SignedInUser, id int64, agentId int64) (int64, error)
+
+	SubmitWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error)
+	ApproveWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error)
+	RejectWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error)
+	ArchiveWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error)
+
+	GenerateWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, shiftTemplateId *int64) ([]*model.AgentWorkingSchedule, []*model.WorkingScheduleStaffing, error)
+	PlaceWorkingSchedulePauses(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, agentIds []int64, placement *model.PausePlacement, overwrite bool) ([]*model.AgentWorkingSchedule, []*model.WorkingScheduleStaffing, error)
+	CloneWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.CloneWorkingSchedule) (*model.WorkingSchedule, error)
+	ValidateWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) ([]*model.WorkingScheduleViolation, error)
 }
 
 type WorkingSchedule struct {
-	storage storage.WorkingScheduleManager
+	storage          storage.WorkingScheduleManager
+	agentSchedule    storage.AgentWorkingScheduleManager
+	agentConditions  storage.AgentWorkingConditionsManager
+	workingCondition storage.WorkingConditionManager
+	shiftTemplate    storage.ShiftTemplateManager
+	pauseTemplate    storage.PauseTemplateManager
+
+	// agentShifts writes generated shifts with the checks of the agent working schedule service.
+	agentShifts *AgentWorkingSchedule
 
 	engine   *engine.Client
 	forecast ForecastCalculationManager
 }
 
-func NewWorkingSchedule(storage storage.WorkingScheduleManager, engine *engine.Client) *WorkingSchedule {
-	return &WorkingSchedule{
-		storage: storage,
-		engine:  engine,
+func NewWorkingSchedule(storage storage.WorkingScheduleManager, agentSchedule storage.AgentWorkingScheduleManager,
+	agentConditions storage.AgentWorkingConditionsManager, workingCondition storage.WorkingConditionManager,
+	shiftTemplate storage.ShiftTemplateManager, pauseTemplate storage.PauseTemplateManager, engine *engine.Client, forecast ForecastCalculationManager,
+) *WorkingSchedule {
+	w := &WorkingSchedule{
+		storage:          storage,
+		agentSchedule:    agentSchedule,
+		agentConditions:  agentConditions,
+		workingCondition: workingCondition,
+		shiftTemplate:    shiftTemplate,
+		pauseTemplate:    pauseTemplate,
+		engine:           engine,
+		forecast:         forecast,
 	}
+
+	// Roster patterns aren't used by generation.
+	w.agentShifts = NewAgentWorkingSchedule(agentSchedule, storage, agentConditions, workingCondition, pauseTemplate, shiftTemplate, nil, w, engine)
+
+	return w
 }
 
 func (w *WorkingSchedule) CreateWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.WorkingSchedule) (*model.WorkingSchedule, error) {
@@ -57,6 +107,7 @@ func (w *WorkingSchedule) CreateWorkingSchedule(ctx context.Context, user *model
 	}
 
 	in.Agents = agents
+	in.State = model.WorkingScheduleStateDraft
 	out, err := w.storage.CreateWorkingSchedule(ctx, user, in)
 	if err != nil {
 		return nil, err
@@ -110,6 +161,73 @@ func (w *WorkingSchedule) ReadWorkingScheduleForecast(ctx context.Context, user
 	return forecast, nil
 }
 
+// ReadWorkingScheduleCoverage compares required agents from the forecast with scheduled agents
+// and agents on pause per interval of a desired granularity.
+// Agents are not counted as scheduled within the hours of their absences.
+// Overnight shifts are counted on both days they cover.
+// If skills are set, only shifts with any of them are counted.
+func (w *WorkingSchedule) ReadWorkingScheduleCoverage(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, granularity time.Duration, skillIds []int64) ([]*model.WorkingScheduleStaffing, error) {
+	if !slices.Contains(coverageGranularities, granularity) {
+		return nil, werror.Wrap(ErrWorkingScheduleGranularity, werror.WithValue("granularity", granularity.String()))
+	}
+
+	ws, err := w.storage.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	forecast, err := w.ReadWorkingScheduleForecast(ctx, user, id, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// Overnight shifts of the previous day are counted within the first day,
+	// absences of the next day are discounted from overnight shifts of the last day.
+	items, err := w.agentSchedule.SearchAgentWorkingSchedule(ctx, user, &model.AgentWorkingScheduleSearch{
+		SearchItem:        model.SearchItem{Date: withAdjacentDays(date, 1, 1)},
+		WorkingScheduleId: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Days of the filter and shift times are local to the calendar timezone, intervals are in UTC.
+	loc := ws.Location()
+	staff := newStaffingIntervals(dayStart(loc, date.From.Time), dayStart(loc, date.To.Time.AddDate(0, 0, 1)), granularity, forecast)
+	for _, item := range items {
+		absences := make(map[time.Time][]*model.AgentSchedule)
+		for _, s := range item.Schedule {
+			if s.Absence != nil {
+				absences[s.Date.Time] = append(absences[s.Date.Time], s)
+			}
+		}
+
+		for _, s := range item.Schedule {
+			if s.Shift == nil || !shiftHasSkill(s.Shift, skillIds) {
+				continue
+			}
+
+			staff.add(shiftInstants(loc, s.Date.Time, s.Shift.Start, s.Shift.End))
+			for _, p := range s.Shift.Pauses {
+				staff.pause(shiftInstants(loc, s.Date.Time, p.Start, p.End))
+			}
+
+			// Absences of the next day are shifted by a day for the overnight part of the shift.
+			for offset, day := range []time.Time{s.Date.Time, s.Date.Time.AddDate(0, 0, 1)} {
+				for _, a := range absences[day] {
+					start, end := absenceBounds(a)
+					start, end = start+int64(offset)*model.MinutesPerDay, end+int64(offset)*model.MinutesPerDay
+					if start, end = max(start, s.Shift.Start), min(end, s.Shift.End); start < end {
+						staff.absent(shiftInstants(loc, s.Date.Time, start, end))
+					}
+				}
+			}
+		}
+	}
+
+	return staff.result(), nil
+}
+
 func (w *WorkingSchedule) SearchWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.SearchItem) ([]*model.WorkingSchedule, bool, error) {
 	out, err := w.storage.SearchWorkingSchedule(ctx, user, search)
 	if err != nil {
@@ -193,3 +311,85 @@ func (w *WorkingSchedule) UpdateWorkingScheduleRemoveAgent(ctx context.Context,
 
 	return out, nil
 }
+
+// SubmitWorkingSchedule sends a draft working schedule for review.
+func (w *WorkingSchedule) SubmitWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error) {
+	return w.transition(ctx, user, id, model.WorkingScheduleStatePending, func(ws *model.WorkingSchedule) error {
+		return w.validateDraft(ctx, user, ws)
+	})
+}
+
+// ApproveWorkingSchedule activates a pending working schedule.
+// Agents of the schedule can't be a part of another active schedule within the same period.
+func (w *WorkingSchedule) ApproveWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error) {
+	return w.transition(ctx, user, id, model.WorkingScheduleStateActive, func(ws *model.WorkingSchedule) error {
+		agents, err := w.storage.SearchWorkingScheduleAgentsOverlap(ctx, user, ws.Id, model.WorkingScheduleStateActive)
+		if err != nil {
+			return err
+		}
+
+		if len(agents) > 0 {
+			return werror.Wrap(ErrWorkingScheduleAgentsOverlap, werror.WithValue("agents", agents))
+		}
+
+		return nil
+	})
+}
+
+// RejectWorkingSchedule returns a pending working schedule back to the draft state.
+func (w *WorkingSchedule) RejectWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error) {
+	return w.transition(ctx, user, id, model.WorkingScheduleStateDraft, nil)
+}
+
+// ArchiveWorkingSchedule archives an active working schedule once its period is over.
+func (w *WorkingSchedule) ArchiveWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) (*model.WorkingSchedule, error) {
+	return w.transition(ctx, user, id, model.WorkingScheduleStateArchived, func(ws *model.WorkingSchedule) error {
+		if !timeutils.Date(time.Now().UTC()).After(ws.EndDateAt.Time) {
+			return werror.Wrap(ErrWorkingScheduleArchiveEndDate, werror.WithValue("end_date_at", ws.EndDateAt.Time.Format(time.DateOnly)))
+		}
+
+		return nil
+	})
+}
+
+// transition checks whether working schedule can be moved to a desired state,
+// runs additional transition check (if any) and stores a new state.
+func (w *WorkingSchedule) transition(ctx context.Context, user *model.SignedInUser, id int64, to model.WorkingScheduleState, check func(ws *model.WorkingSchedule) error) (*model.WorkingSchedule, error) {
+	ws, err := w.storage.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(workingScheduleTransitions[ws.State], to) {
+		return nil, werror.Wrap(ErrWorkingScheduleStateTransition, werror.WithValue("from", ws.State.String()), werror.WithValue("to", to.String()))
+	}
+
+	if check != nil {
+		if err := check(ws); err != nil {
+			return nil, err
+		}
+	}
+
+	out, err := w.storage.UpdateWorkingScheduleState(ctx, user, ws.Id, ws.State, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// shiftHasSkill checks if shift has any of desired skills.
+// Empty list of skills matches any shift.
+func shiftHasSkill(shift *model.AgentScheduleShift, skillIds []int64) bool {
+	if len(skillIds) == 0 {
+		return true
+	}
+
+	for _, skill := range shift.Skills {
+		if slices.Contains(skillIds, skill.Skill.Id) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/pkg/timeutils"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var (
+	ErrWorkingScheduleCloneWeeks      = werror.InvalidArgument("invalid input: rolling pattern is longer than the source working schedule", werror.WithID("service.working_schedule.clone.weeks"))
+	ErrWorkingScheduleCloneWeeksRange = werror.InvalidArgument("invalid input: rolling pattern should repeat at least one week", werror.WithID("service.working_schedule.clone.weeks_range"))
+)
+
+// CloneWorkingSchedule copies the working schedule with its agents and extra skills
+// into a new draft working schedule of a desired period.
+//
+// Shifts, including their pauses and skills, are optionally copied on the same weekdays:
+// the weekday pattern repeats all whole weeks of the source period,
+// the rolling pattern repeats the first weeks of the source period.
+// Source days falling on holidays are skipped, as well as absences, locked days
+// and new days falling on holidays of the calendar.
+// Shifts at the pattern boundaries aren't checked against each other, use ValidateWorkingSchedule for that.
+func (w *WorkingSchedule) CloneWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.CloneWorkingSchedule) (*model.WorkingSchedule, error) {
+	src, err := w.storage.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: in.Id})
+	if err != nil {
+		return nil, err
+	}
+
+	var shifts []*model.AgentWorkingSchedule
+	if in.Shifts == model.WorkingScheduleCloneShiftsWeekday || in.Shifts == model.WorkingScheduleCloneShiftsRolling {
+		if shifts, err = w.cloneShifts(ctx, user, src, in); err != nil {
+			return nil, err
+		}
+	}
+
+	clone := &model.WorkingSchedule{
+		Name:                 src.Name,
+		State:                model.WorkingScheduleStateDraft,
+		Team:                 src.Team,
+		Calendar:             src.Calendar,
+		StartDateAt:          in.StartDateAt,
+		EndDateAt:            in.EndDateAt,
+		StartTimeAt:          src.StartTimeAt,
+		EndTimeAt:            src.EndTimeAt,
+		ExtraSkills:          src.ExtraSkills,
+		BlockOutsideActivity: src.BlockOutsideActivity,
+		Agents:               src.Agents,
+	}
+
+	if in.Name != nil {
+		clone.Name = *in.Name
+	}
+
+	return w.storage.CloneWorkingSchedule(ctx, user, clone, shifts)
+}
+
+// cloneShifts maps each day of the new period onto a source day of the same weekday
+// and copies shifts of the source day for each agent.
+func (w *WorkingSchedule) cloneShifts(ctx context.Context, user *model.SignedInUser, src *model.WorkingSchedule, in *model.CloneWorkingSchedule) ([]*model.AgentWorkingSchedule, error) {
+	srcStart := src.StartDateAt.Time
+	srcDays := int(src.EndDateAt.Time.Sub(srcStart).Hours()/24) + 1
+
+	weeks := max(srcDays/7, 1)
+	if in.Shifts == model.WorkingScheduleCloneShiftsRolling {
+		if in.Weeks < 1 {
+			return nil, werror.Wrap(ErrWorkingScheduleCloneWeeksRange, werror.WithValue("weeks", in.Weeks))
+		}
+
+		if in.Weeks*7 > srcDays {
+			return nil, werror.Wrap(ErrWorkingScheduleCloneWeeks, werror.WithValue("weeks", in.Weeks))
+		}
+
+		weeks = in.Weeks
+	}
+
+	search := &model.AgentWorkingScheduleSearch{
+		SearchItem: model.SearchItem{
+			Date: &model.FilterBetween{
+				From: model.NewTimestamp(srcStart.Unix()),
+				To:   model.NewTimestamp(src.EndDateAt.Time.Unix()),
+			},
+		},
+		WorkingScheduleId: src.Id,
+	}
+
+	items, err := w.agentSchedule.SearchAgentWorkingSchedule(ctx, user, search)
+	if err != nil {
+		return nil, err
+	}
+
+	holidays, err := w.agentSchedule.Holidays(ctx, user, search)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := make(map[string]bool, len(holidays))
+	for _, h := range holidays {
+		skip[h.Date.Time.Format(time.DateOnly)] = true
+	}
+
+	// Holidays of the new period are out of the source working schedule period, so they're resolved by the calendar.
+	targetHolidays, err := w.agentSchedule.CalendarHolidays(ctx, user, src.Calendar.Id, &model.FilterBetween{
+		From: model.NewTimestamp(in.StartDateAt.Time.Unix()),
+		To:   model.NewTimestamp(in.EndDateAt.Time.Unix()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	skipTarget := make(map[string]bool, len(targetHolidays))
+	for _, h := range targetHolidays {
+		skipTarget[h.Date.Time.Format(time.DateOnly)] = true
+	}
+
+	// Source shifts by agents and dates (in time.DateOnly format), split shifts have several segments a day.
+	source := make(map[int64]map[string][]*model.AgentScheduleShift, len(items))
+	for _, item := range items {
+		days := make(map[string][]*model.AgentScheduleShift)
+		for _, s := range item.Schedule {
+			if s.Shift == nil || skip[s.Date.Time.Format(time.DateOnly)] {
+				continue
+			}
+
+			days[s.Date.Time.Format(time.DateOnly)] = append(days[s.Date.Time.Format(time.DateOnly)], s.Shift)
+		}
+
+		source[item.Agent.Id] = days
+	}
+
+	pattern := cloneDays(srcStart, srcDays, in.StartDateAt.Time, in.EndDateAt.Time, weeks)
+	out := make([]*model.AgentWorkingSchedule, 0, len(src.Agents))
+	for _, agent := range src.Agents {
+		days, ok := source[agent.Id]
+		if !ok || len(days) == 0 {
+			continue
+		}
+
+		clone := &model.AgentWorkingSchedule{Agent: *agent}
+		for _, d := range pattern {
+			if skipTarget[d.date.Format(time.DateOnly)] {
+				continue
+			}
+
+			for _, s := range days[d.source.Format(time.DateOnly)] {
+				clone.Schedule = append(clone.Schedule, &model.AgentSchedule{
+					Date:  model.NewDate(d.date.Unix()),
+					Shift: &model.AgentScheduleShift{Start: s.Start, End: s.End, Pauses: s.Pauses, Skills: s.Skills},
+				})
+			}
+		}
+
+		if len(clone.Schedule) > 0 {
+			out = append(out, clone)
+		}
+	}
+
+	return out, nil
+}
+
+// clonedDay is a day of the new period and the source day, that it copies.
+type clonedDay struct {
+	date, source time.Time
+}
+
+// cloneDays maps each day of [start, end] onto a source day of the same weekday,
+// the pattern repeats the first weeks of the source period of srcDays days.
+// Days mapped beyond the source period are skipped, non-positive weeks give no days.
+func cloneDays(srcStart time.Time, srcDays int, start, end time.Time, weeks int) []clonedDay {
+	if weeks < 1 {
+		return nil
+	}
+
+	// Offset aligns the first day of the new period with the source day of the same weekday.
+	cycle := weeks * 7
+	offset := (int(start.Weekday()) - int(srcStart.Weekday()) + 7) % 7
+	series := timeutils.NewPeriod(start, end, timeutils.IncludeAll).GenerateSeries(0, 0, 1)
+	out := make([]clonedDay, 0, len(series))
+	for i, day := range series {
+		k := (i + offset) % cycle
+		if k >= srcDays {
+			continue
+		}
+
+		out = append(out, clonedDay{date: day, source: srcStart.AddDate(0, 0, k)})
+	}
+
+	return out
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneDays(t *testing.T) {
+	// Monday.
+	srcStart := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		srcDays    int
+		start, end time.Time
+		weeks      int
+		expected   map[string]string
+	}{
+		"zero weeks": {
+			srcDays: 14,
+			start:   time.Date(2026, time.April, 6, 0, 0, 0, 0, time.UTC),
+			end:     time.Date(2026, time.April, 12, 0, 0, 0, 0, time.UTC),
+			weeks:   0,
+		},
+		"negative weeks": {
+			srcDays: 14,
+			start:   time.Date(2026, time.April, 6, 0, 0, 0, 0, time.UTC),
+			end:     time.Date(2026, time.April, 12, 0, 0, 0, 0, time.UTC),
+			weeks:   -1,
+		},
+		"same weekday": {
+			srcDays: 7,
+			start:   time.Date(2026, time.April, 6, 0, 0, 0, 0, time.UTC),
+			end:     time.Date(2026, time.April, 8, 0, 0, 0, 0, time.UTC),
+			weeks:   1,
+			expected: map[string]string{
+				"2026-04-06": "2026-03-02",
+				"2026-04-07": "2026-03-03",
+				"2026-04-08": "2026-03-04",
+			},
+		},
+		"new period starts on another weekday": {
+			srcDays: 7,
+			start:   time.Date(2026, time.April, 11, 0, 0, 0, 0, time.UTC),
+			end:     time.Date(2026, time.April, 14, 0, 0, 0, 0, time.UTC),
+			weeks:   1,
+			expected: map[string]string{
+				"2026-04-11": "2026-03-07",
+				"2026-04-12": "2026-03-08",
+				"2026-04-13": "2026-03-02",
+				"2026-04-14": "2026-03-03",
+			},
+		},
+		"rolling pattern repeats the first weeks": {
+			srcDays: 21,
+			start:   time.Date(2026, time.April, 6, 0, 0, 0, 0, time.UTC),
+			end:     time.Date(2026, time.April, 27, 0, 0, 0, 0, time.UTC),
+			weeks:   2,
+			expected: map[string]string{
+				"2026-04-06": "2026-03-02",
+				"2026-04-13": "2026-03-09",
+				"2026-04-20": "2026-03-02",
+				"2026-04-27": "2026-03-09",
+			},
+		},
+		"days beyond a short source period are skipped": {
+			srcDays: 3,
+			start:   time.Date(2026, time.April, 6, 0, 0, 0, 0, time.UTC),
+			end:     time.Date(2026, time.April, 13, 0, 0, 0, 0, time.UTC),
+			weeks:   1,
+			expected: map[string]string{
+				"2026-04-06": "2026-03-02",
+				"2026-04-07": "2026-03-03",
+				"2026-04-08": "2026-03-04",
+				"2026-04-13": "2026-03-02",
+			},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			out := make(map[string]string)
+			for _, d := range cloneDays(srcStart, tt.srcDays, tt.start, tt.end, tt.weeks) {
+				// Rolling pattern days are checked on mondays only.
+				if tt.weeks > 1 && d.date.Weekday() != time.Monday {
+					continue
+				}
+
+				out[d.date.Format(time.DateOnly)] = d.source.Format(time.DateOnly)
+			}
+
+			if len(tt.expected) == 0 {
+				assert.Empty(t, out)
+
+				return
+			}
+
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+}
@@ -0,0 +1,340 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/pkg/timeutils"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var ErrWorkingScheduleGenerateShiftTemplate = werror.InvalidArgument("none of the working schedule agents has a shift template to generate shifts from", werror.WithID("service.working_schedule.generate.shift_template"))
+
+// generateAgent holds agent constraints used by schedule generation.
+type generateAgent struct {
+	agent model.LookupItem
+	times []model.ShiftTemplateTime
+
+	// Limits from the agent's working condition, zero means unlimited.
+	workdaysPerMonth int
+	daysOff          int
+
+	// workdays counts scheduled days by month (in 2006-01 format).
+	workdays map[string]int
+
+	// busy holds dates (in time.DateOnly format), that already have a shift,
+	// an absence or are locked by another working schedule.
+	busy map[string]bool
+
+	// carryover holds minutes of dates (in time.DateOnly format), until which the overnight shift of the previous day lasts.
+	carryover map[string]int64
+
+	// starts holds start minutes of shifts by dates (in time.DateOnly format),
+	// overnight shifts of the previous day should end before them.
+	starts map[string]int64
+}
+
+// fits reports whether the shift starting on a date doesn't overlap shifts of the adjacent days.
+func (g *generateAgent) fits(date time.Time, t model.ShiftTemplateTime) bool {
+	if int64(t.Start) < g.carryover[date.Format(time.DateOnly)] {
+		return false
+	}
+
+	next, ok := g.starts[date.AddDate(0, 0, 1).Format(time.DateOnly)]
+
+	return !ok || int64(t.End)-model.MinutesPerDay <= next
+}
+
+// remaining returns the number of days agent can still work within a month of a date.
+func (g *generateAgent) remaining(date time.Time) int {
+	month := date.Format("2006-01")
+	limit := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day() - g.daysOff
+	if g.workdaysPerMonth > 0 && g.workdaysPerMonth < limit {
+		limit = g.workdaysPerMonth
+	}
+
+	return limit - g.workdays[month]
+}
+
+func (g *generateAgent) assign(date time.Time, shift *model.AgentScheduleShift) {
+	// Segments of a split shift are a single workday.
+	day := date.Format(time.DateOnly)
+	if !g.busy[day] {
+		g.workdays[date.Format("2006-01")]++
+	}
+
+	g.busy[day] = true
+	if shift == nil {
+		return
+	}
+
+	if start, ok := g.starts[day]; !ok || shift.Start < start {
+		g.starts[day] = shift.Start
+	}
+
+	if shift.Overnight() {
+		next := date.AddDate(0, 0, 1).Format(time.DateOnly)
+		g.carryover[next] = max(g.carryover[next], shift.End-model.MinutesPerDay)
+	}
+}
+
+// GenerateWorkingSchedule creates draft shifts for the working schedule agents,
+// that cover forecasted workload as close as possible.
+// Shifts are built from shift templates and respect working condition limits,
+// agent absences and shifts from another working schedules.
+// Returns created shifts and staffing per forecast interval after the generation.
+func (w *WorkingSchedule) GenerateWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64, date *model.FilterBetween, shiftTemplateId *int64) ([]*model.AgentWorkingSchedule, []*model.WorkingScheduleStaffing, error) {
+	ws, err := w.storage.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: id})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if ws.State != model.WorkingScheduleStateDraft {
+		return nil, nil, werror.Wrap(ErrWorkingScheduleUpdateDraft, werror.WithValue("state", ws.State.String()))
+	}
+
+	forecast, err := w.ReadWorkingScheduleForecast(ctx, user, id, date)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loc := ws.Location()
+	staff := newStaffing(forecast)
+	agents, err := w.generateAgents(ctx, ws, shiftTemplateId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Workdays limits are monthly, so existing shifts are taken for whole months of the date filter,
+	// shifts of the adjacent days also limit overnight shifts and shifts after them.
+	months := withAdjacentDays(withWholeMonths(date), 1, 1)
+	existing, err := w.agentSchedule.SearchAgentWorkingSchedule(ctx, user, &model.AgentWorkingScheduleSearch{
+		SearchItem:        model.SearchItem{Date: months},
+		WorkingScheduleId: ws.Id,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Shifts of pending and active working schedules count against the same workdays limits.
+	agentIds := make([]int64, 0, len(agents))
+	for id := range agents {
+		agentIds = append(agentIds, id)
+	}
+
+	others, err := w.agentSchedule.SearchAgentWorkingSchedule(ctx, user, &model.AgentWorkingScheduleSearch{
+		SearchItem:            model.SearchItem{Date: months},
+		WorkingScheduleStates: []model.WorkingScheduleState{model.WorkingScheduleStatePending, model.WorkingScheduleStateActive},
+		AgentIds:              agentIds,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, e := range others {
+		agent, ok := agents[e.Agent.Id]
+		if !ok {
+			continue
+		}
+
+		for _, s := range e.Schedule {
+			if s.Shift != nil {
+				agent.assign(s.Date.Time, s.Shift)
+			}
+		}
+	}
+
+	for _, e := range existing {
+		agent, ok := agents[e.Agent.Id]
+		if !ok {
+			continue
+		}
+
+		for _, s := range e.Schedule {
+			switch {
+			case s.Shift != nil:
+				staff.add(shiftInstants(loc, s.Date.Time, s.Shift.Start, s.Shift.End))
+				agent.assign(s.Date.Time, s.Shift)
+			case s.Locked:
+				agent.assign(s.Date.Time, nil)
+			case s.FullAbsence():
+				agent.busy[s.Date.Time.Format(time.DateOnly)] = true
+			}
+		}
+	}
+
+	generated := make(map[int64]*model.AgentWorkingSchedule)
+	for _, day := range timeutils.NewPeriod(date.From.Time, date.To.Time, timeutils.IncludeAll).GenerateSeries(0, 0, 1) {
+		for {
+			var (
+				best     *generateAgent
+				bestTime model.ShiftTemplateTime
+				bestGain int
+			)
+
+			for _, agent := range ws.Agents {
+				a, ok := agents[agent.Id]
+				if !ok || a.busy[day.Format(time.DateOnly)] || a.remaining(day) <= 0 {
+					continue
+				}
+
+				for _, t := range a.times {
+					if !a.fits(day, t) {
+						continue
+					}
+
+					gain := staff.gain(shiftInstants(loc, day, int64(t.Start), int64(t.End)))
+
+					// Prefer the agent with more remaining workdays to spread shifts evenly.
+					if gain > bestGain || (gain == bestGain && best != nil && gain > 0 && a.remaining(day) > best.remaining(day)) {
+						best, bestTime, bestGain = a, t, gain
+					}
+				}
+			}
+
+			if best == nil || bestGain == 0 {
+				break
+			}
+
+			shift := &model.AgentScheduleShift{Start: int64(bestTime.Start), End: int64(bestTime.End)}
+			staff.add(shiftInstants(loc, day, shift.Start, shift.End))
+			best.assign(day, shift)
+
+			if _, ok := generated[best.agent.Id]; !ok {
+				generated[best.agent.Id] = &model.AgentWorkingSchedule{Agent: best.agent}
+			}
+
+			generated[best.agent.Id].Schedule = append(generated[best.agent.Id].Schedule, &model.AgentSchedule{
+				Date:  model.NewDate(day.Unix()),
+				Shift: shift,
+			})
+		}
+	}
+
+	if len(generated) == 0 {
+		return nil, staff.result(), nil
+	}
+
+	in := make([]*model.AgentWorkingSchedule, 0, len(generated))
+	for _, agent := range ws.Agents {
+		if g, ok := generated[agent.Id]; ok {
+			in = append(in, g)
+		}
+	}
+
+	// Generated shifts go through the same overlap and working condition checks as created ones.
+	out, err := w.agentShifts.createShifts(ctx, user, ws, *date, in, model.ShiftConflictModeFail, nil, false)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return out.Items, staff.result(), nil
+}
+
+// withWholeMonths extends the date filter to the first day of its starting month
+// and the last day of its ending month.
+func withWholeMonths(date *model.FilterBetween) *model.FilterBetween {
+	out := &model.FilterBetween{From: date.From, To: date.To}
+	if from := date.From.Time; date.From.Valid {
+		out.From = model.NewTimestamp(time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, from.Location()).Unix())
+	}
+
+	if to := date.To.Time; date.To.Valid {
+		out.To = model.NewTimestamp(time.Date(to.Year(), to.Month()+1, 0, 0, 0, 0, 0, to.Location()).Unix())
+	}
+
+	return out
+}
+
+// generateAgents collects shift templates and working condition limits for each agent of the working schedule.
+// Agents without any shift template are omitted.
+func (w *WorkingSchedule) generateAgents(ctx context.Context, ws *model.WorkingSchedule, shiftTemplateId *int64) (map[int64]*generateAgent, error) {
+	var (
+		templates  = make(map[int64]*model.ShiftTemplate)
+		conditions = newAgentConditions(w.agentConditions, w.workingCondition)
+	)
+
+	template := func(id int64) (*model.ShiftTemplate, error) {
+		if t, ok := templates[id]; ok {
+			return t, nil
+		}
+
+		read, err := options.NewRead(ctx, options.WithID(id))
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := w.shiftTemplate.ReadShiftTemplate(ctx, read)
+		if err != nil {
+			return nil, err
+		}
+
+		templates[id] = t
+
+		return t, nil
+	}
+
+	out := make(map[int64]*generateAgent, len(ws.Agents))
+	for _, agent := range ws.Agents {
+		var times []model.ShiftTemplateTime
+		condition, err := conditions.read(ctx, agent.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case shiftTemplateId != nil:
+			t, err := template(*shiftTemplateId)
+			if err != nil {
+				return nil, err
+			}
+
+			times = t.Times
+		case condition != nil && condition.ShiftTemplate != nil && condition.ShiftTemplate.Id != 0:
+			t, err := template(condition.ShiftTemplate.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			times = t.Times
+		}
+
+		g := &generateAgent{
+			agent:     *agent,
+			workdays:  make(map[string]int),
+			busy:      make(map[string]bool),
+			carryover: make(map[string]int64),
+			starts:    make(map[string]int64),
+		}
+
+		if condition != nil {
+			if v := condition.WorkdaysPerMonth; v != nil {
+				g.workdaysPerMonth = int(*v)
+			}
+
+			if v := condition.DaysOff; v != nil {
+				g.daysOff = int(*v)
+			}
+		}
+
+		for _, t := range times {
+			if condition != nil && condition.WorkdayHours != nil && *condition.WorkdayHours > 0 && t.End-t.Start > *condition.WorkdayHours*60 {
+				continue
+			}
+
+			g.times = append(g.times, t)
+		}
+
+		if len(g.times) > 0 {
+			out[agent.Id] = g
+		}
+	}
+
+	if len(out) == 0 {
+		return nil, ErrWorkingScheduleGenerateShiftTemplate
+	}
+
+	return out, nil
+}
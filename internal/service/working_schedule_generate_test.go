@@ -0,0 +1,138 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+)
+
+func newTestGenerateAgent(workdaysPerMonth, daysOff int) *generateAgent {
+	return &generateAgent{
+		workdaysPerMonth: workdaysPerMonth,
+		daysOff:          daysOff,
+		workdays:         make(map[string]int),
+		busy:             make(map[string]bool),
+		carryover:        make(map[string]int64),
+		starts:           make(map[string]int64),
+	}
+}
+
+func TestGenerateAgentFits(t *testing.T) {
+	var (
+		day  = time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+		prev = day.AddDate(0, 0, -1)
+		next = day.AddDate(0, 0, 1)
+	)
+
+	tests := map[string]struct {
+		assigned map[time.Time]*model.AgentScheduleShift
+		shift    model.ShiftTemplateTime
+		expected bool
+	}{
+		"no adjacent shifts": {
+			shift:    model.ShiftTemplateTime{Start: 1320, End: 1860},
+			expected: true,
+		},
+		"starts before the overnight shift of the previous day ends": {
+			assigned: map[time.Time]*model.AgentScheduleShift{prev: {Start: 1320, End: 1860}},
+			shift:    model.ShiftTemplateTime{Start: 360, End: 900},
+			expected: false,
+		},
+		"starts once the overnight shift of the previous day ends": {
+			assigned: map[time.Time]*model.AgentScheduleShift{prev: {Start: 1320, End: 1860}},
+			shift:    model.ShiftTemplateTime{Start: 420, End: 900},
+			expected: true,
+		},
+		"overnight shift overlaps the next day": {
+			assigned: map[time.Time]*model.AgentScheduleShift{next: {Start: 360, End: 900}},
+			shift:    model.ShiftTemplateTime{Start: 1320, End: 1860},
+			expected: false,
+		},
+		"overnight shift ends before the next day split shift": {
+			assigned: map[time.Time]*model.AgentScheduleShift{next: {Start: 780, End: 900}},
+			shift:    model.ShiftTemplateTime{Start: 1320, End: 1860},
+			expected: true,
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			g := newTestGenerateAgent(0, 0)
+			for date, shift := range tt.assigned {
+				g.assign(date, shift)
+			}
+
+			assert.Equal(t, tt.expected, g.fits(day, tt.shift))
+		})
+	}
+}
+
+func TestGenerateAgentRemaining(t *testing.T) {
+	day := time.Date(2026, time.February, 2, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		workdaysPerMonth, daysOff int
+		assigned                  []*model.AgentScheduleShift
+		expected                  int
+	}{
+		"unlimited": {
+			expected: 28,
+		},
+		"days off": {
+			daysOff:  8,
+			expected: 20,
+		},
+		"workdays per month below days off limit": {
+			workdaysPerMonth: 15,
+			daysOff:          8,
+			expected:         15,
+		},
+		"split shift segments are a single workday": {
+			workdaysPerMonth: 15,
+			assigned:         []*model.AgentScheduleShift{{Start: 480, End: 720}, {Start: 780, End: 1020}},
+			expected:         14,
+		},
+		"busy day without a shift": {
+			workdaysPerMonth: 15,
+			assigned:         []*model.AgentScheduleShift{nil},
+			expected:         14,
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			g := newTestGenerateAgent(tt.workdaysPerMonth, tt.daysOff)
+			for _, shift := range tt.assigned {
+				g.assign(day, shift)
+			}
+
+			assert.Equal(t, tt.expected, g.remaining(day))
+		})
+	}
+}
+
+func TestWithWholeMonths(t *testing.T) {
+	tests := map[string]struct {
+		date     *model.FilterBetween
+		expected [2]string
+	}{
+		"within a month": {
+			date:     &model.FilterBetween{From: model.NewTimestamp(time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC).Unix()), To: model.NewTimestamp(time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC).Unix())},
+			expected: [2]string{"2026-03-01", "2026-03-31"},
+		},
+		"across months": {
+			date:     &model.FilterBetween{From: model.NewTimestamp(time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC).Unix()), To: model.NewTimestamp(time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC).Unix())},
+			expected: [2]string{"2026-01-01", "2026-02-28"},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			out := withWholeMonths(tt.date)
+			assert.Equal(t, tt.expected[0], out.From.Time.UTC().Format(time.DateOnly))
+			assert.Equal(t, tt.expected[1], out.To.Time.UTC().Format(time.DateOnly))
+		})
+	}
+}
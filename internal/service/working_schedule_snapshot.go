@@ -0,0 +1,241 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/internal/storage"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+var ErrWorkingScheduleSnapshotState = werror.InvalidArgument("invalid input: only an active working schedule can be published", werror.WithID("service.working_schedule_snapshot.state"))
+
+type WorkingScheduleSnapshotManager interface {
+	CreateWorkingScheduleSnapshot(ctx context.Context, read *options.Read, note *string) (*model.WorkingScheduleSnapshot, error)
+	ReadWorkingScheduleSnapshot(ctx context.Context, read *options.Read) (*model.WorkingScheduleSnapshot, []*model.AgentWorkingSchedule, error)
+	SearchWorkingScheduleSnapshot(ctx context.Context, search *options.Search) ([]*model.WorkingScheduleSnapshot, bool, error)
+	DiffWorkingScheduleSnapshot(ctx context.Context, read *options.Read, toId *int64) ([]*model.WorkingScheduleAgentDiff, error)
+}
+
+type WorkingScheduleSnapshot struct {
+	storage         storage.WorkingScheduleSnapshotManager
+	workingSchedule storage.WorkingScheduleManager
+	agentSchedule   storage.AgentWorkingScheduleManager
+}
+
+func NewWorkingScheduleSnapshot(storage storage.WorkingScheduleSnapshotManager, workingSchedule storage.WorkingScheduleManager, agentSchedule storage.AgentWorkingScheduleManager) *WorkingScheduleSnapshot {
+	return &WorkingScheduleSnapshot{
+		storage:         storage,
+		workingSchedule: workingSchedule,
+		agentSchedule:   agentSchedule,
+	}
+}
+
+// CreateWorkingScheduleSnapshot publishes current shifts of an active working schedule as a new snapshot.
+func (w *WorkingScheduleSnapshot) CreateWorkingScheduleSnapshot(ctx context.Context, read *options.Read, note *string) (*model.WorkingScheduleSnapshot, error) {
+	ws, err := w.workingSchedule.ReadWorkingSchedule(ctx, read.User(), &model.SearchItem{Id: read.DerivedByName("working_schedule").ID()})
+	if err != nil {
+		return nil, err
+	}
+
+	if ws.State != model.WorkingScheduleStateActive {
+		return nil, werror.Wrap(ErrWorkingScheduleSnapshotState, werror.WithValue("state", ws.State.String()))
+	}
+
+	id, err := w.storage.CreateWorkingScheduleSnapshot(ctx, read, note)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.storage.ReadWorkingScheduleSnapshot(ctx, read.WithID(id))
+}
+
+// ReadWorkingScheduleSnapshot returns the snapshot with its agent shifts.
+func (w *WorkingScheduleSnapshot) ReadWorkingScheduleSnapshot(ctx context.Context, read *options.Read) (*model.WorkingScheduleSnapshot, []*model.AgentWorkingSchedule, error) {
+	out, err := w.storage.ReadWorkingScheduleSnapshot(ctx, read)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shifts, err := w.storage.SearchWorkingScheduleSnapshotShifts(ctx, read)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return out, shifts, nil
+}
+
+func (w *WorkingScheduleSnapshot) SearchWorkingScheduleSnapshot(ctx context.Context, search *options.Search) ([]*model.WorkingScheduleSnapshot, bool, error) {
+	out, err := w.storage.SearchWorkingScheduleSnapshot(ctx, search)
+	if err != nil {
+		return nil, false, err
+	}
+
+	next, out := model.ListResult(int32(search.Size()), out)
+
+	return out, next, nil
+}
+
+// DiffWorkingScheduleSnapshot compares shifts of the snapshot with shifts of another snapshot
+// of the same working schedule or, if toId is omitted, with the current shifts of the working schedule.
+func (w *WorkingScheduleSnapshot) DiffWorkingScheduleSnapshot(ctx context.Context, read *options.Read, toId *int64) ([]*model.WorkingScheduleAgentDiff, error) {
+	_, before, err := w.ReadWorkingScheduleSnapshot(ctx, read)
+	if err != nil {
+		return nil, err
+	}
+
+	wsId := read.DerivedByName("working_schedule").ID()
+	if toId != nil {
+		to, err := options.NewRead(ctx, options.WithID(*toId), options.WithDerivedID("working_schedule", wsId))
+		if err != nil {
+			return nil, err
+		}
+
+		_, after, err := w.ReadWorkingScheduleSnapshot(ctx, to)
+		if err != nil {
+			return nil, err
+		}
+
+		return diffAgentSchedules(before, after), nil
+	}
+
+	after, err := w.agentSchedule.SearchAgentWorkingSchedule(ctx, read.User(), &model.AgentWorkingScheduleSearch{WorkingScheduleId: wsId})
+	if err != nil {
+		return nil, err
+	}
+
+	return diffAgentSchedules(before, after), nil
+}
+
+// diffAgentSchedules lists added, removed and changed shifts of each agent day.
+// Segments of split shifts are matched by their order within the day.
+// Agents are listed in order of the before schedule, followed by agents, that appear only after.
+func diffAgentSchedules(before, after []*model.AgentWorkingSchedule) []*model.WorkingScheduleAgentDiff {
+	var (
+		agents []model.LookupItem
+		dates  = make(map[string]pgtype.Date)
+		seen   = make(map[int64]bool)
+	)
+
+	// Shifts by agents and dates (in time.DateOnly format).
+	shifts := func(items []*model.AgentWorkingSchedule) map[int64]map[string][]*model.AgentScheduleShift {
+		out := make(map[int64]map[string][]*model.AgentScheduleShift, len(items))
+		for _, item := range items {
+			if !seen[item.Agent.Id] {
+				seen[item.Agent.Id] = true
+				agents = append(agents, item.Agent)
+			}
+
+			days := make(map[string][]*model.AgentScheduleShift)
+			for _, s := range item.Schedule {
+				if s.Shift == nil {
+					continue
+				}
+
+				day := s.Date.Time.Format(time.DateOnly)
+				dates[day] = s.Date
+				days[day] = append(days[day], s.Shift)
+			}
+
+			out[item.Agent.Id] = days
+		}
+
+		return out
+	}
+
+	from, to := shifts(before), shifts(after)
+	byStart := func(a, b *model.AgentScheduleShift) int {
+		return cmp.Compare(a.Start, b.Start)
+	}
+
+	out := make([]*model.WorkingScheduleAgentDiff, 0, len(agents))
+	for _, agent := range agents {
+		days := make([]string, 0, len(from[agent.Id])+len(to[agent.Id]))
+		for day := range from[agent.Id] {
+			days = append(days, day)
+		}
+
+		for day := range to[agent.Id] {
+			if _, ok := from[agent.Id][day]; !ok {
+				days = append(days, day)
+			}
+		}
+
+		slices.Sort(days)
+		diff := &model.WorkingScheduleAgentDiff{Agent: agent}
+		for _, day := range days {
+			b, a := from[agent.Id][day], to[agent.Id][day]
+			slices.SortFunc(b, byStart)
+			slices.SortFunc(a, byStart)
+			for i := range max(len(b), len(a)) {
+				d := &model.WorkingScheduleShiftDiff{Date: dates[day]}
+				switch {
+				case i >= len(b):
+					d.Change, d.After = model.ShiftDiffChangeAdded, a[i]
+				case i >= len(a):
+					d.Change, d.Before = model.ShiftDiffChangeRemoved, b[i]
+				case sameShift(b[i], a[i]):
+					continue
+				default:
+					d.Change, d.Before, d.After = model.ShiftDiffChangeChanged, b[i], a[i]
+				}
+
+				diff.Shifts = append(diff.Shifts, d)
+			}
+		}
+
+		if len(diff.Shifts) > 0 {
+			out = append(out, diff)
+		}
+	}
+
+	return out
+}
+
+// sameShift reports whether shifts have the same times, pauses and skills.
+func sameShift(a, b *model.AgentScheduleShift) bool {
+	type pause struct{ start, end, cause int64 }
+	type skill struct {
+		id, capacity int64
+		enabled      bool
+	}
+
+	pauses := func(s *model.AgentScheduleShift) []pause {
+		out := make([]pause, 0, len(s.Pauses))
+		for _, p := range s.Pauses {
+			var cause int64
+			if p.Cause != nil {
+				cause = p.Cause.Id
+			}
+
+			out = append(out, pause{start: p.Start, end: p.End, cause: cause})
+		}
+
+		slices.SortFunc(out, func(x, y pause) int {
+			return cmp.Or(cmp.Compare(x.start, y.start), cmp.Compare(x.end, y.end), cmp.Compare(x.cause, y.cause))
+		})
+
+		return out
+	}
+
+	skills := func(s *model.AgentScheduleShift) []skill {
+		out := make([]skill, 0, len(s.Skills))
+		for _, k := range s.Skills {
+			out = append(out, skill{id: k.Skill.Id, capacity: k.Capacity, enabled: k.Enabled})
+		}
+
+		slices.SortFunc(out, func(x, y skill) int {
+			return cmp.Compare(x.id, y.id)
+		})
+
+		return out
+	}
+
+	return a.Start == b.Start && a.End == b.End && slices.Equal(pauses(a), pauses(b)) && slices.Equal(skills(a), skills(b))
+}
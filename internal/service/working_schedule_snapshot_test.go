@@ -0,0 +1,101 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+)
+
+func TestDiffAgentSchedules(t *testing.T) {
+	type change struct {
+		agent  int64
+		date   string
+		change model.ShiftDiffChange
+	}
+
+	var (
+		day  = time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+		next = day.AddDate(0, 0, 1)
+	)
+
+	agent := func(id int64, schedule ...*model.AgentSchedule) *model.AgentWorkingSchedule {
+		return &model.AgentWorkingSchedule{Agent: model.LookupItem{Id: id}, Schedule: schedule}
+	}
+
+	withPause := func(s *model.AgentSchedule, cause int64) *model.AgentSchedule {
+		s.Shift.Pauses = []*model.AgentScheduleShiftPause{{Start: 720, End: 780, Cause: &model.LookupItem{Id: cause}}}
+
+		return s
+	}
+
+	withSkill := func(s *model.AgentSchedule, capacity int64) *model.AgentSchedule {
+		s.Shift.Skills = []*model.AgentScheduleShiftSkill{{Skill: model.LookupItem{Id: 1}, Capacity: capacity, Enabled: true}}
+
+		return s
+	}
+
+	tests := map[string]struct {
+		before, after []*model.AgentWorkingSchedule
+		expected      []change
+	}{
+		"no changes": {
+			before:   []*model.AgentWorkingSchedule{agent(1, scheduleShift(day, 1, 540, 1080))},
+			after:    []*model.AgentWorkingSchedule{agent(1, scheduleShift(day, 2, 540, 1080))},
+			expected: []change{},
+		},
+		"added, removed and changed shifts": {
+			before: []*model.AgentWorkingSchedule{agent(1, scheduleShift(day, 1, 540, 1080), scheduleShift(next, 2, 540, 1080))},
+			after: []*model.AgentWorkingSchedule{
+				agent(1, scheduleShift(day, 1, 600, 1080)),
+				agent(2, scheduleShift(next, 3, 540, 1080)),
+			},
+			expected: []change{
+				{agent: 1, date: "2026-03-02", change: model.ShiftDiffChangeChanged},
+				{agent: 1, date: "2026-03-03", change: model.ShiftDiffChangeRemoved},
+				{agent: 2, date: "2026-03-03", change: model.ShiftDiffChangeAdded},
+			},
+		},
+		"split shift segments are matched by their start": {
+			before: []*model.AgentWorkingSchedule{agent(1, scheduleShift(day, 1, 780, 1080), scheduleShift(day, 2, 480, 720))},
+			after:  []*model.AgentWorkingSchedule{agent(1, scheduleShift(day, 3, 480, 720), scheduleShift(day, 4, 780, 1080), scheduleShift(day, 5, 1140, 1200))},
+			expected: []change{
+				{agent: 1, date: "2026-03-02", change: model.ShiftDiffChangeAdded},
+			},
+		},
+		"changed pause cause": {
+			before: []*model.AgentWorkingSchedule{agent(1, withPause(scheduleShift(day, 1, 540, 1080), 1))},
+			after:  []*model.AgentWorkingSchedule{agent(1, withPause(scheduleShift(day, 1, 540, 1080), 2))},
+			expected: []change{
+				{agent: 1, date: "2026-03-02", change: model.ShiftDiffChangeChanged},
+			},
+		},
+		"changed skill capacity": {
+			before: []*model.AgentWorkingSchedule{agent(1, withSkill(scheduleShift(day, 1, 540, 1080), 10))},
+			after:  []*model.AgentWorkingSchedule{agent(1, withSkill(scheduleShift(day, 1, 540, 1080), 20))},
+			expected: []change{
+				{agent: 1, date: "2026-03-02", change: model.ShiftDiffChangeChanged},
+			},
+		},
+		"days without shifts are ignored": {
+			before:   []*model.AgentWorkingSchedule{agent(1, &model.AgentSchedule{Date: model.NewDate(day.Unix())})},
+			after:    []*model.AgentWorkingSchedule{agent(1)},
+			expected: []change{},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			out := make([]change, 0)
+			for _, a := range diffAgentSchedules(tt.before, tt.after) {
+				for _, s := range a.Shifts {
+					out = append(out, change{agent: a.Agent.Id, date: s.Date.Time.Format(time.DateOnly), change: s.Change})
+				}
+			}
+
+			assert.Equal(t, tt.expected, out)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+// minRestBetweenShifts is a minimum rest time between the end of a shift
+// and the start of the next one of the same agent.
+const minRestBetweenShifts = 11 * time.Hour
+
+const (
+	ruleShiftAbsence      = "shift.absence"
+	ruleShiftMinRest      = "shift.min_rest"
+	ruleShiftOverlap      = "shift.overlap"
+	rulePauseOutsideShift = "pause.outside_shift"
+	rulePauseOverlap      = "pause.overlap"
+)
+
+var ErrWorkingScheduleViolations = werror.InvalidArgument("working schedule has rule violations, check them for details", werror.WithID("service.working_schedule.violations"))
+
+// scheduleRule evaluates a single agent schedule and reports its violations.
+// Working condition is nil if agent has none, shift minutes are wall-clock minutes of the location.
+type scheduleRule func(agent *model.AgentWorkingSchedule, condition *model.WorkingCondition, loc *time.Location) []*model.WorkingScheduleViolation
+
+// scheduleRules lists rules evaluated by ValidateWorkingSchedule.
+var scheduleRules = []scheduleRule{
+	checkShiftAbsence,
+	checkPauses,
+	checkShiftMinRest,
+	checkConditionLimits,
+}
+
+// ValidateWorkingSchedule evaluates all agent schedules of the working schedule against scheduleRules.
+func (w *WorkingSchedule) ValidateWorkingSchedule(ctx context.Context, user *model.SignedInUser, id int64) ([]*model.WorkingScheduleViolation, error) {
+	ws, err := w.storage.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return w.validate(ctx, user, ws)
+}
+
+func (w *WorkingSchedule) validate(ctx context.Context, user *model.SignedInUser, ws *model.WorkingSchedule) ([]*model.WorkingScheduleViolation, error) {
+	search := &model.AgentWorkingScheduleSearch{
+		SearchItem: model.SearchItem{
+			Date: &model.FilterBetween{
+				From: model.NewTimestamp(ws.StartDateAt.Time.Unix()),
+				To:   model.NewTimestamp(ws.EndDateAt.Time.Unix()),
+			},
+		},
+		WorkingScheduleId: ws.Id,
+	}
+
+	items, err := w.agentSchedule.SearchAgentWorkingSchedule(ctx, user, search)
+	if err != nil {
+		return nil, err
+	}
+
+	agentIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		agentIds = append(agentIds, item.Agent.Id)
+	}
+
+	conditions, err := newAgentConditions(w.agentConditions, w.workingCondition).readAll(ctx, agentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := ws.Location()
+	out := make([]*model.WorkingScheduleViolation, 0)
+	for _, item := range items {
+		slices.SortStableFunc(item.Schedule, func(a, b *model.AgentSchedule) int {
+			return a.Date.Time.Compare(b.Date.Time)
+		})
+
+		for _, rule := range scheduleRules {
+			out = append(out, rule(item, conditions[item.Agent.Id], loc)...)
+		}
+	}
+
+	return out, nil
+}
+
+// validateDraft rejects the working schedule leaving the draft state with violations of an error severity.
+func (w *WorkingSchedule) validateDraft(ctx context.Context, user *model.SignedInUser, ws *model.WorkingSchedule) error {
+	violations, err := w.validate(ctx, user, ws)
+	if err != nil {
+		return err
+	}
+
+	var errs int
+	for _, v := range violations {
+		if v.Severity == model.ViolationSeverityError {
+			errs++
+		}
+	}
+
+	if errs > 0 {
+		return werror.Wrap(ErrWorkingScheduleViolations, werror.WithValue("errors", errs))
+	}
+
+	return nil
+}
+
+func newViolation(agent *model.AgentWorkingSchedule, schedule *model.AgentSchedule, rule string, severity model.ViolationSeverity, msg string) *model.WorkingScheduleViolation {
+	return &model.WorkingScheduleViolation{
+		Agent:    agent.Agent,
+		Date:     schedule.Date,
+		Rule:     rule,
+		Severity: severity,
+		Message:  msg,
+	}
+}
+
+// checkShiftAbsence reports shifts planned on the agent's whole-day absences,
+// including overnight shifts, that continue into the absence day.
+// Partial-day absences are allowed within the shift.
+func checkShiftAbsence(agent *model.AgentWorkingSchedule, _ *model.WorkingCondition, _ *time.Location) []*model.WorkingScheduleViolation {
+	absences := make(map[string]bool)
+	for _, s := range agent.Schedule {
+		if s.FullAbsence() {
+			absences[s.Date.Time.Format(time.DateOnly)] = true
+		}
+	}
+
+	var out []*model.WorkingScheduleViolation
+	for _, s := range agent.Schedule {
+		if s.Shift == nil {
+			continue
+		}
+
+		switch {
+		case absences[s.Date.Time.Format(time.DateOnly)]:
+			out = append(out, newViolation(agent, s, ruleShiftAbsence, model.ViolationSeverityError, "shift is planned on the agent absence day"))
+		case s.Shift.Overnight() && absences[s.Date.Time.AddDate(0, 0, 1).Format(time.DateOnly)]:
+			out = append(out, newViolation(agent, s, ruleShiftAbsence, model.ViolationSeverityError, "overnight shift continues into the agent absence day"))
+		}
+	}
+
+	return out
+}
+
+// checkPauses reports pauses outside the shift bounds and pauses overlapping each other.
+func checkPauses(agent *model.AgentWorkingSchedule, _ *model.WorkingCondition, _ *time.Location) []*model.WorkingScheduleViolation {
+	var out []*model.WorkingScheduleViolation
+	for _, s := range agent.Schedule {
+		if s.Shift == nil {
+			continue
+		}
+
+		pauses := slices.Clone(s.Shift.Pauses)
+		slices.SortFunc(pauses, func(a, b *model.AgentScheduleShiftPause) int {
+			return int(a.Start - b.Start)
+		})
+
+		// Pause, that ends the latest so far, so pauses nested into a long one are reported as well.
+		var latest *model.AgentScheduleShiftPause
+		for _, p := range pauses {
+			if p.Start < s.Shift.Start || p.End > s.Shift.End {
+				out = append(out, newViolation(agent, s, rulePauseOutsideShift, model.ViolationSeverityError,
+					fmt.Sprintf("pause %d-%d is outside the shift %d-%d", p.Start, p.End, s.Shift.Start, s.Shift.End)),
+				)
+			}
+
+			if latest != nil && p.Start < latest.End {
+				out = append(out, newViolation(agent, s, rulePauseOverlap, model.ViolationSeverityError,
+					fmt.Sprintf("pause %d-%d overlaps pause %d-%d", p.Start, p.End, latest.Start, latest.End)),
+				)
+			}
+
+			if latest == nil || p.End > latest.End {
+				latest = p
+			}
+		}
+	}
+
+	return out
+}
+
+// checkShiftMinRest reports shifts, that start earlier than minRestBetweenShifts after the previous workday,
+// and shifts, that start before the previous shift or segment ends.
+// Rest is only required between workdays, not between segments of a split shift.
+// Agent schedule should be sorted by date.
+func checkShiftMinRest(agent *model.AgentWorkingSchedule, _ *model.WorkingCondition, loc *time.Location) []*model.WorkingScheduleViolation {
+	var (
+		out      []*model.WorkingScheduleViolation
+		prevEnd  time.Time
+		hasShift bool
+	)
+
+	for _, day := range shiftDays(agent.Schedule) {
+		slices.SortFunc(day, func(a, b *model.AgentSchedule) int {
+			return int(a.Shift.Start - b.Shift.Start)
+		})
+
+		for i, s := range day {
+			start, end := shiftInstants(loc, s.Date.Time, s.Shift.Start, s.Shift.End)
+			switch rest := start.Sub(prevEnd); {
+			case hasShift && rest < 0:
+				out = append(out, newViolation(agent, s, ruleShiftOverlap, model.ViolationSeverityError,
+					fmt.Sprintf("shift starts %s before the previous shift ends", -rest)),
+				)
+			case hasShift && i == 0 && rest < minRestBetweenShifts:
+				out = append(out, newViolation(agent, s, ruleShiftMinRest, model.ViolationSeverityWarning,
+					fmt.Sprintf("rest before the shift is %s, expected at least %s", rest, minRestBetweenShifts)),
+				)
+			}
+
+			if end.After(prevEnd) {
+				prevEnd = end
+			}
+
+			hasShift = true
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/webitel/webitel-wfm/internal/model"
+)
+
+func scheduleShift(date time.Time, id, start, end int64) *model.AgentSchedule {
+	return &model.AgentSchedule{
+		Date:  model.NewDate(date.Unix()),
+		Shift: &model.AgentScheduleShift{DomainRecord: model.DomainRecord{Id: id}, Start: start, End: end},
+	}
+}
+
+func violationRules(violations []*model.WorkingScheduleViolation) []string {
+	out := make([]string, 0, len(violations))
+	for _, v := range violations {
+		out = append(out, v.Rule)
+	}
+
+	return out
+}
+
+func TestCheckPauses(t *testing.T) {
+	day := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	pause := func(start, end int64) *model.AgentScheduleShiftPause {
+		return &model.AgentScheduleShiftPause{Start: start, End: end}
+	}
+
+	tests := map[string]struct {
+		pauses   []*model.AgentScheduleShiftPause
+		expected []string
+	}{
+		"separate pauses": {
+			pauses:   []*model.AgentScheduleShiftPause{pause(720, 780), pause(600, 615)},
+			expected: []string{},
+		},
+		"adjacent pauses": {
+			pauses:   []*model.AgentScheduleShiftPause{pause(600, 615), pause(615, 630)},
+			expected: []string{},
+		},
+		"overlapping pauses": {
+			pauses:   []*model.AgentScheduleShiftPause{pause(600, 630), pause(615, 645)},
+			expected: []string{rulePauseOverlap},
+		},
+		"pauses nested into a longer pause": {
+			pauses:   []*model.AgentScheduleShiftPause{pause(600, 720), pause(610, 620), pause(700, 710)},
+			expected: []string{rulePauseOverlap, rulePauseOverlap},
+		},
+		"pause outside the shift": {
+			pauses:   []*model.AgentScheduleShiftPause{pause(500, 560), pause(1070, 1090)},
+			expected: []string{rulePauseOutsideShift, rulePauseOutsideShift},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			s := scheduleShift(day, 1, 540, 1080)
+			s.Shift.Pauses = tt.pauses
+
+			out := checkPauses(&model.AgentWorkingSchedule{Schedule: []*model.AgentSchedule{s}}, nil, time.UTC)
+			assert.Equal(t, tt.expected, violationRules(out))
+		})
+	}
+}
+
+func TestCheckShiftMinRest(t *testing.T) {
+	kyiv, err := time.LoadLocation("Europe/Kyiv")
+	require.NoError(t, err)
+
+	var (
+		day     = time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+		springs = time.Date(2026, time.March, 29, 0, 0, 0, 0, time.UTC)
+		falls   = time.Date(2026, time.October, 25, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := map[string]struct {
+		loc      *time.Location
+		schedule []*model.AgentSchedule
+		expected []string
+	}{
+		"enough rest": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 540, 1080), scheduleShift(day.AddDate(0, 0, 1), 2, 540, 1080)},
+			expected: []string{},
+		},
+		"short rest": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 720, 1320), scheduleShift(day.AddDate(0, 0, 1), 2, 480, 960)},
+			expected: []string{ruleShiftMinRest},
+		},
+		"split shift segments need no rest": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 480, 720), scheduleShift(day, 2, 780, 1020)},
+			expected: []string{},
+		},
+		"split shift segments overlap": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 480, 720), scheduleShift(day, 2, 660, 1020)},
+			expected: []string{ruleShiftOverlap},
+		},
+		"overnight shift overlaps the next day": {
+			loc:      time.UTC,
+			schedule: []*model.AgentSchedule{scheduleShift(day, 1, 1320, 1860), scheduleShift(day.AddDate(0, 0, 1), 2, 360, 720)},
+			expected: []string{ruleShiftOverlap},
+		},
+		"rest over the DST day losing an hour": {
+			loc:      kyiv,
+			schedule: []*model.AgentSchedule{scheduleShift(springs.AddDate(0, 0, -1), 1, 900, 1380), scheduleShift(springs, 2, 600, 1080)},
+			expected: []string{ruleShiftMinRest},
+		},
+		"rest over the DST day gaining an hour": {
+			loc:      kyiv,
+			schedule: []*model.AgentSchedule{scheduleShift(falls.AddDate(0, 0, -1), 1, 900, 1380), scheduleShift(falls, 2, 540, 1080)},
+			expected: []string{},
+		},
+	}
+
+	for scenario, tt := range tests {
+		t.Run(scenario, func(t *testing.T) {
+			out := checkShiftMinRest(&model.AgentWorkingSchedule{Schedule: tt.schedule}, nil, tt.loc)
+			assert.Equal(t, tt.expected, violationRules(out))
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
+	b "github.com/webitel/webitel-wfm/infra/storage/dbsql/builder"
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql/cluster"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+type AbsenceTypeManager interface {
+	CreateAbsenceType(ctx context.Context, user *model.SignedInUser, in *model.AbsenceType) (int64, error)
+	ReadAbsenceType(ctx context.Context, read *options.Read) (*model.AbsenceType, error)
+	SearchAbsenceType(ctx context.Context, search *options.Search) ([]*model.AbsenceType, error)
+	UpdateAbsenceType(ctx context.Context, user *model.SignedInUser, in *model.AbsenceType) error
+	DeleteAbsenceType(ctx context.Context, read *options.Read) (int64, error)
+}
+
+type AbsenceType struct {
+	db cluster.Store
+}
+
+func NewAbsenceType(db cluster.Store) *AbsenceType {
+	return &AbsenceType{
+		db: db,
+	}
+}
+
+func (a *AbsenceType) CreateAbsenceType(ctx context.Context, user *model.SignedInUser, in *model.AbsenceType) (int64, error) {
+	var id int64
+	columns := []map[string]any{
+		{
+			"domain_id":              user.DomainId,
+			"created_by":             user.Id,
+			"updated_by":             user.Id,
+			"name":                   in.Name,
+			"description":            in.Description,
+			"color":                  in.Color,
+			"paid":                   in.Paid,
+			"counts_against_balance": in.CountsAgainstBalance,
+			"allowance":              int32(in.Allowance),
+			"requires_approval":      in.RequiresApproval,
+		},
+	}
+
+	sql, args := b.Insert(b.AbsenceTypeTable.Name(), columns).SQL("RETURNING id").Build()
+	if err := a.db.Primary().Get(ctx, &id, sql, args...); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (a *AbsenceType) ReadAbsenceType(ctx context.Context, read *options.Read) (*model.AbsenceType, error) {
+	search, err := options.NewSearch(ctx, options.WithID(read.ID()))
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := a.SearchAbsenceType(ctx, search.PopulateFromRead(read))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) > 1 {
+		return nil, werror.Wrap(dbsql.ErrEntityConflict, werror.WithID("storage.absence_type.read.conflict"))
+	}
+
+	if len(items) == 0 {
+		return nil, werror.Wrap(dbsql.ErrNoRows, werror.WithID("storage.absence_type.read"))
+	}
+
+	return items[0], nil
+}
+
+func (a *AbsenceType) SearchAbsenceType(ctx context.Context, search *options.Search) ([]*model.AbsenceType, error) {
+	const (
+		linkCreatedBy = 1 << iota
+		linkUpdatedBy
+	)
+
+	var (
+		absenceType = b.AbsenceTypeTable
+		createdBy   = b.UserTable.WithAlias("crt")
+		updatedBy   = b.UserTable.WithAlias("upd")
+		base        = b.Select().From(absenceType.String())
+
+		join          = 0
+		joinCreatedBy = func() {
+			if join&linkCreatedBy != 0 {
+				return
+			}
+
+			join |= linkCreatedBy
+			base.JoinWithOption(
+				b.LeftJoin(createdBy,
+					b.Equal(absenceType.Ident("created_by"), createdBy.Ident("id")),
+				),
+			)
+		}
+
+		joinUpdatedBy = func() {
+			if join&linkUpdatedBy != 0 {
+				return
+			}
+
+			join |= linkUpdatedBy
+			base.JoinWithOption(
+				b.LeftJoin(updatedBy,
+					b.Equal(absenceType.Ident("updated_by"), updatedBy.Ident("id")),
+				),
+			)
+		}
+	)
+
+	{
+		fields := []string{
+			"id", "domain_id", "created_at", "created_by", "updated_at", "updated_by",
+			"name", "description", "color", "paid", "counts_against_balance", "allowance", "requires_approval",
+		}
+
+		for _, field := range fields {
+			search.WithField(field)
+		}
+
+		for _, field := range search.Fields() {
+			switch field {
+			case "id", "domain_id", "created_at", "updated_at", "name", "description", "color", "paid",
+				"counts_against_balance", "allowance", "requires_approval":
+				field = absenceType.Ident(field)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(createdBy)), field)
+
+			case "updated_by":
+				joinUpdatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(updatedBy)), field)
+			}
+
+			base.SelectMore(field)
+		}
+	}
+
+	{
+		base.Where(base.EQ(absenceType.Ident("domain_id"), search.User().DomainId))
+		if search.Query() != "" {
+			base.Where(base.ILike(absenceType.Ident("name"), search.Query()))
+		}
+
+		if ids := search.IDs(); len(ids) > 0 {
+			base.Where(base.In(absenceType.Ident("id"), b.ConvertArgs(ids)...))
+		}
+	}
+
+	{
+		orderBy := search.OrderBy()
+		if len(orderBy) == 0 {
+			orderBy.WithOrderBy("name", b.OrderDirectionASC)
+		}
+
+		for field, direction := range orderBy {
+			switch field {
+			case "id", "name", "description", "color", "paid", "counts_against_balance", "allowance", "requires_approval",
+				"created_at", "updated_at":
+				field = b.OrderBy(absenceType.Ident(field), direction)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.OrderBy(createdBy.Ident("name"), direction)
+
+			case "updated_by":
+				joinUpdatedBy()
+				field = b.OrderBy(updatedBy.Ident("name"), direction)
+			}
+
+			base.OrderBy(field)
+		}
+	}
+
+	var items []*model.AbsenceType
+	sql, args := base.Limit(search.Size()).Offset(search.Offset()).Build()
+	if err := a.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (a *AbsenceType) UpdateAbsenceType(ctx context.Context, user *model.SignedInUser, in *model.AbsenceType) error {
+	columns := map[string]any{
+		"updated_by":             user.Id,
+		"name":                   in.Name,
+		"description":            in.Description,
+		"color":                  in.Color,
+		"paid":                   in.Paid,
+		"counts_against_balance": in.CountsAgainstBalance,
+		"allowance":              int32(in.Allowance),
+		"requires_approval":      in.RequiresApproval,
+	}
+
+	ub := b.Update(b.AbsenceTypeTable.Name(), columns)
+	clauses := []string{
+		ub.Equal("domain_id", user.DomainId),
+		ub.Equal("id", in.Id),
+	}
+
+	sql, args := ub.Where(clauses...).Build()
+	if err := a.db.Primary().Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AbsenceType) DeleteAbsenceType(ctx context.Context, read *options.Read) (int64, error) {
+	db := b.Delete(b.AbsenceTypeTable.Name())
+	clauses := []string{
+		db.Equal("domain_id", read.User().DomainId),
+		db.Equal("id", read.ID()),
+	}
+
+	sql, args := db.Where(clauses...).Build()
+	if err := a.db.Primary().Exec(ctx, sql, args...); err != nil {
+		return 0, err
+	}
+
+	return read.ID(), nil
+}
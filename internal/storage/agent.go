@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"context"
+
+	b "github.com/webitel/webitel-wfm/infra/storage/dbsql/builder"
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql/cluster"
+	"github.com/webitel/webitel-wfm/internal/model"
+)
+
+type AgentManager interface {
+	ReadUserAgent(ctx context.Context, user *model.SignedInUser) (*model.LookupItem, error)
+}
+
+type Agent struct {
+	db cluster.Store
+}
+
+func NewAgent(db cluster.Store) *Agent {
+	return &Agent{
+		db: db,
+	}
+}
+
+// ReadUserAgent returns the agent, that is linked to the user.
+func (a *Agent) ReadUserAgent(ctx context.Context, user *model.SignedInUser) (*model.LookupItem, error) {
+	var (
+		agent     = b.AgentTable
+		agentUser = b.UserTable.WithAlias("au")
+		base      = b.Select(agent.Ident("id"), b.Alias(b.Coalesce(agentUser.Ident("name"), agentUser.Ident("username")), "name")).From(agent.String())
+	)
+
+	base.JoinWithOption(
+		b.LeftJoin(agentUser,
+			b.Equal(agent.Ident("user_id"), agentUser.Ident("id")),
+		),
+	)
+
+	base.Where(
+		base.EQ(agent.Ident("domain_id"), user.DomainId),
+		base.EQ(agent.Ident("user_id"), user.Id),
+	)
+
+	var item model.LookupItem
+	sql, args := base.Build()
+	if err := a.db.StandbyPreferred().Get(ctx, &item, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
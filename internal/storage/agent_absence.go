@@ -70,15 +70,155 @@ func (a *AgentAbsence) ReadAgentAbsence(ctx context.Context, read *options.Read)
 }
 
 func (a *AgentAbsence) SearchAgentAbsence(ctx context.Context, search *options.Search) ([]*model.Absence, error) {
+	const (
+		linkCreatedBy = 1 << iota
+		linkUpdatedBy
+		linkAbsenceType
+	)
+
+	var (
+		agentAbsence = b.AgentAbsenceTable
+		createdBy    = b.UserTable.WithAlias("crt")
+		updatedBy    = b.UserTable.WithAlias("upd")
+		absenceType  = b.AbsenceTypeTable
+		base         = b.Select().From(agentAbsence.String())
+
+		join          = 0
+		joinCreatedBy = func() {
+			if join&linkCreatedBy != 0 {
+				return
+			}
+
+			join |= linkCreatedBy
+			base.JoinWithOption(
+				b.LeftJoin(createdBy,
+					b.Equal(agentAbsence.Ident("created_by"), createdBy.Ident("id")),
+				),
+			)
+		}
+
+		joinUpdatedBy = func() {
+			if join&linkUpdatedBy != 0 {
+				return
+			}
 
-	panic("implement me")
+			join |= linkUpdatedBy
+			base.JoinWithOption(
+				b.LeftJoin(updatedBy,
+					b.Equal(agentAbsence.Ident("updated_by"), updatedBy.Ident("id")),
+				),
+			)
+		}
+		joinAbsenceType = func() {
+			if join&linkAbsenceType != 0 {
+				return
+			}
+
+			join |= linkAbsenceType
+			base.JoinWithOption(
+				b.LeftJoin(absenceType,
+					b.Equal(agentAbsence.Ident("absence_type_id"), absenceType.Ident("id")),
+				),
+			)
+		}
+	)
+
+	// Fields to retrieve.
+	{
+		fields := []string{
+			"id", "created_at", "created_by", "updated_at", "updated_by",
+			"absent_at", "absence_type", "start", "end",
+		}
+
+		for _, field := range fields {
+			search.WithField(field)
+		}
+
+		for _, field := range search.Fields() {
+			switch field {
+			case "id", "domain_id", "created_at", "updated_at", "absent_at":
+				field = agentAbsence.Ident(field)
+
+			case "start", "end":
+				field = b.Alias(agentAbsence.Ident(field+"_min"), field)
+
+			case "absence_type":
+				joinAbsenceType()
+				field = b.Alias(b.JSONBuildObject(b.Lookup(absenceType, "id", "name")), field)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(createdBy)), field)
+
+			case "updated_by":
+				joinUpdatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(updatedBy)), field)
+			}
+
+			base.SelectMore(field)
+		}
+	}
+
+	// Add WHERE clauses.
+	{
+		base.Where(base.EQ(agentAbsence.Ident("domain_id"), search.User().DomainId))
+		if agent := search.DerivedByName("agent").ID(); agent != 0 {
+			base.Where(base.EQ(agentAbsence.Ident("agent_id"), agent))
+		}
+
+		if ids := search.IDs(); len(ids) > 0 {
+			base.Where(base.In(agentAbsence.Ident("id"), b.ConvertArgs(ids)...))
+		}
+	}
+
+	// Construct ORDER BY fields.
+	{
+		orderBy := search.OrderBy()
+		if len(orderBy) == 0 {
+			orderBy.WithOrderBy("absent_at", b.OrderDirectionASC)
+		}
+
+		for field, direction := range orderBy {
+			switch field {
+			case "id", "created_at", "updated_at", "absent_at":
+				field = b.OrderBy(agentAbsence.Ident(field), direction)
+
+			case "start", "end":
+				field = b.OrderBy(agentAbsence.Ident(field+"_min"), direction)
+
+			case "absence_type":
+				joinAbsenceType()
+				field = b.OrderBy(absenceType.Ident("name"), direction)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.OrderBy(createdBy.Ident("name"), direction)
+
+			case "updated_by":
+				joinUpdatedBy()
+				field = b.OrderBy(updatedBy.Ident("name"), direction)
+			}
+
+			base.OrderBy(field)
+		}
+	}
+
+	var items []*model.Absence
+	sql, args := base.Limit(search.Size()).Offset(search.Offset()).Build()
+	if err := a.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
 }
 
 func (a *AgentAbsence) UpdateAgentAbsence(ctx context.Context, read *options.Read, in *model.Absence) error {
 	columns := map[string]any{
 		"updated_by":      read.User().Id,
 		"absent_at":       in.AbsentAt,
-		"absence_type_id": in.AbsenceType,
+		"absence_type_id": in.AbsenceType.Id,
+		"start_min":       in.Start,
+		"end_min":         in.End,
 	}
 
 	ub := b.Update(b.AgentAbsenceTable.Name(), columns)
@@ -134,12 +274,14 @@ func (a *AgentAbsence) SearchAgentsAbsences(ctx context.Context, search *options
 		linkCreatedBy = 1 << iota
 		linkUpdatedBy
 		linkAgent
+		linkAbsenceType
 	)
 
 	var (
 		agentAbsence = b.AgentAbsenceTable
 		createdBy    = b.UserTable.WithAlias("crt")
 		updatedBy    = b.UserTable.WithAlias("upd")
+		absenceType  = b.AbsenceTypeTable
 		agent        = b.AgentTable
 		agentUser    = b.UserTable.WithAlias("au")
 		base         = b.Select().From(agentAbsence.String())
@@ -189,6 +331,18 @@ func (a *AgentAbsence) SearchAgentsAbsences(ctx context.Context, search *options
 				),
 			)
 		}
+		joinAbsenceType = func() {
+			if join&linkAbsenceType != 0 {
+				return
+			}
+
+			join |= linkAbsenceType
+			base.JoinWithOption(
+				b.LeftJoin(absenceType,
+					b.Equal(agentAbsence.Ident("absence_type_id"), absenceType.Ident("id")),
+				),
+			)
+		}
 	)
 
 	{
@@ -209,7 +363,7 @@ func (a *AgentAbsence) SearchAgentsAbsences(ctx context.Context, search *options
 				absencesDerived := search.DerivedByName(field)
 				absencesDerivedFields := absencesDerived.Fields()
 				if len(absencesDerivedFields) == 0 {
-					for _, v := range []string{"id", "created_at", "updated_at", "absent_at", "absence_type_id"} {
+					for _, v := range []string{"id", "created_at", "updated_at", "absent_at", "absence_type", "start", "end"} {
 						absencesDerivedFields.WithField(v)
 					}
 				}
@@ -217,9 +371,16 @@ func (a *AgentAbsence) SearchAgentsAbsences(ctx context.Context, search *options
 				jsonObj := make(b.JSONBuildObjectFields, len(absencesDerivedFields))
 				for _, absencesDerivedField := range absencesDerivedFields {
 					switch absencesDerivedField {
-					case "id", "created_at", "updated_at", "absent_at", "absence_type_id":
+					case "id", "created_at", "updated_at", "absent_at":
 						jsonObj.More(b.JSONBuildObjectFields{absencesDerivedField: agentAbsence.Ident(absencesDerivedField)})
 
+					case "absence_type":
+						joinAbsenceType()
+						jsonObj.More(b.JSONBuildObjectFields{absencesDerivedField: b.JSONBuildObject(b.Lookup(absenceType, "id", "name"))})
+
+					case "start", "end":
+						jsonObj.More(b.JSONBuildObjectFields{absencesDerivedField: agentAbsence.Ident(absencesDerivedField + "_min")})
+
 					case "created_by":
 						joinCreatedBy()
 						jsonObj.More(b.JSONBuildObjectFields{absencesDerivedField: b.JSONBuildObject(b.UserLookup(createdBy))})
@@ -265,7 +426,9 @@ func (a *AgentAbsence) createAgentAbsencePrepareColumns(user *model.SignedInUser
 		"updated_by":      user.Id,
 		"absent_at":       in.AbsentAt,
 		"agent_id":        agent,
-		"absence_type_id": int32(in.AbsenceType),
+		"absence_type_id": in.AbsenceType.Id,
+		"start_min":       in.Start,
+		"end_min":         in.End,
 	}
 }
 
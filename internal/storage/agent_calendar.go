@@ -0,0 +1,238 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/huandu/go-sqlbuilder"
+
+	b "github.com/webitel/webitel-wfm/infra/storage/dbsql/builder"
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql/cluster"
+	"github.com/webitel/webitel-wfm/internal/model"
+)
+
+type AgentCalendarManager interface {
+	ReadAgentCalendar(ctx context.Context, user *model.SignedInUser, search *model.AgentCalendarSearch) (*model.AgentCalendar, error)
+	SearchAgentHolidays(ctx context.Context, user *model.SignedInUser, search *model.AgentCalendarSearch) ([]*model.Holiday, error)
+	SearchAgentScheduleChanges(ctx context.Context, user *model.SignedInUser, search *model.AgentCalendarSearch) ([]*model.AgentScheduleChange, error)
+
+	CreateAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64, tokenHash string) error
+	ReadAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64) (*model.AgentCalendarFeed, error)
+	ReadAgentCalendarFeedByToken(ctx context.Context, tokenHash string) (*model.AgentCalendarFeed, error)
+	DeleteAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64) error
+}
+
+type AgentCalendar struct {
+	db cluster.Store
+}
+
+func NewAgentCalendar(db cluster.Store) *AgentCalendar {
+	return &AgentCalendar{
+		db: db,
+	}
+}
+
+// ReadAgentCalendar returns the agent with shifts of active and archived working schedules,
+// drafts aren't published to agents yet, and absences of the agent within the date period.
+func (a *AgentCalendar) ReadAgentCalendar(ctx context.Context, user *model.SignedInUser, search *model.AgentCalendarSearch) (*model.AgentCalendar, error) {
+	var (
+		agent     = b.AgentTable
+		agentUser = b.UserTable.WithAlias("au")
+		timezone  = b.CalendarTimezoneTable.WithAlias("act")
+		absence   = b.AgentAbsenceTable
+	)
+
+	shifts := b.Select("jsonb_agg(jsonb_build_object('working_schedule', call_center.cc_get_lookup(ws.id, ws.name), 'date', s.date, 'shift', s.shift) ORDER BY s.date, (s.shift ->> 'start')::bigint)").
+		From(agentWorkingScheduleView + " s")
+	shifts.Join(workingScheduleTable+" ws", "ws.id = s.working_schedule_id")
+	shifts.Where(
+		shifts.Equal("s.domain_id", user.DomainId),
+		"(s.agent ->> 'id')::bigint = "+agent.Ident("id"),
+		shifts.IsNotNull("s.shift"),
+		shifts.Between("s.date", search.Date.From, search.Date.To),
+		publishedWorkingSchedule(&shifts.Cond),
+	)
+
+	absences := b.Select("jsonb_agg(jsonb_build_object('id', aa.id, 'updated_at', aa.updated_at, 'absent_at', aa.absent_at, 'absence_type', call_center.cc_get_lookup(at.id, at.name), 'start', aa.start_min, 'end', aa.end_min) ORDER BY aa.absent_at)").
+		From(absence.String())
+	absences.Join(b.AbsenceTypeTable.String(), b.Equal(absence.Ident("absence_type_id"), b.AbsenceTypeTable.Ident("id")).String())
+	absences.Where(
+		absences.Equal(absence.Ident("domain_id"), user.DomainId),
+		b.Equal(absence.Ident("agent_id"), agent.Ident("id")).String(),
+		absences.Between(absence.Ident("absent_at"), search.Date.From, search.Date.To),
+	)
+
+	base := b.Select(
+		b.Alias(b.JSONBuildObject(b.JSONBuildObjectFields{
+			"id":   agent.Ident("id"),
+			"name": b.Coalesce(agentUser.Ident("name"), agentUser.Ident("username")),
+		}), "agent"),
+		b.Alias(timezone.Ident("sys_name"), "timezone"),
+	).From(agent.String())
+
+	base.SelectMore(
+		b.Alias("("+base.Var(shifts)+")", "shifts"),
+		b.Alias("("+base.Var(absences)+")", "absences"),
+	)
+
+	base.JoinWithOption(b.LeftJoin(agentUser, b.Equal(agent.Ident("user_id"), agentUser.Ident("id"))))
+	base.JoinWithOption(b.LeftJoin(b.AgentWorkingConditionTable, b.Equal(b.AgentWorkingConditionTable.Ident("agent_id"), agent.Ident("id"))))
+	base.JoinWithOption(b.LeftJoin(timezone, b.Equal(b.AgentWorkingConditionTable.Ident("timezone_id"), timezone.Ident("id"))))
+	base.Where(
+		base.EQ(agent.Ident("domain_id"), user.DomainId),
+		base.EQ(agent.Ident("id"), search.AgentId),
+	)
+
+	var item model.AgentCalendar
+	sql, args := base.Build()
+	if err := a.db.StandbyPreferred().Get(ctx, &item, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// SearchAgentHolidays returns holidays of published working schedules, which the agent belongs to.
+func (a *AgentCalendar) SearchAgentHolidays(ctx context.Context, user *model.SignedInUser, search *model.AgentCalendarSearch) ([]*model.Holiday, error) {
+	sb := b.Select("DISTINCT h.date", "h.name").From(agentWorkingScheduleHolidaysView + " h")
+	sb.Join(workingScheduleTable+" ws", "ws.id = h.working_schedule_id")
+	sb.Join(workingScheduleAgentTable+" wsa", "wsa.working_schedule_id = h.working_schedule_id")
+	sb.Where(
+		sb.Equal("h.domain_id", user.DomainId),
+		sb.Equal("wsa.agent_id", search.AgentId),
+		sb.Between("h.date", search.Date.From, search.Date.To),
+		publishedWorkingSchedule(&sb.Cond),
+	).OrderBy("h.date")
+
+	var items []*model.Holiday
+	sql, args := sb.Build()
+	if err := a.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// SearchAgentScheduleChanges returns changes of the agent shifts dated within the period
+// of published working schedules, the latest first.
+func (a *AgentCalendar) SearchAgentScheduleChanges(ctx context.Context, user *model.SignedInUser, search *model.AgentCalendarSearch) ([]*model.AgentScheduleChange, error) {
+	createdBy := b.UserTable.WithAlias("crt")
+	sb := b.Select(
+		"h.id", "h.created_at", b.Alias(b.JSONBuildObject(b.UserLookup(createdBy)), "created_by"),
+		"call_center.cc_get_lookup(ws.id, ws.name) AS working_schedule",
+		"h.agent_working_schedule_id AS shift_id", "h.schedule_at AS date", "h.action",
+		"h.start_min AS start", "h.end_min AS end", "h.prev_start_min AS prev_start", "h.prev_end_min AS prev_end",
+	).From(agentWorkingScheduleHistoryTable + " h")
+
+	sb.Join(workingScheduleTable+" ws", "ws.id = h.working_schedule_id")
+	sb.JoinWithOption(b.LeftJoin(createdBy, b.Equal("h.created_by", createdBy.Ident("id"))))
+	sb.Where(
+		sb.Equal("h.domain_id", user.DomainId),
+		sb.Equal("h.agent_id", search.AgentId),
+		sb.Between("h.schedule_at", search.Date.From, search.Date.To),
+		publishedWorkingSchedule(&sb.Cond),
+	).OrderBy("h.created_at DESC", "h.id DESC")
+
+	var items []*model.AgentScheduleChange
+	sql, args := sb.Build()
+	if err := a.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// CreateAgentCalendarFeed stores the hash of the subscribe token of the agent's calendar,
+// that replaces the previous token of the agent.
+func (a *AgentCalendar) CreateAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64, tokenHash string) error {
+	columns := []map[string]any{
+		{
+			"domain_id":  user.DomainId,
+			"created_by": user.Id,
+			"agent_id":   agentId,
+			"token_hash": tokenHash,
+		},
+	}
+
+	sql, args := b.Insert(b.AgentCalendarFeedTable.Name(), columns).
+		SQL("ON CONFLICT (domain_id, agent_id) DO UPDATE SET token_hash = EXCLUDED.token_hash, created_by = EXCLUDED.created_by, created_at = EXCLUDED.created_at").
+		Build()
+
+	if err := a.db.Primary().Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *AgentCalendar) ReadAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64) (*model.AgentCalendarFeed, error) {
+	base := agentCalendarFeedQuery()
+	base.Where(
+		base.EQ(b.AgentCalendarFeedTable.Ident("domain_id"), user.DomainId),
+		base.EQ(b.AgentCalendarFeedTable.Ident("agent_id"), agentId),
+	)
+
+	var item model.AgentCalendarFeed
+	sql, args := base.Build()
+	if err := a.db.StandbyPreferred().Get(ctx, &item, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+// ReadAgentCalendarFeedByToken returns the feed by the hash of its token within any domain,
+// since calendar clients don't have a session.
+func (a *AgentCalendar) ReadAgentCalendarFeedByToken(ctx context.Context, tokenHash string) (*model.AgentCalendarFeed, error) {
+	base := agentCalendarFeedQuery()
+	base.Where(base.EQ(b.AgentCalendarFeedTable.Ident("token_hash"), tokenHash))
+
+	var item model.AgentCalendarFeed
+	sql, args := base.Build()
+	if err := a.db.StandbyPreferred().Get(ctx, &item, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (a *AgentCalendar) DeleteAgentCalendarFeed(ctx context.Context, user *model.SignedInUser, agentId int64) error {
+	db := b.Delete(b.AgentCalendarFeedTable.Name())
+	db.Where(db.Equal("domain_id", user.DomainId), db.Equal("agent_id", agentId)).SQL("RETURNING id")
+
+	var id int64
+	sql, args := db.Build()
+	if err := a.db.Primary().Get(ctx, &id, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// publishedWorkingSchedule filters working schedules "ws", that are visible to agents.
+func publishedWorkingSchedule(cond *sqlbuilder.Cond) string {
+	return cond.In("ws.state", int32(model.WorkingScheduleStateActive), int32(model.WorkingScheduleStateArchived))
+}
+
+func agentCalendarFeedQuery() *sqlbuilder.SelectBuilder {
+	var (
+		feed      = b.AgentCalendarFeedTable
+		createdBy = b.UserTable.WithAlias("crt")
+		agent     = b.AgentTable
+		agentUser = b.UserTable.WithAlias("au")
+	)
+
+	base := b.Select(
+		feed.Ident("domain_id"), feed.Ident("created_at"),
+		b.Alias(b.JSONBuildObject(b.UserLookup(createdBy)), "created_by"),
+		b.Alias(b.JSONBuildObject(b.JSONBuildObjectFields{
+			"id":   agent.Ident("id"),
+			"name": b.Coalesce(agentUser.Ident("name"), agentUser.Ident("username")),
+		}), "agent"),
+	).From(feed.String())
+
+	base.JoinWithOption(b.LeftJoin(createdBy, b.Equal(feed.Ident("created_by"), createdBy.Ident("id"))))
+	base.JoinWithOption(b.LeftJoin(agent, b.Equal(feed.Ident("agent_id"), agent.Ident("id"))))
+	base.JoinWithOption(b.LeftJoin(agentUser, b.Equal(agent.Ident("user_id"), agentUser.Ident("id"))))
+
+	return base
+}
@@ -12,6 +12,8 @@ import (
 type AgentWorkingConditionsManager interface {
 	ReadAgentWorkingConditions(ctx context.Context, read *options.Read) (*model.AgentWorkingConditions, error)
 	UpdateAgentWorkingConditions(ctx context.Context, read *options.Read, in *model.AgentWorkingConditions) error
+
+	SearchAgentWorkingConditionsHistory(ctx context.Context, read *options.Read) ([]*model.AgentWorkingConditionsHistory, error)
 }
 
 type AgentWorkingConditions struct {
@@ -28,12 +30,14 @@ func (a *AgentWorkingConditions) ReadAgentWorkingConditions(ctx context.Context,
 	const (
 		linkWorkingCondition = 1 << iota
 		linkPauseTemplate
+		linkTimezone
 	)
 
 	var (
 		agentWorkingCondition = b.AgentWorkingConditionTable
 		workingCondition      = b.WorkingConditionTable
 		pauseTemplate         = b.PauseTemplateTable
+		timezone              = b.CalendarTimezoneTable
 		base                  = b.Select().From(agentWorkingCondition.String())
 
 		join                 = 0
@@ -62,10 +66,23 @@ func (a *AgentWorkingConditions) ReadAgentWorkingConditions(ctx context.Context,
 				),
 			)
 		}
+
+		joinTimezone = func() {
+			if join&linkTimezone != 0 {
+				return
+			}
+
+			join |= linkTimezone
+			base.JoinWithOption(
+				b.LeftJoin(timezone,
+					b.Equal(agentWorkingCondition.Ident("timezone_id"), timezone.Ident("id")),
+				),
+			)
+		}
 	)
 
 	{
-		for _, field := range []string{"working_condition", "pause_template"} {
+		for _, field := range []string{"working_condition", "pause_template", "timezone"} {
 			read.WithField(field)
 		}
 
@@ -78,6 +95,10 @@ func (a *AgentWorkingConditions) ReadAgentWorkingConditions(ctx context.Context,
 			case "pause_template":
 				joinPauseTemplate()
 				field = b.Alias(b.JSONBuildObject(b.Lookup(pauseTemplate, "id", "name")), field)
+
+			case "timezone":
+				joinTimezone()
+				field = b.Alias(b.JSONBuildObject(b.Lookup(timezone, "id", "name")), field)
 			}
 
 			base.SelectMore(field)
@@ -111,10 +132,54 @@ func (a *AgentWorkingConditions) UpdateAgentWorkingConditions(ctx context.Contex
 		},
 	}
 
-	sql, args := b.Insert(b.AgentWorkingConditionTable.Name(), columns).Build()
+	// Timezone override is kept, unless it is set explicitly.
+	set := "updated_by = EXCLUDED.updated_by, working_condition_id = EXCLUDED.working_condition_id, pause_template_id = EXCLUDED.pause_template_id"
+	if in.Timezone != nil {
+		columns[0]["timezone_id"] = in.Timezone.SafeId()
+		set += ", timezone_id = EXCLUDED.timezone_id"
+	}
+
+	sql, args := b.Insert(b.AgentWorkingConditionTable.Name(), columns).
+		SQL("ON CONFLICT (domain_id, agent_id) DO UPDATE SET " + set).
+		Build()
 	if err := a.db.Primary().Exec(ctx, sql, args...); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// SearchAgentWorkingConditionsHistory returns working conditions linked to the agent over time,
+// ordered by the date they started at.
+func (a *AgentWorkingConditions) SearchAgentWorkingConditionsHistory(ctx context.Context, read *options.Read) ([]*model.AgentWorkingConditionsHistory, error) {
+	var (
+		history          = b.AgentWorkingConditionHistoryTable
+		workingCondition = b.WorkingConditionTable
+		base             = b.Select(
+			history.Ident("started_at"),
+			b.Alias(b.JSONBuildObject(b.Lookup(workingCondition, "id", "name")), "working_condition"),
+			workingCondition.Ident("workday_hours"),
+			workingCondition.Ident("vacation"),
+			workingCondition.Ident("sick_leaves"),
+		).From(history.String())
+	)
+
+	base.JoinWithOption(
+		b.LeftJoin(workingCondition,
+			b.Equal(history.Ident("working_condition_id"), workingCondition.Ident("id")),
+		),
+	)
+
+	base.Where(
+		base.EQ(history.Ident("domain_id"), read.User().DomainId),
+		base.EQ(history.Ident("agent_id"), read.ID()),
+	).OrderBy(history.Ident("started_at"))
+
+	var items []*model.AgentWorkingConditionsHistory
+	sql, args := base.Build()
+	if err := a.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
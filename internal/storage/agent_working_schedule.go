@@ -2,11 +2,14 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/webitel/webitel-wfm/infra/storage/cache"
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
 	"github.com/webitel/webitel-wfm/infra/storage/dbsql/builder"
 	"github.com/webitel/webitel-wfm/infra/storage/dbsql/cluster"
 	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/pkg/werror"
 )
 
 const (
@@ -14,14 +17,19 @@ const (
 	agentWorkingSchedulePauseTable = agentWorkingScheduleTable + "_pause"
 	agentWorkingScheduleSkillTable = agentWorkingScheduleTable + "_skill"
 
+	agentWorkingScheduleHistoryTable = agentWorkingScheduleTable + "_history"
+
 	agentWorkingScheduleView         = agentWorkingScheduleTable + "_v"
 	agentWorkingScheduleHolidaysView = agentWorkingScheduleTable + "_holidays_v"
 )
 
 type AgentWorkingScheduleManager interface {
-	CreateAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, workingScheduleID int64, in []*model.AgentWorkingSchedule) ([]*model.AgentWorkingSchedule, error)
+	CreateAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, workingScheduleID int64, in []*model.AgentWorkingSchedule, overwrite bool) ([]*model.AgentWorkingSchedule, error)
+	UpdateAgentWorkingScheduleShift(ctx context.Context, user *model.SignedInUser, workingScheduleID int64, in *model.AgentScheduleShift) (*model.AgentWorkingSchedule, error)
+	DeleteAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, in *model.DeleteAgentsWorkingScheduleShifts) ([]int64, error)
 	SearchAgentWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.AgentWorkingScheduleSearch) ([]*model.AgentWorkingSchedule, error)
 	Holidays(ctx context.Context, user *model.SignedInUser, search *model.AgentWorkingScheduleSearch) ([]*model.Holiday, error)
+	CalendarHolidays(ctx context.Context, user *model.SignedInUser, calendarId int64, date *model.FilterBetween) ([]*model.Holiday, error)
 }
 
 type AgentWorkingSchedule struct {
@@ -36,9 +44,35 @@ func NewAgentWorkingSchedule(db cluster.Store, manager cache.Manager) *AgentWork
 	}
 }
 
-func (a *AgentWorkingSchedule) CreateAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, workingScheduleID int64, in []*model.AgentWorkingSchedule) ([]*model.AgentWorkingSchedule, error) {
+// CreateAgentsWorkingScheduleShifts inserts shifts for each agent day.
+// If overwrite is set, existing shift of the agent day is replaced, including its pauses and skills.
+func (a *AgentWorkingSchedule) CreateAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, workingScheduleID int64, in []*model.AgentWorkingSchedule, overwrite bool) ([]*model.AgentWorkingSchedule, error) {
 	batch := a.db.Primary().Batch()
+	queueAgentsWorkingScheduleShifts(batch, user, workingScheduleID, in, overwrite)
+
+	var ids []int64
+	if err := batch.Select(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	out, err := a.SearchAgentWorkingSchedule(ctx, user, &model.AgentWorkingScheduleSearch{WorkingScheduleId: workingScheduleID, Ids: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// queueAgentsWorkingScheduleShifts queues a query per shift of the agents, each query returns the shift id.
+func queueAgentsWorkingScheduleShifts(batch dbsql.BatchNode, user *model.SignedInUser, workingScheduleID int64, in []*model.AgentWorkingSchedule, overwrite bool) {
 	for _, agent := range in {
+		// Segments of split shifts are identified by their start within a day.
+		starts := make(map[string][]any, len(agent.Schedule))
+		for _, shift := range agent.Schedule {
+			date := shift.Date.Time.Format(time.DateOnly)
+			starts[date] = append(starts[date], shift.Shift.Start)
+		}
+
 		for _, shift := range agent.Schedule {
 			columns := []map[string]any{
 				{
@@ -48,60 +82,107 @@ func (a *AgentWorkingSchedule) CreateAgentsWorkingScheduleShifts(ctx context.Con
 					"schedule_at":               shift.Date,
 					"start_min":                 shift.Shift.Start,
 					"end_min":                   shift.Shift.End,
+					"roster_pattern_id":         shift.Shift.RosterPattern.SafeId(),
 				},
 			}
 
-			cte := builder.CTE(builder.With("schedule").As(builder.Insert(agentWorkingScheduleTable, columns).SQL("RETURNING id")))
-			if l := len(shift.Shift.Pauses); l > 0 {
-				pauses := make([]map[string]any, l)
-				for _, pause := range shift.Shift.Pauses {
-					pauses = append(pauses, map[string]any{
-						"domain_id":                 user.DomainId,
-						"created_by":                user.Id,
-						"agent_working_schedule_id": builder.Format("(SELECT id::bigint FROM schedule)"),
-						"pause_cause_id":            pause.Cause.SafeId(),
-						"start_min":                 pause.Start,
-						"end_min":                   pause.End,
-					})
-				}
-
-				cte.With(builder.With("pauses").As(builder.Insert(agentWorkingSchedulePauseTable, pauses).SQL("RETURNING id")))
+			ib := builder.Insert(agentWorkingScheduleTable, columns)
+			if overwrite {
+				ib.SQL("ON CONFLICT (working_schedule_agent_id, schedule_at, start_min) DO UPDATE SET end_min = EXCLUDED.end_min, roster_pattern_id = EXCLUDED.roster_pattern_id, updated_by = EXCLUDED.created_by")
 			}
 
-			if l := len(shift.Shift.Skills); l > 0 {
-				skills := make([]map[string]any, l)
-				for _, skill := range shift.Shift.Skills {
-					skills = append(skills, map[string]any{
-						"domain_id":                 user.DomainId,
-						"agent_working_schedule_id": builder.Format("(SELECT id::bigint FROM schedule)"),
-						"skill_id":                  skill.Skill.Id,
-						"capacity":                  skill.Capacity,
-					})
-				}
-
-				cte.With(builder.With("skills").As(builder.Insert(agentWorkingScheduleSkillTable, skills).SQL("RETURNING id")))
+			cte := builder.CTE(builder.With("schedule").As(ib.SQL("RETURNING id")))
+			if overwrite {
+				delPauses := builder.Delete(agentWorkingSchedulePauseTable)
+				delPauses.Where(delPauses.Equal("domain_id", user.DomainId), delPauses.In("agent_working_schedule_id", builder.Format("SELECT id FROM schedule"))).SQL("RETURNING id")
+
+				delSkills := builder.Delete(agentWorkingScheduleSkillTable)
+				delSkills.Where(delSkills.Equal("domain_id", user.DomainId), delSkills.In("agent_working_schedule_id", builder.Format("SELECT id FROM schedule"))).SQL("RETURNING id")
+
+				// Segments of the day, that aren't desired anymore, are replaced as well.
+				delSegments := builder.Delete(agentWorkingScheduleTable)
+				delSegments.Where(delSegments.Equal("domain_id", user.DomainId),
+					delSegments.Equal("working_schedule_agent_id", columns[0]["working_schedule_agent_id"]),
+					delSegments.Equal("schedule_at", shift.Date),
+					delSegments.NotIn("start_min", starts[shift.Date.Time.Format(time.DateOnly)]...),
+				).SQL("RETURNING id")
+
+				cte.With(builder.With("del_pauses").As(delPauses)).With(builder.With("del_skills").As(delSkills)).With(builder.With("del_segments").As(delSegments))
 			}
 
-			sql, args := builder.Select("distinct schedule.id").With(cte.Builder()).From(cte.Tables()...).Build()
+			shiftPausesAndSkills(cte, user, shift.Shift)
+
+			sql, args := builder.Select("distinct schedule.id").With(cte.Builder()).From("schedule").Build()
 			batch.Queue(sql, args...)
 		}
 	}
+}
 
-	var ids []int64
-	if err := batch.Select(ctx, &ids); err != nil {
+func (a *AgentWorkingSchedule) UpdateAgentWorkingScheduleShift(ctx context.Context, user *model.SignedInUser, workingScheduleID int64, in *model.AgentScheduleShift) (*model.AgentWorkingSchedule, error) {
+	columns := map[string]any{
+		"updated_by": user.Id,
+		"start_min":  in.Start,
+		"end_min":    in.End,
+	}
+
+	ub := builder.Update(agentWorkingScheduleTable, columns)
+	ub.Where(ub.Equal("domain_id", user.DomainId), ub.Equal("id", in.Id),
+		ub.In("working_schedule_agent_id", builder.Format("SELECT id FROM "+workingScheduleAgentTable+" WHERE domain_id = $? AND working_schedule_id = $?", user.DomainId, workingScheduleID)),
+	).SQL("RETURNING id")
+
+	delPauses := builder.Delete(agentWorkingSchedulePauseTable)
+	delPauses.Where(delPauses.Equal("domain_id", user.DomainId), delPauses.In("agent_working_schedule_id", builder.Format("SELECT id FROM schedule"))).SQL("RETURNING id")
+
+	delSkills := builder.Delete(agentWorkingScheduleSkillTable)
+	delSkills.Where(delSkills.Equal("domain_id", user.DomainId), delSkills.In("agent_working_schedule_id", builder.Format("SELECT id FROM schedule"))).SQL("RETURNING id")
+
+	cte := builder.CTE(
+		builder.With("schedule").As(ub),
+		builder.With("del_pauses").As(delPauses),
+		builder.With("del_skills").As(delSkills),
+	)
+
+	shiftPausesAndSkills(cte, user, in)
+	sql, args := builder.Select("distinct schedule.id").With(cte.Builder()).From("schedule").Build()
+
+	var id int64
+	if err := a.db.Primary().Get(ctx, &id, sql, args...); err != nil {
 		return nil, err
 	}
 
-	out, err := a.SearchAgentWorkingSchedule(ctx, user, &model.AgentWorkingScheduleSearch{WorkingScheduleId: workingScheduleID, Ids: ids})
+	out, err := a.SearchAgentWorkingSchedule(ctx, user, &model.AgentWorkingScheduleSearch{WorkingScheduleId: workingScheduleID, Ids: []int64{id}})
 	if err != nil {
 		return nil, err
 	}
 
-	return out, nil
+	if len(out) == 0 {
+		return nil, werror.Wrap(dbsql.ErrNoRows, werror.WithID("storage.agent_working_schedule.update"))
+	}
+
+	return out[0], nil
+}
+
+func (a *AgentWorkingSchedule) DeleteAgentsWorkingScheduleShifts(ctx context.Context, user *model.SignedInUser, in *model.DeleteAgentsWorkingScheduleShifts) ([]int64, error) {
+	agents := builder.Select("id").From(workingScheduleAgentTable)
+	agents.Where(agents.Equal("domain_id", user.DomainId), agents.Equal("working_schedule_id", in.WorkingScheduleID), agents.In("agent_id", builder.ConvertArgs(in.AgentIds)...))
+
+	db := builder.Delete(agentWorkingScheduleTable)
+	sql, args := db.Where(
+		db.Equal("domain_id", user.DomainId),
+		db.In("working_schedule_agent_id", agents),
+		db.Between("schedule_at", in.Date.From, in.Date.To),
+	).SQL("RETURNING id").Build()
+
+	var ids []int64
+	if err := a.db.Primary().Select(ctx, &ids, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
 }
 
 func (a *AgentWorkingSchedule) SearchAgentWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.AgentWorkingScheduleSearch) ([]*model.AgentWorkingSchedule, error) {
-	sb := builder.Select("agent AS agent", "jsonb_agg(schedule.*) FILTER (WHERE date NOTNULL) AS schedule")
+	sb := builder.Select("agent AS agent", "timezone AS timezone", "jsonb_agg(schedule.*) FILTER (WHERE date NOTNULL) AS schedule")
 	if len(search.AgentIds) > 0 {
 		in := make([]any, 0, len(search.AgentIds))
 		for _, id := range search.AgentIds {
@@ -134,14 +215,30 @@ func (a *AgentWorkingSchedule) SearchAgentWorkingSchedule(ctx context.Context, u
 			and = append(and, sb.LessEqualThan("date", s.To))
 		}
 
-		sb.Or(sb.IsNull("date"), sb.And(and...))
+		if len(and) > 0 {
+			sb.Where(sb.Or(sb.IsNull("date"), sb.And(and...)))
+		}
+	}
+
+	// Shifts of all working schedules are searched, if the working schedule isn't set.
+	if search.WorkingScheduleId != 0 {
+		sb.Where(sb.Equal("working_schedule_id", search.WorkingScheduleId))
+	}
+
+	if len(search.WorkingScheduleStates) > 0 {
+		states := make([]any, 0, len(search.WorkingScheduleStates))
+		for _, state := range search.WorkingScheduleStates {
+			states = append(states, int32(state))
+		}
+
+		ws := builder.Select("id").From(workingScheduleTable)
+		ws.Where(ws.Equal("domain_id", user.DomainId), ws.In("state", states...))
+		sb.Where(sb.In("working_schedule_id", ws))
 	}
 
 	sql, args := sb.From(agentWorkingScheduleView+" AS schedule").
-		Where(sb.Equal("domain_id", user.DomainId),
-			sb.Equal("working_schedule_id", search.WorkingScheduleId),
-		).
-		GroupBy("agent").
+		Where(sb.Equal("domain_id", user.DomainId)).
+		GroupBy("agent", "timezone").
 		Build()
 
 	var items []*model.AgentWorkingSchedule
@@ -165,3 +262,68 @@ func (a *AgentWorkingSchedule) Holidays(ctx context.Context, user *model.SignedI
 
 	return items, nil
 }
+
+// CalendarHolidays returns holidays of the calendar within the date filter,
+// which may be out of the period of any working schedule.
+// Holidays are resolved the same way as within agentWorkingScheduleHolidaysView.
+func (a *AgentWorkingSchedule) CalendarHolidays(ctx context.Context, user *model.SignedInUser, calendarId int64, date *model.FilterBetween) ([]*model.Holiday, error) {
+	sql, args := builder.Format(`SELECT i::date AS date, ca.excepted AS name
+FROM generate_series($?::date, $?::date, '1d'::interval) i
+         INNER JOIN LATERAL (SELECT (SELECT x.name
+                                     FROM unnest(c.excepts) AS x
+                                     WHERE NOT x.disabled IS TRUE
+                                       AND CASE
+                                               WHEN x.repeat IS TRUE THEN
+                                                   to_char(i::date, 'MM-DD') =
+                                                   to_char((to_timestamp(x.date / 1000) AT TIME ZONE ct.sys_name)::date, 'MM-DD')
+                                               ELSE
+                                                   i::date = (to_timestamp(x.date / 1000) AT TIME ZONE ct.sys_name)::date
+                                         END
+                                     LIMIT 1) excepted
+                             FROM flow.calendar c
+                                      INNER JOIN flow.calendar_timezones ct ON c.timezone_id = ct.id
+                             WHERE c.domain_id = $? AND c.id = $?) ca ON ca.excepted NOTNULL
+ORDER BY i`, date.From, date.To, user.DomainId, calendarId).Build()
+
+	var items []*model.Holiday
+	if err := a.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// shiftPausesAndSkills appends pauses and skills of a shift to the query,
+// which inserts or updates a shift within "schedule" table expression.
+func shiftPausesAndSkills(cte *builder.CTEQuery, user *model.SignedInUser, shift *model.AgentScheduleShift) {
+	if l := len(shift.Pauses); l > 0 {
+		pauses := make([]map[string]any, 0, l)
+		for _, pause := range shift.Pauses {
+			pauses = append(pauses, map[string]any{
+				"domain_id":                 user.DomainId,
+				"created_by":                user.Id,
+				"agent_working_schedule_id": builder.Format("(SELECT id::bigint FROM schedule)"),
+				"pause_cause_id":            pause.Cause.SafeId(),
+				"start_min":                 pause.Start,
+				"end_min":                   pause.End,
+			})
+		}
+
+		cte.With(builder.With("pauses").As(builder.Insert(agentWorkingSchedulePauseTable, pauses).SQL("RETURNING id")))
+	}
+
+	if l := len(shift.Skills); l > 0 {
+		skills := make([]map[string]any, 0, l)
+		for _, skill := range shift.Skills {
+			skills = append(skills, map[string]any{
+				"domain_id":                 user.DomainId,
+				"agent_working_schedule_id": builder.Format("(SELECT id::bigint FROM schedule)"),
+				"skill_id":                  skill.Skill.Id,
+				"capacity":                  skill.Capacity,
+				"enabled":                   skill.Enabled,
+			})
+		}
+
+		cte.With(builder.With("skills").As(builder.Insert(agentWorkingScheduleSkillTable, skills).SQL("RETURNING id")))
+	}
+}
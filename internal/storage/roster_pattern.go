@@ -0,0 +1,339 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
+	b "github.com/webitel/webitel-wfm/infra/storage/dbsql/builder"
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql/cluster"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+type RosterPatternManager interface {
+	CreateRosterPattern(ctx context.Context, user *model.SignedInUser, in *model.RosterPattern) (int64, error)
+	ReadRosterPattern(ctx context.Context, read *options.Read) (*model.RosterPattern, error)
+	SearchRosterPattern(ctx context.Context, search *options.Search) ([]*model.RosterPattern, error)
+	UpdateRosterPattern(ctx context.Context, user *model.SignedInUser, in *model.RosterPattern) error
+	DeleteRosterPattern(ctx context.Context, read *options.Read) (int64, error)
+
+	SearchRosterPatternAgents(ctx context.Context, user *model.SignedInUser, id int64, workingScheduleId int64) ([]*model.LookupItem, error)
+}
+
+type RosterPattern struct {
+	db cluster.Store
+}
+
+func NewRosterPattern(db cluster.Store) *RosterPattern {
+	return &RosterPattern{
+		db: db,
+	}
+}
+
+func (r *RosterPattern) CreateRosterPattern(ctx context.Context, user *model.SignedInUser, in *model.RosterPattern) (int64, error) {
+	pattern := []map[string]any{
+		{
+			"domain_id":   user.DomainId,
+			"created_by":  user.Id,
+			"updated_by":  user.Id,
+			"name":        in.Name,
+			"description": in.Description,
+			"team_id":     in.Team.SafeId(),
+			"shifts":      in.Shifts,
+		},
+	}
+
+	cte := b.CTE(b.With("pattern").As(b.Insert(b.RosterPatternTable.Name(), pattern).SQL("RETURNING id")))
+	withRosterPatternAgents(cte, user, in.Agents)
+
+	var id int64
+	sql, args := b.Select("pattern.id").With(cte.Builder()).From("pattern").Build()
+	if err := r.db.Primary().Get(ctx, &id, sql, args...); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (r *RosterPattern) ReadRosterPattern(ctx context.Context, read *options.Read) (*model.RosterPattern, error) {
+	search, err := options.NewSearch(ctx, options.WithID(read.ID()))
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := r.SearchRosterPattern(ctx, search.PopulateFromRead(read))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) > 1 {
+		return nil, werror.Wrap(dbsql.ErrEntityConflict, werror.WithID("storage.roster_pattern.read.conflict"))
+	}
+
+	if len(items) == 0 {
+		return nil, werror.Wrap(dbsql.ErrNoRows, werror.WithID("storage.roster_pattern.read"))
+	}
+
+	return items[0], nil
+}
+
+func (r *RosterPattern) SearchRosterPattern(ctx context.Context, search *options.Search) ([]*model.RosterPattern, error) {
+	const (
+		linkCreatedBy = 1 << iota
+		linkUpdatedBy
+		linkTeam
+	)
+
+	var (
+		pattern   = b.RosterPatternTable
+		createdBy = b.UserTable.WithAlias("crt")
+		updatedBy = b.UserTable.WithAlias("upd")
+		team      = b.TeamTable
+		base      = b.Select().From(pattern.String())
+
+		join          = 0
+		joinCreatedBy = func() {
+			if join&linkCreatedBy != 0 {
+				return
+			}
+
+			join |= linkCreatedBy
+			base.JoinWithOption(
+				b.LeftJoin(createdBy,
+					b.Equal(pattern.Ident("created_by"), createdBy.Ident("id")),
+				),
+			)
+		}
+
+		joinUpdatedBy = func() {
+			if join&linkUpdatedBy != 0 {
+				return
+			}
+
+			join |= linkUpdatedBy
+			base.JoinWithOption(
+				b.LeftJoin(updatedBy,
+					b.Equal(pattern.Ident("updated_by"), updatedBy.Ident("id")),
+				),
+			)
+		}
+
+		joinTeam = func() {
+			if join&linkTeam != 0 {
+				return
+			}
+
+			join |= linkTeam
+			base.JoinWithOption(
+				b.LeftJoin(team,
+					b.Equal(pattern.Ident("team_id"), team.Ident("id")),
+				),
+			)
+		}
+	)
+
+	// Fields to retrieve.
+	{
+		fields := []string{
+			"id", "created_at", "created_by", "updated_at", "updated_by",
+			"name", "description", "team", "agents", "shifts",
+		}
+
+		for _, field := range fields {
+			search.WithField(field)
+		}
+
+		for _, field := range search.Fields() {
+			switch field {
+			case "id", "domain_id", "created_at", "updated_at", "name", "description", "shifts":
+				field = pattern.Ident(field)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(createdBy)), field)
+
+			case "updated_by":
+				joinUpdatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(updatedBy)), field)
+
+			case "team":
+				joinTeam()
+				field = b.Alias("call_center.cc_get_lookup("+team.Ident("id")+", "+team.Ident("name")+")", field)
+
+			case "agents":
+				field = b.Alias("(SELECT jsonb_agg(call_center.cc_get_lookup(a.id, coalesce(u.name, u.username)) ORDER BY coalesce(u.name, u.username)) "+
+					"FROM "+b.RosterPatternAgentTable.Name()+" rpa INNER JOIN "+b.AgentTable.Name()+" a ON a.id = rpa.agent_id "+
+					"INNER JOIN "+b.UserTable.Name()+" u ON u.id = a.user_id WHERE rpa.roster_pattern_id = "+pattern.Ident("id")+")", field)
+			}
+
+			base.SelectMore(field)
+		}
+	}
+
+	// Add WHERE clauses.
+	{
+		base.Where(base.EQ(pattern.Ident("domain_id"), search.User().DomainId))
+		if search.Query() != "" {
+			base.Where(base.ILike(pattern.Ident("name"), search.Query()))
+		}
+
+		if ids := search.IDs(); len(ids) > 0 {
+			base.Where(base.In(pattern.Ident("id"), b.ConvertArgs(ids)...))
+		}
+
+		if teamId := search.DerivedByName("team").ID(); teamId != 0 {
+			base.Where(base.EQ(pattern.Ident("team_id"), teamId))
+		}
+
+		// Patterns of the agent are assigned directly or by the team of the agent.
+		if agentId := search.DerivedByName("agent").ID(); agentId != 0 {
+			base.Where(base.Or(
+				base.Exists(b.Format("SELECT 1 FROM "+b.RosterPatternAgentTable.Name()+" rpa WHERE rpa.roster_pattern_id = "+pattern.Ident("id")+" AND rpa.agent_id = $?", agentId)),
+				base.Exists(b.Format("SELECT 1 FROM "+b.AgentTable.Name()+" a WHERE a.id = $? AND a.team_id = "+pattern.Ident("team_id"), agentId)),
+			))
+		}
+	}
+
+	// Construct ORDER BY fields.
+	{
+		orderBy := search.OrderBy()
+		if len(orderBy) == 0 {
+			orderBy.WithOrderBy("created_at", b.OrderDirectionASC)
+		}
+
+		for field, direction := range orderBy {
+			switch field {
+			case "id", "name", "description", "created_at", "updated_at":
+				field = b.OrderBy(pattern.Ident(field), direction)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.OrderBy(createdBy.Ident("name"), direction)
+
+			case "updated_by":
+				joinUpdatedBy()
+				field = b.OrderBy(updatedBy.Ident("name"), direction)
+
+			case "team":
+				joinTeam()
+				field = b.OrderBy(team.Ident("name"), direction)
+			}
+
+			base.OrderBy(field)
+		}
+	}
+
+	var items []*model.RosterPattern
+	sql, args := base.Limit(search.Size()).Offset(search.Offset()).Build()
+	if err := r.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// UpdateRosterPattern updates the pattern and replaces its agents,
+// agents, that are still assigned, are kept as is.
+func (r *RosterPattern) UpdateRosterPattern(ctx context.Context, user *model.SignedInUser, in *model.RosterPattern) error {
+	columns := map[string]any{
+		"updated_by":  user.Id,
+		"name":        in.Name,
+		"description": in.Description,
+		"team_id":     in.Team.SafeId(),
+		"shifts":      in.Shifts,
+	}
+
+	ub := b.Update(b.RosterPatternTable.Name(), columns)
+	ub.Where(ub.Equal("domain_id", user.DomainId), ub.Equal("id", in.Id)).SQL("RETURNING id")
+
+	agentIds := make([]int64, 0, len(in.Agents))
+	for _, a := range in.Agents {
+		agentIds = append(agentIds, a.Id)
+	}
+
+	db := b.Delete(b.RosterPatternAgentTable.Name())
+	db.Where(db.Equal("domain_id", user.DomainId),
+		db.In("roster_pattern_id", b.Format("SELECT id FROM pattern")),
+		db.Not(db.Equal("agent_id", b.Format("ANY($?::bigint[])", agentIds))),
+	).SQL("RETURNING id")
+
+	cte := b.CTE(b.With("pattern").As(ub), b.With("del_agents").As(db))
+	withRosterPatternAgents(cte, user, in.Agents)
+
+	sql, args := b.Select("pattern.id").With(cte.Builder()).From("pattern").Build()
+	if err := r.db.Primary().Exec(ctx, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RosterPattern) DeleteRosterPattern(ctx context.Context, read *options.Read) (int64, error) {
+	db := b.Delete(b.RosterPatternTable.Name())
+	clauses := []string{
+		db.Equal("domain_id", read.User().DomainId),
+		db.Equal("id", read.ID()),
+	}
+
+	sql, args := db.Where(clauses...).Build()
+	if err := r.db.Primary().Exec(ctx, sql, args...); err != nil {
+		return 0, err
+	}
+
+	return read.ID(), nil
+}
+
+// SearchRosterPatternAgents returns agents of the working schedule, that are assigned to the pattern
+// directly or by their team.
+func (r *RosterPattern) SearchRosterPatternAgents(ctx context.Context, user *model.SignedInUser, id int64, workingScheduleId int64) ([]*model.LookupItem, error) {
+	var (
+		agent     = b.AgentTable
+		agentUser = b.UserTable.WithAlias("au")
+		pattern   = b.RosterPatternTable
+		name      = b.Coalesce(agentUser.Ident("name"), agentUser.Ident("username"))
+		base      = b.Select(agent.Ident("id"), b.Alias(name, "name")).From(workingScheduleAgentTable + " wsa")
+	)
+
+	base.Join(agent.String(), b.Equal(agent.Ident("id"), "wsa.agent_id").String()).
+		Join(agentUser.String(), b.Equal(agentUser.Ident("id"), agent.Ident("user_id")).String()).
+		Join(pattern.String(), b.Equal(pattern.Ident("domain_id"), "wsa.domain_id").String())
+
+	base.Where(
+		base.EQ("wsa.domain_id", user.DomainId),
+		base.EQ("wsa.working_schedule_id", workingScheduleId),
+		base.EQ(pattern.Ident("id"), id),
+		base.Or(
+			b.Equal(agent.Ident("team_id"), pattern.Ident("team_id")).String(),
+			base.Exists(b.Format("SELECT 1 FROM "+b.RosterPatternAgentTable.Name()+" rpa WHERE rpa.roster_pattern_id = "+pattern.Ident("id")+" AND rpa.agent_id = "+agent.Ident("id"))),
+		),
+	).OrderBy(name)
+
+	var items []*model.LookupItem
+	sql, args := base.Build()
+	if err := r.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// withRosterPatternAgents appends agents of the pattern, which is selected by "pattern" table expression, to the query.
+// Agents, that are already assigned, are skipped.
+func withRosterPatternAgents(cte *b.CTEQuery, user *model.SignedInUser, agents []*model.LookupItem) {
+	if len(agents) == 0 {
+		return
+	}
+
+	agentIds := make([]int64, 0, len(agents))
+	for _, a := range agents {
+		agentIds = append(agentIds, a.Id)
+	}
+
+	ib := b.Format("INSERT INTO "+b.RosterPatternAgentTable.Name()+" (domain_id, roster_pattern_id, agent_id) "+
+		"SELECT $?, pattern.id, unnest($?::bigint[]) FROM pattern ON CONFLICT (roster_pattern_id, agent_id) DO NOTHING RETURNING id",
+		user.DomainId, agentIds,
+	)
+
+	cte.With(b.With("agents").As(ib))
+}
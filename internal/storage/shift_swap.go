@@ -0,0 +1,534 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/huandu/go-sqlbuilder"
+
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
+	b "github.com/webitel/webitel-wfm/infra/storage/dbsql/builder"
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql/cluster"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+type ShiftSwapManager interface {
+	CreateShiftSwap(ctx context.Context, read *options.Read, in *model.ShiftSwap) (int64, error)
+	ReadShiftSwap(ctx context.Context, read *options.Read) (*model.ShiftSwap, error)
+	SearchShiftSwap(ctx context.Context, search *options.Search, filter *model.ShiftSwapSearch) ([]*model.ShiftSwap, error)
+	AcceptShiftSwap(ctx context.Context, read *options.Read, agentId int64, shiftId *int64) error
+	UpdateShiftSwapState(ctx context.Context, read *options.Read, from model.ShiftSwapState, in *model.ShiftSwap) error
+	SearchShiftSwapMissingSkills(ctx context.Context, read *options.Read, shiftId, agentId int64) ([]*model.LookupItem, error)
+}
+
+type ShiftSwap struct {
+	db cluster.Store
+}
+
+func NewShiftSwap(db cluster.Store) *ShiftSwap {
+	return &ShiftSwap{
+		db: db,
+	}
+}
+
+// CreateShiftSwap offers the shift of the agent, the shift should belong to an active working schedule.
+// Returns dbsql.ErrNoRows if the agent doesn't have such shift within an active working schedule.
+func (s *ShiftSwap) CreateShiftSwap(ctx context.Context, read *options.Read, in *model.ShiftSwap) (int64, error) {
+	var (
+		shift           = b.AgentWorkingScheduleTable
+		workingSchedule = b.WorkingScheduleTable
+		sb              = b.Select()
+	)
+
+	sb.Select("wsa.domain_id", sb.Var(read.User().Id), sb.Var(read.User().Id), "wsa.working_schedule_id", "wsa.agent_id",
+		shift.Ident("id"), sb.Var(in.TargetAgent.SafeId()), sb.Var(in.Note),
+	).From(shift.String())
+
+	sb.Join(workingScheduleAgentTable+" wsa", b.Equal("wsa.id", shift.Ident("working_schedule_agent_id")).String())
+	sb.Join(workingSchedule.String(), b.Equal(workingSchedule.Ident("id"), "wsa.working_schedule_id").String())
+	sb.Where(
+		sb.Equal(shift.Ident("domain_id"), read.User().DomainId),
+		sb.Equal(shift.Ident("id"), in.Shift.Id),
+		sb.Equal("wsa.agent_id", in.Agent.Id),
+		sb.Equal(workingSchedule.Ident("state"), int32(model.WorkingScheduleStateActive)),
+	)
+
+	ib := b.Format("INSERT INTO "+b.AgentShiftSwapTable.Name()+
+		" (domain_id, created_by, updated_by, working_schedule_id, agent_id, agent_working_schedule_id, target_agent_id, note) $? RETURNING id", sb,
+	)
+
+	var id int64
+	sql, args := ib.Build()
+	if err := s.db.Primary().Get(ctx, &id, sql, args...); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (s *ShiftSwap) ReadShiftSwap(ctx context.Context, read *options.Read) (*model.ShiftSwap, error) {
+	search, err := options.NewSearch(ctx, options.WithID(read.ID()))
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.SearchShiftSwap(ctx, search.PopulateFromRead(read), &model.ShiftSwapSearch{})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) > 1 {
+		return nil, werror.Wrap(dbsql.ErrEntityConflict, werror.WithID("storage.shift_swap.read.conflict"))
+	}
+
+	if len(items) == 0 {
+		return nil, werror.Wrap(dbsql.ErrNoRows, werror.WithID("storage.shift_swap.read"))
+	}
+
+	return items[0], nil
+}
+
+func (s *ShiftSwap) SearchShiftSwap(ctx context.Context, search *options.Search, filter *model.ShiftSwapSearch) ([]*model.ShiftSwap, error) {
+	const (
+		linkCreatedBy = 1 << iota
+		linkUpdatedBy
+		linkDecidedBy
+		linkWorkingSchedule
+		linkAgent
+		linkTargetAgent
+		linkCounterAgent
+		linkShift
+		linkCounterShift
+	)
+
+	var (
+		swap             = b.AgentShiftSwapTable
+		createdBy        = b.UserTable.WithAlias("crt")
+		updatedBy        = b.UserTable.WithAlias("upd")
+		decidedBy        = b.UserTable.WithAlias("dcd")
+		agent            = b.AgentTable
+		agentUser        = b.UserTable.WithAlias("au")
+		targetAgent      = b.AgentTable.WithAlias("tca")
+		targetAgentUser  = b.UserTable.WithAlias("tau")
+		counterAgent     = b.AgentTable.WithAlias("cca")
+		counterAgentUser = b.UserTable.WithAlias("cau")
+		shift            = b.AgentWorkingScheduleTable
+		counterShift     = b.AgentWorkingScheduleTable.WithAlias("caws")
+		workingSchedule  = b.WorkingScheduleTable
+		base             = b.Select().From(swap.String())
+
+		join          = 0
+		joinCreatedBy = func() {
+			if join&linkCreatedBy != 0 {
+				return
+			}
+
+			join |= linkCreatedBy
+			base.JoinWithOption(
+				b.LeftJoin(createdBy,
+					b.Equal(swap.Ident("created_by"), createdBy.Ident("id")),
+				),
+			)
+		}
+
+		joinUpdatedBy = func() {
+			if join&linkUpdatedBy != 0 {
+				return
+			}
+
+			join |= linkUpdatedBy
+			base.JoinWithOption(
+				b.LeftJoin(updatedBy,
+					b.Equal(swap.Ident("updated_by"), updatedBy.Ident("id")),
+				),
+			)
+		}
+
+		joinDecidedBy = func() {
+			if join&linkDecidedBy != 0 {
+				return
+			}
+
+			join |= linkDecidedBy
+			base.JoinWithOption(
+				b.LeftJoin(decidedBy,
+					b.Equal(swap.Ident("decided_by"), decidedBy.Ident("id")),
+				),
+			)
+		}
+
+		joinWorkingSchedule = func() {
+			if join&linkWorkingSchedule != 0 {
+				return
+			}
+
+			join |= linkWorkingSchedule
+			base.JoinWithOption(
+				b.LeftJoin(workingSchedule,
+					b.Equal(swap.Ident("working_schedule_id"), workingSchedule.Ident("id")),
+				),
+			)
+		}
+
+		joinAgent = func() {
+			if join&linkAgent != 0 {
+				return
+			}
+
+			join |= linkAgent
+			base.JoinWithOption(
+				b.LeftJoin(agent,
+					b.Equal(swap.Ident("agent_id"), agent.Ident("id")),
+				),
+			)
+
+			base.JoinWithOption(
+				b.LeftJoin(agentUser,
+					b.Equal(agent.Ident("user_id"), agentUser.Ident("id")),
+				),
+			)
+		}
+
+		joinTargetAgent = func() {
+			if join&linkTargetAgent != 0 {
+				return
+			}
+
+			join |= linkTargetAgent
+			base.JoinWithOption(
+				b.LeftJoin(targetAgent,
+					b.Equal(swap.Ident("target_agent_id"), targetAgent.Ident("id")),
+				),
+			)
+
+			base.JoinWithOption(
+				b.LeftJoin(targetAgentUser,
+					b.Equal(targetAgent.Ident("user_id"), targetAgentUser.Ident("id")),
+				),
+			)
+		}
+
+		joinCounterAgent = func() {
+			if join&linkCounterAgent != 0 {
+				return
+			}
+
+			join |= linkCounterAgent
+			base.JoinWithOption(
+				b.LeftJoin(counterAgent,
+					b.Equal(swap.Ident("counter_agent_id"), counterAgent.Ident("id")),
+				),
+			)
+
+			base.JoinWithOption(
+				b.LeftJoin(counterAgentUser,
+					b.Equal(counterAgent.Ident("user_id"), counterAgentUser.Ident("id")),
+				),
+			)
+		}
+
+		joinShift = func() {
+			if join&linkShift != 0 {
+				return
+			}
+
+			join |= linkShift
+			base.JoinWithOption(
+				b.LeftJoin(shift,
+					b.Equal(swap.Ident("agent_working_schedule_id"), shift.Ident("id")),
+				),
+			)
+		}
+
+		joinCounterShift = func() {
+			if join&linkCounterShift != 0 {
+				return
+			}
+
+			join |= linkCounterShift
+			base.JoinWithOption(
+				b.LeftJoin(counterShift,
+					b.Equal(swap.Ident("counter_agent_working_schedule_id"), counterShift.Ident("id")),
+				),
+			)
+		}
+	)
+
+	// Fields to retrieve.
+	{
+		fields := []string{
+			"id", "created_at", "created_by", "updated_at", "updated_by",
+			"working_schedule", "agent", "shift", "target_agent", "counter_agent", "counter_shift",
+			"state", "note", "comment", "accepted_at", "decided_at", "decided_by",
+		}
+
+		for _, field := range fields {
+			search.WithField(field)
+		}
+
+		for _, field := range search.Fields() {
+			switch field {
+			case "id", "domain_id", "created_at", "updated_at", "state", "note", "comment", "accepted_at", "decided_at":
+				field = swap.Ident(field)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(createdBy)), field)
+
+			case "updated_by":
+				joinUpdatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(updatedBy)), field)
+
+			case "decided_by":
+				joinDecidedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(decidedBy)), field)
+
+			case "working_schedule":
+				joinWorkingSchedule()
+				field = b.Alias(b.JSONBuildObject(b.Lookup(workingSchedule, "id", "name")), field)
+
+			case "agent":
+				joinAgent()
+				field = b.Alias(b.JSONBuildObject(b.JSONBuildObjectFields{
+					"id":   agent.Ident("id"),
+					"name": b.Coalesce(agentUser.Ident("name"), agentUser.Ident("username")),
+				}), field)
+
+			// Optional agents and shifts are NULL instead of an empty object.
+			case "target_agent":
+				joinTargetAgent()
+				field = b.Alias("call_center.cc_get_lookup("+targetAgent.Ident("id")+", "+b.Coalesce(targetAgentUser.Ident("name"), targetAgentUser.Ident("username"))+")", field)
+
+			case "counter_agent":
+				joinCounterAgent()
+				field = b.Alias("call_center.cc_get_lookup("+counterAgent.Ident("id")+", "+b.Coalesce(counterAgentUser.Ident("name"), counterAgentUser.Ident("username"))+")", field)
+
+			case "shift":
+				joinShift()
+				field = b.Alias(swapShiftObject(shift), field)
+
+			case "counter_shift":
+				joinCounterShift()
+				field = b.Alias("CASE WHEN "+counterShift.Ident("id")+" NOTNULL THEN "+swapShiftObject(counterShift)+" END", field)
+			}
+
+			base.SelectMore(field)
+		}
+	}
+
+	// Add WHERE clauses.
+	{
+		base.Where(base.EQ(swap.Ident("domain_id"), search.User().DomainId))
+		if search.Query() != "" {
+			joinAgent()
+			base.Where(base.ILike(agentUser.Ident("name"), search.Query()))
+		}
+
+		if ids := search.IDs(); len(ids) > 0 {
+			base.Where(base.In(swap.Ident("id"), b.ConvertArgs(ids)...))
+		}
+
+		if len(filter.WorkingScheduleIds) > 0 {
+			base.Where(base.In(swap.Ident("working_schedule_id"), b.ConvertArgs(filter.WorkingScheduleIds)...))
+		}
+
+		if len(filter.AgentIds) > 0 {
+			agentIds := b.ConvertArgs(filter.AgentIds)
+			base.Where(base.Or(base.In(swap.Ident("agent_id"), agentIds...), base.In(swap.Ident("counter_agent_id"), agentIds...)))
+		}
+
+		if len(filter.States) > 0 {
+			states := make([]any, 0, len(filter.States))
+			for _, state := range filter.States {
+				states = append(states, int32(state))
+			}
+
+			base.Where(base.In(swap.Ident("state"), states...))
+		}
+	}
+
+	// Construct ORDER BY fields.
+	{
+		orderBy := search.OrderBy()
+		if len(orderBy) == 0 {
+			orderBy.WithOrderBy("created_at", b.OrderDirectionDESC)
+		}
+
+		for field, direction := range orderBy {
+			switch field {
+			case "id", "created_at", "updated_at", "state", "accepted_at", "decided_at":
+				field = b.OrderBy(swap.Ident(field), direction)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.OrderBy(createdBy.Ident("name"), direction)
+
+			case "updated_by":
+				joinUpdatedBy()
+				field = b.OrderBy(updatedBy.Ident("name"), direction)
+
+			case "decided_by":
+				joinDecidedBy()
+				field = b.OrderBy(decidedBy.Ident("name"), direction)
+
+			case "working_schedule":
+				joinWorkingSchedule()
+				field = b.OrderBy(workingSchedule.Ident("name"), direction)
+
+			case "agent":
+				joinAgent()
+				field = b.OrderBy(agentUser.Ident("name"), direction)
+
+			case "shift":
+				joinShift()
+				field = b.OrderBy(shift.Ident("schedule_at"), direction)
+			}
+
+			base.OrderBy(field)
+		}
+	}
+
+	var items []*model.ShiftSwap
+	sql, args := base.Limit(search.Size()).Offset(search.Offset()).Build()
+	if err := s.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// AcceptShiftSwap accepts the open swap on behalf of the agent, who should belong to the working schedule of the swap.
+// If shiftId is set, the shift of the agent within the same working schedule is proposed in exchange.
+// Returns dbsql.ErrNoRows if the swap isn't open anymore, is addressed to another agent,
+// or the agent doesn't have the desired shift within the working schedule.
+func (s *ShiftSwap) AcceptShiftSwap(ctx context.Context, read *options.Read, agentId int64, shiftId *int64) error {
+	columns := map[string]any{
+		"updated_by":                        read.User().Id,
+		"state":                             int32(model.ShiftSwapStateAccepted),
+		"accepted_at":                       b.Format("now()"),
+		"counter_agent_id":                  agentId,
+		"counter_agent_working_schedule_id": shiftId,
+	}
+
+	ub := b.Update(b.AgentShiftSwapTable.Name(), columns)
+	ub.Where(
+		ub.Equal("domain_id", read.User().DomainId),
+		ub.Equal("id", read.ID()),
+		ub.Equal("state", int32(model.ShiftSwapStateOpen)),
+		ub.NotEqual("agent_id", agentId),
+		ub.Or(ub.IsNull("target_agent_id"), ub.Equal("target_agent_id", agentId)),
+		ub.Exists(b.Format("SELECT 1 FROM "+workingScheduleAgentTable+" wsa WHERE wsa.working_schedule_id = agent_shift_swap.working_schedule_id AND wsa.agent_id = $?", agentId)),
+	)
+
+	if shiftId != nil {
+		ub.Where(ub.Exists(b.Format("SELECT 1 FROM "+agentWorkingScheduleTable+" aws INNER JOIN "+workingScheduleAgentTable+" wsa ON wsa.id = aws.working_schedule_agent_id "+
+			"WHERE aws.id = $? AND wsa.working_schedule_id = agent_shift_swap.working_schedule_id AND wsa.agent_id = $?", *shiftId, agentId,
+		)))
+	}
+
+	return s.transition(ctx, read, ub.SQL("RETURNING id"), model.ShiftSwapStateOpen, model.ShiftSwapStateAccepted)
+}
+
+// UpdateShiftSwapState moves the swap from the desired state to in.State.
+// If decision maker is set, the decision time and comment are stored as well.
+// Approved swap reassigns the offered shift to the counter agent and the counter shift, if any,
+// to the offering agent in the same query. The swap isn't approved if any of the shifts
+// doesn't belong to its agent anymore.
+// Returns dbsql.ErrNoRows if swap is not in the desired state.
+func (s *ShiftSwap) UpdateShiftSwapState(ctx context.Context, read *options.Read, from model.ShiftSwapState, in *model.ShiftSwap) error {
+	columns := map[string]any{
+		"updated_by": read.User().Id,
+		"state":      int32(in.State),
+	}
+
+	if in.DecidedBy != nil {
+		columns["decided_at"] = b.Format("now()")
+		columns["decided_by"] = in.DecidedBy.Id
+		columns["comment"] = in.Comment
+	}
+
+	ub := b.Update(b.AgentShiftSwapTable.Name(), columns)
+	ub.Where(
+		ub.Equal("domain_id", read.User().DomainId),
+		ub.Equal("id", read.ID()),
+		ub.Equal("state", int32(from)),
+	)
+
+	if in.State == model.ShiftSwapStateApproved {
+		ub.Where(
+			ub.Exists(b.Format(swapShiftOwner("agent_shift_swap.agent_working_schedule_id", "agent_shift_swap.agent_id"))),
+			ub.Or(ub.IsNull("counter_agent_working_schedule_id"),
+				ub.Exists(b.Format(swapShiftOwner("agent_shift_swap.counter_agent_working_schedule_id", "agent_shift_swap.counter_agent_id"))),
+			),
+		)
+	}
+
+	return s.transition(ctx, read, ub.SQL("RETURNING *"), from, in.State)
+}
+
+// transition runs the swap update within "swap" table expression and records the transition into the state history.
+// Approved swap reassigns its shifts, see UpdateShiftSwapState.
+func (s *ShiftSwap) transition(ctx context.Context, read *options.Read, swap sqlbuilder.Builder, from, to model.ShiftSwapState) error {
+	history := b.Format("INSERT INTO "+b.AgentShiftSwapStateHistoryTable.Name()+" (domain_id, created_by, agent_shift_swap_id, from_state, to_state) SELECT $?, $?, id, $?, $? FROM swap RETURNING id",
+		read.User().DomainId, read.User().Id, int32(from), int32(to),
+	)
+
+	cte := b.CTE(b.With("swap").As(swap), b.With("history").As(history))
+	if to == model.ShiftSwapStateApproved {
+		cte.With(b.With("given").As(reassignShift(read, "agent_working_schedule_id", "counter_agent_id")))
+		cte.With(b.With("taken").As(reassignShift(read, "counter_agent_working_schedule_id", "agent_id")))
+	}
+
+	var id int64
+	sql, args := b.Select("swap.id").With(cte.Builder()).From("swap").Build()
+	if err := s.db.Primary().Get(ctx, &id, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SearchShiftSwapMissingSkills returns enabled skills of the shift, that the agent doesn't have enabled.
+func (s *ShiftSwap) SearchShiftSwapMissingSkills(ctx context.Context, read *options.Read, shiftId, agentId int64) ([]*model.LookupItem, error) {
+	sb := b.Select("sk.id", "sk.name").From(agentWorkingScheduleSkillTable + " s")
+	sb.Join("call_center.cc_skill sk", "sk.id = s.skill_id")
+	sb.Where(
+		sb.Equal("s.domain_id", read.User().DomainId),
+		sb.Equal("s.agent_working_schedule_id", shiftId),
+		"s.enabled",
+		sb.NotExists(b.Format("SELECT 1 FROM call_center.cc_skill_in_agent sa WHERE sa.agent_id = $? AND sa.skill_id = s.skill_id AND sa.enabled", agentId)),
+	).OrderBy("sk.name")
+
+	var items []*model.LookupItem
+	sql, args := sb.Build()
+	if err := s.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// swapShiftObject builds the json object of model.ShiftSwapShift.
+func swapShiftObject(shift b.Table) string {
+	return b.JSONBuildObject(b.JSONBuildObjectFields{
+		"id":    shift.Ident("id"),
+		"date":  shift.Ident("schedule_at"),
+		"start": shift.Ident("start_min"),
+		"end":   shift.Ident("end_min"),
+	})
+}
+
+// swapShiftOwner checks, that the shift belongs to the agent within the working schedule of the swap.
+func swapShiftOwner(shiftId, agentId string) string {
+	return "SELECT 1 FROM " + agentWorkingScheduleTable + " aws INNER JOIN " + workingScheduleAgentTable + " wsa ON wsa.id = aws.working_schedule_agent_id " +
+		"WHERE aws.id = " + shiftId + " AND wsa.working_schedule_id = agent_shift_swap.working_schedule_id AND wsa.agent_id = " + agentId
+}
+
+// reassignShift moves the shift of the approved swap to the agent of the same working schedule.
+func reassignShift(read *options.Read, shiftId, agentId string) sqlbuilder.Builder {
+	return b.Format("UPDATE "+agentWorkingScheduleTable+" aws SET working_schedule_agent_id = wsa.id, updated_by = $? "+
+		"FROM swap, "+workingScheduleAgentTable+" wsa WHERE aws.id = swap."+shiftId+" AND wsa.working_schedule_id = swap.working_schedule_id "+
+		"AND wsa.agent_id = swap."+agentId+" RETURNING aws.id", read.User().Id,
+	)
+}
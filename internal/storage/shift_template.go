@@ -39,6 +39,7 @@ func (s *ShiftTemplate) CreateShiftTemplate(ctx context.Context, user *model.Sig
 			"name":        in.Name,
 			"description": in.Description,
 			"times":       in.Times,
+			"rotation":    in.Rotation,
 		},
 	}
 
@@ -116,7 +117,7 @@ func (s *ShiftTemplate) SearchShiftTemplate(ctx context.Context, search *options
 	{
 		fields := []string{
 			"id", "created_at", "created_by", "updated_at", "updated_by",
-			"name", "description", "times",
+			"name", "description", "times", "rotation",
 		}
 
 		for _, field := range fields {
@@ -125,7 +126,7 @@ func (s *ShiftTemplate) SearchShiftTemplate(ctx context.Context, search *options
 
 		for _, field := range search.Fields() {
 			switch field {
-			case "id", "domain_id", "created_at", "updated_at", "name", "description", "times":
+			case "id", "domain_id", "created_at", "updated_at", "name", "description", "times", "rotation":
 				field = shiftTemplate.Ident(field)
 
 			case "created_by":
@@ -193,6 +194,7 @@ func (s *ShiftTemplate) UpdateShiftTemplate(ctx context.Context, user *model.Sig
 		"name":        in.Name,
 		"description": in.Description,
 		"times":       in.Times,
+		"rotation":    in.Rotation,
 	}
 
 	ub := b.Update(b.ShiftTemplateTable.Name(), columns)
@@ -0,0 +1,327 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
+	b "github.com/webitel/webitel-wfm/infra/storage/dbsql/builder"
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql/cluster"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+type TimeOffRequestManager interface {
+	CreateTimeOffRequest(ctx context.Context, read *options.Read, in *model.TimeOffRequest) (int64, error)
+	ReadTimeOffRequest(ctx context.Context, read *options.Read) (*model.TimeOffRequest, error)
+	SearchTimeOffRequest(ctx context.Context, search *options.Search, filter *model.TimeOffRequestSearch) ([]*model.TimeOffRequest, error)
+	UpdateTimeOffRequestState(ctx context.Context, read *options.Read, from model.TimeOffRequestState, in *model.TimeOffRequest) error
+}
+
+type TimeOffRequest struct {
+	db cluster.Store
+}
+
+func NewTimeOffRequest(db cluster.Store) *TimeOffRequest {
+	return &TimeOffRequest{
+		db: db,
+	}
+}
+
+func (t *TimeOffRequest) CreateTimeOffRequest(ctx context.Context, read *options.Read, in *model.TimeOffRequest) (int64, error) {
+	columns := []map[string]any{
+		{
+			"domain_id":       read.User().DomainId,
+			"created_by":      read.User().Id,
+			"updated_by":      read.User().Id,
+			"agent_id":        in.Agent.Id,
+			"absence_type_id": in.AbsenceType.Id,
+			"date_from":       in.DateFrom,
+			"date_to":         in.DateTo,
+			"state":           int32(model.TimeOffRequestStatePending),
+			"note":            in.Note,
+		},
+	}
+
+	var id int64
+	sql, args := b.Insert(b.AgentTimeOffRequestTable.Name(), columns).SQL("RETURNING id").Build()
+	if err := t.db.Primary().Get(ctx, &id, sql, args...); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (t *TimeOffRequest) ReadTimeOffRequest(ctx context.Context, read *options.Read) (*model.TimeOffRequest, error) {
+	search, err := options.NewSearch(ctx, options.WithID(read.ID()))
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := t.SearchTimeOffRequest(ctx, search.PopulateFromRead(read), &model.TimeOffRequestSearch{})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) > 1 {
+		return nil, werror.Wrap(dbsql.ErrEntityConflict, werror.WithID("storage.time_off_request.read.conflict"))
+	}
+
+	if len(items) == 0 {
+		return nil, werror.Wrap(dbsql.ErrNoRows, werror.WithID("storage.time_off_request.read"))
+	}
+
+	return items[0], nil
+}
+
+func (t *TimeOffRequest) SearchTimeOffRequest(ctx context.Context, search *options.Search, filter *model.TimeOffRequestSearch) ([]*model.TimeOffRequest, error) {
+	const (
+		linkCreatedBy = 1 << iota
+		linkUpdatedBy
+		linkDecidedBy
+		linkAgent
+		linkAbsenceType
+	)
+
+	var (
+		request     = b.AgentTimeOffRequestTable
+		createdBy   = b.UserTable.WithAlias("crt")
+		updatedBy   = b.UserTable.WithAlias("upd")
+		decidedBy   = b.UserTable.WithAlias("dcd")
+		agent       = b.AgentTable
+		agentUser   = b.UserTable.WithAlias("au")
+		absenceType = b.AbsenceTypeTable
+		base        = b.Select().From(request.String())
+
+		join          = 0
+		joinCreatedBy = func() {
+			if join&linkCreatedBy != 0 {
+				return
+			}
+
+			join |= linkCreatedBy
+			base.JoinWithOption(
+				b.LeftJoin(createdBy,
+					b.Equal(request.Ident("created_by"), createdBy.Ident("id")),
+				),
+			)
+		}
+
+		joinUpdatedBy = func() {
+			if join&linkUpdatedBy != 0 {
+				return
+			}
+
+			join |= linkUpdatedBy
+			base.JoinWithOption(
+				b.LeftJoin(updatedBy,
+					b.Equal(request.Ident("updated_by"), updatedBy.Ident("id")),
+				),
+			)
+		}
+
+		joinDecidedBy = func() {
+			if join&linkDecidedBy != 0 {
+				return
+			}
+
+			join |= linkDecidedBy
+			base.JoinWithOption(
+				b.LeftJoin(decidedBy,
+					b.Equal(request.Ident("decided_by"), decidedBy.Ident("id")),
+				),
+			)
+		}
+
+		joinAgent = func() {
+			if join&linkAgent != 0 {
+				return
+			}
+
+			join |= linkAgent
+			base.JoinWithOption(
+				b.LeftJoin(agent,
+					b.Equal(request.Ident("agent_id"), agent.Ident("id")),
+				),
+			)
+
+			base.JoinWithOption(
+				b.LeftJoin(agentUser,
+					b.Equal(agent.Ident("user_id"), agentUser.Ident("id")),
+				),
+			)
+		}
+
+		joinAbsenceType = func() {
+			if join&linkAbsenceType != 0 {
+				return
+			}
+
+			join |= linkAbsenceType
+			base.JoinWithOption(
+				b.LeftJoin(absenceType,
+					b.Equal(request.Ident("absence_type_id"), absenceType.Ident("id")),
+				),
+			)
+		}
+	)
+
+	// Fields to retrieve.
+	{
+		fields := []string{
+			"id", "created_at", "created_by", "updated_at", "updated_by",
+			"agent", "absence_type", "date_from", "date_to", "state", "note", "comment", "decided_at", "decided_by",
+		}
+
+		for _, field := range fields {
+			search.WithField(field)
+		}
+
+		for _, field := range search.Fields() {
+			switch field {
+			case "id", "domain_id", "created_at", "updated_at", "date_from", "date_to",
+				"state", "note", "comment", "decided_at":
+				field = request.Ident(field)
+
+			case "absence_type":
+				joinAbsenceType()
+				field = b.Alias(b.JSONBuildObject(b.Lookup(absenceType, "id", "name")), field)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(createdBy)), field)
+
+			case "updated_by":
+				joinUpdatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(updatedBy)), field)
+
+			case "decided_by":
+				joinDecidedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(decidedBy)), field)
+
+			case "agent":
+				joinAgent()
+				field = b.Alias(b.JSONBuildObject(b.JSONBuildObjectFields{
+					"id":   agent.Ident("id"),
+					"name": b.Coalesce(agentUser.Ident("name"), agentUser.Ident("username")),
+				}), field)
+			}
+
+			base.SelectMore(field)
+		}
+	}
+
+	// Add WHERE clauses.
+	{
+		base.Where(base.EQ(request.Ident("domain_id"), search.User().DomainId))
+		if search.Query() != "" {
+			joinAgent()
+			base.Where(base.ILike(agentUser.Ident("name"), search.Query()))
+		}
+
+		if ids := search.IDs(); len(ids) > 0 {
+			base.Where(base.In(request.Ident("id"), b.ConvertArgs(ids)...))
+		}
+
+		if len(filter.AgentIds) > 0 {
+			base.Where(base.In(request.Ident("agent_id"), b.ConvertArgs(filter.AgentIds)...))
+		}
+
+		if len(filter.States) > 0 {
+			states := make([]any, 0, len(filter.States))
+			for _, state := range filter.States {
+				states = append(states, int32(state))
+			}
+
+			base.Where(base.In(request.Ident("state"), states...))
+		}
+	}
+
+	// Construct ORDER BY fields.
+	{
+		orderBy := search.OrderBy()
+		if len(orderBy) == 0 {
+			orderBy.WithOrderBy("created_at", b.OrderDirectionDESC)
+		}
+
+		for field, direction := range orderBy {
+			switch field {
+			case "id", "created_at", "updated_at", "date_from", "date_to", "state", "decided_at":
+				field = b.OrderBy(request.Ident(field), direction)
+
+			case "absence_type":
+				joinAbsenceType()
+				field = b.OrderBy(absenceType.Ident("name"), direction)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.OrderBy(createdBy.Ident("name"), direction)
+
+			case "updated_by":
+				joinUpdatedBy()
+				field = b.OrderBy(updatedBy.Ident("name"), direction)
+
+			case "decided_by":
+				joinDecidedBy()
+				field = b.OrderBy(decidedBy.Ident("name"), direction)
+
+			case "agent":
+				joinAgent()
+				field = b.OrderBy(agentUser.Ident("name"), direction)
+			}
+
+			base.OrderBy(field)
+		}
+	}
+
+	var items []*model.TimeOffRequest
+	sql, args := base.Limit(search.Size()).Offset(search.Offset()).Build()
+	if err := t.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// UpdateTimeOffRequestState moves the request from the desired state to in.State.
+// If decision maker is set, the decision time and comment are stored as well.
+// Approved request creates agent absences for each day of its period in the same query,
+// days that already have the same absence are skipped.
+// Returns dbsql.ErrNoRows if request is not in the desired state.
+func (t *TimeOffRequest) UpdateTimeOffRequestState(ctx context.Context, read *options.Read, from model.TimeOffRequestState, in *model.TimeOffRequest) error {
+	columns := map[string]any{
+		"updated_by": read.User().Id,
+		"state":      int32(in.State),
+	}
+
+	if in.DecidedBy != nil {
+		columns["decided_at"] = b.Format("now()")
+		columns["decided_by"] = in.DecidedBy.Id
+		columns["comment"] = in.Comment
+	}
+
+	ub := b.Update(b.AgentTimeOffRequestTable.Name(), columns)
+	ub.Where(
+		ub.Equal("domain_id", read.User().DomainId),
+		ub.Equal("id", read.ID()),
+		ub.Equal("state", int32(from)),
+	).SQL("RETURNING *")
+
+	cte := b.CTE(b.With("request").As(ub))
+	if in.State == model.TimeOffRequestStateApproved {
+		absences := b.Format("INSERT INTO "+b.AgentAbsenceTable.Name()+" (domain_id, created_by, updated_by, absent_at, agent_id, absence_type_id) "+
+			"SELECT r.domain_id, $?, $?, d::date, r.agent_id, r.absence_type_id FROM request r, generate_series(r.date_from, r.date_to, '1 day'::interval) d "+
+			"ON CONFLICT DO NOTHING RETURNING id", read.User().Id, read.User().Id,
+		)
+
+		cte.With(b.With("absences").As(absences))
+	}
+
+	var id int64
+	sql, args := b.Select("request.id").With(cte.Builder()).From("request").Build()
+	if err := t.db.Primary().Get(ctx, &id, sql, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -17,10 +17,12 @@ const (
 	workingScheduleView            = workingScheduleTable + "_v"
 	workingScheduleExtraSkillTable = workingScheduleTable + "_extra_skill"
 	workingScheduleAgentTable      = workingScheduleTable + "_agent"
+	workingScheduleStateHistory    = workingScheduleTable + "_state_history"
 )
 
 type WorkingScheduleManager interface {
 	CreateWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.WorkingSchedule) (*model.WorkingSchedule, error)
+	CloneWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.WorkingSchedule, shifts []*model.AgentWorkingSchedule) (*model.WorkingSchedule, error)
 	ReadWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.SearchItem) (*model.WorkingSchedule, error)
 	SearchWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.SearchItem) ([]*model.WorkingSchedule, error)
 	UpdateWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.WorkingSchedule) (*model.WorkingSchedule, error)
@@ -28,6 +30,9 @@ type WorkingScheduleManager interface {
 
 	UpdateWorkingScheduleAddAgents(ctx context.Context, user *model.SignedInUser, id int64, agentIds []int64) ([]*model.LookupItem, error)
 	UpdateWorkingScheduleRemoveAgent(ctx context.Context, user *model.SignedInUser, id int64, agentId int64) (int64, error)
+
+	UpdateWorkingScheduleState(ctx context.Context, user *model.SignedInUser, id int64, from, to model.WorkingScheduleState) (*model.WorkingSchedule, error)
+	SearchWorkingScheduleAgentsOverlap(ctx context.Context, user *model.SignedInUser, id int64, state model.WorkingScheduleState) ([]int64, error)
 }
 
 type WorkingSchedule struct {
@@ -45,6 +50,55 @@ func NewWorkingSchedule(db cluster.Store, manager cache.Manager) *WorkingSchedul
 }
 
 func (w *WorkingSchedule) CreateWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.WorkingSchedule) (*model.WorkingSchedule, error) {
+	sql, args := createWorkingScheduleQuery(user, in)
+	var id int64
+	if err := w.db.Primary().Get(ctx, &id, sql, args...); err != nil {
+		return nil, err
+	}
+
+	out, err := w.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	w.cache.Key(user.DomainId, id).Set(ctx, *out)
+
+	return out, nil
+}
+
+// CloneWorkingSchedule creates the working schedule together with shifts of its agents.
+// Queries are sent in a single batch, that runs in an implicit transaction,
+// so the working schedule isn't left behind without its shifts if any of them fails.
+func (w *WorkingSchedule) CloneWorkingSchedule(ctx context.Context, user *model.SignedInUser, in *model.WorkingSchedule, shifts []*model.AgentWorkingSchedule) (*model.WorkingSchedule, error) {
+	// Shifts refer to the working schedule, so its id is reserved in advance.
+	var id int64
+	if err := w.db.Primary().Get(ctx, &id, "SELECT nextval(pg_get_serial_sequence($1, 'id'))", workingScheduleTable); err != nil {
+		return nil, err
+	}
+
+	in.Id = id
+	batch := w.db.Primary().Batch()
+	batch.Queue(createWorkingScheduleQuery(user, in))
+	queueAgentsWorkingScheduleShifts(batch, user, id, shifts, false)
+
+	var ids []int64
+	if err := batch.Select(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	out, err := w.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	w.cache.Key(user.DomainId, id).Set(ctx, *out)
+
+	return out, nil
+}
+
+// createWorkingScheduleQuery returns a query, that inserts the working schedule with its extra skills and agents
+// and returns its id. Id of the working schedule is generated, unless it is set.
+func createWorkingScheduleQuery(user *model.SignedInUser, in *model.WorkingSchedule) (string, []any) {
 	cteq := builder.CTE()
 	schedule := []map[string]any{
 		{
@@ -63,6 +117,10 @@ func (w *WorkingSchedule) CreateWorkingSchedule(ctx context.Context, user *model
 		},
 	}
 
+	if in.Id != 0 {
+		schedule[0]["id"] = in.Id
+	}
+
 	cteq.With(builder.With("schedule").As(builder.Insert(workingScheduleTable, schedule).SQL("RETURNING id")))
 	if c := len(in.ExtraSkills); c > 0 {
 		skills := make([]map[string]any, 0, len(in.ExtraSkills))
@@ -95,20 +153,8 @@ func (w *WorkingSchedule) CreateWorkingSchedule(ctx context.Context, user *model
 	}
 
 	cte := cteq.Builder()
-	sql, args := builder.Select("schedule.id").Distinct().With(cte).From(cte.TableNames()...).Build()
-	var id int64
-	if err := w.db.Primary().Get(ctx, &id, sql, args...); err != nil {
-		return nil, err
-	}
-
-	out, err := w.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: id})
-	if err != nil {
-		return nil, err
-	}
 
-	w.cache.Key(user.DomainId, id).Set(ctx, *out)
-
-	return out, nil
+	return builder.Select("schedule.id").Distinct().With(cte).From(cte.TableNames()...).Build()
 }
 
 func (w *WorkingSchedule) ReadWorkingSchedule(ctx context.Context, user *model.SignedInUser, search *model.SearchItem) (*model.WorkingSchedule, error) {
@@ -176,7 +222,9 @@ func (w *WorkingSchedule) UpdateWorkingSchedule(ctx context.Context, user *model
 		"block_outside_activity": in.BlockOutsideActivity,
 	}
 
-	cteq.With(builder.With("schedule").As(builder.Update(workingScheduleTable, schedule).SQL("RETURNING id")))
+	ub := builder.Update(workingScheduleTable, schedule)
+	ub.Where(ub.Equal("domain_id", user.DomainId), ub.Equal("id", in.Id)).SQL("RETURNING id")
+	cteq.With(builder.With("schedule").As(ub))
 
 	del := builder.Delete(workingScheduleExtraSkillTable)
 	del.Where(del.Equal("domain_id", user.DomainId), del.Equal("working_schedule_id", in.Id)).SQL("RETURNING id")
@@ -261,3 +309,65 @@ func (w *WorkingSchedule) UpdateWorkingScheduleRemoveAgent(ctx context.Context,
 
 	return agentId, nil
 }
+
+// UpdateWorkingScheduleState moves working schedule from one state to another
+// and records the transition into the state history.
+// Activated working schedule gets a snapshot of its shifts as the first published version.
+// Returns dbsql.ErrNoRows if the schedule isn't in the from state anymore.
+func (w *WorkingSchedule) UpdateWorkingScheduleState(ctx context.Context, user *model.SignedInUser, id int64, from, to model.WorkingScheduleState) (*model.WorkingSchedule, error) {
+	schedule := map[string]any{
+		"updated_by":       user.Id,
+		"state":            int32(to),
+		"state_changed_at": builder.Format("now()"),
+		"state_changed_by": user.Id,
+	}
+
+	ub := builder.Update(workingScheduleTable, schedule)
+	ub.Where(ub.Equal("domain_id", user.DomainId), ub.Equal("id", id), ub.Equal("state", int32(from))).SQL("RETURNING id")
+	history := builder.Format("INSERT INTO "+workingScheduleStateHistory+" (domain_id, created_by, working_schedule_id, from_state, to_state) SELECT $?, $?, id, $?, $? FROM schedule RETURNING id",
+		user.DomainId, user.Id, int32(from), int32(to),
+	)
+
+	cte := builder.CTE(builder.With("schedule").As(ub), builder.With("history").As(history))
+	if to == model.WorkingScheduleStateActive {
+		withSnapshot(cte, user, nil)
+	}
+
+	sql, args := builder.Select("schedule.id").With(cte.Builder()).From("schedule").Build()
+
+	var out int64
+	if err := w.db.Primary().Get(ctx, &out, sql, args...); err != nil {
+		return nil, err
+	}
+
+	w.cache.Key(user.DomainId, id).Delete(ctx)
+	w.cache.Key(user.DomainId, 0).Delete(ctx)
+
+	return w.ReadWorkingSchedule(ctx, user, &model.SearchItem{Id: id})
+}
+
+// SearchWorkingScheduleAgentsOverlap returns agents of the working schedule,
+// that are also assigned to another working schedule in a desired state
+// with an overlapping period.
+func (w *WorkingSchedule) SearchWorkingScheduleAgentsOverlap(ctx context.Context, user *model.SignedInUser, id int64, state model.WorkingScheduleState) ([]int64, error) {
+	sb := builder.Select("DISTINCT wsa.agent_id").
+		From(workingScheduleTable+" ws").
+		Join(workingScheduleAgentTable+" wsa", "wsa.working_schedule_id = ws.id").
+		Join(workingScheduleAgentTable+" owsa", "owsa.agent_id = wsa.agent_id", "owsa.working_schedule_id != ws.id").
+		Join(workingScheduleTable+" ows", "ows.id = owsa.working_schedule_id")
+
+	sql, args := sb.Where(
+		sb.Equal("ws.domain_id", user.DomainId),
+		sb.Equal("ws.id", id),
+		sb.Equal("ows.state", int32(state)),
+		"ows.start_date_at <= ws.end_date_at",
+		"ows.end_date_at >= ws.start_date_at",
+	).Build()
+
+	var items []int64
+	if err := w.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,231 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql"
+	b "github.com/webitel/webitel-wfm/infra/storage/dbsql/builder"
+	"github.com/webitel/webitel-wfm/infra/storage/dbsql/cluster"
+	"github.com/webitel/webitel-wfm/internal/model"
+	"github.com/webitel/webitel-wfm/internal/model/options"
+	"github.com/webitel/webitel-wfm/pkg/werror"
+)
+
+const workingScheduleSnapshotShiftTable = "wfm.working_schedule_snapshot_shift"
+
+type WorkingScheduleSnapshotManager interface {
+	CreateWorkingScheduleSnapshot(ctx context.Context, read *options.Read, note *string) (int64, error)
+	ReadWorkingScheduleSnapshot(ctx context.Context, read *options.Read) (*model.WorkingScheduleSnapshot, error)
+	SearchWorkingScheduleSnapshot(ctx context.Context, search *options.Search) ([]*model.WorkingScheduleSnapshot, error)
+	SearchWorkingScheduleSnapshotShifts(ctx context.Context, read *options.Read) ([]*model.AgentWorkingSchedule, error)
+}
+
+type WorkingScheduleSnapshot struct {
+	db cluster.Store
+}
+
+func NewWorkingScheduleSnapshot(db cluster.Store) *WorkingScheduleSnapshot {
+	return &WorkingScheduleSnapshot{
+		db: db,
+	}
+}
+
+// CreateWorkingScheduleSnapshot copies current shifts of the active working schedule into a new snapshot.
+// Returns dbsql.ErrNoRows if the working schedule isn't active.
+func (w *WorkingScheduleSnapshot) CreateWorkingScheduleSnapshot(ctx context.Context, read *options.Read, note *string) (int64, error) {
+	ws := b.WorkingScheduleTable
+	sb := b.Select(ws.Ident("id")).From(ws.String())
+	sb.Where(
+		sb.Equal(ws.Ident("domain_id"), read.User().DomainId),
+		sb.Equal(ws.Ident("id"), read.DerivedByName("working_schedule").ID()),
+		sb.Equal(ws.Ident("state"), int32(model.WorkingScheduleStateActive)),
+	)
+
+	cte := b.CTE(b.With("schedule").As(sb))
+	withSnapshot(cte, read.User(), note)
+
+	var id int64
+	sql, args := b.Select("snapshot.id").With(cte.Builder()).From("snapshot").Build()
+	if err := w.db.Primary().Get(ctx, &id, sql, args...); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (w *WorkingScheduleSnapshot) ReadWorkingScheduleSnapshot(ctx context.Context, read *options.Read) (*model.WorkingScheduleSnapshot, error) {
+	search, err := options.NewSearch(ctx, options.WithID(read.ID()))
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := w.SearchWorkingScheduleSnapshot(ctx, search.PopulateFromRead(read))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) > 1 {
+		return nil, werror.Wrap(dbsql.ErrEntityConflict, werror.WithID("storage.working_schedule_snapshot.read.conflict"))
+	}
+
+	if len(items) == 0 {
+		return nil, werror.Wrap(dbsql.ErrNoRows, werror.WithID("storage.working_schedule_snapshot.read"))
+	}
+
+	return items[0], nil
+}
+
+func (w *WorkingScheduleSnapshot) SearchWorkingScheduleSnapshot(ctx context.Context, search *options.Search) ([]*model.WorkingScheduleSnapshot, error) {
+	const (
+		linkCreatedBy = 1 << iota
+		linkWorkingSchedule
+	)
+
+	var (
+		snapshot        = b.WorkingScheduleSnapshotTable
+		createdBy       = b.UserTable.WithAlias("crt")
+		workingSchedule = b.WorkingScheduleTable
+		base            = b.Select().From(snapshot.String())
+
+		join          = 0
+		joinCreatedBy = func() {
+			if join&linkCreatedBy != 0 {
+				return
+			}
+
+			join |= linkCreatedBy
+			base.JoinWithOption(
+				b.LeftJoin(createdBy,
+					b.Equal(snapshot.Ident("created_by"), createdBy.Ident("id")),
+				),
+			)
+		}
+
+		joinWorkingSchedule = func() {
+			if join&linkWorkingSchedule != 0 {
+				return
+			}
+
+			join |= linkWorkingSchedule
+			base.JoinWithOption(
+				b.LeftJoin(workingSchedule,
+					b.Equal(snapshot.Ident("working_schedule_id"), workingSchedule.Ident("id")),
+				),
+			)
+		}
+	)
+
+	// Fields to retrieve.
+	{
+		fields := []string{"id", "created_at", "created_by", "working_schedule", "version", "note", "shifts"}
+		for _, field := range fields {
+			search.WithField(field)
+		}
+
+		for _, field := range search.Fields() {
+			switch field {
+			case "id", "domain_id", "created_at", "version", "note":
+				field = snapshot.Ident(field)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.Alias(b.JSONBuildObject(b.UserLookup(createdBy)), field)
+
+			case "working_schedule":
+				joinWorkingSchedule()
+				field = b.Alias(b.JSONBuildObject(b.Lookup(workingSchedule, "id", "name")), field)
+
+			case "shifts":
+				field = b.Alias("(SELECT count(*) FROM "+workingScheduleSnapshotShiftTable+" s WHERE s.working_schedule_snapshot_id = "+snapshot.Ident("id")+")", field)
+			}
+
+			base.SelectMore(field)
+		}
+	}
+
+	// Add WHERE clauses.
+	{
+		base.Where(base.EQ(snapshot.Ident("domain_id"), search.User().DomainId))
+		if ws := search.DerivedByName("working_schedule").ID(); ws != 0 {
+			base.Where(base.EQ(snapshot.Ident("working_schedule_id"), ws))
+		}
+
+		if ids := search.IDs(); len(ids) > 0 {
+			base.Where(base.In(snapshot.Ident("id"), b.ConvertArgs(ids)...))
+		}
+	}
+
+	// Construct ORDER BY fields.
+	{
+		orderBy := search.OrderBy()
+		if len(orderBy) == 0 {
+			orderBy.WithOrderBy("version", b.OrderDirectionDESC)
+		}
+
+		for field, direction := range orderBy {
+			switch field {
+			case "id", "created_at", "version":
+				field = b.OrderBy(snapshot.Ident(field), direction)
+
+			case "created_by":
+				joinCreatedBy()
+				field = b.OrderBy(createdBy.Ident("name"), direction)
+			}
+
+			base.OrderBy(field)
+		}
+	}
+
+	var items []*model.WorkingScheduleSnapshot
+	sql, args := base.Limit(search.Size()).Offset(search.Offset()).Build()
+	if err := w.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// SearchWorkingScheduleSnapshotShifts returns shifts of the snapshot grouped by agents,
+// in the same shape as the current shifts of the working schedule.
+func (w *WorkingScheduleSnapshot) SearchWorkingScheduleSnapshotShifts(ctx context.Context, read *options.Read) ([]*model.AgentWorkingSchedule, error) {
+	shift := b.JSONBuildObject(b.JSONBuildObjectFields{
+		"date": "schedule_at",
+		"shift": b.JSONBuildObjectFields{
+			"start":  "start_min",
+			"end":    "end_min",
+			"pauses": "pauses",
+			"skills": "skills",
+		},
+	})
+
+	sb := b.Select("agent", "jsonb_agg("+shift+" ORDER BY schedule_at, start_min) AS schedule").From(workingScheduleSnapshotShiftTable)
+	sql, args := sb.Where(sb.Equal("domain_id", read.User().DomainId), sb.Equal("working_schedule_snapshot_id", read.ID())).
+		GroupBy("agent").
+		OrderBy("agent ->> 'name'").
+		Build()
+
+	var items []*model.AgentWorkingSchedule
+	if err := w.db.StandbyPreferred().Select(ctx, &items, sql, args...); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// withSnapshot appends a snapshot of all agent shifts of the working schedule,
+// which is selected by "schedule" table expression, to the query.
+// The snapshot gets the next version within the working schedule.
+func withSnapshot(cte *b.CTEQuery, user *model.SignedInUser, note *string) {
+	snapshot := b.Format("INSERT INTO "+b.WorkingScheduleSnapshotTable.Name()+" (domain_id, created_by, working_schedule_id, version, note) "+
+		"SELECT $?, $?, schedule.id, coalesce((SELECT max(s.version) FROM "+b.WorkingScheduleSnapshotTable.Name()+" s WHERE s.working_schedule_id = schedule.id), 0) + 1, $? "+
+		"FROM schedule RETURNING id, working_schedule_id", user.DomainId, user.Id, note,
+	)
+
+	shifts := b.Format("INSERT INTO " + workingScheduleSnapshotShiftTable + " (domain_id, working_schedule_snapshot_id, agent, schedule_at, start_min, end_min, pauses, skills) " +
+		"SELECT v.domain_id, snapshot.id, v.agent, v.date, (v.shift ->> 'start')::int, (v.shift ->> 'end')::int, v.shift -> 'pauses', v.shift -> 'skills' " +
+		"FROM " + agentWorkingScheduleView + " v INNER JOIN snapshot ON snapshot.working_schedule_id = v.working_schedule_id " +
+		"WHERE v.shift NOTNULL RETURNING id",
+	)
+
+	cte.With(b.With("snapshot").As(snapshot)).With(b.With("snapshot_shifts").As(shifts))
+}
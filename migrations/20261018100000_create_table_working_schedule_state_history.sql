@@ -0,0 +1,120 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE wfm.working_schedule
+    ADD COLUMN state_changed_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN state_changed_by BIGINT,
+    ADD FOREIGN KEY (domain_id, state_changed_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (state_changed_by);
+
+-- States were stored in the proto numbering (active = 1, draft = 3), swap them to the model one (draft = 1, active = 3),
+-- schedules without a state become drafts.
+UPDATE wfm.working_schedule
+SET state = CASE state WHEN 0 THEN 1 WHEN 1 THEN 3 WHEN 3 THEN 1 ELSE state END;
+
+CREATE TABLE wfm.working_schedule_state_history
+(
+    id                  SERIAL PRIMARY KEY,
+    domain_id           BIGINT                                                                  NOT NULL,
+    created_at          TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    created_by          BIGINT,
+
+    working_schedule_id BIGINT                                                                  NOT NULL,
+    from_state          INT2                                                                    NOT NULL,
+    to_state            INT2                                                                    NOT NULL,
+
+    UNIQUE (domain_id, id),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, created_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (created_by),
+    FOREIGN KEY (domain_id, working_schedule_id) REFERENCES wfm.working_schedule (domain_id, id) ON DELETE CASCADE
+);
+
+CREATE OR REPLACE VIEW wfm.working_schedule_v AS
+SELECT t.id                                      AS id
+     , t.domain_id                               AS domain_id
+     , t.created_at                              AS created_at
+     , call_center.cc_get_lookup(c.id, c.name)   AS created_by
+     , t.updated_at                              AS updated_at
+     , call_center.cc_get_lookup(u.id, u.name)   AS updated_by
+     , t.name                                    AS name
+     , t.state                                   AS state
+     , call_center.cc_get_lookup(at.id, at.name) AS team
+     , call_center.cc_get_lookup(ca.id, ca.name) AS calendar
+     , t.start_date_at                           AS start_date_at
+     , t.end_date_at                             AS end_date_at
+     , t.start_time_at                           AS start_time_at
+     , t.end_time_at                             AS end_time_at
+     , t.block_outside_activity                  AS block_outside_activity
+     , ag.agents                                 AS agents
+     , sg.skills                                 AS extra_skills
+     , t.state_changed_at                        AS state_changed_at
+     , call_center.cc_get_lookup(sc.id, sc.name) AS state_changed_by
+FROM wfm.working_schedule t
+         LEFT JOIN directory.wbt_user c ON t.created_by = c.id
+         LEFT JOIN directory.wbt_user u ON t.updated_by = u.id
+         LEFT JOIN directory.wbt_user sc ON t.state_changed_by = sc.id
+         LEFT JOIN call_center.cc_team at ON t.team_id = at.id
+         LEFT JOIN flow.calendar ca ON t.calendar_id = ca.id
+         LEFT JOIN LATERAL (
+    SELECT jsonb_agg(call_center.cc_get_lookup(a.id, au.name)) AS agents
+    FROM wfm.working_schedule_agent wa
+             INNER JOIN call_center.cc_agent a on wa.agent_id = a.id
+             INNER JOIN directory.wbt_user au ON a.user_id = au.id
+    WHERE wa.working_schedule_id = t.id
+    ) ag ON true
+         LEFT JOIN LATERAL (
+    SELECT jsonb_agg(call_center.cc_get_lookup(s.id, s.name)) AS skills
+    FROM wfm.working_schedule_extra_skill ws
+             INNER JOIN call_center.cc_skill s on ws.skill_id = s.id
+    WHERE ws.working_schedule_id = t.id
+    ) sg ON true;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW wfm.working_schedule_v;
+
+CREATE VIEW wfm.working_schedule_v AS
+SELECT t.id                                      AS id
+     , t.domain_id                               AS domain_id
+     , t.created_at                              AS created_at
+     , call_center.cc_get_lookup(c.id, c.name)   AS created_by
+     , t.updated_at                              AS updated_at
+     , call_center.cc_get_lookup(u.id, u.name)   AS updated_by
+     , t.name                                    AS name
+     , t.state                                   AS state
+     , call_center.cc_get_lookup(at.id, at.name) AS team
+     , call_center.cc_get_lookup(ca.id, ca.name) AS calendar
+     , t.start_date_at                           AS start_date_at
+     , t.end_date_at                             AS end_date_at
+     , t.start_time_at                           AS start_time_at
+     , t.end_time_at                             AS end_time_at
+     , t.block_outside_activity                  AS block_outside_activity
+     , ag.agents                                 AS agents
+     , sg.skills                                 AS extra_skills
+FROM wfm.working_schedule t
+         LEFT JOIN directory.wbt_user c ON t.created_by = c.id
+         LEFT JOIN directory.wbt_user u ON t.updated_by = u.id
+         LEFT JOIN call_center.cc_team at ON t.team_id = at.id
+         LEFT JOIN flow.calendar ca ON t.calendar_id = ca.id
+         LEFT JOIN LATERAL (
+    SELECT jsonb_agg(call_center.cc_get_lookup(a.id, au.name)) AS agents
+    FROM wfm.working_schedule_agent wa
+             INNER JOIN call_center.cc_agent a on wa.agent_id = a.id
+             INNER JOIN directory.wbt_user au ON a.user_id = au.id
+    WHERE wa.working_schedule_id = t.id
+    ) ag ON true
+         LEFT JOIN LATERAL (
+    SELECT jsonb_agg(call_center.cc_get_lookup(s.id, s.name)) AS skills
+    FROM wfm.working_schedule_extra_skill ws
+             INNER JOIN call_center.cc_skill s on ws.skill_id = s.id
+    WHERE ws.working_schedule_id = t.id
+    ) sg ON true;
+
+DROP TABLE wfm.working_schedule_state_history;
+
+UPDATE wfm.working_schedule
+SET state = CASE state WHEN 1 THEN 3 WHEN 3 THEN 1 ELSE state END;
+
+ALTER TABLE wfm.working_schedule
+    DROP COLUMN state_changed_by,
+    DROP COLUMN state_changed_at;
+-- +goose StatementEnd
@@ -0,0 +1,168 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE wfm.agent_working_schedule_skill
+    ADD COLUMN enabled BOOLEAN DEFAULT true NOT NULL;
+
+DROP VIEW wfm.agent_working_schedule_v;
+
+CREATE VIEW wfm.agent_working_schedule_v AS
+(
+SELECT ws.id                                                           AS working_schedule_id
+     , ws.domain_id                                                    AS domain_id
+     , call_center.cc_get_lookup(a.id, coalesce(wu.name, wu.username)) AS agent
+     , x.date                                                          AS date
+     , x.locked                                                        AS locked
+     , x.absence                                                       AS absence
+     , x.shift                                                         AS shift
+FROM wfm.working_schedule ws
+         INNER JOIN wfm.working_schedule_agent wsa ON wsa.working_schedule_id = ws.id
+         INNER JOIN call_center.cc_agent a ON a.id = wsa.agent_id
+         INNER JOIN directory.wbt_user wu ON wu.id = a.user_id
+         LEFT JOIN LATERAL (
+    SELECT null               AS locked
+         , aa.absent_at       AS date
+         , aa.agent_id        AS agent_id
+         , aa.absence_type_id AS absence
+         , null::jsonb           shift
+    FROM wfm.agent_absence aa
+    WHERE aa.agent_id = wsa.agent_id
+      AND aa.absent_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select true
+         , aws.schedule_at
+         , wsa2.agent_id
+         , null
+         , null::jsonb
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN wfm.working_schedule_agent wsa2 ON wsa2.id = aws.working_schedule_agent_id
+             INNER JOIN wfm.working_schedule ws2 ON ws2.id = wsa2.working_schedule_id
+    WHERE wsa2.agent_id = wsa.agent_id
+      AND ws2.id != ws.id
+      AND aws.schedule_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select null
+         , aws.schedule_at
+         , wsa.agent_id
+         , null
+         , jsonb_build_object('id', aws.id
+        , 'domain_id', aws.domain_id
+        , 'created_at', aws.created_at
+        , 'created_by', call_center.cc_get_lookup(c.id, c.name)
+        , 'updated_at', aws.updated_at
+        , 'updated_by', call_center.cc_get_lookup(u.id, u.name)
+        , 'start', aws.start_min
+        , 'end', aws.end_min
+        , 'pauses', p.pauses
+        , 'skills', s.skills)
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN directory.wbt_user c ON aws.created_by = c.id
+             LEFT JOIN directory.wbt_user u ON aws.updated_by = u.id
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('id', id
+            , 'domain_id', domain_id
+            , 'created_at', created_at
+            , 'created_by', created_by
+            , 'updated_at', updated_at
+            , 'updated_by', updated_by
+            , 'start', start_min
+            , 'end', end_min
+            , 'cause', cause)) pauses
+        FROM wfm.agent_working_schedule_pause_v
+        WHERE agent_working_schedule_id = aws.id
+        ) p ON true
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('skill', call_center.cc_get_lookup(sk.id, sk.name)
+            , 'capacity', aws_s.capacity
+            , 'enabled', aws_s.enabled)) skills
+        FROM wfm.agent_working_schedule_skill aws_s
+                 INNER JOIN call_center.cc_skill sk ON sk.id = aws_s.skill_id
+        WHERE aws_s.agent_working_schedule_id = aws.id
+        ) s ON true
+    WHERE aws.working_schedule_agent_id = wsa.id
+    ) x ON true
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW wfm.agent_working_schedule_v;
+
+CREATE VIEW wfm.agent_working_schedule_v AS
+(
+SELECT ws.id                                                           AS working_schedule_id
+     , ws.domain_id                                                    AS domain_id
+     , call_center.cc_get_lookup(a.id, coalesce(wu.name, wu.username)) AS agent
+     , x.date                                                          AS date
+     , x.locked                                                        AS locked
+     , x.absence                                                       AS absence
+     , x.shift                                                         AS shift
+FROM wfm.working_schedule ws
+         INNER JOIN wfm.working_schedule_agent wsa ON wsa.working_schedule_id = ws.id
+         INNER JOIN call_center.cc_agent a ON a.id = wsa.agent_id
+         INNER JOIN directory.wbt_user wu ON wu.id = a.user_id
+         LEFT JOIN LATERAL (
+    SELECT null               AS locked
+         , aa.absent_at       AS date
+         , aa.agent_id        AS agent_id
+         , aa.absence_type_id AS absence
+         , null::jsonb           shift
+    FROM wfm.agent_absence aa
+    WHERE aa.agent_id = wsa.agent_id
+      AND aa.absent_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select true
+         , aws.schedule_at
+         , wsa2.agent_id
+         , null
+         , null::jsonb
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN wfm.working_schedule_agent wsa2 ON wsa2.id = aws.working_schedule_agent_id
+             INNER JOIN wfm.working_schedule ws2 ON ws2.id = wsa2.working_schedule_id
+    WHERE wsa2.agent_id = wsa.agent_id
+      AND ws2.id != ws.id
+      AND aws.schedule_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select null
+         , aws.schedule_at
+         , wsa.agent_id
+         , null
+         , jsonb_build_object('id', aws.id
+        , 'domain_id', aws.domain_id
+        , 'created_at', aws.created_at
+        , 'created_by', call_center.cc_get_lookup(c.id, c.name)
+        , 'updated_at', aws.updated_at
+        , 'updated_by', call_center.cc_get_lookup(u.id, u.name)
+        , 'start', aws.start_min
+        , 'end', aws.end_min
+        , 'pauses', p.pauses)
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN directory.wbt_user c ON aws.created_by = c.id
+             LEFT JOIN directory.wbt_user u ON aws.updated_by = u.id
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('id', id
+            , 'domain_id', domain_id
+            , 'created_at', created_at
+            , 'created_by', created_by
+            , 'updated_at', updated_at
+            , 'updated_by', updated_by
+            , 'start', start_min
+            , 'end', end_min
+            , 'cause', cause)) pauses
+        FROM wfm.agent_working_schedule_pause_v
+        WHERE agent_working_schedule_id = aws.id
+        ) p ON true
+    WHERE aws.working_schedule_agent_id = wsa.id
+    ) x ON true
+    );
+
+ALTER TABLE wfm.agent_working_schedule_skill
+    DROP COLUMN enabled;
+-- +goose StatementEnd
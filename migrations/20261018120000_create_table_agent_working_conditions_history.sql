@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE wfm.agent_working_conditions_history
+(
+    id                   SERIAL PRIMARY KEY,
+    domain_id            BIGINT                                         NOT NULL,
+    started_at           DATE DEFAULT (CURRENT_DATE AT TIME ZONE 'UTC') NOT NULL,
+
+    agent_id             BIGINT                                         NOT NULL,
+    working_condition_id BIGINT                                         NOT NULL,
+
+    UNIQUE (domain_id, agent_id, started_at),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, agent_id) REFERENCES call_center.cc_agent (domain_id, id) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, working_condition_id) REFERENCES wfm.working_condition (domain_id, id) ON DELETE CASCADE
+);
+
+-- Current working conditions are in effect since ever, as the date of their assignment is unknown.
+INSERT INTO wfm.agent_working_conditions_history (domain_id, started_at, agent_id, working_condition_id)
+SELECT domain_id, '-infinity'::date, agent_id, working_condition_id
+FROM wfm.agent_working_conditions;
+
+CREATE OR REPLACE FUNCTION wfm.tg_populate_agent_working_conditions_history()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    INSERT INTO wfm.agent_working_conditions_history (domain_id, started_at, agent_id, working_condition_id)
+    VALUES (NEW.domain_id, (now() AT TIME ZONE 'UTC')::date, NEW.agent_id, NEW.working_condition_id)
+    ON CONFLICT (domain_id, agent_id, started_at) DO UPDATE SET working_condition_id = EXCLUDED.working_condition_id;
+
+    RETURN NEW;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER tg_populate_agent_working_conditions_history
+    AFTER INSERT OR UPDATE OF working_condition_id
+    ON wfm.agent_working_conditions
+    FOR EACH ROW
+EXECUTE PROCEDURE wfm.tg_populate_agent_working_conditions_history();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER tg_populate_agent_working_conditions_history ON wfm.agent_working_conditions;
+
+DROP FUNCTION wfm.tg_populate_agent_working_conditions_history;
+
+DROP TABLE wfm.agent_working_conditions_history;
+-- +goose StatementEnd
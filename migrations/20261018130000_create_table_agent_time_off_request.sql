@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE wfm.agent_time_off_request
+(
+    id              SERIAL PRIMARY KEY,
+    domain_id       BIGINT                                                                  NOT NULL,
+    created_at      TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    created_by      BIGINT,
+    updated_at      TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    updated_by      BIGINT,
+
+    agent_id        BIGINT                                                                  NOT NULL,
+    absence_type_id BIGINT                                                                  NOT NULL,
+    date_from       DATE                                                                    NOT NULL,
+    date_to         DATE                                                                    NOT NULL,
+    state           INT2                     DEFAULT 1                                      NOT NULL,
+    note            TEXT,
+    comment         TEXT,
+    decided_at      TIMESTAMP WITH TIME ZONE,
+    decided_by      BIGINT,
+
+    UNIQUE (domain_id, id),
+    CHECK (date_from <= date_to),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, created_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (created_by),
+    FOREIGN KEY (domain_id, updated_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (updated_by),
+    FOREIGN KEY (domain_id, decided_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (decided_by),
+    FOREIGN KEY (domain_id, agent_id) REFERENCES call_center.cc_agent (domain_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX agent_time_off_request_domain_id_agent_id_state_index
+    ON wfm.agent_time_off_request (domain_id, agent_id, state);
+
+CREATE TRIGGER tg_populate_updated_at_column
+    BEFORE UPDATE
+    ON wfm.agent_time_off_request
+    FOR EACH ROW
+EXECUTE PROCEDURE wfm.tg_populate_updated_at_column();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER tg_populate_updated_at_column ON wfm.agent_time_off_request;
+
+DROP TABLE wfm.agent_time_off_request;
+-- +goose StatementEnd
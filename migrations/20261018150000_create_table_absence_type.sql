@@ -0,0 +1,296 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE wfm.absence_type
+(
+    id                     SERIAL PRIMARY KEY,
+    domain_id              BIGINT                                                                  NOT NULL,
+    created_at             TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    created_by             BIGINT,
+    updated_at             TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    updated_by             BIGINT,
+
+    name                   TEXT                                                                    NOT NULL,
+    description            TEXT,
+    color                  TEXT                     DEFAULT '#9e9e9e'                              NOT NULL,
+    paid                   BOOLEAN                  DEFAULT false                                  NOT NULL,
+    counts_against_balance BOOLEAN                  DEFAULT false                                  NOT NULL,
+    allowance              INT2                     DEFAULT 0                                      NOT NULL,
+    requires_approval      BOOLEAN                  DEFAULT true                                   NOT NULL,
+
+    UNIQUE (domain_id, id),
+    UNIQUE (domain_id, name),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, created_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (created_by),
+    FOREIGN KEY (domain_id, updated_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (updated_by),
+
+    CHECK ( char_length(name) <= 250 ),
+    CHECK ( color ~ '^#[0-9a-fA-F]{6}$' ),
+    CHECK ( allowance between 0 and 2 )
+);
+
+CREATE TRIGGER tg_populate_updated_at_column
+    BEFORE UPDATE
+    ON wfm.absence_type
+    FOR EACH ROW
+EXECUTE PROCEDURE wfm.tg_populate_updated_at_column();
+
+-- Former hardcoded types: 1 - day off, 2 - vacation, 3 - sick day.
+CREATE TEMPORARY TABLE absence_type_legacy (id, name, color, paid, counts_against_balance, allowance) ON COMMIT DROP AS
+VALUES (1, 'Day off', '#2196f3', false, false, 0),
+       (2, 'Vacation', '#4caf50', true, true, 1),
+       (3, 'Sick day', '#f44336', true, true, 2);
+
+INSERT INTO wfm.absence_type (domain_id, name, color, paid, counts_against_balance, allowance, requires_approval)
+SELECT d.dc, l.name, l.color, l.paid, l.counts_against_balance, l.allowance, true
+FROM directory.wbt_domain d
+   , absence_type_legacy l;
+
+UPDATE wfm.agent_absence aa
+SET absence_type_id = at.id
+FROM absence_type_legacy l
+   , wfm.absence_type at
+WHERE l.id = aa.absence_type_id
+  AND at.domain_id = aa.domain_id
+  AND at.name = l.name;
+
+UPDATE wfm.agent_time_off_request atr
+SET absence_type_id = at.id
+FROM absence_type_legacy l
+   , wfm.absence_type at
+WHERE l.id = atr.absence_type_id
+  AND at.domain_id = atr.domain_id
+  AND at.name = l.name;
+
+ALTER TABLE wfm.agent_absence
+    ADD FOREIGN KEY (domain_id, absence_type_id) REFERENCES wfm.absence_type (domain_id, id) ON DELETE RESTRICT;
+
+ALTER TABLE wfm.agent_time_off_request
+    ADD FOREIGN KEY (domain_id, absence_type_id) REFERENCES wfm.absence_type (domain_id, id) ON DELETE RESTRICT;
+
+DROP VIEW wfm.agent_working_schedule_v;
+
+CREATE VIEW wfm.agent_working_schedule_v AS
+(
+SELECT ws.id                                                           AS working_schedule_id
+     , ws.domain_id                                                    AS domain_id
+     , call_center.cc_get_lookup(a.id, coalesce(wu.name, wu.username)) AS agent
+     , x.date                                                          AS date
+     , x.locked                                                        AS locked
+     , x.absence                                                       AS absence
+     , x.absence_start                                                 AS absence_start
+     , x.absence_end                                                   AS absence_end
+     , x.shift                                                         AS shift
+FROM wfm.working_schedule ws
+         INNER JOIN wfm.working_schedule_agent wsa ON wsa.working_schedule_id = ws.id
+         INNER JOIN call_center.cc_agent a ON a.id = wsa.agent_id
+         INNER JOIN directory.wbt_user wu ON wu.id = a.user_id
+         LEFT JOIN LATERAL (
+    SELECT null               AS locked
+         , aa.absent_at       AS date
+         , aa.agent_id        AS agent_id
+         , call_center.cc_get_lookup(at.id, at.name) AS absence
+         , aa.start_min       AS absence_start
+         , aa.end_min         AS absence_end
+         , null::jsonb           shift
+    FROM wfm.agent_absence aa
+             INNER JOIN wfm.absence_type at ON at.id = aa.absence_type_id
+    WHERE aa.agent_id = wsa.agent_id
+      AND aa.absent_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select true
+         , aws.schedule_at
+         , wsa2.agent_id
+         , null
+         , null
+         , null
+         , null::jsonb
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN wfm.working_schedule_agent wsa2 ON wsa2.id = aws.working_schedule_agent_id
+             INNER JOIN wfm.working_schedule ws2 ON ws2.id = wsa2.working_schedule_id
+    WHERE wsa2.agent_id = wsa.agent_id
+      AND ws2.id != ws.id
+      AND aws.schedule_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select null
+         , aws.schedule_at
+         , wsa.agent_id
+         , null
+         , null
+         , null
+         , jsonb_build_object('id', aws.id
+        , 'domain_id', aws.domain_id
+        , 'created_at', aws.created_at
+        , 'created_by', call_center.cc_get_lookup(c.id, c.name)
+        , 'updated_at', aws.updated_at
+        , 'updated_by', call_center.cc_get_lookup(u.id, u.name)
+        , 'start', aws.start_min
+        , 'end', aws.end_min
+        , 'pauses', p.pauses
+        , 'skills', s.skills)
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN directory.wbt_user c ON aws.created_by = c.id
+             LEFT JOIN directory.wbt_user u ON aws.updated_by = u.id
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('id', id
+            , 'domain_id', domain_id
+            , 'created_at', created_at
+            , 'created_by', created_by
+            , 'updated_at', updated_at
+            , 'updated_by', updated_by
+            , 'start', start_min
+            , 'end', end_min
+            , 'cause', cause)) pauses
+        FROM wfm.agent_working_schedule_pause_v
+        WHERE agent_working_schedule_id = aws.id
+        ) p ON true
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('skill', call_center.cc_get_lookup(sk.id, sk.name)
+            , 'capacity', aws_s.capacity
+            , 'enabled', aws_s.enabled)) skills
+        FROM wfm.agent_working_schedule_skill aws_s
+                 INNER JOIN call_center.cc_skill sk ON sk.id = aws_s.skill_id
+        WHERE aws_s.agent_working_schedule_id = aws.id
+        ) s ON true
+    WHERE aws.working_schedule_agent_id = wsa.id
+    ) x ON true
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW wfm.agent_working_schedule_v;
+
+CREATE VIEW wfm.agent_working_schedule_v AS
+(
+SELECT ws.id                                                           AS working_schedule_id
+     , ws.domain_id                                                    AS domain_id
+     , call_center.cc_get_lookup(a.id, coalesce(wu.name, wu.username)) AS agent
+     , x.date                                                          AS date
+     , x.locked                                                        AS locked
+     , x.absence                                                       AS absence
+     , x.absence_start                                                 AS absence_start
+     , x.absence_end                                                   AS absence_end
+     , x.shift                                                         AS shift
+FROM wfm.working_schedule ws
+         INNER JOIN wfm.working_schedule_agent wsa ON wsa.working_schedule_id = ws.id
+         INNER JOIN call_center.cc_agent a ON a.id = wsa.agent_id
+         INNER JOIN directory.wbt_user wu ON wu.id = a.user_id
+         LEFT JOIN LATERAL (
+    SELECT null               AS locked
+         , aa.absent_at       AS date
+         , aa.agent_id        AS agent_id
+         , aa.absence_type_id AS absence
+         , aa.start_min       AS absence_start
+         , aa.end_min         AS absence_end
+         , null::jsonb           shift
+    FROM wfm.agent_absence aa
+    WHERE aa.agent_id = wsa.agent_id
+      AND aa.absent_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select true
+         , aws.schedule_at
+         , wsa2.agent_id
+         , null
+         , null
+         , null
+         , null::jsonb
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN wfm.working_schedule_agent wsa2 ON wsa2.id = aws.working_schedule_agent_id
+             INNER JOIN wfm.working_schedule ws2 ON ws2.id = wsa2.working_schedule_id
+    WHERE wsa2.agent_id = wsa.agent_id
+      AND ws2.id != ws.id
+      AND aws.schedule_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select null
+         , aws.schedule_at
+         , wsa.agent_id
+         , null
+         , null
+         , null
+         , jsonb_build_object('id', aws.id
+        , 'domain_id', aws.domain_id
+        , 'created_at', aws.created_at
+        , 'created_by', call_center.cc_get_lookup(c.id, c.name)
+        , 'updated_at', aws.updated_at
+        , 'updated_by', call_center.cc_get_lookup(u.id, u.name)
+        , 'start', aws.start_min
+        , 'end', aws.end_min
+        , 'pauses', p.pauses
+        , 'skills', s.skills)
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN directory.wbt_user c ON aws.created_by = c.id
+             LEFT JOIN directory.wbt_user u ON aws.updated_by = u.id
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('id', id
+            , 'domain_id', domain_id
+            , 'created_at', created_at
+            , 'created_by', created_by
+            , 'updated_at', updated_at
+            , 'updated_by', updated_by
+            , 'start', start_min
+            , 'end', end_min
+            , 'cause', cause)) pauses
+        FROM wfm.agent_working_schedule_pause_v
+        WHERE agent_working_schedule_id = aws.id
+        ) p ON true
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('skill', call_center.cc_get_lookup(sk.id, sk.name)
+            , 'capacity', aws_s.capacity
+            , 'enabled', aws_s.enabled)) skills
+        FROM wfm.agent_working_schedule_skill aws_s
+                 INNER JOIN call_center.cc_skill sk ON sk.id = aws_s.skill_id
+        WHERE aws_s.agent_working_schedule_id = aws.id
+        ) s ON true
+    WHERE aws.working_schedule_agent_id = wsa.id
+    ) x ON true
+    );
+
+ALTER TABLE wfm.agent_time_off_request
+    DROP CONSTRAINT agent_time_off_request_domain_id_absence_type_id_fkey;
+
+ALTER TABLE wfm.agent_absence
+    DROP CONSTRAINT agent_absence_domain_id_absence_type_id_fkey;
+
+CREATE TEMPORARY TABLE absence_type_legacy (id, name) ON COMMIT DROP AS
+VALUES (1, 'Day off'),
+       (2, 'Vacation'),
+       (3, 'Sick day');
+
+DELETE
+FROM wfm.agent_absence aa
+    USING wfm.absence_type at
+WHERE at.id = aa.absence_type_id
+  AND at.name NOT IN (SELECT name FROM absence_type_legacy);
+
+DELETE
+FROM wfm.agent_time_off_request atr
+    USING wfm.absence_type at
+WHERE at.id = atr.absence_type_id
+  AND at.name NOT IN (SELECT name FROM absence_type_legacy);
+
+UPDATE wfm.agent_absence aa
+SET absence_type_id = l.id
+FROM absence_type_legacy l
+   , wfm.absence_type at
+WHERE at.id = aa.absence_type_id
+  AND at.name = l.name;
+
+UPDATE wfm.agent_time_off_request atr
+SET absence_type_id = l.id
+FROM absence_type_legacy l
+   , wfm.absence_type at
+WHERE at.id = atr.absence_type_id
+  AND at.name = l.name;
+
+DROP TRIGGER tg_populate_updated_at_column ON wfm.absence_type;
+
+DROP TABLE wfm.absence_type;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE wfm.agent_working_schedule
+    ADD CONSTRAINT agent_working_schedule_time_check CHECK (
+        start_min >= 0 AND start_min < 1440 AND start_min < end_min AND end_min - start_min <= 1440
+        );
+
+ALTER TABLE wfm.agent_working_schedule_pause
+    ADD CONSTRAINT agent_working_schedule_pause_time_check CHECK (
+        start_min >= 0 AND end_min <= 2880 AND start_min < end_min
+        );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE wfm.agent_working_schedule_pause
+    DROP CONSTRAINT agent_working_schedule_pause_time_check;
+
+ALTER TABLE wfm.agent_working_schedule
+    DROP CONSTRAINT agent_working_schedule_time_check;
+-- +goose StatementEnd
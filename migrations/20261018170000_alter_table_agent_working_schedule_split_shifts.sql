@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE wfm.agent_working_schedule
+    DROP CONSTRAINT agent_working_schedule_working_schedule_agent_id_schedule_at_key,
+    ADD CONSTRAINT agent_working_schedule_segment_key UNIQUE (working_schedule_agent_id, schedule_at, start_min);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE
+FROM wfm.agent_working_schedule aws
+    USING wfm.agent_working_schedule first
+WHERE first.working_schedule_agent_id = aws.working_schedule_agent_id
+  AND first.schedule_at = aws.schedule_at
+  AND first.start_min < aws.start_min;
+
+ALTER TABLE wfm.agent_working_schedule
+    DROP CONSTRAINT agent_working_schedule_segment_key,
+    ADD CONSTRAINT agent_working_schedule_working_schedule_agent_id_schedule_at_key UNIQUE (working_schedule_agent_id, schedule_at);
+-- +goose StatementEnd
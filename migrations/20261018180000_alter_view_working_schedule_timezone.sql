@@ -0,0 +1,281 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE VIEW wfm.working_schedule_v AS
+SELECT t.id                                      AS id
+     , t.domain_id                               AS domain_id
+     , t.created_at                              AS created_at
+     , call_center.cc_get_lookup(c.id, c.name)   AS created_by
+     , t.updated_at                              AS updated_at
+     , call_center.cc_get_lookup(u.id, u.name)   AS updated_by
+     , t.name                                    AS name
+     , t.state                                   AS state
+     , call_center.cc_get_lookup(at.id, at.name) AS team
+     , call_center.cc_get_lookup(ca.id, ca.name) AS calendar
+     , t.start_date_at                           AS start_date_at
+     , t.end_date_at                             AS end_date_at
+     , t.start_time_at                           AS start_time_at
+     , t.end_time_at                             AS end_time_at
+     , t.block_outside_activity                  AS block_outside_activity
+     , ag.agents                                 AS agents
+     , sg.skills                                 AS extra_skills
+     , t.state_changed_at                        AS state_changed_at
+     , call_center.cc_get_lookup(sc.id, sc.name) AS state_changed_by
+     , coalesce(ct.sys_name, 'UTC')              AS timezone
+FROM wfm.working_schedule t
+         LEFT JOIN directory.wbt_user c ON t.created_by = c.id
+         LEFT JOIN directory.wbt_user u ON t.updated_by = u.id
+         LEFT JOIN directory.wbt_user sc ON t.state_changed_by = sc.id
+         LEFT JOIN call_center.cc_team at ON t.team_id = at.id
+         LEFT JOIN flow.calendar ca ON t.calendar_id = ca.id
+         LEFT JOIN flow.calendar_timezones ct ON ca.timezone_id = ct.id
+         LEFT JOIN LATERAL (
+    SELECT jsonb_agg(call_center.cc_get_lookup(a.id, au.name)) AS agents
+    FROM wfm.working_schedule_agent wa
+             INNER JOIN call_center.cc_agent a on wa.agent_id = a.id
+             INNER JOIN directory.wbt_user au ON a.user_id = au.id
+    WHERE wa.working_schedule_id = t.id
+    ) ag ON true
+         LEFT JOIN LATERAL (
+    SELECT jsonb_agg(call_center.cc_get_lookup(s.id, s.name)) AS skills
+    FROM wfm.working_schedule_extra_skill ws
+             INNER JOIN call_center.cc_skill s on ws.skill_id = s.id
+    WHERE ws.working_schedule_id = t.id
+    ) sg ON true;
+
+-- Shift and pause minutes are wall-clock minutes of the calendar timezone,
+-- so instants are resolved from the local date, which accounts for DST transition days.
+DROP VIEW wfm.agent_working_schedule_v;
+
+CREATE VIEW wfm.agent_working_schedule_v AS
+(
+SELECT ws.id                                                           AS working_schedule_id
+     , ws.domain_id                                                    AS domain_id
+     , call_center.cc_get_lookup(a.id, coalesce(wu.name, wu.username)) AS agent
+     , x.date                                                          AS date
+     , x.locked                                                        AS locked
+     , x.absence                                                       AS absence
+     , x.absence_start                                                 AS absence_start
+     , x.absence_end                                                   AS absence_end
+     , x.shift                                                         AS shift
+FROM wfm.working_schedule ws
+         INNER JOIN wfm.working_schedule_agent wsa ON wsa.working_schedule_id = ws.id
+         INNER JOIN call_center.cc_agent a ON a.id = wsa.agent_id
+         INNER JOIN directory.wbt_user wu ON wu.id = a.user_id
+         LEFT JOIN flow.calendar ca ON ca.id = ws.calendar_id
+         LEFT JOIN flow.calendar_timezones ct ON ct.id = ca.timezone_id
+         LEFT JOIN LATERAL (
+    SELECT null               AS locked
+         , aa.absent_at       AS date
+         , aa.agent_id        AS agent_id
+         , call_center.cc_get_lookup(at.id, at.name) AS absence
+         , aa.start_min       AS absence_start
+         , aa.end_min         AS absence_end
+         , null::jsonb           shift
+    FROM wfm.agent_absence aa
+             INNER JOIN wfm.absence_type at ON at.id = aa.absence_type_id
+    WHERE aa.agent_id = wsa.agent_id
+      AND aa.absent_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select true
+         , aws.schedule_at
+         , wsa2.agent_id
+         , null
+         , null
+         , null
+         , null::jsonb
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN wfm.working_schedule_agent wsa2 ON wsa2.id = aws.working_schedule_agent_id
+             INNER JOIN wfm.working_schedule ws2 ON ws2.id = wsa2.working_schedule_id
+    WHERE wsa2.agent_id = wsa.agent_id
+      AND ws2.id != ws.id
+      AND aws.schedule_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select null
+         , aws.schedule_at
+         , wsa.agent_id
+         , null
+         , null
+         , null
+         , jsonb_build_object('id', aws.id
+        , 'domain_id', aws.domain_id
+        , 'created_at', aws.created_at
+        , 'created_by', call_center.cc_get_lookup(c.id, c.name)
+        , 'updated_at', aws.updated_at
+        , 'updated_by', call_center.cc_get_lookup(u.id, u.name)
+        , 'start', aws.start_min
+        , 'end', aws.end_min
+        , 'start_at', (aws.schedule_at + make_interval(mins => aws.start_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+        , 'end_at', (aws.schedule_at + make_interval(mins => aws.end_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+        , 'pauses', p.pauses
+        , 'skills', s.skills)
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN directory.wbt_user c ON aws.created_by = c.id
+             LEFT JOIN directory.wbt_user u ON aws.updated_by = u.id
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('id', id
+            , 'domain_id', domain_id
+            , 'created_at', created_at
+            , 'created_by', created_by
+            , 'updated_at', updated_at
+            , 'updated_by', updated_by
+            , 'start', start_min
+            , 'end', end_min
+            , 'start_at', (aws.schedule_at + make_interval(mins => start_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+            , 'end_at', (aws.schedule_at + make_interval(mins => end_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+            , 'cause', cause)) pauses
+        FROM wfm.agent_working_schedule_pause_v
+        WHERE agent_working_schedule_id = aws.id
+        ) p ON true
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('skill', call_center.cc_get_lookup(sk.id, sk.name)
+            , 'capacity', aws_s.capacity
+            , 'enabled', aws_s.enabled)) skills
+        FROM wfm.agent_working_schedule_skill aws_s
+                 INNER JOIN call_center.cc_skill sk ON sk.id = aws_s.skill_id
+        WHERE aws_s.agent_working_schedule_id = aws.id
+        ) s ON true
+    WHERE aws.working_schedule_agent_id = wsa.id
+    ) x ON true
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW wfm.working_schedule_v;
+
+CREATE VIEW wfm.working_schedule_v AS
+SELECT t.id                                      AS id
+     , t.domain_id                               AS domain_id
+     , t.created_at                              AS created_at
+     , call_center.cc_get_lookup(c.id, c.name)   AS created_by
+     , t.updated_at                              AS updated_at
+     , call_center.cc_get_lookup(u.id, u.name)   AS updated_by
+     , t.name                                    AS name
+     , t.state                                   AS state
+     , call_center.cc_get_lookup(at.id, at.name) AS team
+     , call_center.cc_get_lookup(ca.id, ca.name) AS calendar
+     , t.start_date_at                           AS start_date_at
+     , t.end_date_at                             AS end_date_at
+     , t.start_time_at                           AS start_time_at
+     , t.end_time_at                             AS end_time_at
+     , t.block_outside_activity                  AS block_outside_activity
+     , ag.agents                                 AS agents
+     , sg.skills                                 AS extra_skills
+     , t.state_changed_at                        AS state_changed_at
+     , call_center.cc_get_lookup(sc.id, sc.name) AS state_changed_by
+FROM wfm.working_schedule t
+         LEFT JOIN directory.wbt_user c ON t.created_by = c.id
+         LEFT JOIN directory.wbt_user u ON t.updated_by = u.id
+         LEFT JOIN directory.wbt_user sc ON t.state_changed_by = sc.id
+         LEFT JOIN call_center.cc_team at ON t.team_id = at.id
+         LEFT JOIN flow.calendar ca ON t.calendar_id = ca.id
+         LEFT JOIN LATERAL (
+    SELECT jsonb_agg(call_center.cc_get_lookup(a.id, au.name)) AS agents
+    FROM wfm.working_schedule_agent wa
+             INNER JOIN call_center.cc_agent a on wa.agent_id = a.id
+             INNER JOIN directory.wbt_user au ON a.user_id = au.id
+    WHERE wa.working_schedule_id = t.id
+    ) ag ON true
+         LEFT JOIN LATERAL (
+    SELECT jsonb_agg(call_center.cc_get_lookup(s.id, s.name)) AS skills
+    FROM wfm.working_schedule_extra_skill ws
+             INNER JOIN call_center.cc_skill s on ws.skill_id = s.id
+    WHERE ws.working_schedule_id = t.id
+    ) sg ON true;
+
+DROP VIEW wfm.agent_working_schedule_v;
+
+CREATE VIEW wfm.agent_working_schedule_v AS
+(
+SELECT ws.id                                                           AS working_schedule_id
+     , ws.domain_id                                                    AS domain_id
+     , call_center.cc_get_lookup(a.id, coalesce(wu.name, wu.username)) AS agent
+     , x.date                                                          AS date
+     , x.locked                                                        AS locked
+     , x.absence                                                       AS absence
+     , x.absence_start                                                 AS absence_start
+     , x.absence_end                                                   AS absence_end
+     , x.shift                                                         AS shift
+FROM wfm.working_schedule ws
+         INNER JOIN wfm.working_schedule_agent wsa ON wsa.working_schedule_id = ws.id
+         INNER JOIN call_center.cc_agent a ON a.id = wsa.agent_id
+         INNER JOIN directory.wbt_user wu ON wu.id = a.user_id
+         LEFT JOIN LATERAL (
+    SELECT null               AS locked
+         , aa.absent_at       AS date
+         , aa.agent_id        AS agent_id
+         , call_center.cc_get_lookup(at.id, at.name) AS absence
+         , aa.start_min       AS absence_start
+         , aa.end_min         AS absence_end
+         , null::jsonb           shift
+    FROM wfm.agent_absence aa
+             INNER JOIN wfm.absence_type at ON at.id = aa.absence_type_id
+    WHERE aa.agent_id = wsa.agent_id
+      AND aa.absent_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select true
+         , aws.schedule_at
+         , wsa2.agent_id
+         , null
+         , null
+         , null
+         , null::jsonb
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN wfm.working_schedule_agent wsa2 ON wsa2.id = aws.working_schedule_agent_id
+             INNER JOIN wfm.working_schedule ws2 ON ws2.id = wsa2.working_schedule_id
+    WHERE wsa2.agent_id = wsa.agent_id
+      AND ws2.id != ws.id
+      AND aws.schedule_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select null
+         , aws.schedule_at
+         , wsa.agent_id
+         , null
+         , null
+         , null
+         , jsonb_build_object('id', aws.id
+        , 'domain_id', aws.domain_id
+        , 'created_at', aws.created_at
+        , 'created_by', call_center.cc_get_lookup(c.id, c.name)
+        , 'updated_at', aws.updated_at
+        , 'updated_by', call_center.cc_get_lookup(u.id, u.name)
+        , 'start', aws.start_min
+        , 'end', aws.end_min
+        , 'pauses', p.pauses
+        , 'skills', s.skills)
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN directory.wbt_user c ON aws.created_by = c.id
+             LEFT JOIN directory.wbt_user u ON aws.updated_by = u.id
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('id', id
+            , 'domain_id', domain_id
+            , 'created_at', created_at
+            , 'created_by', created_by
+            , 'updated_at', updated_at
+            , 'updated_by', updated_by
+            , 'start', start_min
+            , 'end', end_min
+            , 'cause', cause)) pauses
+        FROM wfm.agent_working_schedule_pause_v
+        WHERE agent_working_schedule_id = aws.id
+        ) p ON true
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('skill', call_center.cc_get_lookup(sk.id, sk.name)
+            , 'capacity', aws_s.capacity
+            , 'enabled', aws_s.enabled)) skills
+        FROM wfm.agent_working_schedule_skill aws_s
+                 INNER JOIN call_center.cc_skill sk ON sk.id = aws_s.skill_id
+        WHERE aws_s.agent_working_schedule_id = aws.id
+        ) s ON true
+    WHERE aws.working_schedule_agent_id = wsa.id
+    ) x ON true
+    );
+-- +goose StatementEnd
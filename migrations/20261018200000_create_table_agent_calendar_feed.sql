@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE wfm.agent_calendar_feed
+(
+    id         SERIAL PRIMARY KEY,
+    domain_id  BIGINT                                                                  NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    created_by BIGINT,
+
+    agent_id   BIGINT                                                                  NOT NULL,
+    token      TEXT                                                                    NOT NULL,
+
+    UNIQUE (domain_id, id),
+    UNIQUE (domain_id, agent_id),
+    UNIQUE (token),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, created_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (created_by),
+    FOREIGN KEY (domain_id, agent_id) REFERENCES call_center.cc_agent (domain_id, id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE wfm.agent_calendar_feed;
+-- +goose StatementEnd
@@ -0,0 +1,82 @@
+-- +goose Up
+-- +goose StatementBegin
+-- History of agent shift changes, action is 1 - created, 2 - updated, 3 - deleted.
+-- Shift moved to another agent is deleted for the previous agent and created for the new one.
+CREATE TABLE wfm.agent_working_schedule_history
+(
+    id                        SERIAL PRIMARY KEY,
+    domain_id                 BIGINT                                                                  NOT NULL,
+    created_at                TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    created_by                BIGINT,
+
+    agent_working_schedule_id BIGINT                                                                  NOT NULL,
+    working_schedule_id       BIGINT                                                                  NOT NULL,
+    agent_id                  BIGINT                                                                  NOT NULL,
+    schedule_at               DATE                                                                    NOT NULL,
+    action                    INT2                                                                    NOT NULL,
+    start_min                 INT2,
+    end_min                   INT2,
+    prev_start_min            INT2,
+    prev_end_min              INT2,
+
+    UNIQUE (domain_id, id),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, created_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (created_by),
+    FOREIGN KEY (domain_id, working_schedule_id) REFERENCES wfm.working_schedule (domain_id, id) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, agent_id) REFERENCES call_center.cc_agent (domain_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX agent_working_schedule_history_domain_id_agent_id_schedule_at_index
+    ON wfm.agent_working_schedule_history (domain_id, agent_id, schedule_at);
+
+-- Shifts, which are deleted together with their working schedule or agent, aren't recorded,
+-- since the working schedule agent is already gone.
+CREATE OR REPLACE FUNCTION wfm.tg_populate_agent_working_schedule_history()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' OR (TG_OP = 'UPDATE' AND NEW.working_schedule_agent_id != OLD.working_schedule_agent_id) THEN
+        INSERT INTO wfm.agent_working_schedule_history (domain_id, created_by, agent_working_schedule_id, working_schedule_id,
+                                                        agent_id, schedule_at, action, prev_start_min, prev_end_min)
+        SELECT OLD.domain_id, CASE WHEN TG_OP = 'UPDATE' THEN NEW.updated_by END, OLD.id, wsa.working_schedule_id
+             , wsa.agent_id, OLD.schedule_at, 3, OLD.start_min, OLD.end_min
+        FROM wfm.working_schedule_agent wsa
+        WHERE wsa.id = OLD.working_schedule_agent_id;
+    END IF;
+
+    IF TG_OP = 'INSERT' OR (TG_OP = 'UPDATE' AND NEW.working_schedule_agent_id != OLD.working_schedule_agent_id) THEN
+        INSERT INTO wfm.agent_working_schedule_history (domain_id, created_by, agent_working_schedule_id, working_schedule_id,
+                                                        agent_id, schedule_at, action, start_min, end_min)
+        SELECT NEW.domain_id, coalesce(NEW.updated_by, NEW.created_by), NEW.id, wsa.working_schedule_id
+             , wsa.agent_id, NEW.schedule_at, 1, NEW.start_min, NEW.end_min
+        FROM wfm.working_schedule_agent wsa
+        WHERE wsa.id = NEW.working_schedule_agent_id;
+    ELSIF TG_OP = 'UPDATE' AND (NEW.schedule_at, NEW.start_min, NEW.end_min) IS DISTINCT FROM (OLD.schedule_at, OLD.start_min, OLD.end_min) THEN
+        INSERT INTO wfm.agent_working_schedule_history (domain_id, created_by, agent_working_schedule_id, working_schedule_id,
+                                                        agent_id, schedule_at, action, start_min, end_min, prev_start_min,
+                                                        prev_end_min)
+        SELECT NEW.domain_id, NEW.updated_by, NEW.id, wsa.working_schedule_id
+             , wsa.agent_id, NEW.schedule_at, 2, NEW.start_min, NEW.end_min, OLD.start_min, OLD.end_min
+        FROM wfm.working_schedule_agent wsa
+        WHERE wsa.id = NEW.working_schedule_agent_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER tg_populate_agent_working_schedule_history
+    AFTER INSERT OR UPDATE OR DELETE
+    ON wfm.agent_working_schedule
+    FOR EACH ROW
+EXECUTE PROCEDURE wfm.tg_populate_agent_working_schedule_history();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER tg_populate_agent_working_schedule_history ON wfm.agent_working_schedule;
+
+DROP FUNCTION wfm.tg_populate_agent_working_schedule_history;
+
+DROP TABLE wfm.agent_working_schedule_history;
+-- +goose StatementEnd
@@ -0,0 +1,80 @@
+-- +goose Up
+-- +goose StatementBegin
+-- State is 1 - open, 2 - accepted, 3 - approved, 4 - rejected, 5 - cancelled.
+CREATE TABLE wfm.agent_shift_swap
+(
+    id                                SERIAL PRIMARY KEY,
+    domain_id                         BIGINT                                                                  NOT NULL,
+    created_at                        TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    created_by                        BIGINT,
+    updated_at                        TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    updated_by                        BIGINT,
+
+    working_schedule_id               BIGINT                                                                  NOT NULL,
+    agent_id                          BIGINT                                                                  NOT NULL,
+    agent_working_schedule_id         BIGINT                                                                  NOT NULL,
+    target_agent_id                   BIGINT,
+    counter_agent_id                  BIGINT,
+    counter_agent_working_schedule_id BIGINT,
+    state                             INT2                     DEFAULT 1                                      NOT NULL,
+    note                              TEXT,
+    comment                           TEXT,
+    accepted_at                       TIMESTAMP WITH TIME ZONE,
+    decided_at                        TIMESTAMP WITH TIME ZONE,
+    decided_by                        BIGINT,
+
+    UNIQUE (domain_id, id),
+    CHECK (agent_id != target_agent_id),
+    CHECK (agent_id != counter_agent_id),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, created_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (created_by),
+    FOREIGN KEY (domain_id, updated_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (updated_by),
+    FOREIGN KEY (domain_id, decided_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (decided_by),
+    FOREIGN KEY (domain_id, working_schedule_id) REFERENCES wfm.working_schedule (domain_id, id) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, agent_id) REFERENCES call_center.cc_agent (domain_id, id) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, target_agent_id) REFERENCES call_center.cc_agent (domain_id, id) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, counter_agent_id) REFERENCES call_center.cc_agent (domain_id, id) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, agent_working_schedule_id) REFERENCES wfm.agent_working_schedule (domain_id, id) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, counter_agent_working_schedule_id) REFERENCES wfm.agent_working_schedule (domain_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX agent_shift_swap_domain_id_working_schedule_id_state_index
+    ON wfm.agent_shift_swap (domain_id, working_schedule_id, state);
+
+-- Only one swap of the shift is in progress at a time.
+CREATE UNIQUE INDEX agent_shift_swap_agent_working_schedule_id_in_progress_index
+    ON wfm.agent_shift_swap (agent_working_schedule_id)
+    WHERE state IN (1, 2);
+
+CREATE TRIGGER tg_populate_updated_at_column
+    BEFORE UPDATE
+    ON wfm.agent_shift_swap
+    FOR EACH ROW
+EXECUTE PROCEDURE wfm.tg_populate_updated_at_column();
+
+CREATE TABLE wfm.agent_shift_swap_state_history
+(
+    id                  SERIAL PRIMARY KEY,
+    domain_id           BIGINT                                                                  NOT NULL,
+    created_at          TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    created_by          BIGINT,
+
+    agent_shift_swap_id BIGINT                                                                  NOT NULL,
+    from_state          INT2                                                                    NOT NULL,
+    to_state            INT2                                                                    NOT NULL,
+
+    UNIQUE (domain_id, id),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, created_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (created_by),
+    FOREIGN KEY (domain_id, agent_shift_swap_id) REFERENCES wfm.agent_shift_swap (domain_id, id) ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE wfm.agent_shift_swap_state_history;
+
+DROP TRIGGER tg_populate_updated_at_column ON wfm.agent_shift_swap;
+
+DROP TABLE wfm.agent_shift_swap;
+-- +goose StatementEnd
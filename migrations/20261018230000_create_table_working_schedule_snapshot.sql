@@ -0,0 +1,78 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE wfm.working_schedule_snapshot
+(
+    id                  SERIAL PRIMARY KEY,
+    domain_id           BIGINT                                                                  NOT NULL,
+    created_at          TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    created_by          BIGINT,
+
+    working_schedule_id BIGINT                                                                  NOT NULL,
+    version             INT                                                                     NOT NULL,
+    note                TEXT,
+
+    UNIQUE (domain_id, id),
+    UNIQUE (working_schedule_id, version),
+    CHECK ( char_length(note) <= 500 ),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, created_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (created_by),
+    FOREIGN KEY (domain_id, working_schedule_id) REFERENCES wfm.working_schedule (domain_id, id) ON DELETE CASCADE
+);
+
+-- Agent, pauses and skills are copied as they were published, so the snapshot doesn't follow later changes
+-- of agents, pause causes or skills.
+CREATE TABLE wfm.working_schedule_snapshot_shift
+(
+    id                           SERIAL PRIMARY KEY,
+    domain_id                    BIGINT NOT NULL,
+
+    working_schedule_snapshot_id BIGINT NOT NULL,
+    agent                        JSONB  NOT NULL,
+    schedule_at                  DATE   NOT NULL,
+    start_min                    INT    NOT NULL,
+    end_min                      INT    NOT NULL,
+    pauses                       JSONB,
+    skills                       JSONB,
+
+    UNIQUE (domain_id, id),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, working_schedule_snapshot_id) REFERENCES wfm.working_schedule_snapshot (domain_id, id) ON DELETE CASCADE
+);
+
+CREATE INDEX working_schedule_snapshot_shift_snapshot_id_index
+    ON wfm.working_schedule_snapshot_shift (working_schedule_snapshot_id);
+
+-- Snapshots are immutable, they are only removed together with their working schedule.
+CREATE OR REPLACE FUNCTION wfm.tg_forbid_snapshot_update()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'working schedule snapshot is immutable';
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER tg_forbid_snapshot_update
+    BEFORE UPDATE
+    ON wfm.working_schedule_snapshot
+    FOR EACH ROW
+EXECUTE PROCEDURE wfm.tg_forbid_snapshot_update();
+
+CREATE TRIGGER tg_forbid_snapshot_update
+    BEFORE UPDATE
+    ON wfm.working_schedule_snapshot_shift
+    FOR EACH ROW
+EXECUTE PROCEDURE wfm.tg_forbid_snapshot_update();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER tg_forbid_snapshot_update ON wfm.working_schedule_snapshot_shift;
+
+DROP TRIGGER tg_forbid_snapshot_update ON wfm.working_schedule_snapshot;
+
+DROP FUNCTION wfm.tg_forbid_snapshot_update;
+
+DROP TABLE wfm.working_schedule_snapshot_shift;
+
+DROP TABLE wfm.working_schedule_snapshot;
+-- +goose StatementEnd
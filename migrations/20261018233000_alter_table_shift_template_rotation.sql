@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Rotation is an N-day cycle of shift times, where a day without time is a day off.
+ALTER TABLE wfm.shift_template
+    ADD COLUMN rotation JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE wfm.shift_template
+    DROP COLUMN rotation;
+-- +goose StatementEnd
@@ -0,0 +1,265 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Shifts are stored in the same shape as shifts of the agent working schedule, grouped by day of week.
+CREATE TABLE wfm.roster_pattern
+(
+    id          SERIAL PRIMARY KEY,
+    domain_id   BIGINT                                                                  NOT NULL,
+    created_at  TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    created_by  BIGINT,
+    updated_at  TIMESTAMP WITH TIME ZONE DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC') NOT NULL,
+    updated_by  BIGINT,
+
+    name        TEXT                                                                    NOT NULL,
+    description TEXT,
+    team_id     BIGINT,
+    shifts      JSONB                                                                   NOT NULL,
+
+    UNIQUE (domain_id, id),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, created_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (created_by),
+    FOREIGN KEY (domain_id, updated_by) REFERENCES directory.wbt_user (dc, id) ON DELETE SET NULL (updated_by),
+    FOREIGN KEY (domain_id, team_id) REFERENCES call_center.cc_team (domain_id, id) ON DELETE SET NULL (team_id),
+
+    CHECK ( char_length(name) <= 250 )
+);
+
+CREATE TRIGGER tg_populate_updated_at_column
+    BEFORE UPDATE
+    ON wfm.roster_pattern
+    FOR EACH ROW
+EXECUTE PROCEDURE wfm.tg_populate_updated_at_column();
+
+CREATE TABLE wfm.roster_pattern_agent
+(
+    id                SERIAL PRIMARY KEY,
+    domain_id         BIGINT NOT NULL,
+    roster_pattern_id BIGINT NOT NULL,
+    agent_id          BIGINT NOT NULL,
+
+    UNIQUE (domain_id, id),
+    UNIQUE (roster_pattern_id, agent_id),
+    FOREIGN KEY (domain_id) REFERENCES directory.wbt_domain (dc) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, roster_pattern_id) REFERENCES wfm.roster_pattern (domain_id, id) ON DELETE CASCADE,
+    FOREIGN KEY (domain_id, agent_id) REFERENCES call_center.cc_agent (domain_id, id) ON DELETE CASCADE
+);
+
+ALTER TABLE wfm.agent_working_schedule
+    ADD COLUMN roster_pattern_id BIGINT,
+    ADD FOREIGN KEY (domain_id, roster_pattern_id) REFERENCES wfm.roster_pattern (domain_id, id) ON DELETE SET NULL (roster_pattern_id);
+
+-- Roster pattern is a reference to the pattern, that the shift is created from.
+DROP VIEW wfm.agent_working_schedule_v;
+
+CREATE VIEW wfm.agent_working_schedule_v AS
+(
+SELECT ws.id                                                           AS working_schedule_id
+     , ws.domain_id                                                    AS domain_id
+     , call_center.cc_get_lookup(a.id, coalesce(wu.name, wu.username)) AS agent
+     , x.date                                                          AS date
+     , x.locked                                                        AS locked
+     , x.absence                                                       AS absence
+     , x.absence_start                                                 AS absence_start
+     , x.absence_end                                                   AS absence_end
+     , x.shift                                                         AS shift
+     , act.sys_name                                                    AS timezone
+FROM wfm.working_schedule ws
+         INNER JOIN wfm.working_schedule_agent wsa ON wsa.working_schedule_id = ws.id
+         INNER JOIN call_center.cc_agent a ON a.id = wsa.agent_id
+         INNER JOIN directory.wbt_user wu ON wu.id = a.user_id
+         LEFT JOIN flow.calendar ca ON ca.id = ws.calendar_id
+         LEFT JOIN flow.calendar_timezones ct ON ct.id = ca.timezone_id
+         LEFT JOIN wfm.agent_working_conditions awc ON awc.agent_id = a.id
+         LEFT JOIN flow.calendar_timezones act ON act.id = awc.timezone_id
+         LEFT JOIN LATERAL (
+    SELECT null               AS locked
+         , aa.absent_at       AS date
+         , aa.agent_id        AS agent_id
+         , call_center.cc_get_lookup(at.id, at.name) AS absence
+         , aa.start_min       AS absence_start
+         , aa.end_min         AS absence_end
+         , null::jsonb           shift
+    FROM wfm.agent_absence aa
+             INNER JOIN wfm.absence_type at ON at.id = aa.absence_type_id
+    WHERE aa.agent_id = wsa.agent_id
+      AND aa.absent_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select true
+         , aws.schedule_at
+         , wsa2.agent_id
+         , null
+         , null
+         , null
+         , null::jsonb
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN wfm.working_schedule_agent wsa2 ON wsa2.id = aws.working_schedule_agent_id
+             INNER JOIN wfm.working_schedule ws2 ON ws2.id = wsa2.working_schedule_id
+    WHERE wsa2.agent_id = wsa.agent_id
+      AND ws2.id != ws.id
+      AND aws.schedule_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select null
+         , aws.schedule_at
+         , wsa.agent_id
+         , null
+         , null
+         , null
+         , jsonb_build_object('id', aws.id
+        , 'domain_id', aws.domain_id
+        , 'created_at', aws.created_at
+        , 'created_by', call_center.cc_get_lookup(c.id, c.name)
+        , 'updated_at', aws.updated_at
+        , 'updated_by', call_center.cc_get_lookup(u.id, u.name)
+        , 'start', aws.start_min
+        , 'end', aws.end_min
+        , 'start_at', (aws.schedule_at + make_interval(mins => aws.start_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+        , 'end_at', (aws.schedule_at + make_interval(mins => aws.end_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+        , 'pauses', p.pauses
+        , 'skills', s.skills
+        , 'roster_pattern', call_center.cc_get_lookup(rp.id, rp.name))
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN directory.wbt_user c ON aws.created_by = c.id
+             LEFT JOIN directory.wbt_user u ON aws.updated_by = u.id
+             LEFT JOIN wfm.roster_pattern rp ON rp.id = aws.roster_pattern_id
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('id', id
+            , 'domain_id', domain_id
+            , 'created_at', created_at
+            , 'created_by', created_by
+            , 'updated_at', updated_at
+            , 'updated_by', updated_by
+            , 'start', start_min
+            , 'end', end_min
+            , 'start_at', (aws.schedule_at + make_interval(mins => start_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+            , 'end_at', (aws.schedule_at + make_interval(mins => end_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+            , 'cause', cause)) pauses
+        FROM wfm.agent_working_schedule_pause_v
+        WHERE agent_working_schedule_id = aws.id
+        ) p ON true
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('skill', call_center.cc_get_lookup(sk.id, sk.name)
+            , 'capacity', aws_s.capacity
+            , 'enabled', aws_s.enabled)) skills
+        FROM wfm.agent_working_schedule_skill aws_s
+                 INNER JOIN call_center.cc_skill sk ON sk.id = aws_s.skill_id
+        WHERE aws_s.agent_working_schedule_id = aws.id
+        ) s ON true
+    WHERE aws.working_schedule_agent_id = wsa.id
+    ) x ON true
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP VIEW wfm.agent_working_schedule_v;
+
+CREATE VIEW wfm.agent_working_schedule_v AS
+(
+SELECT ws.id                                                           AS working_schedule_id
+     , ws.domain_id                                                    AS domain_id
+     , call_center.cc_get_lookup(a.id, coalesce(wu.name, wu.username)) AS agent
+     , x.date                                                          AS date
+     , x.locked                                                        AS locked
+     , x.absence                                                       AS absence
+     , x.absence_start                                                 AS absence_start
+     , x.absence_end                                                   AS absence_end
+     , x.shift                                                         AS shift
+     , act.sys_name                                                    AS timezone
+FROM wfm.working_schedule ws
+         INNER JOIN wfm.working_schedule_agent wsa ON wsa.working_schedule_id = ws.id
+         INNER JOIN call_center.cc_agent a ON a.id = wsa.agent_id
+         INNER JOIN directory.wbt_user wu ON wu.id = a.user_id
+         LEFT JOIN flow.calendar ca ON ca.id = ws.calendar_id
+         LEFT JOIN flow.calendar_timezones ct ON ct.id = ca.timezone_id
+         LEFT JOIN wfm.agent_working_conditions awc ON awc.agent_id = a.id
+         LEFT JOIN flow.calendar_timezones act ON act.id = awc.timezone_id
+         LEFT JOIN LATERAL (
+    SELECT null               AS locked
+         , aa.absent_at       AS date
+         , aa.agent_id        AS agent_id
+         , call_center.cc_get_lookup(at.id, at.name) AS absence
+         , aa.start_min       AS absence_start
+         , aa.end_min         AS absence_end
+         , null::jsonb           shift
+    FROM wfm.agent_absence aa
+             INNER JOIN wfm.absence_type at ON at.id = aa.absence_type_id
+    WHERE aa.agent_id = wsa.agent_id
+      AND aa.absent_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select true
+         , aws.schedule_at
+         , wsa2.agent_id
+         , null
+         , null
+         , null
+         , null::jsonb
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN wfm.working_schedule_agent wsa2 ON wsa2.id = aws.working_schedule_agent_id
+             INNER JOIN wfm.working_schedule ws2 ON ws2.id = wsa2.working_schedule_id
+    WHERE wsa2.agent_id = wsa.agent_id
+      AND ws2.id != ws.id
+      AND aws.schedule_at BETWEEN ws.start_date_at AND ws.end_date_at
+
+    UNION ALL
+
+    select null
+         , aws.schedule_at
+         , wsa.agent_id
+         , null
+         , null
+         , null
+         , jsonb_build_object('id', aws.id
+        , 'domain_id', aws.domain_id
+        , 'created_at', aws.created_at
+        , 'created_by', call_center.cc_get_lookup(c.id, c.name)
+        , 'updated_at', aws.updated_at
+        , 'updated_by', call_center.cc_get_lookup(u.id, u.name)
+        , 'start', aws.start_min
+        , 'end', aws.end_min
+        , 'start_at', (aws.schedule_at + make_interval(mins => aws.start_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+        , 'end_at', (aws.schedule_at + make_interval(mins => aws.end_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+        , 'pauses', p.pauses
+        , 'skills', s.skills)
+    FROM wfm.agent_working_schedule aws
+             INNER JOIN directory.wbt_user c ON aws.created_by = c.id
+             LEFT JOIN directory.wbt_user u ON aws.updated_by = u.id
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('id', id
+            , 'domain_id', domain_id
+            , 'created_at', created_at
+            , 'created_by', created_by
+            , 'updated_at', updated_at
+            , 'updated_by', updated_by
+            , 'start', start_min
+            , 'end', end_min
+            , 'start_at', (aws.schedule_at + make_interval(mins => start_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+            , 'end_at', (aws.schedule_at + make_interval(mins => end_min)) AT TIME ZONE coalesce(ct.sys_name, 'UTC')
+            , 'cause', cause)) pauses
+        FROM wfm.agent_working_schedule_pause_v
+        WHERE agent_working_schedule_id = aws.id
+        ) p ON true
+             LEFT JOIN LATERAL (
+        SELECT jsonb_agg(jsonb_build_object('skill', call_center.cc_get_lookup(sk.id, sk.name)
+            , 'capacity', aws_s.capacity
+            , 'enabled', aws_s.enabled)) skills
+        FROM wfm.agent_working_schedule_skill aws_s
+                 INNER JOIN call_center.cc_skill sk ON sk.id = aws_s.skill_id
+        WHERE aws_s.agent_working_schedule_id = aws.id
+        ) s ON true
+    WHERE aws.working_schedule_agent_id = wsa.id
+    ) x ON true
+    );
+
+ALTER TABLE wfm.agent_working_schedule
+    DROP COLUMN roster_pattern_id;
+
+DROP TABLE wfm.roster_pattern_agent;
+
+DROP TABLE wfm.roster_pattern;
+-- +goose StatementEnd
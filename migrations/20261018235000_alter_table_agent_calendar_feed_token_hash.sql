@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Only a SHA-256 hash of the subscribe token is stored, existing tokens keep working.
+ALTER TABLE wfm.agent_calendar_feed
+    ADD COLUMN token_hash TEXT;
+
+UPDATE wfm.agent_calendar_feed
+SET token_hash = encode(sha256(convert_to(token, 'UTF8')), 'hex');
+
+ALTER TABLE wfm.agent_calendar_feed
+    ALTER COLUMN token_hash SET NOT NULL,
+    ADD UNIQUE (token_hash),
+    DROP COLUMN token;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Raw tokens can't be restored, so feeds should be created again.
+DELETE
+FROM wfm.agent_calendar_feed;
+
+ALTER TABLE wfm.agent_calendar_feed
+    ADD COLUMN token TEXT NOT NULL,
+    ADD UNIQUE (token),
+    DROP COLUMN token_hash;
+-- +goose StatementEnd
@@ -0,0 +1,114 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ContentType is the MIME type of iCalendar files.
+const ContentType = "text/calendar; charset=utf-8"
+
+const (
+	// lineLimit is the maximum length of a content line in octets, excluding the line break.
+	lineLimit = 75
+
+	dateFormat     = "20060102"
+	dateTimeFormat = "20060102T150405Z"
+)
+
+// Calendar is a VCALENDAR object, that is published to calendar clients.
+type Calendar struct {
+	ProdID string
+	Name   string
+	Events []*Event
+}
+
+// Event is a VEVENT component.
+// Start and End of all-day events are dates, End is exclusive, so a single day event ends on the next date.
+// Timed events are written in UTC.
+type Event struct {
+	UID         string
+	Stamp       time.Time
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Summary     string
+	Description string
+
+	// Transparent events don't block the time of the attendee.
+	Transparent bool
+}
+
+// Bytes returns the calendar in the RFC 5545 format.
+func (c *Calendar) Bytes() []byte {
+	var buf bytes.Buffer
+	line := func(name, value string) {
+		fold(&buf, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", c.ProdID)
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		line("X-WR-CALNAME", escape(c.Name))
+	}
+
+	for _, e := range c.Events {
+		line("BEGIN", "VEVENT")
+		line("UID", e.UID)
+		line("DTSTAMP", e.Stamp.UTC().Format(dateTimeFormat))
+		if e.AllDay {
+			line("DTSTART;VALUE=DATE", e.Start.Format(dateFormat))
+			line("DTEND;VALUE=DATE", e.End.Format(dateFormat))
+		} else {
+			line("DTSTART", e.Start.UTC().Format(dateTimeFormat))
+			line("DTEND", e.End.UTC().Format(dateTimeFormat))
+		}
+
+		line("SUMMARY", escape(e.Summary))
+		if e.Description != "" {
+			line("DESCRIPTION", escape(e.Description))
+		}
+
+		if e.Transparent {
+			line("TRANSP", "TRANSPARENT")
+		}
+
+		line("END", "VEVENT")
+	}
+
+	line("END", "VCALENDAR")
+
+	return buf.Bytes()
+}
+
+// escape escapes a TEXT value.
+func escape(v string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(v)
+}
+
+// fold writes a content line, lines longer than the limit are split into continuation lines,
+// that start with a space. Multi-octet characters are never split.
+func fold(buf *bytes.Buffer, line string) {
+	limit := lineLimit
+	for len(line) > limit {
+		i := limit
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+
+		buf.WriteString(line[:i])
+		buf.WriteString("\r\n ")
+		line = line[i:]
+
+		// The leading space of the continuation line counts towards the limit.
+		limit = lineLimit - 1
+	}
+
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,102 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarBytes(t *testing.T) {
+	stamp := time.Date(2026, 10, 1, 9, 30, 0, 0, time.UTC)
+	cal := &Calendar{
+		ProdID: "-//Webitel//WFM//EN",
+		Name:   "Doe, John",
+		Events: []*Event{
+			{
+				UID:         "shift-1@wfm.webitel",
+				Stamp:       stamp,
+				Start:       time.Date(2026, 10, 18, 11, 0, 0, 0, time.FixedZone("EEST", 3*60*60)),
+				End:         time.Date(2026, 10, 18, 19, 0, 0, 0, time.FixedZone("EEST", 3*60*60)),
+				Summary:     "Shift; October",
+				Description: "Pauses:\n12:00-12:30",
+			},
+			{
+				UID:         "absence-2@wfm.webitel",
+				Stamp:       stamp,
+				Start:       time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+				End:         time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC),
+				AllDay:      true,
+				Summary:     "Vacation",
+				Transparent: true,
+			},
+		},
+	}
+
+	expected := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Webitel//WFM//EN",
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		`X-WR-CALNAME:Doe\, John`,
+		"BEGIN:VEVENT",
+		"UID:shift-1@wfm.webitel",
+		"DTSTAMP:20261001T093000Z",
+		"DTSTART:20261018T080000Z",
+		"DTEND:20261018T160000Z",
+		`SUMMARY:Shift\; October`,
+		`DESCRIPTION:Pauses:\n12:00-12:30`,
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:absence-2@wfm.webitel",
+		"DTSTAMP:20261001T093000Z",
+		"DTSTART;VALUE=DATE:20261019",
+		"DTEND;VALUE=DATE:20261020",
+		"SUMMARY:Vacation",
+		"TRANSP:TRANSPARENT",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	assert.Equal(t, expected, string(cal.Bytes()))
+}
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "short",
+			input:    "SUMMARY:Shift",
+			expected: "SUMMARY:Shift\r\n",
+		},
+		{
+			name:     "long",
+			input:    "SUMMARY:" + strings.Repeat("a", 100),
+			expected: "SUMMARY:" + strings.Repeat("a", 67) + "\r\n " + strings.Repeat("a", 33) + "\r\n",
+		},
+		{
+			name:     "multi-octet",
+			input:    "SUMMARY:" + strings.Repeat("a", 66) + "ї" + "b",
+			expected: "SUMMARY:" + strings.Repeat("a", 66) + "\r\n ї" + "b\r\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			fold(&buf, tt.input)
+			assert.Equal(t, tt.expected, buf.String())
+
+			for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+				assert.LessOrEqual(t, len(line), lineLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package spreadsheet
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+// serialEpoch is the zero day of spreadsheet serial dates.
+var serialEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// dateLayouts are accepted text layouts of dates.
+var dateLayouts = []string{time.DateOnly, "02.01.2006", "2006/01/02"}
+
+// ParseDate parses a date cell, which is either a text date
+// or a serial number of days as spreadsheet editors store dates.
+func ParseDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	if serial, err := strconv.ParseFloat(s, 64); err == nil && serial >= 1 {
+		return serialEpoch.AddDate(0, 0, int(serial)), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid date %q, expected YYYY-MM-DD", s)
+}
+
+// ParseClock parses a time of day cell into minutes from midnight, which is either a text time (HH:MM or HH:MM:SS)
+// or a fraction of a day as spreadsheet editors store times. 24:00 is the end of the day.
+func ParseClock(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if h, m, ok := strings.Cut(s, ":"); ok {
+		m, _, _ = strings.Cut(m, ":")
+		hours, herr := strconv.ParseInt(h, 10, 64)
+		minutes, merr := strconv.ParseInt(m, 10, 64)
+		if herr == nil && merr == nil && hours >= 0 && minutes >= 0 && minutes < 60 {
+			if v := hours*60 + minutes; v <= minutesPerDay {
+				return v, nil
+			}
+		}
+	}
+
+	if fraction, err := strconv.ParseFloat(s, 64); err == nil && fraction >= 0 && fraction <= 1 {
+		return int64(math.Round(fraction * minutesPerDay)), nil
+	}
+
+	return 0, fmt.Errorf("invalid time %q, expected HH:MM", s)
+}
+
+// FormatClock formats minutes from midnight as HH:MM, minutes past a day are wrapped to the next day time.
+func FormatClock(minutes int64) string {
+	minutes %= minutesPerDay
+
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,171 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Format is a file format of a spreadsheet.
+type Format int32
+
+const (
+	FormatUnspecified Format = iota
+	FormatCSV
+	FormatXLSX
+)
+
+func (f Format) String() string {
+	return []string{"unspecified", "csv", "xlsx"}[f]
+}
+
+// ContentType returns the MIME type of files of the format.
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+
+	return "text/csv"
+}
+
+var ErrEmpty = errors.New("spreadsheet is empty")
+
+// zipSignature starts every XLSX file, which is a zip archive.
+var zipSignature = []byte("PK\x03\x04")
+
+// utf8BOM is written by spreadsheet editors at the start of CSV files.
+var utf8BOM = []byte("\xef\xbb\xbf")
+
+// Detect returns the format of data by its signature, anything but a zip archive is treated as CSV.
+func Detect(data []byte) Format {
+	if bytes.HasPrefix(data, zipSignature) {
+		return FormatXLSX
+	}
+
+	return FormatCSV
+}
+
+// Read returns rows of the spreadsheet, for XLSX files rows of the first sheet.
+// Cells of XLSX files hold raw values, so dates and times are serial numbers unless stored as text.
+// The format is detected if it is unspecified.
+func Read(data []byte, format Format) ([][]string, error) {
+	if format == FormatUnspecified {
+		format = Detect(data)
+	}
+
+	var (
+		rows [][]string
+		err  error
+	)
+
+	switch format {
+	case FormatCSV:
+		rows, err = readCSV(data)
+	case FormatXLSX:
+		rows, err = readXLSX(data)
+	default:
+		return nil, fmt.Errorf("unsupported spreadsheet format: %d", format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrEmpty
+	}
+
+	return rows, nil
+}
+
+// readCSV reads comma or semicolon separated values,
+// the separator is chosen by the first line as spreadsheet editors use either of them depending on the locale.
+func readCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		r.Comma = ';'
+	}
+
+	rows, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("read csv: %w", err)
+	}
+
+	return rows, nil
+}
+
+func readXLSX(data []byte) ([][]string, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("open xlsx: %w", err)
+	}
+
+	defer f.Close()
+
+	rows, err := f.GetRows(f.GetSheetName(0), excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, fmt.Errorf("read xlsx: %w", err)
+	}
+
+	return rows, nil
+}
+
+// Write returns the spreadsheet file with rows, for XLSX files rows are written into the first sheet.
+// Unspecified format is written as CSV.
+func Write(rows [][]string, format Format) ([]byte, error) {
+	switch format {
+	case FormatUnspecified, FormatCSV:
+		return writeCSV(rows)
+	case FormatXLSX:
+		return writeXLSX(rows)
+	}
+
+	return nil, fmt.Errorf("unsupported spreadsheet format: %d", format)
+}
+
+func writeCSV(rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("write csv: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeXLSX(rows [][]string) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheet := f.GetSheetName(0)
+	for i, row := range rows {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return nil, err
+		}
+
+		values := make([]any, 0, len(row))
+		for _, v := range row {
+			values = append(values, v)
+		}
+
+		if err := f.SetSheetRow(sheet, cell, &values); err != nil {
+			return nil, fmt.Errorf("write xlsx: %w", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := f.Write(&buf); err != nil {
+		return nil, fmt.Errorf("write xlsx: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
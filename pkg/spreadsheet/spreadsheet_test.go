@@ -0,0 +1,164 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestRead(t *testing.T) {
+	xlsx := excelize.NewFile()
+	require.NoError(t, xlsx.SetSheetRow("Sheet1", "A1", &[]any{"agent", "date"}))
+	require.NoError(t, xlsx.SetSheetRow("Sheet1", "A2", &[]any{"John", 46313}))
+
+	var buf bytes.Buffer
+	require.NoError(t, xlsx.Write(&buf))
+
+	tests := []struct {
+		name     string
+		data     []byte
+		format   Format
+		expected [][]string
+		err      bool
+	}{
+		{
+			name:     "comma separated",
+			data:     []byte("agent,date\nJohn,2026-10-18\n"),
+			expected: [][]string{{"agent", "date"}, {"John", "2026-10-18"}},
+		},
+		{
+			name:     "semicolon separated with bom",
+			data:     []byte("\xef\xbb\xbfagent;date\nDoe, John;2026-10-18\n"),
+			format:   FormatCSV,
+			expected: [][]string{{"agent", "date"}, {"Doe, John", "2026-10-18"}},
+		},
+		{
+			name:     "detected xlsx",
+			data:     buf.Bytes(),
+			expected: [][]string{{"agent", "date"}, {"John", "46313"}},
+		},
+		{
+			name: "empty",
+			data: []byte(""),
+			err:  true,
+		},
+		{
+			name:   "malformed xlsx",
+			data:   []byte("agent,date\n"),
+			format: FormatXLSX,
+			err:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := Read(tt.data, tt.format)
+			if tt.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, rows)
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	rows := [][]string{{"agent", "2026-10-18"}, {"Doe, John", "08:00-16:00"}}
+	for _, format := range []Format{FormatCSV, FormatXLSX} {
+		t.Run(format.String(), func(t *testing.T) {
+			data, err := Write(rows, format)
+			require.NoError(t, err)
+			assert.Equal(t, format, Detect(data))
+
+			read, err := Read(data, format)
+			require.NoError(t, err)
+			assert.Equal(t, rows, read)
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Time
+		err      bool
+	}{
+		{input: "2026-10-18", expected: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{input: "18.10.2026", expected: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{input: " 2026/10/18 ", expected: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{input: "46313", expected: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{input: "tomorrow", err: true},
+		{input: "0.5", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			date, err := ParseDate(tt.input)
+			if tt.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, date)
+		})
+	}
+}
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+		err      bool
+	}{
+		{input: "08:00", expected: 480},
+		{input: "8:30", expected: 510},
+		{input: "22:15:00", expected: 1335},
+		{input: "24:00", expected: 1440},
+		{input: "0.75", expected: 1080},
+		{input: "24:01", err: true},
+		{input: "08:60", err: true},
+		{input: "noon", err: true},
+		{input: "1.5", err: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			minutes, err := ParseClock(tt.input)
+			if tt.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, minutes)
+		})
+	}
+}
+
+func TestFormatClock(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{input: 0, expected: "00:00"},
+		{input: 510, expected: "08:30"},
+		{input: 1440, expected: "00:00"},
+		{input: 1800, expected: "06:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, FormatClock(tt.input))
+		})
+	}
+}
@@ -3,3 +3,12 @@ package pkg
 func ToPTR[T any](s T) *T {
 	return &s
 }
+
+func FromPTR[T any](s *T) T {
+	var out T
+	if s != nil {
+		out = *s
+	}
+
+	return out
+}